@@ -360,6 +360,7 @@ func NewShentuApp(
 		app.AccountKeeper,
 		app.CertKeeper,
 		app.BankKeeper,
+		bountyDistrKeeper{Keeper: app.DistrKeeper, bankKeeper: app.BankKeeper},
 		authtypes.NewModuleAddress(sdkgovtypes.ModuleName).String(),
 	)
 	app.MintKeeper = mintkeeper.NewKeeper(
@@ -0,0 +1,39 @@
+package app
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrkeeper "github.com/cosmos/cosmos-sdk/x/distribution/keeper"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	bankkeeper "github.com/shentufoundation/shentu/v2/x/bank/keeper"
+)
+
+// bountyDistrKeeper extends the distribution keeper with community pool spends to a module
+// account, so that the bounty module can be funded by governance without a transit account.
+type bountyDistrKeeper struct {
+	distrkeeper.Keeper
+
+	bankKeeper bankkeeper.Keeper
+}
+
+// DistributeFromFeePoolToModule sends coins of the community pool from the distribution module
+// account to a module account and deducts them from the fee pool.
+func (k bountyDistrKeeper) DistributeFromFeePoolToModule(ctx context.Context, amount sdk.Coins, recipientModule string) error {
+	feePool, err := k.FeePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	newPool, negative := feePool.CommunityPool.SafeSub(sdk.NewDecCoinsFromCoins(amount...))
+	if negative {
+		return distrtypes.ErrBadDistribution
+	}
+	feePool.CommunityPool = newPool
+
+	if err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, distrtypes.ModuleName, recipientModule, amount); err != nil {
+		return err
+	}
+	return k.FeePool.Set(ctx, feePool)
+}
@@ -44,6 +44,7 @@ import (
 	"github.com/shentufoundation/shentu/v2/common"
 	authcli "github.com/shentufoundation/shentu/v2/x/auth/client/cli"
 	bankcli "github.com/shentufoundation/shentu/v2/x/bank/client/cli"
+	bountycli "github.com/shentufoundation/shentu/v2/x/bounty/client/cli"
 )
 
 const EnvPrefix = "SHENTU"
@@ -185,6 +186,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
+		bountycli.GetOpenMathCmd(),
 	)
 }
 
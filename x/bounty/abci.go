@@ -16,26 +16,48 @@ func EndBlocker(ctx sdk.Context, k *keeper.Keeper) error {
 	logger := ctx.Logger().With("module", "x/"+types.ModuleName)
 
 	rngProof := collections.NewPrefixUntilPairRange[time.Time, string](ctx.BlockTime())
-	err := k.ActiveProofsQueue.Walk(ctx, rngProof, func(_ collections.Pair[time.Time, string], value types.Proof) (stop bool, err error) {
-		// Only delete proofs in hash_lock phase
-		if value.Status != types.ProofStatus_PROOF_STATUS_HASH_LOCK_PERIOD {
-			return false, nil
+	var expiredProofs []string
+	err := k.ActiveProofsQueue.Walk(ctx, rngProof, func(key collections.Pair[time.Time, string]) (stop bool, err error) {
+		expiredProofs = append(expiredProofs, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+	for _, proofID := range expiredProofs {
+		proof, err := k.Proofs.Get(ctx, proofID)
+		if err != nil {
+			return err
+		}
+		// revealed proofs checkers did not decide on time, with or without split verdicts, are refunded
+		if proof.Status == types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD {
+			if err = k.ExpireRevealedProof(ctx, proof); err != nil {
+				return err
+			}
+
+			logger.Info(
+				"proof was not decided by checkers on time; refunded",
+				"proof_id", proof.Id,
+				"theorem", proof.TheoremId,
+			)
+			continue
+		}
+		if proof.Status != types.ProofStatus_PROOF_STATUS_HASH_LOCK_PERIOD {
+			continue
 		}
 
-		err = k.DeleteProof(ctx, value.Id)
-		if err != nil {
-			return false, err
+		if err = k.ForfeitDeposits(ctx, proof, nil); err != nil {
+			return err
+		}
+		if err = k.DeleteProof(ctx, proof.Id); err != nil {
+			return err
 		}
 
 		logger.Info(
 			"proof did not submit detail on time; expired",
-			"proof_id", value.Id,
+			"proof_id", proof.Id,
+			"theorem", proof.TheoremId,
 		)
-
-		return false, nil
-	})
-	if err != nil {
-		return err
 	}
 
 	// delete dead theorems from store and returns theirs grant.
@@ -67,6 +89,11 @@ func EndBlocker(ctx sdk.Context, k *keeper.Keeper) error {
 			return false, err
 		}
 
+		err = k.ReleaseForfeitedDeposits(ctx, theorem)
+		if err != nil {
+			return false, err
+		}
+
 		logger.Info(
 			"theorem did not meet correct proof on time; deleted",
 			"theorem", theorem.Id,
@@ -80,5 +107,55 @@ func EndBlocker(ctx sdk.Context, k *keeper.Keeper) error {
 		return err
 	}
 
+	// escalate findings the program team did not handle within its SLA to bounty admin review.
+	escalated, err := k.EscalateBreachedFindings(ctx, ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	for _, finding := range escalated {
+		logger.Info(
+			"finding SLA breached; escalated to bounty admins",
+			"finding_id", finding.FindingId,
+			"program_id", finding.ProgramId,
+		)
+	}
+
+	// flag paid and closed findings whose disclosure embargo ended as overdue for disclosure.
+	overdue, err := k.FlagOverdueDisclosures(ctx, ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	for _, finding := range overdue {
+		logger.Info(
+			"finding disclosure embargo ended; overdue for disclosure",
+			"finding_id", finding.FindingId,
+			"program_id", finding.ProgramId,
+		)
+	}
+
+	// apply the outcome of finding disputes whose voting window ended.
+	disputes, err := k.ResolveEndedDisputes(ctx, ctx.BlockTime())
+	if err != nil {
+		return err
+	}
+	for _, dispute := range disputes {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeResolveDispute,
+				sdk.NewAttribute(types.AttributeKeyFindingID, dispute.FindingId),
+				sdk.NewAttribute(types.AttributeKeyProgramID, dispute.ProgramId),
+				sdk.NewAttribute(types.AttributeKeyOutcome, dispute.Status.String()),
+			),
+		)
+
+		logger.Info(
+			"finding dispute resolved",
+			"finding_id", dispute.FindingId,
+			"outcome", dispute.Status.String(),
+			"uphold_votes", dispute.UpholdVotes,
+			"overturn_votes", dispute.OverturnVotes,
+		)
+	}
+
 	return nil
 }
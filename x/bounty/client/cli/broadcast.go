@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+)
+
+// txBroadcaster signs and broadcasts a series of transactions from the same account. The account
+// sequence is tracked locally so that a transaction does not wait for the previous ones to be committed.
+type txBroadcaster struct {
+	clientCtx client.Context
+	txf       tx.Factory
+}
+
+func newTxBroadcaster(clientCtx client.Context, txf tx.Factory) *txBroadcaster {
+	return &txBroadcaster{clientCtx: clientCtx, txf: txf}
+}
+
+// broadcast signs and broadcasts a transaction with the given messages.
+func (b *txBroadcaster) broadcast(msgs ...sdk.Msg) (*sdk.TxResponse, error) {
+	txf, err := b.txf.Prepare(b.clientCtx)
+	if err != nil {
+		return nil, err
+	}
+	if txf.SimulateAndExecute() {
+		_, adjusted, err := tx.CalculateGas(b.clientCtx, txf, msgs...)
+		if err != nil {
+			return nil, err
+		}
+		txf = txf.WithGas(adjusted)
+	}
+
+	unsignedTx, err := txf.BuildUnsignedTx(msgs...)
+	if err != nil {
+		return nil, err
+	}
+	if err = tx.Sign(b.clientCtx.CmdContext, txf, b.clientCtx.FromName, unsignedTx, true); err != nil {
+		return nil, err
+	}
+	txBytes, err := b.clientCtx.TxConfig.TxEncoder()(unsignedTx.GetTx())
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := b.clientCtx.BroadcastTx(txBytes)
+	if err != nil {
+		return nil, err
+	}
+	if res.Code != 0 {
+		if res.Codespace == sdkerrors.ErrWrongSequence.Codespace() && res.Code == sdkerrors.ErrWrongSequence.ABCICode() {
+			// query the sequence again on the next broadcast
+			b.txf = txf.WithSequence(0)
+		}
+		return res, fmt.Errorf("tx %s failed with code %d: %s", res.TxHash, res.Code, res.RawLog)
+	}
+
+	b.txf = txf.WithSequence(txf.Sequence() + 1)
+	return res, nil
+}
+
+// waitForTx polls the node until a broadcast transaction is committed and fails if it was rejected.
+func (b *txBroadcaster) waitForTx(ctx context.Context, hash string, pollInterval time.Duration) error {
+	for {
+		res, err := authtx.QueryTx(b.clientCtx, hash)
+		if err == nil {
+			if res.Code != 0 {
+				return fmt.Errorf("tx %s failed with code %d: %s", hash, res.Code, res.RawLog)
+			}
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(pollInterval):
+		}
+	}
+}
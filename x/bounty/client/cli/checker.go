@@ -0,0 +1,449 @@
+package cli
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"os"
+	"os/exec"
+	"os/signal"
+	"slices"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	grpcstatus "google.golang.org/grpc/status"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+const (
+	FlagVerifier        = "verifier"
+	FlagVerifierTimeout = "verifier-timeout"
+	FlagPollInterval    = "poll-interval"
+	FlagStartHeight     = "start-height"
+)
+
+// VerifierInput is the JSON document written to the stdin of the local verifier.
+type VerifierInput struct {
+	ProofID   string `json:"proof_id"`
+	TheoremID uint64 `json:"theorem_id"`
+	// TheoremType is the declared language of the theorem (rocq/lean).
+	TheoremType string `json:"theorem_type"`
+	TheoremCode string `json:"theorem_code"`
+	ProofDetail string `json:"proof_detail"`
+}
+
+// VerifierOutput is the JSON document the local verifier prints on stdout.
+type VerifierOutput struct {
+	// Status is either "passed" or "failed".
+	Status     string   `json:"status"`
+	Complexity int64    `json:"complexity"`
+	Imports    []uint64 `json:"imports"`
+}
+
+// GetOpenMathCmd returns the OpenMath off-chain commands.
+func GetOpenMathCmd() *cobra.Command {
+	openMathCmd := &cobra.Command{
+		Use:                        "openmath",
+		Short:                      "OpenMath off-chain tooling",
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	openMathCmd.AddCommand(
+		GetCmdChecker(),
+	)
+
+	return openMathCmd
+}
+
+// GetCmdChecker implements the OpenMath checker daemon command.
+func GetCmdChecker() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checker",
+		Short: "Run an OpenMath checker that verifies submitted proofs with a local verifier",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Follow the submit_proof_detail events of the node, verify every submitted proof with a local
+verifier command and broadcast the verdict as a MsgSubmitProofVerification signed by the --from key.
+The proofs already waiting for verification when the checker starts are verified as well, and a
+verdict that is not recorded on chain is broadcast again until the proof is decided.
+
+The verifier is any executable, e.g. a lean or coqc wrapper. It receives on stdin a JSON document
+{"proof_id", "theorem_id", "theorem_type", "theorem_code", "proof_detail"} and must print on stdout
+a JSON document {"status": "passed"|"failed", "complexity": <int>, "imports": [<theorem-id>...]}.
+A verifier exiting with an error or timing out leaves the proof to the other checkers.
+
+Example:
+$ %s openmath checker --verifier "/usr/local/bin/lean-verify --strict" --from checker --chain-id shentu-2.2
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			clientCtx = clientCtx.WithSkipConfirmation(true)
+
+			verifier, err := cmd.Flags().GetString(FlagVerifier)
+			if err != nil {
+				return err
+			}
+			verifierArgs := strings.Fields(verifier)
+			if len(verifierArgs) == 0 {
+				return fmt.Errorf("verifier command cannot be empty")
+			}
+			verifierTimeout, err := cmd.Flags().GetDuration(FlagVerifierTimeout)
+			if err != nil {
+				return err
+			}
+			pollInterval, err := cmd.Flags().GetDuration(FlagPollInterval)
+			if err != nil {
+				return err
+			}
+			startHeight, err := cmd.Flags().GetInt64(FlagStartHeight)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			ctx, stop := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+			defer stop()
+
+			c := &checker{
+				clientCtx:       clientCtx,
+				queryClient:     types.NewQueryClient(clientCtx),
+				broadcaster:     newTxBroadcaster(clientCtx, txf),
+				verifierArgs:    verifierArgs,
+				verifierTimeout: verifierTimeout,
+				out:             cmd.ErrOrStderr(),
+			}
+			return c.run(ctx, startHeight, pollInterval)
+		},
+	}
+
+	cmd.Flags().String(FlagVerifier, "", "The local verifier command, run for each proof")
+	cmd.Flags().Duration(FlagVerifierTimeout, 10*time.Minute, "Maximum duration of a single verification")
+	cmd.Flags().Duration(FlagPollInterval, 5*time.Second, "Interval between polls of the node for new blocks")
+	cmd.Flags().Int64(FlagStartHeight, 0, "The first block height to scan for proofs, 0 starts from the next block. Proofs waiting for verification at startup are always verified")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagVerifier)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// rebroadcastBlocks is the number of blocks after which a verdict still not recorded on chain is
+// broadcast again: its transaction failed, e.g. because an earlier proof had to be decided first, or
+// it was dropped from the mempool.
+const rebroadcastBlocks = 5
+
+// checker verifies the proofs submitted on chain with a local verifier and broadcasts its verdicts.
+type checker struct {
+	clientCtx       client.Context
+	queryClient     types.QueryClient
+	broadcaster     *txBroadcaster
+	verifierArgs    []string
+	verifierTimeout time.Duration
+	out             io.Writer
+	// pending are the proofs waiting for the verdict of the checker to be recorded, by proof id.
+	pending map[string]*pendingProof
+}
+
+// pendingProof tracks the verdict of the checker on a proof until it is recorded on chain.
+type pendingProof struct {
+	// msg is the verdict of the local verifier, nil until the proof is verified.
+	msg *types.MsgSubmitProofVerification
+	// broadcastHeight is the height of the last broadcast of the verdict, 0 until it is broadcast.
+	broadcastHeight int64
+}
+
+func (c *checker) logf(format string, args ...interface{}) {
+	_, _ = fmt.Fprintf(c.out, "%s %s\n", time.Now().UTC().Format(time.RFC3339), fmt.Sprintf(format, args...))
+}
+
+// run loads the proofs already waiting for verification, then scans the blocks from the start height
+// and keeps following the new blocks until the context is done. Proofs whose verdict could not be
+// recorded are retried on every poll.
+func (c *checker) run(ctx context.Context, height int64, pollInterval time.Duration) error {
+	c.logf("checker %s started", c.clientCtx.GetFromAddress())
+	if c.pending == nil {
+		c.pending = make(map[string]*pendingProof)
+	}
+	loaded := false
+	for {
+		status, err := c.clientCtx.Client.Status(ctx)
+		if err != nil {
+			c.logf("failed to query node status: %v", err)
+		} else {
+			latest := status.SyncInfo.LatestBlockHeight
+			if !loaded {
+				if err = c.loadPending(ctx); err != nil {
+					c.logf("failed to load the proofs waiting for verification: %v", err)
+				} else {
+					loaded = true
+				}
+			}
+			if height <= 0 {
+				height = latest + 1
+			}
+			for ; height <= latest && ctx.Err() == nil; height++ {
+				if err = c.processBlock(ctx, height); err != nil {
+					c.logf("failed to process block %d: %v", height, err)
+					break
+				}
+			}
+			c.processPending(ctx, latest)
+		}
+
+		select {
+		case <-ctx.Done():
+			c.logf("checker stopped at height %d", height)
+			return nil
+		case <-time.After(pollInterval):
+		}
+	}
+}
+
+// loadPending adds the proofs of the theorems in proof period waiting for verification.
+func (c *checker) loadPending(ctx context.Context) error {
+	var nextKey []byte
+	for {
+		theoremsRes, err := c.queryClient.Theorems(ctx, &types.QueryTheoremsRequest{Pagination: &query.PageRequest{Key: nextKey}})
+		if err != nil {
+			return err
+		}
+		for _, theorem := range theoremsRes.Theorems {
+			if theorem.Status != types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD {
+				continue
+			}
+			if err = c.loadTheoremProofs(ctx, theorem.Id); err != nil {
+				return err
+			}
+		}
+		if theoremsRes.Pagination == nil || len(theoremsRes.Pagination.NextKey) == 0 {
+			return nil
+		}
+		nextKey = theoremsRes.Pagination.NextKey
+	}
+}
+
+// loadTheoremProofs adds the proofs of a theorem waiting for verification.
+func (c *checker) loadTheoremProofs(ctx context.Context, theoremID uint64) error {
+	var nextKey []byte
+	for {
+		proofsRes, err := c.queryClient.Proofs(ctx, &types.QueryProofsRequest{TheoremId: theoremID, Pagination: &query.PageRequest{Key: nextKey}})
+		if err != nil {
+			return err
+		}
+		for _, proof := range proofsRes.Proofs {
+			if proof.Status == types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD {
+				c.addPending(proof.Id)
+			}
+		}
+		if proofsRes.Pagination == nil || len(proofsRes.Pagination.NextKey) == 0 {
+			return nil
+		}
+		nextKey = proofsRes.Pagination.NextKey
+	}
+}
+
+func (c *checker) addPending(proofID string) {
+	if _, ok := c.pending[proofID]; !ok {
+		c.pending[proofID] = &pendingProof{}
+	}
+}
+
+// processBlock adds the proofs whose detail was submitted in a block to the pending proofs.
+func (c *checker) processBlock(ctx context.Context, height int64) error {
+	results, err := c.clientCtx.Client.BlockResults(ctx, &height)
+	if err != nil {
+		return err
+	}
+
+	for _, txResult := range results.TxsResults {
+		if txResult.Code != 0 {
+			continue
+		}
+		for _, proofID := range submittedProofIDs(txResult.Events) {
+			c.addPending(proofID)
+		}
+	}
+	return nil
+}
+
+// submittedProofIDs returns the ids of the proofs in the submit_proof_detail events.
+func submittedProofIDs(events []abci.Event) []string {
+	var proofIDs []string
+	for _, event := range events {
+		if event.Type != types.EventTypeSubmitProofDetail {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == types.AttributeKeyProofID {
+				proofIDs = append(proofIDs, attr.Value)
+			}
+		}
+	}
+	return proofIDs
+}
+
+// processPending processes the pending proofs and drops the ones that no longer need a verdict.
+func (c *checker) processPending(ctx context.Context, height int64) {
+	for _, proofID := range slices.Sorted(maps.Keys(c.pending)) {
+		if ctx.Err() != nil {
+			return
+		}
+		done, err := c.processProof(ctx, proofID, c.pending[proofID], height)
+		if err != nil {
+			c.logf("proof %s: %v", proofID, err)
+		}
+		if done {
+			delete(c.pending, proofID)
+		}
+	}
+}
+
+// processProof verifies a proof waiting for verification and broadcasts the verdict. It reports
+// whether the proof is done: its verdict is recorded, it was decided, or the verifier gave up on it.
+// Otherwise the proof is processed again on the next poll.
+func (c *checker) processProof(ctx context.Context, proofID string, pending *pendingProof, height int64) (bool, error) {
+	proofRes, err := c.queryClient.Proof(ctx, &types.QueryProofRequest{ProofId: proofID})
+	if err != nil {
+		if grpcstatus.Code(err) == codes.NotFound {
+			c.logf("proof %s no longer exists, skipped", proofID)
+			return true, nil
+		}
+		return false, err
+	}
+	proof := proofRes.Proof
+	if proof.Status != types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD {
+		c.logf("proof %s is %s, skipped", proofID, proof.Status)
+		return true, nil
+	}
+	for _, verdict := range proofRes.Verdicts {
+		if verdict.Checker == c.clientCtx.GetFromAddress().String() {
+			c.logf("proof %s verified", proofID)
+			return true, nil
+		}
+	}
+	// wait for the last broadcast to be committed
+	if pending.broadcastHeight > 0 && height < pending.broadcastHeight+rebroadcastBlocks {
+		return false, nil
+	}
+
+	if pending.msg == nil {
+		theoremRes, err := c.queryClient.Theorem(ctx, &types.QueryTheoremRequest{TheoremId: proof.TheoremId})
+		if err != nil {
+			return false, err
+		}
+		theorem := theoremRes.Theorem
+		// verifications of theorems whose type could not be inferred are rejected
+		if theorem.TheoremType == types.TheoremType_THEOREM_TYPE_UNSPECIFIED {
+			c.logf("theorem %d of proof %s has no type, skipped", theorem.Id, proofID)
+			return true, nil
+		}
+
+		detail := proof.Detail
+		if len(proof.DetailChunks) > 0 {
+			var buf strings.Builder
+			if err = writeProofDetail(ctx, c.queryClient, proof, &buf); err != nil {
+				return false, err
+			}
+			detail = buf.String()
+		}
+
+		// a verifier failing leaves the proof to the other checkers
+		output, err := c.verify(ctx, VerifierInput{
+			ProofID:     proof.Id,
+			TheoremID:   theorem.Id,
+			TheoremType: theoremTypeName(theorem.TheoremType),
+			TheoremCode: theorem.Code,
+			ProofDetail: detail,
+		})
+		if err != nil {
+			return true, fmt.Errorf("verifier: %w", err)
+		}
+		msg, err := verdictMsg(proof.Id, c.clientCtx.GetFromAddress().String(), theorem.TheoremType, output)
+		if err != nil {
+			return true, fmt.Errorf("verifier: %w", err)
+		}
+		pending.msg = msg
+		c.logf("proof %s of theorem %d: %s, complexity %d, imports %v", proof.Id, theorem.Id, msg.Status, msg.Complexity, msg.Imports)
+	}
+
+	res, err := c.broadcaster.broadcast(pending.msg)
+	if err != nil {
+		return false, err
+	}
+	pending.broadcastHeight = height
+	c.logf("broadcast tx %s", res.TxHash)
+	return false, nil
+}
+
+// theoremTypeName returns the name of a theorem type as accepted by --theorem-type.
+func theoremTypeName(t types.TheoremType) string {
+	return strings.ToLower(strings.TrimPrefix(t.String(), "THEOREM_TYPE_"))
+}
+
+// verify runs the local verifier on a proof.
+func (c *checker) verify(ctx context.Context, input VerifierInput) (VerifierOutput, error) {
+	var output VerifierOutput
+	bz, err := json.Marshal(input)
+	if err != nil {
+		return output, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.verifierTimeout)
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, c.verifierArgs[0], c.verifierArgs[1:]...) //nolint:gosec // the verifier is configured by the operator
+	cmd.Stdin = bytes.NewReader(bz)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	if err = cmd.Run(); err != nil {
+		return output, fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+
+	if err = json.Unmarshal(stdout.Bytes(), &output); err != nil {
+		return output, fmt.Errorf("invalid output: %w", err)
+	}
+	return output, nil
+}
+
+// verdictMsg builds the verification message of a proof of a theorem of the given type from the
+// verifier output.
+func verdictMsg(proofID, checker string, theoremType types.TheoremType, output VerifierOutput) (*types.MsgSubmitProofVerification, error) {
+	var status types.ProofStatus
+	switch strings.ToLower(output.Status) {
+	case "passed":
+		status = types.ProofStatus_PROOF_STATUS_PASSED
+	case "failed":
+		status = types.ProofStatus_PROOF_STATUS_FAILED
+	default:
+		return nil, fmt.Errorf("invalid status %q, expected passed or failed", output.Status)
+	}
+
+	return types.NewMsgSubmitProofVerification(proofID, status, checker, output.Complexity, output.Imports, theoremType), nil
+}
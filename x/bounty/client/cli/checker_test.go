@@ -0,0 +1,119 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+func TestSubmittedProofIDs(t *testing.T) {
+	detailEvent := func(proofID string) abci.Event {
+		return abci.Event{
+			Type: types.EventTypeSubmitProofDetail,
+			Attributes: []abci.EventAttribute{
+				{Key: types.AttributeKeyTheoremID, Value: "1"},
+				{Key: types.AttributeKeyProofID, Value: proofID},
+				{Key: types.AttributeKeyProver, Value: "prover"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		events []abci.Event
+		want   []string
+	}{
+		{
+			"no events",
+			nil,
+			nil,
+		},
+		{
+			"proof detail submitted",
+			[]abci.Event{detailEvent("proof-1")},
+			[]string{"proof-1"},
+		},
+		{
+			"other events ignored",
+			[]abci.Event{
+				{Type: types.EventTypeSubmitProofHash, Attributes: []abci.EventAttribute{{Key: types.AttributeKeyProofID, Value: "proof-1"}}},
+				{Type: "transfer"},
+				detailEvent("proof-2"),
+			},
+			[]string{"proof-2"},
+		},
+		{
+			"several proofs in order",
+			[]abci.Event{detailEvent("proof-2"), detailEvent("proof-1")},
+			[]string{"proof-2", "proof-1"},
+		},
+		{
+			"event without proof id",
+			[]abci.Event{{Type: types.EventTypeSubmitProofDetail, Attributes: []abci.EventAttribute{{Key: types.AttributeKeyTheoremID, Value: "1"}}}},
+			nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, submittedProofIDs(tt.events))
+		})
+	}
+}
+
+func TestVerdictMsg(t *testing.T) {
+	tests := []struct {
+		name        string
+		theoremType types.TheoremType
+		output      VerifierOutput
+		wantStatus  types.ProofStatus
+		wantType    types.TheoremType
+		wantErr     bool
+	}{
+		{
+			"passed proof of a declared theorem",
+			types.TheoremType_THEOREM_TYPE_ROCQ,
+			VerifierOutput{Status: "passed", Complexity: 12, Imports: []uint64{3, 4}},
+			types.ProofStatus_PROOF_STATUS_PASSED,
+			types.TheoremType_THEOREM_TYPE_ROCQ,
+			false,
+		},
+		{
+			"failed proof, status is case insensitive",
+			types.TheoremType_THEOREM_TYPE_LEAN,
+			VerifierOutput{Status: "FAILED"},
+			types.ProofStatus_PROOF_STATUS_FAILED,
+			types.TheoremType_THEOREM_TYPE_LEAN,
+			false,
+		},
+		{
+			"invalid status",
+			types.TheoremType_THEOREM_TYPE_ROCQ,
+			VerifierOutput{Status: "unknown"},
+			types.ProofStatus_PROOF_STATUS_UNSPECIFIED,
+			types.TheoremType_THEOREM_TYPE_UNSPECIFIED,
+			true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			msg, err := verdictMsg("proof-1", "checker", tt.theoremType, tt.output)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "proof-1", msg.ProofId)
+			require.Equal(t, "checker", msg.Checker)
+			require.Equal(t, tt.wantStatus, msg.Status)
+			require.Equal(t, tt.wantType, msg.TheoremType)
+			require.Equal(t, tt.output.Complexity, msg.Complexity)
+			require.Equal(t, tt.output.Imports, msg.Imports)
+		})
+	}
+}
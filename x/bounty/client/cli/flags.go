@@ -15,16 +15,43 @@ const (
 	FlagTheoremID   = "theorem-id"
 	FlagProofID     = "proof-id"
 	FlagStatus      = "status"
+	FlagRewardPool  = "reward-pool"
+	FlagReward      = "reward"
+
+	FlagRewardSchedule = "reward-schedule"
+	FlagEncrypt        = "encrypt"
+	FlagDecrypt        = "decrypt"
+
+	FlagCriticalApprovals = "critical-approvals"
+	FlagDuplicatePolicy   = "duplicate-policy"
+	FlagDuplicateOf       = "duplicate-of"
+	FlagActivationSLA     = "activation-sla"
+	FlagConfirmationSLA   = "confirmation-sla"
+	FlagDisclosureEmbargo = "disclosure-embargo"
+	FlagOverdue           = "overdue"
+	FlagScope             = "scope"
+	FlagTargetID          = "target-id"
+
+	FlagMinConfirmedFindings = "min-confirmed-findings"
+	FlagRequireIdentityCert  = "require-identity-cert"
 
 	FlagFindingProofOfContent = "poc"
 	FlagFindingSeverityLevel  = "severity-level"
 	FlagFindingPaymentHash    = "payment-hash"
 	FlagFindingFingerprint    = "fingerprint"
 	FlagSubmitterAddress      = "submitter-address"
+	FlagAdminAddress          = "admin-address"
+	FlagCreatedAfter          = "created-after"
+	FlagCreatedBefore         = "created-before"
 
 	FlagComplexity  = "complexity"
 	FlagImports     = "imports"
 	FlagTheoremType = "theorem-type"
+	FlagFormat      = "format"
+	FlagCompress    = "compress"
+	FlagChunkSize   = "chunk-size"
+	FlagOutputFile  = "output-file"
+	FlagDenom       = "denom"
 
 	FlagRequireOpenMathCert = "require-openmath-cert"
 )
@@ -1,14 +1,20 @@
 package cli
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/crypto"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
@@ -28,17 +34,31 @@ func GetQueryCmd() *cobra.Command {
 	bountyQueryCmd.AddCommand(
 		GetCmdQueryProgram(),
 		GetCmdQueryPrograms(),
+		GetCmdQueryProgramMembers(),
+		GetCmdQuerySponsorships(),
 		GetCmdQueryFinding(),
+		GetCmdQueryDispute(),
+		GetCmdQueryDisclosures(),
 		GetCmdQueryFindings(),
 		GetCmdQueryFindingFingerprint(),
 		GetCmdQueryProgramFingerprint(),
+		GetCmdQueryHacker(),
+		GetCmdQueryHackers(),
 		GetCmdQueryTheorem(),
+		GetCmdQueryTheoremByCodeHash(),
 		GetCmdQueryProof(),
+		GetCmdQueryProofDetail(),
 		GetCmdQueryTheorems(),
 		GetCmdQueryRewards(),
 		GetCmdQueryParams(),
 		GetCmdQueryProofs(),
 		GetCmdQueryGrants(),
+		GetCmdQueryTheoremDependents(),
+		GetCmdQueryTheoremDependencies(),
+		GetCmdQueryTheoremGraph(),
+		GetCmdQueryOpenMathStats(),
+		GetCmdQueryTheoremTypeStats(),
+		GetCmdQueryLeaderboard(),
 	)
 
 	return bountyQueryCmd
@@ -82,6 +102,98 @@ $ %s query bounty program 1
 	return cmd
 }
 
+// GetCmdQueryProgramMembers implements the query program members command.
+func GetCmdQueryProgramMembers() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "program-members [program-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the team members of a program",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the triagers and admins of a program team.
+
+Example:
+$ %s query bounty program-members 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.ProgramMembers(
+				cmd.Context(),
+				&types.QueryProgramMembersRequest{
+					ProgramId:  args[0],
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "program members")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySponsorships implements the query sponsorships command.
+func GetCmdQuerySponsorships() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsorships [program-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the sponsorships of a program",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the amount each sponsor deposited into the reward pool of a program.
+
+Example:
+$ %s query bounty sponsorships 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Sponsorships(
+				cmd.Context(),
+				&types.QuerySponsorshipsRequest{
+					ProgramId:  args[0],
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "sponsorships")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryPrograms implements the query programs command.
 func GetCmdQueryPrograms() *cobra.Command {
 	cmd := &cobra.Command{
@@ -92,8 +204,10 @@ func GetCmdQueryPrograms() *cobra.Command {
 
 Example:
 $ %s query bounty programs --page=1 --limit=100
+$ %s query bounty programs --status active --reverse
+$ %s query bounty programs --admin-address cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
 `,
-				version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -103,6 +217,15 @@ $ %s query bounty programs --page=1 --limit=100
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
+			programStatus, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			adminAddr, err := cmd.Flags().GetString(FlagAdminAddress)
+			if err != nil {
+				return err
+			}
+
 			pageReq, err := client.ReadPageRequest(cmd.Flags())
 			if err != nil {
 				return err
@@ -111,7 +234,9 @@ $ %s query bounty programs --page=1 --limit=100
 			res, err := queryClient.Programs(
 				cmd.Context(),
 				&types.QueryProgramsRequest{
-					Pagination: pageReq,
+					Status:       programStatus,
+					AdminAddress: adminAddr,
+					Pagination:   pageReq,
 				})
 			if err != nil {
 				return err
@@ -125,6 +250,8 @@ $ %s query bounty programs --page=1 --limit=100
 		},
 	}
 
+	cmd.Flags().String(FlagStatus, "", "(optional) filter by program status: inactive, active or closed")
+	cmd.Flags().String(FlagAdminAddress, "", "(optional) filter by program admin address")
 	flags.AddPaginationFlagsToCmd(cmd, "programs")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
@@ -140,8 +267,11 @@ func GetCmdQueryFinding() *cobra.Command {
 			fmt.Sprintf(`Query details for a finding. You can find the finding-id by running "%s query bounty findings".
 Example:
 $ %s query bounty finding 1
+
+The encrypted payload of a finding can be decrypted with the local key of a program team member:
+$ %s query bounty finding 1 --decrypt admin-key --keyring-backend test
 `,
-				version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -161,6 +291,92 @@ $ %s query bounty finding 1
 				return err
 			}
 
+			keyName, err := cmd.Flags().GetString(FlagDecrypt)
+			if err != nil {
+				return err
+			}
+			if len(keyName) == 0 {
+				return clientCtx.PrintProto(res)
+			}
+
+			payload, err := decryptFindingPayload(clientCtx, keyName, res.Finding.EncryptedPayload)
+			if err != nil {
+				return err
+			}
+			out, err := json.Marshal(struct {
+				FindingID string               `json:"finding_id"`
+				Payload   types.FindingPayload `json:"payload"`
+				HashValid bool                 `json:"hash_valid"`
+			}{
+				FindingID: res.Finding.FindingId,
+				Payload:   payload,
+				HashValid: payload.Hash(res.Finding.SubmitterAddress) == res.Finding.FindingHash,
+			})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	cmd.Flags().String(FlagDecrypt, "", "Name of the local key used to decrypt the finding's encrypted payload")
+	cmd.Flags().String(flags.FlagKeyringBackend, flags.DefaultKeyringBackend, "Select keyring's backend (os|file|kwallet|pass|test|memory)")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// decryptFindingPayload decrypts the encrypted payload with the private key of the named local key.
+func decryptFindingPayload(clientCtx client.Context, keyName string, encryptedPayload *types.EncryptedFindingPayload) (types.FindingPayload, error) {
+	if encryptedPayload == nil {
+		return types.FindingPayload{}, fmt.Errorf("finding has no encrypted payload")
+	}
+	if clientCtx.Keyring == nil {
+		return types.FindingPayload{}, fmt.Errorf("keyring is not available")
+	}
+	armor, err := clientCtx.Keyring.ExportPrivKeyArmor(keyName, "")
+	if err != nil {
+		return types.FindingPayload{}, err
+	}
+	privKey, _, err := crypto.UnarmorDecryptPrivKey(armor, "")
+	if err != nil {
+		return types.FindingPayload{}, err
+	}
+	if privKey.Type() != "secp256k1" {
+		return types.FindingPayload{}, fmt.Errorf("key %s has an unsupported %s private key", keyName, privKey.Type())
+	}
+	return types.DecryptFindingPayload(sdk.AccAddress(privKey.PubKey().Address()).String(), privKey.Bytes(), encryptedPayload)
+}
+
+// GetCmdQueryDispute implements the query dispute command.
+func GetCmdQueryDispute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute [finding-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the dispute of a finding and its votes",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the dispute of a finding and the votes of bounty admins.
+Example:
+$ %s query bounty dispute 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Dispute(
+				cmd.Context(),
+				&types.QueryDisputeRequest{
+					FindingId: args[0],
+				})
+			if err != nil {
+				return err
+			}
+
 			return clientCtx.PrintProto(res)
 		},
 	}
@@ -180,9 +396,13 @@ func GetCmdQueryFindings() *cobra.Command {
 Example:
 $ %s query bounty findings --program-id 1
 $ %s query bounty findings --submitter-address cosmos1skjwj5whet0lpe65qaq4rpq03hjxlwd9nf39lk
+$ %s query bounty findings --duplicate-of 1
+$ %s query bounty findings --program-id 1 --target-id vault
+$ %s query bounty findings --status confirmed --severity-level critical
+$ %s query bounty findings --created-after 2024-01-01T00:00:00Z --reverse
 $ %s query bounty findings --page=1 --limit=100
 `,
-				version.AppName, version.AppName, version.AppName,
+				version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName, version.AppName,
 			),
 		),
 		RunE: func(cmd *cobra.Command, _ []string) error {
@@ -200,6 +420,36 @@ $ %s query bounty findings --page=1 --limit=100
 				_ = sdk.MustAccAddressFromBech32(submitterAddr)
 			}
 
+			duplicateOf, err := cmd.Flags().GetString(FlagDuplicateOf)
+			if err != nil {
+				return err
+			}
+
+			targetID, err := cmd.Flags().GetString(FlagTargetID)
+			if err != nil {
+				return err
+			}
+			if len(targetID) != 0 && len(pid) == 0 {
+				return fmt.Errorf("--%s requires --%s", FlagTargetID, FlagProgramID)
+			}
+
+			findingStatus, err := cmd.Flags().GetString(FlagStatus)
+			if err != nil {
+				return err
+			}
+			severityLevel, err := cmd.Flags().GetString(FlagFindingSeverityLevel)
+			if err != nil {
+				return err
+			}
+			createdAfter, err := readTimeFlag(cmd, FlagCreatedAfter)
+			if err != nil {
+				return err
+			}
+			createdBefore, err := readTimeFlag(cmd, FlagCreatedBefore)
+			if err != nil {
+				return err
+			}
+
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
@@ -213,13 +463,20 @@ $ %s query bounty findings --page=1 --limit=100
 
 			req := &types.QueryFindingsRequest{
 				SubmitterAddress: submitterAddr,
+				DuplicateOf:      duplicateOf,
+				TargetId:         targetID,
+				Status:           findingStatus,
+				SeverityLevel:    severityLevel,
+				CreatedAfter:     createdAfter,
+				CreatedBefore:    createdBefore,
 				Pagination:       pageReq,
 			}
 			if len(pid) != 0 {
 				req.ProgramId = pid
 			}
 
-			if len(req.ProgramId) == 0 && len(req.SubmitterAddress) == 0 {
+			if len(req.ProgramId) == 0 && len(req.SubmitterAddress) == 0 && len(req.DuplicateOf) == 0 &&
+				len(req.Status) == 0 && len(req.SeverityLevel) == 0 && req.CreatedAfter == nil && req.CreatedBefore == nil {
 				return fmt.Errorf("invalid request")
 			}
 			res, err := queryClient.Findings(cmd.Context(), req)
@@ -237,11 +494,30 @@ $ %s query bounty findings --page=1 --limit=100
 
 	cmd.Flags().String(FlagProgramID, "", "(optional) filter by programs find by program id")
 	cmd.Flags().String(FlagSubmitterAddress, "", "(optional) filter by programs find by submitter address")
+	cmd.Flags().String(FlagDuplicateOf, "", "(optional) filter by duplicates of the finding id")
+	cmd.Flags().String(FlagTargetID, "", "(optional) filter by the program scope target id, requires --program-id")
+	cmd.Flags().String(FlagStatus, "", "(optional) filter by finding status, e.g. confirmed")
+	cmd.Flags().String(FlagFindingSeverityLevel, "", "(optional) filter by finding severity level, e.g. critical")
+	cmd.Flags().String(FlagCreatedAfter, "", "(optional) filter by findings created at or after the RFC3339 time")
+	cmd.Flags().String(FlagCreatedBefore, "", "(optional) filter by findings created before the RFC3339 time")
 	flags.AddPaginationFlagsToCmd(cmd, "findings")
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
 
+// readTimeFlag returns the RFC3339 time set by the flag, or nil if the flag is empty.
+func readTimeFlag(cmd *cobra.Command, flag string) (*time.Time, error) {
+	value, err := cmd.Flags().GetString(flag)
+	if err != nil || value == "" {
+		return nil, err
+	}
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return nil, err
+	}
+	return &t, nil
+}
+
 // GetCmdQueryFindingFingerprint implements the query finding fingerPrint command.
 func GetCmdQueryFindingFingerprint() *cobra.Command {
 	cmd := &cobra.Command{
@@ -363,6 +639,61 @@ $ %s query bounty theorem 1
 	return cmd
 }
 
+// GetCmdQueryTheoremByCodeHash implements the query theorem by code hash command.
+func GetCmdQueryTheoremByCodeHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "theorem-by-code-hash [code-hash]",
+		Args:  cobra.RangeArgs(0, 1),
+		Short: "Query the theorem with the same normalized code",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the theorem indexed by the sha256 hash of its normalized code, given either the hash
+or the code with --code, e.g. to check that a theorem is not a duplicate before creating it.
+
+Example:
+$ %s query bounty theorem-by-code-hash [code-hash]
+$ %s query bounty theorem-by-code-hash --code "theorem add_zero (n : Nat) : n + 0 = n"
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			code, err := cmd.Flags().GetString(FlagCode)
+			if err != nil {
+				return err
+			}
+			var codeHash string
+			switch {
+			case len(args) == 1 && code == "":
+				codeHash = args[0]
+			case len(args) == 0 && code != "":
+				codeHash = types.TheoremCodeHash(code)
+			default:
+				return fmt.Errorf("either a code hash or --%s is required", FlagCode)
+			}
+
+			res, err := queryClient.TheoremByCodeHash(
+				cmd.Context(),
+				&types.QueryTheoremByCodeHashRequest{CodeHash: codeHash},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagCode, "", "The theorem code to hash, instead of the code hash")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetCmdQueryProof implements the query proof command.
 func GetCmdQueryProof() *cobra.Command {
 	cmd := &cobra.Command{
@@ -401,12 +732,82 @@ $ %s query bounty proof "hash"
 	return cmd
 }
 
+// GetCmdQueryProofDetail implements the query proof detail command.
+func GetCmdQueryProofDetail() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof-detail [proof-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Download the detail of a proof",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Download the detail of a proof, fetching the detail submitted in chunks one chunk at a time.
+The detail is written to stdout unless an output file is given.
+Example:
+$ %s query bounty proof-detail "hash" --output-file proof.lean
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			outputFile, err := cmd.Flags().GetString(FlagOutputFile)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Proof(cmd.Context(), &types.QueryProofRequest{ProofId: args[0]})
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			if outputFile != "" {
+				file, err := os.Create(outputFile)
+				if err != nil {
+					return err
+				}
+				defer file.Close()
+				out = file
+			}
+
+			return writeProofDetail(cmd.Context(), queryClient, res.Proof, out)
+		},
+	}
+
+	cmd.Flags().String(FlagOutputFile, "", "The file to write the proof detail to")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// writeProofDetail writes the detail of a proof, querying its chunks in order if it was submitted in chunks.
+func writeProofDetail(ctx context.Context, queryClient types.QueryClient, proof *types.Proof, w io.Writer) error {
+	if len(proof.DetailChunks) == 0 {
+		_, err := io.WriteString(w, proof.Detail)
+		return err
+	}
+
+	for i := range proof.DetailChunks {
+		res, err := queryClient.ProofChunk(ctx, &types.QueryProofChunkRequest{ProofId: proof.Id, Index: uint32(i)})
+		if err != nil {
+			return fmt.Errorf("chunk %d: %w", i, err)
+		}
+		if _, err = w.Write(res.Data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetCmdQueryTheorems implements the query all theorems command.
 func GetCmdQueryTheorems() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "theorems",
 		Short: "Query all theorems",
-		Long:  "Query all theorems with optional pagination",
+		Long:  "Query all theorems with optional type filter and pagination",
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -418,9 +819,21 @@ func GetCmdQueryTheorems() *cobra.Command {
 				return err
 			}
 
+			var theoremType types.TheoremType
+			theoremTypeStr, err := cmd.Flags().GetString(FlagTheoremType)
+			if err != nil {
+				return err
+			}
+			if theoremTypeStr != "" {
+				if theoremType, err = parseTheoremType(theoremTypeStr); err != nil {
+					return err
+				}
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 			res, err := queryClient.Theorems(cmd.Context(), &types.QueryTheoremsRequest{
-				Pagination: pageReq,
+				Pagination:  pageReq,
+				TheoremType: theoremType,
 			})
 			if err != nil {
 				return err
@@ -430,6 +843,7 @@ func GetCmdQueryTheorems() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(FlagTheoremType, "", "Filter theorems by type (rocq/lean)")
 	flags.AddPaginationFlagsToCmd(cmd, "theorems")
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -475,32 +889,32 @@ $ %s query bounty rewards [address]
 	return cmd
 }
 
-// GetCmdQueryParams implements the query params command.
-func GetCmdQueryParams() *cobra.Command {
+// GetCmdQueryHacker implements the query hacker command.
+func GetCmdQueryHacker() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "params",
-		Args:  cobra.NoArgs,
-		Short: "Query the current bounty module parameters",
+		Use:   "hacker [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the reputation of a finding submitter",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query the current bounty module parameters.
+			fmt.Sprintf(`Query the confirmed findings by severity, the paid and closed findings, the total rewards
+and the acceptance rate of a finding submitter.
 
 Example:
-$ %s query bounty params
+$ %s query bounty hacker [address]
 `,
 				version.AppName,
 			),
 		),
-		RunE: func(cmd *cobra.Command, _ []string) error {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
 				return err
 			}
 			queryClient := types.NewQueryClient(clientCtx)
 
-			// Query the params
-			res, err := queryClient.Params(
+			res, err := queryClient.Hacker(
 				cmd.Context(),
-				&types.QueryParamsRequest{},
+				&types.QueryHackerRequest{Address: args[0]},
 			)
 			if err != nil {
 				return err
@@ -514,17 +928,153 @@ $ %s query bounty params
 	return cmd
 }
 
-// GetCmdQueryProofs implements the query proofs command.
-func GetCmdQueryProofs() *cobra.Command {
+// GetCmdQueryHackers implements the query hackers command.
+func GetCmdQueryHackers() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "proofs [theorem-id]",
-		Args:  cobra.ExactArgs(1),
-		Short: "Query all proofs for a theorem",
+		Use:   "hackers",
+		Short: "Query the reputation of all finding submitters",
 		Long: strings.TrimSpace(
-			fmt.Sprintf(`Query all proofs for a theorem by theorem ID.
+			fmt.Sprintf(`Query for the paginated reputation records of all finding submitters.
 
 Example:
-$ %s query bounty proofs 1
+$ %s query bounty hackers --page=1 --limit=100
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Hackers(
+				cmd.Context(),
+				&types.QueryHackersRequest{
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, "hackers")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDisclosures implements the query disclosures command.
+func GetCmdQueryDisclosures() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "disclosures",
+		Short: "Query the findings under disclosure embargo",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query for the paginated paid or closed findings under disclosure embargo, ordered by deadline.
+Use --overdue to list the findings whose embargo ended without being published instead.
+
+Example:
+$ %s query bounty disclosures
+$ %s query bounty disclosures --overdue
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			overdue, err := cmd.Flags().GetBool(FlagOverdue)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Disclosures(
+				cmd.Context(),
+				&types.QueryDisclosuresRequest{
+					Overdue:    overdue,
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Bool(FlagOverdue, false, "(optional) list the findings overdue for disclosure")
+	flags.AddPaginationFlagsToCmd(cmd, "disclosures")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryParams implements the query params command.
+func GetCmdQueryParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "Query the current bounty module parameters",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current bounty module parameters.
+
+Example:
+$ %s query bounty params
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			// Query the params
+			res, err := queryClient.Params(
+				cmd.Context(),
+				&types.QueryParamsRequest{},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryProofs implements the query proofs command.
+func GetCmdQueryProofs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proofs [theorem-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query all proofs for a theorem",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query all proofs for a theorem by theorem ID.
+
+Example:
+$ %s query bounty proofs 1
 `,
 				version.AppName,
 			),
@@ -611,3 +1161,328 @@ $ %s query bounty grants 1
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryTheoremDependents implements the query theorem dependents command.
+func GetCmdQueryTheoremDependents() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "theorem-dependents [theorem-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the theorems that import a theorem",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the theorems that directly import a theorem.
+
+Example:
+$ %s query bounty theorem-dependents 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theoremID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("theorem-id %s is not a valid uint, please input a valid theorem-id", args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TheoremDependents(
+				cmd.Context(),
+				&types.QueryTheoremDependentsRequest{
+					TheoremId:  theoremID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "theorem dependents")
+	return cmd
+}
+
+// GetCmdQueryTheoremDependencies implements the query theorem dependencies command.
+func GetCmdQueryTheoremDependencies() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "theorem-dependencies [theorem-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the theorems a theorem depends on",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the theorems imported directly or indirectly by a theorem.
+
+Example:
+$ %s query bounty theorem-dependencies 1
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theoremID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("theorem-id %s is not a valid uint, please input a valid theorem-id", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TheoremDependencies(
+				cmd.Context(),
+				&types.QueryTheoremDependenciesRequest{TheoremId: theoremID},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTheoremGraph implements the query theorem graph command.
+func GetCmdQueryTheoremGraph() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "theorem-graph [theorem-id]",
+		Args:  cobra.MaximumNArgs(1),
+		Short: "Export the theorem import graph",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Export the theorem import graph as JSON or as a Graphviz DOT digraph. Without a
+theorem-id the whole library is exported page by page, otherwise the graph of the theorem
+and its dependencies. Edges point from the importing theorem to the imported theorem.
+
+Example:
+$ %s query bounty theorem-graph --format dot | dot -Tsvg > library.svg
+$ %s query bounty theorem-graph 1 --format json
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var theoremID uint64
+			if len(args) == 1 {
+				theoremID, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("theorem-id %s is not a valid uint, please input a valid theorem-id", args[0])
+				}
+			}
+
+			format, err := cmd.Flags().GetString(FlagFormat)
+			if err != nil {
+				return err
+			}
+			if format != "json" && format != "dot" {
+				return fmt.Errorf("invalid format %s, expected json or dot", format)
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.TheoremGraph(
+				cmd.Context(),
+				&types.QueryTheoremGraphRequest{
+					TheoremId:  theoremID,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			if format == "dot" {
+				return clientCtx.PrintString(theoremGraphDOT(res))
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagFormat, "json", "Output format of the graph (json|dot)")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "theorem graph edges")
+	return cmd
+}
+
+// theoremGraphDOT renders a theorem import graph as a Graphviz DOT digraph.
+func theoremGraphDOT(graph *types.QueryTheoremGraphResponse) string {
+	var b strings.Builder
+	b.WriteString("digraph theorems {\n")
+	for _, node := range graph.Nodes {
+		status := strings.TrimPrefix(node.Status.String(), "THEOREM_STATUS_")
+		label := fmt.Sprintf("#%d %s\\n%s", node.Id, dotEscape(node.Title), status)
+		fmt.Fprintf(&b, "  %d [label=\"%s\"];\n", node.Id, label)
+	}
+	for _, edge := range graph.Edges {
+		fmt.Fprintf(&b, "  %d -> %d;\n", edge.Importer, edge.Imported)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotEscape escapes a string for a quoted DOT label.
+func dotEscape(s string) string {
+	s = strings.ReplaceAll(s, `\`, `\\`)
+	s = strings.ReplaceAll(s, `"`, `\"`)
+	return strings.ReplaceAll(s, "\n", " ")
+}
+
+// GetCmdQueryOpenMathStats implements the query openmath statistics command.
+func GetCmdQueryOpenMathStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "openmath-stats [address]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the OpenMath statistics of an address",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the theorems proven, the proofs failed and checked, and the prover, checker and
+imported rewards earned by an address.
+
+Example:
+$ %s query bounty openmath-stats [address]
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OpenMathStats(
+				cmd.Context(),
+				&types.QueryOpenMathStatsRequest{Address: args[0]},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTheoremTypeStats implements the query theorem type statistics command.
+func GetCmdQueryTheoremTypeStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "theorem-type-stats",
+		Args:  cobra.NoArgs,
+		Short: "Query the OpenMath statistics of every theorem type",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the theorems proven, the proofs failed and the rewards distributed by theorem type.
+
+Example:
+$ %s query bounty theorem-type-stats
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.TheoremTypeStats(cmd.Context(), &types.QueryTheoremTypeStatsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryLeaderboard implements the query leaderboard command.
+func GetCmdQueryLeaderboard() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "leaderboard [metric]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Query the OpenMath leaderboard of a metric",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the addresses ranked by a metric, the highest score first. The metric is one of
+theorems-proven, proofs-checked, prover-rewards, checker-rewards, imported-rewards and total-rewards.
+The reward metrics rank the rewards in the --denom denom. Use --reverse for the lowest scores first.
+
+Example:
+$ %s query bounty leaderboard theorems-proven --limit=10
+$ %s query bounty leaderboard prover-rewards --denom=uctk --limit=10
+`,
+				version.AppName, version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			metric, err := types.LeaderboardMetricFromString(args[0])
+			if err != nil {
+				return err
+			}
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+			if metric.IsRewardMetric() && denom == "" {
+				return fmt.Errorf("--%s is required for the %s leaderboard", FlagDenom, args[0])
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.Leaderboard(
+				cmd.Context(),
+				&types.QueryLeaderboardRequest{
+					Metric:     metric,
+					Denom:      denom,
+					Pagination: pageReq,
+				})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(FlagDenom, "", "The reward denom, required for the reward metrics")
+	flags.AddPaginationFlagsToCmd(cmd, "leaderboard")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
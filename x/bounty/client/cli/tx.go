@@ -3,17 +3,23 @@ package cli
 import (
 	"crypto/sha256"
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/klauspost/compress/zstd"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/shentufoundation/shentu/v2/x/bounty/types"
 )
@@ -30,6 +36,9 @@ func NewTxCmd() *cobra.Command {
 		NewEditProgramCmd(),
 		NewActivateProgramCmd(),
 		NewCloseProgramCmd(),
+		NewFundProgramCmd(),
+		NewAddProgramMemberCmd(),
+		NewRemoveProgramMemberCmd(),
 		NewSubmitFindingCmd(),
 		NewEditFindingCmd(),
 		NewActivateFindingCmd(),
@@ -37,10 +46,16 @@ func NewTxCmd() *cobra.Command {
 		NewConfirmFindingPaidCmd(),
 		NewCloseFindingCmd(),
 		NewPublishFindingCmd(),
+		NewMarkDuplicateFindingCmd(),
+		NewDisputeFindingCmd(),
+		NewVoteDisputeCmd(),
 		NewCreateTheoremCmd(),
 		NewGrantTheoremCmd(),
+		NewWithdrawGrantCmd(),
+		NewCloseTheoremCmd(),
 		NewSubmitProofHashCmd(),
 		NewSubmitProofDetailCmd(),
+		NewUploadProofDetailCmd(),
 		NewSubmitProofVerificationCmd(),
 		NewWithdrawRewardCmd(),
 	)
@@ -71,8 +86,55 @@ func NewCreateProgramCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			flagRewardPool, err := cmd.Flags().GetString(FlagRewardPool)
+			if err != nil {
+				return err
+			}
+			rewardPool, err := sdk.ParseCoinsNormalized(flagRewardPool)
+			if err != nil {
+				return err
+			}
+			flagRewardSchedule, err := cmd.Flags().GetString(FlagRewardSchedule)
+			if err != nil {
+				return err
+			}
+			rewardSchedule, err := parseRewardSchedule(flagRewardSchedule)
+			if err != nil {
+				return err
+			}
+
+			criticalApprovals, err := cmd.Flags().GetUint32(FlagCriticalApprovals)
+			if err != nil {
+				return err
+			}
+
+			duplicatePolicy := types.DuplicatePolicyUnspecified
+			flagDuplicatePolicy, err := cmd.Flags().GetString(FlagDuplicatePolicy)
+			if err != nil {
+				return err
+			}
+			if len(flagDuplicatePolicy) != 0 {
+				if duplicatePolicy, err = types.DuplicatePolicyFromString(flagDuplicatePolicy); err != nil {
+					return err
+				}
+			}
 
-			msg := types.NewMsgCreateProgram(pid, name, detail, creatorAddr)
+			msg := types.NewMsgCreateProgram(pid, name, detail, creatorAddr, rewardPool, rewardSchedule, criticalApprovals, duplicatePolicy)
+			if msg.Scope, err = readScopeFlag(cmd, clientCtx); err != nil {
+				return err
+			}
+			if msg.SubmissionRequirements, err = readSubmissionRequirementsFlags(cmd); err != nil {
+				return err
+			}
+			if msg.ActivationSla, err = readDurationFlag(cmd, FlagActivationSLA); err != nil {
+				return err
+			}
+			if msg.ConfirmationSla, err = readDurationFlag(cmd, FlagConfirmationSLA); err != nil {
+				return err
+			}
+			if msg.DisclosureEmbargo, err = readDurationFlag(cmd, FlagDisclosureEmbargo); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -81,6 +143,16 @@ func NewCreateProgramCmd() *cobra.Command {
 	cmd.Flags().String(FlagProgramID, "", "The program's id")
 	cmd.Flags().String(FlagName, "", "The program's name")
 	cmd.Flags().String(FlagDetail, "", "The program's detail")
+	cmd.Flags().String(FlagRewardPool, "", "The program's reward pool locked in escrow")
+	cmd.Flags().String(FlagRewardSchedule, "", "The program's reward per severity level, e.g. critical=1000uctk:5000uctk;high=500uctk")
+	cmd.Flags().Uint32(FlagCriticalApprovals, 0, "The number of program admins that must confirm a critical finding")
+	cmd.Flags().String(FlagDuplicatePolicy, "", "How rewards are shared with duplicate findings: first-reporter or equal-split")
+	cmd.Flags().Duration(FlagActivationSLA, 0, "The time to activate or close a submitted finding before it is escalated, 0 for no limit")
+	cmd.Flags().Duration(FlagConfirmationSLA, 0, "The time to confirm or close an active finding before it is escalated, 0 for no limit")
+	cmd.Flags().Duration(FlagDisclosureEmbargo, 0, "The time paid or closed findings stay confidential before either party can publish them, 0 for no embargo")
+	cmd.Flags().String(FlagScope, "", "Path to a JSON file with the program's scope targets")
+	cmd.Flags().Uint64(FlagMinConfirmedFindings, 0, "The number of confirmed findings a hacker needs to submit findings")
+	cmd.Flags().Bool(FlagRequireIdentityCert, false, "Require hackers to hold an identity certificate to submit findings")
 	flags.AddTxFlagsToCmd(cmd)
 
 	_ = cmd.MarkFlagRequired(FlagProgramID)
@@ -114,8 +186,51 @@ func NewEditProgramCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
+			flagRewardSchedule, err := cmd.Flags().GetString(FlagRewardSchedule)
+			if err != nil {
+				return err
+			}
+			rewardSchedule, err := parseRewardSchedule(flagRewardSchedule)
+			if err != nil {
+				return err
+			}
 
-			msg := types.NewMsgEditProgram(pid, name, detail, creatorAddr)
+			criticalApprovals, err := cmd.Flags().GetUint32(FlagCriticalApprovals)
+			if err != nil {
+				return err
+			}
+
+			duplicatePolicy := types.DuplicatePolicyUnspecified
+			flagDuplicatePolicy, err := cmd.Flags().GetString(FlagDuplicatePolicy)
+			if err != nil {
+				return err
+			}
+			if len(flagDuplicatePolicy) != 0 {
+				if duplicatePolicy, err = types.DuplicatePolicyFromString(flagDuplicatePolicy); err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgEditProgram(pid, name, detail, creatorAddr, rewardSchedule, criticalApprovals, duplicatePolicy)
+			if msg.Scope, err = readScopeFlag(cmd, clientCtx); err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(FlagMinConfirmedFindings) || cmd.Flags().Changed(FlagRequireIdentityCert) {
+				requirements, err := readSubmissionRequirementsFlags(cmd)
+				if err != nil {
+					return err
+				}
+				msg.SubmissionRequirements = &requirements
+			}
+			if msg.ActivationSla, err = readDurationFlag(cmd, FlagActivationSLA); err != nil {
+				return err
+			}
+			if msg.ConfirmationSla, err = readDurationFlag(cmd, FlagConfirmationSLA); err != nil {
+				return err
+			}
+			if msg.DisclosureEmbargo, err = readDurationFlag(cmd, FlagDisclosureEmbargo); err != nil {
+				return err
+			}
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -124,6 +239,15 @@ func NewEditProgramCmd() *cobra.Command {
 	cmd.Flags().String(FlagProgramID, "", "The program's id")
 	cmd.Flags().String(FlagName, "", "The program's name")
 	cmd.Flags().String(FlagDetail, "", "The program's detail")
+	cmd.Flags().String(FlagRewardSchedule, "", "The program's reward per severity level, e.g. critical=1000uctk:5000uctk;high=500uctk")
+	cmd.Flags().Uint32(FlagCriticalApprovals, 0, "The number of program admins that must confirm a critical finding")
+	cmd.Flags().String(FlagDuplicatePolicy, "", "How rewards are shared with duplicate findings: first-reporter or equal-split")
+	cmd.Flags().Duration(FlagActivationSLA, 0, "The time to activate or close a submitted finding before it is escalated, 0 for no limit")
+	cmd.Flags().Duration(FlagConfirmationSLA, 0, "The time to confirm or close an active finding before it is escalated, 0 for no limit")
+	cmd.Flags().Duration(FlagDisclosureEmbargo, 0, "The time paid or closed findings stay confidential before either party can publish them, 0 for no embargo")
+	cmd.Flags().String(FlagScope, "", "Path to a JSON file with the program's scope targets")
+	cmd.Flags().Uint64(FlagMinConfirmedFindings, 0, "The number of confirmed findings a hacker needs to submit findings")
+	cmd.Flags().Bool(FlagRequireIdentityCert, false, "Require hackers to hold an identity certificate to submit findings")
 	flags.AddTxFlagsToCmd(cmd)
 
 	_ = cmd.MarkFlagRequired(FlagProgramID)
@@ -132,6 +256,40 @@ func NewEditProgramCmd() *cobra.Command {
 	return cmd
 }
 
+// parseRewardSchedule parses a reward schedule of the form "critical=1000uctk:5000uctk;high=500uctk".
+// A single amount defines a fixed reward.
+func parseRewardSchedule(s string) ([]types.SeverityReward, error) {
+	var schedule []types.SeverityReward
+	for _, entry := range strings.Split(s, ";") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		levelStr, amountStr, ok := strings.Cut(entry, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid reward schedule entry: %s", entry)
+		}
+		level, err := types.SeverityLevelFromString(types.NormalizeSeverityLevel(strings.TrimSpace(levelStr)))
+		if err != nil {
+			return nil, err
+		}
+		minStr, maxStr, isRange := strings.Cut(amountStr, ":")
+		if !isRange {
+			maxStr = minStr
+		}
+		minAmount, err := sdk.ParseCoinsNormalized(minStr)
+		if err != nil {
+			return nil, err
+		}
+		maxAmount, err := sdk.ParseCoinsNormalized(maxStr)
+		if err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, types.NewSeverityReward(level, minAmount, maxAmount))
+	}
+	return schedule, nil
+}
+
 func NewActivateProgramCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "activate-program [program-id]",
@@ -172,6 +330,87 @@ func NewCloseProgramCmd() *cobra.Command {
 	return cmd
 }
 
+func NewFundProgramCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fund-program [program-id] [amount]",
+		Args:  cobra.ExactArgs(2),
+		Short: "sponsor an active program by depositing into its reward pool",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			fromAddr := clientCtx.GetFromAddress()
+
+			amount, err := sdk.ParseCoinsNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgFundProgram(args[0], fromAddr, amount)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewAddProgramMemberCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "add-program-member [program-id] [member-address] [triager|admin]",
+		Args:  cobra.ExactArgs(3),
+		Short: "add a member to the program team or change its role",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			fromAddr := clientCtx.GetFromAddress()
+
+			memberAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+			role, err := types.ProgramRoleFromString(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgAddProgramMember(args[0], memberAddr, role, fromAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
+func NewRemoveProgramMemberCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-program-member [program-id] [member-address]",
+		Args:  cobra.ExactArgs(2),
+		Short: "remove a member from the program team",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			fromAddr := clientCtx.GetFromAddress()
+
+			memberAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRemoveProgramMember(args[0], memberAddr, fromAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
 func NewSubmitFindingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "submit-finding",
@@ -210,7 +449,17 @@ func NewSubmitFindingCmd() *cobra.Command {
 			}
 			hash := sha256.Sum256([]byte(desc + poc + submitAddr.String()))
 
-			msg := types.NewMsgSubmitFinding(pid, fid, hex.EncodeToString(hash[:]), submitAddr, byteSeverityLevel)
+			encryptedPayload, err := encryptFindingPayload(cmd, clientCtx, pid, desc, poc)
+			if err != nil {
+				return err
+			}
+
+			targetID, err := cmd.Flags().GetString(FlagTargetID)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSubmitFinding(pid, fid, targetID, hex.EncodeToString(hash[:]), submitAddr, byteSeverityLevel, encryptedPayload)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -220,6 +469,10 @@ func NewSubmitFindingCmd() *cobra.Command {
 	cmd.Flags().String(FlagDescription, "", "The finding's description")
 	cmd.Flags().String(FlagFindingProofOfContent, "", "The finding's proof of content")
 	cmd.Flags().String(FlagFindingSeverityLevel, "unspecified", "The finding's severity level")
+	cmd.Flags().String(FlagTargetID, "", "The id of the program scope target affected by the finding")
+	cmd.Flags().Bool(FlagEncrypt, false, "Attach the finding encrypted to the public keys of the program team")
+	cmd.Flags().String(FlagTitle, "", "The finding's title, included in the encrypted payload")
+	cmd.Flags().String(FlagDetail, "", "The finding's detail, included in the encrypted payload")
 	flags.AddTxFlagsToCmd(cmd)
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
@@ -285,7 +538,24 @@ func NewEditFindingCmd() *cobra.Command {
 				return err
 			}
 
-			msg := types.NewMsgEditFinding(fid, hashString, paymentHash, submitAddr, byteSeverityLevel)
+			// the encrypted payload of a finding is replaced along with its hash
+			var encryptedPayload *types.EncryptedFindingPayload
+			if len(hashString) > 0 {
+				res, err := types.NewQueryClient(clientCtx).Finding(cmd.Context(), &types.QueryFindingRequest{FindingId: fid})
+				if err != nil {
+					return err
+				}
+				if res.Finding.EncryptedPayload != nil {
+					encryptedPayload, err = encryptToProgramTeam(cmd, clientCtx, res.Finding.ProgramId, desc, poc)
+				} else {
+					encryptedPayload, err = encryptFindingPayload(cmd, clientCtx, res.Finding.ProgramId, desc, poc)
+				}
+				if err != nil {
+					return err
+				}
+			}
+
+			msg := types.NewMsgEditFinding(fid, hashString, paymentHash, submitAddr, byteSeverityLevel, encryptedPayload)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -295,6 +565,9 @@ func NewEditFindingCmd() *cobra.Command {
 	cmd.Flags().String(FlagFindingProofOfContent, "", "The finding's proof of content")
 	cmd.Flags().String(FlagFindingSeverityLevel, "unspecified", "The finding's severity level")
 	cmd.Flags().String(FlagFindingPaymentHash, "", "The finding's payment hash")
+	cmd.Flags().Bool(FlagEncrypt, false, "Attach the edited finding encrypted to the public keys of the program team, always done if the finding has an encrypted payload")
+	cmd.Flags().String(FlagTitle, "", "The finding's title, included in the encrypted payload")
+	cmd.Flags().String(FlagDetail, "", "The finding's detail, included in the encrypted payload")
 	flags.AddTxFlagsToCmd(cmd)
 
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
@@ -339,12 +612,21 @@ func NewConfirmFindingCmd() *cobra.Command {
 			if err != nil {
 				return err
 			}
-			msg := types.NewMsgConfirmFinding(args[0], fingerprint, submitAddr)
+			flagReward, err := cmd.Flags().GetString(FlagReward)
+			if err != nil {
+				return err
+			}
+			reward, err := sdk.ParseCoinsNormalized(flagReward)
+			if err != nil {
+				return err
+			}
+			msg := types.NewMsgConfirmFinding(args[0], fingerprint, submitAddr, reward)
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
 
 	cmd.Flags().String(FlagFindingFingerprint, "", "The finding's fingerprint")
+	cmd.Flags().String(FlagReward, "", "The reward paid to the submitter from the program reward pool")
 	flags.AddTxFlagsToCmd(cmd)
 
 	_ = cmd.MarkFlagRequired(FlagFindingFingerprint)
@@ -394,6 +676,78 @@ func NewCloseFindingCmd() *cobra.Command {
 	return cmd
 }
 
+// readScopeFlag returns the scope targets read from the JSON file set by the flag, or nil if the flag is not set.
+func readScopeFlag(cmd *cobra.Command, clientCtx client.Context) ([]types.ScopeTarget, error) {
+	path, err := cmd.Flags().GetString(FlagScope)
+	if err != nil || path == "" {
+		return nil, err
+	}
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var rawTargets []json.RawMessage
+	if err = json.Unmarshal(contents, &rawTargets); err != nil {
+		return nil, err
+	}
+	scope := make([]types.ScopeTarget, len(rawTargets))
+	for i, rawTarget := range rawTargets {
+		if err = clientCtx.Codec.UnmarshalJSON(rawTarget, &scope[i]); err != nil {
+			return nil, err
+		}
+	}
+	return scope, nil
+}
+
+// readSubmissionRequirementsFlags returns the program submission requirements set by the flags.
+func readSubmissionRequirementsFlags(cmd *cobra.Command) (types.SubmissionRequirements, error) {
+	minConfirmedFindings, err := cmd.Flags().GetUint64(FlagMinConfirmedFindings)
+	if err != nil {
+		return types.SubmissionRequirements{}, err
+	}
+	requireIdentityCert, err := cmd.Flags().GetBool(FlagRequireIdentityCert)
+	if err != nil {
+		return types.SubmissionRequirements{}, err
+	}
+	return types.SubmissionRequirements{
+		MinConfirmedFindings: minConfirmedFindings,
+		RequireIdentityCert:  requireIdentityCert,
+	}, nil
+}
+
+// readDurationFlag returns the duration set by the flag, or nil if the flag is not set.
+func readDurationFlag(cmd *cobra.Command, flag string) (*time.Duration, error) {
+	if !cmd.Flags().Changed(flag) {
+		return nil, nil
+	}
+	sla, err := cmd.Flags().GetDuration(flag)
+	if err != nil {
+		return nil, err
+	}
+	return &sla, nil
+}
+
+func NewMarkDuplicateFindingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mark-duplicate-finding [finding id] [original finding id]",
+		Args:  cobra.ExactArgs(2),
+		Short: "mark the specific finding as a duplicate of an earlier finding",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			operatorAddr := clientCtx.GetFromAddress()
+			msg := types.NewMsgMarkDuplicateFinding(args[0], args[1], operatorAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
 func NewPublishFindingCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "publish-finding [finding id]",
@@ -428,6 +782,53 @@ func NewPublishFindingCmd() *cobra.Command {
 	return cmd
 }
 
+func NewDisputeFindingCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dispute-finding [finding-id] [reason]",
+		Args:  cobra.ExactArgs(2),
+		Short: "dispute the closure of a finding",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			fromAddr := clientCtx.GetFromAddress()
+
+			msg := types.NewMsgDisputeFinding(args[0], args[1], fromAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
+func NewVoteDisputeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "vote-dispute [finding-id] [uphold|overturn]",
+		Args:  cobra.ExactArgs(2),
+		Short: "vote on a finding dispute as a bounty admin",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			fromAddr := clientCtx.GetFromAddress()
+
+			option, err := types.DisputeVoteOptionFromString(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgVoteDispute(args[0], option, fromAddr)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+	return cmd
+}
+
 func NewCreateTheoremCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-theorem",
@@ -459,12 +860,21 @@ func NewCreateTheoremCmd() *cobra.Command {
 				return err
 			}
 
+			theoremTypeStr, err := cmd.Flags().GetString(FlagTheoremType)
+			if err != nil {
+				return err
+			}
+			theoremType, err := parseTheoremType(theoremTypeStr)
+			if err != nil {
+				return err
+			}
+
 			requireOpenMathCert, err := cmd.Flags().GetBool(FlagRequireOpenMathCert)
 			if err != nil {
 				return err
 			}
 
-			msg := types.NewMsgCreateTheorem(title, desc, code, clientCtx.GetFromAddress().String(), grant, requireOpenMathCert)
+			msg := types.NewMsgCreateTheorem(title, desc, code, clientCtx.GetFromAddress().String(), grant, theoremType, requireOpenMathCert)
 
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
@@ -474,6 +884,7 @@ func NewCreateTheoremCmd() *cobra.Command {
 	cmd.Flags().String(FlagDescription, "", "The theorem's desc")
 	cmd.Flags().String(FlagCode, "", "The theorem's code")
 	cmd.Flags().String(FlagGrant, "", "The theorem's grant")
+	cmd.Flags().String(FlagTheoremType, "", "The theorem type (rocq/lean)")
 	cmd.Flags().Bool(FlagRequireOpenMathCert, false, "Require provers to hold an openmath certificate")
 	flags.AddTxFlagsToCmd(cmd)
 
@@ -481,6 +892,7 @@ func NewCreateTheoremCmd() *cobra.Command {
 	_ = cmd.MarkFlagRequired(FlagDescription)
 	_ = cmd.MarkFlagRequired(FlagCode)
 	_ = cmd.MarkFlagRequired(FlagGrant)
+	_ = cmd.MarkFlagRequired(FlagTheoremType)
 	_ = cmd.MarkFlagRequired(flags.FlagFrom)
 
 	return cmd
@@ -518,6 +930,60 @@ func NewGrantTheoremCmd() *cobra.Command {
 	return cmd
 }
 
+func NewWithdrawGrantCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "withdraw-grant [theorem-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "withdraw your grant from a theorem without a proof in progress, minus the withdrawal penalty",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theoremID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("theorem-id %s is not a valid uint, please input a valid theorem-id", args[0])
+			}
+
+			msg := types.NewMsgWithdrawGrant(theoremID, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewCloseTheoremCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "close-theorem [theorem-id]",
+		Args:  cobra.ExactArgs(1),
+		Short: "close your theorem without a proof in progress and refund all its grants",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			theoremID, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("theorem-id %s is not a valid uint, please input a valid theorem-id", args[0])
+			}
+
+			msg := types.NewMsgCloseTheorem(theoremID, clientCtx.GetFromAddress().String())
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func NewSubmitProofHashCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "proof-hash",
@@ -602,6 +1068,140 @@ func NewSubmitProofDetailCmd() *cobra.Command {
 	return cmd
 }
 
+func NewUploadProofDetailCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "upload-proof-detail [file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "upload a large proof detail in chunks and submit it",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Split a proof detail file into chunks, upload every chunk in its own transaction and, once they
+are committed, submit the detail as the concatenation of the chunks. With --compress every chunk is
+stored zstd compressed. Every stored chunk adds the proof chunk deposit parameter to the deposit of
+the proof. The proof hash must have been computed on the whole file content.
+
+Example:
+$ %s tx bounty upload-proof-detail proof.lean --proof-id <hash> --compress --from prover
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			proofID, err := cmd.Flags().GetString(FlagProofID)
+			if err != nil {
+				return err
+			}
+			compress, err := cmd.Flags().GetBool(FlagCompress)
+			if err != nil {
+				return err
+			}
+			chunkSize, err := cmd.Flags().GetInt(FlagChunkSize)
+			if err != nil {
+				return err
+			}
+			if chunkSize <= 0 || chunkSize > types.MaxProofChunkSize {
+				return fmt.Errorf("chunk size must be between 1 and %d", types.MaxProofChunkSize)
+			}
+			detail, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+			prover := clientCtx.GetFromAddress().String()
+
+			res, err := types.NewQueryClient(clientCtx).Proof(cmd.Context(), &types.QueryProofRequest{ProofId: proofID})
+			if err != nil {
+				return err
+			}
+			proofHash := clientCtx.Codec.MustMarshal(&types.ProofHash{TheoremId: res.Proof.TheoremId, Prover: prover, Detail: string(detail)})
+			if hash := sha256.Sum256(proofHash); hex.EncodeToString(hash[:]) != res.Proof.Id {
+				return fmt.Errorf("the proof hash of %s does not match proof %s", args[0], res.Proof.Id)
+			}
+
+			chunks, err := splitProofDetail(detail, chunkSize, compress)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			broadcaster := newTxBroadcaster(clientCtx, txf)
+
+			var (
+				hashes   []string
+				lastHash string
+			)
+			for i, chunk := range chunks {
+				chunkRes, err := broadcaster.broadcast(types.NewMsgUploadProofChunk(res.Proof.Id, prover, chunk))
+				if err != nil {
+					return fmt.Errorf("chunk %d: %w", i, err)
+				}
+				hashes = append(hashes, types.ProofChunkHash(chunk))
+				lastHash = chunkRes.TxHash
+				_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "uploaded chunk %d/%d in tx %s\n", i+1, len(chunks), chunkRes.TxHash)
+			}
+
+			// the chunks must be committed for the detail to be checked
+			if err = broadcaster.waitForTx(cmd.Context(), lastHash, time.Second); err != nil {
+				return err
+			}
+
+			submitRes, err := broadcaster.broadcast(types.NewMsgSubmitProofDetailChunks(res.Proof.Id, prover, hashes, compress))
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(submitRes)
+		},
+	}
+
+	cmd.Flags().String(FlagProofID, "", "The proof id")
+	cmd.Flags().Bool(FlagCompress, false, "Store the chunks zstd compressed")
+	cmd.Flags().Int(FlagChunkSize, types.MaxProofChunkSize, "The size of the chunks the detail is split into, before compression")
+	flags.AddTxFlagsToCmd(cmd)
+
+	_ = cmd.MarkFlagRequired(FlagProofID)
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+// splitProofDetail splits a proof detail into chunks of the given size, zstd compressing each of them
+// if requested.
+func splitProofDetail(detail []byte, chunkSize int, compress bool) ([][]byte, error) {
+	var encoder *zstd.Encoder
+	if compress {
+		var err error
+		if encoder, err = zstd.NewWriter(nil, zstd.WithEncoderLevel(zstd.SpeedBestCompression)); err != nil {
+			return nil, err
+		}
+		defer encoder.Close()
+	}
+
+	var chunks [][]byte
+	for start := 0; start < len(detail); start += chunkSize {
+		chunk := detail[start:min(start+chunkSize, len(detail))]
+		if encoder != nil {
+			chunk = encoder.EncodeAll(chunk, nil)
+			if len(chunk) > types.MaxProofChunkSize {
+				return nil, fmt.Errorf("compressed chunk exceeds %d bytes, use a smaller chunk size", types.MaxProofChunkSize)
+			}
+		}
+		chunks = append(chunks, chunk)
+	}
+	if len(chunks) == 0 {
+		return nil, fmt.Errorf("proof detail cannot be empty")
+	}
+	if len(chunks) > types.MaxProofChunks {
+		return nil, fmt.Errorf("proof detail needs %d chunks, at most %d are allowed", len(chunks), types.MaxProofChunks)
+	}
+	return chunks, nil
+}
+
 func NewSubmitProofVerificationCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "proof-verification",
@@ -688,6 +1288,83 @@ func NewSubmitProofVerificationCmd() *cobra.Command {
 	return cmd
 }
 
+// encryptFindingPayload encrypts the finding to the program team when the --encrypt flag is set,
+// and returns nil otherwise.
+func encryptFindingPayload(cmd *cobra.Command, clientCtx client.Context, pid, desc, poc string) (*types.EncryptedFindingPayload, error) {
+	encrypt, err := cmd.Flags().GetBool(FlagEncrypt)
+	if err != nil || !encrypt {
+		return nil, err
+	}
+	return encryptToProgramTeam(cmd, clientCtx, pid, desc, poc)
+}
+
+// encryptToProgramTeam encrypts the finding to the on-chain public keys of the program admin and
+// of every member of the program team. Team members without a secp256k1 public key on chain are
+// skipped with a warning, they can be added by editing the finding once they have signed a transaction.
+func encryptToProgramTeam(cmd *cobra.Command, clientCtx client.Context, pid, desc, poc string) (*types.EncryptedFindingPayload, error) {
+	title, err := cmd.Flags().GetString(FlagTitle)
+	if err != nil {
+		return nil, err
+	}
+	detail, err := cmd.Flags().GetString(FlagDetail)
+	if err != nil {
+		return nil, err
+	}
+
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Program(cmd.Context(), &types.QueryProgramRequest{ProgramId: pid})
+	if err != nil {
+		return nil, err
+	}
+	team := []string{res.Program.AdminAddress}
+	var nextKey []byte
+	for {
+		membersRes, err := queryClient.ProgramMembers(cmd.Context(), &types.QueryProgramMembersRequest{
+			ProgramId:  pid,
+			Pagination: &query.PageRequest{Key: nextKey},
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, member := range membersRes.Members {
+			team = append(team, member.Address)
+		}
+		if membersRes.Pagination == nil || len(membersRes.Pagination.NextKey) == 0 {
+			break
+		}
+		nextKey = membersRes.Pagination.NextKey
+	}
+
+	recipients := make([]types.PayloadRecipient, 0, len(team))
+	for _, address := range team {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return nil, err
+		}
+		account, err := clientCtx.AccountRetriever.GetAccount(clientCtx, addr)
+		if err != nil {
+			return nil, err
+		}
+		pubKey := account.GetPubKey()
+		if pubKey == nil {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "warning: program team member %s has no public key on chain and cannot read the finding\n", addr)
+			continue
+		}
+		if pubKey.Type() != "secp256k1" {
+			_, _ = fmt.Fprintf(cmd.ErrOrStderr(), "warning: program team member %s has an unsupported %s public key and cannot read the finding\n", addr, pubKey.Type())
+			continue
+		}
+		recipients = append(recipients, types.PayloadRecipient{Address: address, PubKey: pubKey.Bytes()})
+	}
+
+	return types.EncryptFindingPayload(recipients, types.FindingPayload{
+		Title:          title,
+		Description:    desc,
+		ProofOfConcept: poc,
+		Detail:         detail,
+	})
+}
+
 // parseTheoremType parses the theorem type string into TheoremType enum.
 func parseTheoremType(s string) (types.TheoremType, error) {
 	switch strings.ToLower(s) {
@@ -31,6 +31,131 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, k keeper.Keeper, data
 		if err := k.ProgramFindings.Set(ctx, collections.Join(finding.ProgramId, finding.FindingId)); err != nil {
 			return err
 		}
+		if finding.SlaDeadline != nil {
+			if err := k.FindingSLAQueue.Set(ctx, collections.Join(*finding.SlaDeadline, finding.FindingId)); err != nil {
+				return err
+			}
+		}
+		if finding.DisclosureDeadline != nil {
+			queue := k.DisclosureQueue
+			if finding.DisclosureOverdue {
+				queue = k.OverdueDisclosures
+			}
+			if err := queue.Set(ctx, collections.Join(*finding.DisclosureDeadline, finding.FindingId)); err != nil {
+				return err
+			}
+		}
+		if len(finding.DuplicateOf) != 0 {
+			if err := k.DuplicateFindings.Set(ctx, collections.Join(finding.DuplicateOf, finding.FindingId)); err != nil {
+				return err
+			}
+		}
+		if len(finding.TargetId) != 0 {
+			if err := k.FindingTargets.Set(ctx, collections.Join3(finding.ProgramId, finding.TargetId, finding.FindingId)); err != nil {
+				return err
+			}
+		}
+	}
+
+	// initialize program members
+	for _, member := range data.ProgramMembers {
+		addr, err := ak.AddressCodec().StringToBytes(member.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.ProgramMembers.Set(ctx, collections.Join(member.ProgramId, sdk.AccAddress(addr)), *member); err != nil {
+			return err
+		}
+	}
+
+	// initialize disputes
+	for _, dispute := range data.Disputes {
+		if dispute.Status == types.DisputeStatusVoting {
+			if err := k.ActiveDisputesQueue.Set(ctx, collections.Join(dispute.EndTime, dispute.FindingId)); err != nil {
+				return err
+			}
+		}
+		if err := k.Disputes.Set(ctx, dispute.FindingId, *dispute); err != nil {
+			return err
+		}
+	}
+
+	// initialize hacker reputations
+	for _, reputation := range data.HackerReputations {
+		addr, err := ak.AddressCodec().StringToBytes(reputation.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.HackerReputations.Set(ctx, sdk.AccAddress(addr), *reputation); err != nil {
+			return err
+		}
+	}
+
+	// initialize program sponsorships
+	for _, sponsorship := range data.Sponsorships {
+		addr, err := ak.AddressCodec().StringToBytes(sponsorship.Sponsor)
+		if err != nil {
+			return err
+		}
+		if err := k.Sponsorships.Set(ctx, collections.Join(sponsorship.ProgramId, sdk.AccAddress(addr)), *sponsorship); err != nil {
+			return err
+		}
+	}
+
+	// initialize dispute votes
+	for _, vote := range data.DisputeVotes {
+		addr, err := ak.AddressCodec().StringToBytes(vote.Voter)
+		if err != nil {
+			return err
+		}
+		if err := k.DisputeVotes.Set(ctx, collections.Join(vote.FindingId, sdk.AccAddress(addr)), *vote); err != nil {
+			return err
+		}
+	}
+
+	// initialize proof verdicts
+	for _, verdict := range data.ProofVerdicts {
+		addr, err := ak.AddressCodec().StringToBytes(verdict.Checker)
+		if err != nil {
+			return err
+		}
+		if err := k.ProofVerdicts.Set(ctx, collections.Join(verdict.ProofId, sdk.AccAddress(addr)), *verdict); err != nil {
+			return err
+		}
+	}
+
+	// initialize proof chunks
+	for _, chunk := range data.ProofChunks {
+		if err := k.ProofChunks.Set(ctx, collections.Join(chunk.ProofId, chunk.Hash), chunk.Data); err != nil {
+			return err
+		}
+	}
+
+	// initialize reward vestings
+	for _, vesting := range data.RewardVestings {
+		addr, err := ak.AddressCodec().StringToBytes(vesting.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.RewardVestings.Set(ctx, addr, *vesting); err != nil {
+			return err
+		}
+	}
+
+	// initialize openmath statistics and their leaderboards
+	for _, stats := range data.OpenmathStats {
+		addr, err := ak.AddressCodec().StringToBytes(stats.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.SetOpenMathStats(ctx, addr, *stats); err != nil {
+			return err
+		}
+	}
+	for _, stats := range data.TheoremTypeStats {
+		if err := k.TheoremTypeStats.Set(ctx, int32(stats.TheoremType), *stats); err != nil {
+			return err
+		}
 	}
 
 	// initialize theorem ID
@@ -48,6 +173,12 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, k keeper.Keeper, data
 		if err := k.Theorems.Set(ctx, theorem.Id, *theorem); err != nil {
 			return err
 		}
+		if err := k.IndexTheoremCode(ctx, *theorem); err != nil {
+			return err
+		}
+		if err := k.IndexTheoremImports(ctx, *theorem); err != nil {
+			return err
+		}
 	}
 
 	// initialize grants
@@ -103,7 +234,13 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, k keeper.Keeper, data
 	for _, proof := range data.Proofs {
 		if proof.Status == types.ProofStatus_PROOF_STATUS_HASH_LOCK_PERIOD {
 			endTime := proof.SubmitTime.Add(*data.Params.ProofMaxLockPeriod)
-			if err := k.ActiveProofsQueue.Set(ctx, collections.Join(endTime, proof.Id), *proof); err != nil {
+			if err := k.ActiveProofsQueue.Set(ctx, collections.Join(endTime, proof.Id)); err != nil {
+				return err
+			}
+		}
+		// revealed proofs keep their verification deadline
+		if proof.Status == types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD && proof.EndTime != nil {
+			if err := k.ActiveProofsQueue.Set(ctx, collections.Join(*proof.EndTime, proof.Id)); err != nil {
 				return err
 			}
 		}
@@ -111,7 +248,7 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, k keeper.Keeper, data
 			return err
 		}
 
-		if err := k.ProofsByTheorem.Set(ctx, collections.Join(proof.TheoremId, proof.Id), []byte{}); err != nil {
+		if err := k.ProofsByTheorem.Set(ctx, collections.Join3(proof.TheoremId, proof.Sequence, proof.Id)); err != nil {
 			return err
 		}
 	}
@@ -121,14 +258,24 @@ func InitGenesis(ctx sdk.Context, ak types.AccountKeeper, k keeper.Keeper, data
 
 func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	var (
-		programs        []*types.Program
-		findings        []*types.Finding
-		theorems        []*types.Theorem
-		proofs          []*types.Proof
-		grants          []*types.Grant
-		rewards         []*types.Reward
-		importedRewards []*types.Reward
-		deposits        []*types.Deposit
+		programs         []*types.Program
+		findings         []*types.Finding
+		members          []*types.ProgramMember
+		disputes         []*types.Dispute
+		disputeVotes     []*types.DisputeVote
+		hackers          []*types.HackerReputation
+		sponsorships     []*types.Sponsorship
+		theorems         []*types.Theorem
+		proofs           []*types.Proof
+		proofVerdicts    []*types.ProofVerdict
+		proofChunks      []*types.ProofChunk
+		rewardVestings   []*types.RewardVesting
+		openMathStats    []*types.OpenMathStats
+		theoremTypeStats []*types.TheoremTypeStats
+		grants           []*types.Grant
+		rewards          []*types.Reward
+		importedRewards  []*types.Reward
+		deposits         []*types.Deposit
 	)
 
 	err := k.Programs.Walk(ctx, nil, func(_ string, value types.Program) (stop bool, err error) {
@@ -147,6 +294,46 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	err = k.ProgramMembers.Walk(ctx, nil, func(_ collections.Pair[string, sdk.AccAddress], value types.ProgramMember) (stop bool, err error) {
+		members = append(members, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.Disputes.Walk(ctx, nil, func(_ string, value types.Dispute) (stop bool, err error) {
+		disputes = append(disputes, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.DisputeVotes.Walk(ctx, nil, func(_ collections.Pair[string, sdk.AccAddress], value types.DisputeVote) (stop bool, err error) {
+		disputeVotes = append(disputeVotes, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.HackerReputations.Walk(ctx, nil, func(_ sdk.AccAddress, value types.HackerReputation) (stop bool, err error) {
+		hackers = append(hackers, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.Sponsorships.Walk(ctx, nil, func(_ collections.Pair[string, sdk.AccAddress], value types.Sponsorship) (stop bool, err error) {
+		sponsorships = append(sponsorships, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	err = k.Theorems.Walk(ctx, nil, func(_ uint64, value types.Theorem) (stop bool, err error) {
 		theorems = append(theorems, &value)
 		return false, nil
@@ -163,6 +350,46 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		panic(err)
 	}
 
+	err = k.ProofVerdicts.Walk(ctx, nil, func(_ collections.Pair[string, sdk.AccAddress], value types.ProofVerdict) (stop bool, err error) {
+		proofVerdicts = append(proofVerdicts, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.ProofChunks.Walk(ctx, nil, func(key collections.Pair[string, string], value []byte) (stop bool, err error) {
+		proofChunks = append(proofChunks, &types.ProofChunk{ProofId: key.K1(), Hash: key.K2(), Data: value})
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.RewardVestings.Walk(ctx, nil, func(_ sdk.AccAddress, value types.RewardVesting) (stop bool, err error) {
+		rewardVestings = append(rewardVestings, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.OpenMathStats.Walk(ctx, nil, func(_ sdk.AccAddress, value types.OpenMathStats) (stop bool, err error) {
+		openMathStats = append(openMathStats, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.TheoremTypeStats.Walk(ctx, nil, func(_ int32, value types.TheoremTypeStats) (stop bool, err error) {
+		theoremTypeStats = append(theoremTypeStats, &value)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	err = k.Grants.Walk(ctx, nil, func(_ collections.Pair[uint64, sdk.AccAddress], value types.Grant) (stop bool, err error) {
 		grants = append(grants, &value)
 		return false, nil
@@ -208,9 +435,19 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 	return &types.GenesisState{
 		Programs:          programs,
 		Findings:          findings,
+		ProgramMembers:    members,
+		Disputes:          disputes,
+		DisputeVotes:      disputeVotes,
+		HackerReputations: hackers,
+		Sponsorships:      sponsorships,
 		StartingTheoremId: startingTheoremID,
 		Theorems:          theorems,
 		Proofs:            proofs,
+		ProofVerdicts:     proofVerdicts,
+		ProofChunks:       proofChunks,
+		RewardVestings:    rewardVestings,
+		OpenmathStats:     openMathStats,
+		TheoremTypeStats:  theoremTypeStats,
 		Grants:            grants,
 		Rewards:           rewards,
 		ImportedRewards:   importedRewards,
@@ -114,6 +114,22 @@ func TestExportGenesis(t *testing.T) {
 				Reward:  sdk.NewDecCoins(sdk.NewDecCoinFromDec("uctk", sdkmath.LegacyNewDec(50))),
 			},
 		},
+		HackerReputations: []*types.HackerReputation{
+			{
+				Address:        acc1.String(),
+				Confirmed:      []types.SeverityCount{{SeverityLevel: types.Critical, Count: 1}},
+				PaidFindings:   1,
+				ClosedFindings: 1,
+				TotalPaid:      sdk.NewCoins(sdk.NewCoin("uctk", sdkmath.NewInt(100))),
+			},
+		},
+		Sponsorships: []*types.Sponsorship{
+			{
+				ProgramId: "1",
+				Sponsor:   acc2.String(),
+				Amount:    sdk.NewCoins(sdk.NewCoin("uctk", sdkmath.NewInt(1000))),
+			},
+		},
 		Params: &params,
 	}
 
@@ -146,6 +162,8 @@ func TestExportGenesis(t *testing.T) {
 	require.Len(t, exported1.Deposits, 1)
 	require.Len(t, exported1.Rewards, 1)
 	require.Len(t, exported1.ImportedRewards, 1)
+	require.Len(t, exported1.HackerReputations, 1)
+	require.Len(t, exported1.Sponsorships, 1)
 	require.Equal(t, uint64(1), exported1.StartingTheoremId)
 	require.NotNil(t, exported1.Params)
 }
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty"
+)
+
+// TestForfeitExpiredProofDeposit tests the forfeiture of the deposit of a proof whose detail was not
+// submitted on time, and the release of the forfeited deposits once the theorem expires
+func (suite *KeeperTestSuite) TestForfeitExpiredProofDeposit() {
+	testCases := []struct {
+		name          string
+		slashFraction math.LegacyDec
+	}{
+		{"deposit fully forfeited", math.LegacyOneDec()},
+		{"deposit partially forfeited", math.LegacyNewDecWithPrec(4, 1)},
+		{"deposit not forfeited", math.LegacyZeroDec()},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			params, err := suite.keeper.Params.Get(suite.ctx)
+			suite.Require().NoError(err)
+			params.ProofDepositSlashFraction = tc.slashFraction
+			suite.Require().NoError(suite.keeper.Params.Set(suite.ctx, params))
+
+			bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			suite.Require().NoError(err)
+			theoremID := suite.InitCreateTheorem()
+			proofID := suite.InitSubmitProofHash(theoremID)
+			proof, err := suite.keeper.Proofs.Get(suite.ctx, proofID)
+			suite.Require().NoError(err)
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.whiteHatAddr, bondDenom)
+			feePool, err := suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+			suite.Require().NoError(err)
+			poolBefore := feePool.CommunityPool.AmountOf(bondDenom)
+
+			// the proof is kept until its lock deadline
+			suite.ctx = suite.ctx.WithBlockTime(proof.EndTime.Add(-time.Second))
+			suite.Require().NoError(bounty.EndBlocker(suite.ctx, &suite.keeper))
+			_, err = suite.keeper.Proofs.Get(suite.ctx, proofID)
+			suite.Require().NoError(err)
+
+			suite.ctx = suite.ctx.WithBlockTime(proof.EndTime.Add(time.Second))
+			suite.Require().NoError(bounty.EndBlocker(suite.ctx, &suite.keeper))
+			_, err = suite.keeper.Proofs.Get(suite.ctx, proofID)
+			suite.Require().ErrorIs(err, collections.ErrNotFound)
+			has, err := suite.keeper.Deposits.Has(suite.ctx, collections.Join(proofID, suite.whiteHatAddr))
+			suite.Require().NoError(err)
+			suite.Require().False(has)
+
+			// the rest of the deposit is refunded, the grant pool gets half of the slashed part and,
+			// without checker, the community pool the other half
+			deposit := proof.Deposit[0].Amount
+			slashed := math.LegacyNewDecFromInt(deposit).Mul(tc.slashFraction).TruncateInt()
+			grantShare := slashed.MulRaw(5).QuoRaw(10)
+			balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, suite.whiteHatAddr, bondDenom)
+			suite.Require().Equal(balanceBefore.Amount.Add(deposit.Sub(slashed)), balanceAfter.Amount)
+
+			theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, grantShare)), sdk.NewCoins(theorem.ForfeitedDeposits...))
+			feePool, err = suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(poolBefore.Add(math.LegacyNewDecFromInt(slashed.Sub(grantShare))), feePool.CommunityPool.AmountOf(bondDenom))
+
+			// the forfeited deposits go to the community pool as well when the theorem expires
+			suite.ctx = suite.ctx.WithBlockTime(theorem.EndTime.Add(time.Second))
+			suite.Require().NoError(bounty.EndBlocker(suite.ctx, &suite.keeper))
+			_, err = suite.keeper.Theorems.Get(suite.ctx, theoremID)
+			suite.Require().ErrorIs(err, collections.ErrNotFound)
+			feePool, err = suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(poolBefore.Add(math.LegacyNewDecFromInt(slashed)), feePool.CommunityPool.AmountOf(bondDenom))
+		})
+	}
+}
@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// ==========================================
+// Finding Disclosure Operations
+// ==========================================
+
+// ScheduleFindingDisclosure starts the disclosure embargo of a paid or closed finding that is not
+// published yet. The caller is responsible for storing the finding.
+func (k Keeper) ScheduleFindingDisclosure(ctx context.Context, program types.Program, finding *types.Finding) error {
+	if err := k.RemoveFindingDisclosure(ctx, finding); err != nil {
+		return err
+	}
+
+	embargo, ok := program.Embargo()
+	if !ok || len(finding.Description) != 0 {
+		return nil
+	}
+	deadline := sdk.UnwrapSDKContext(ctx).BlockTime().Add(embargo)
+	finding.DisclosureDeadline = &deadline
+	return k.DisclosureQueue.Set(ctx, collections.Join(deadline, finding.FindingId))
+}
+
+// RemoveFindingDisclosure clears the disclosure embargo of a finding that is published or no longer
+// paid or closed. The caller is responsible for storing the finding.
+func (k Keeper) RemoveFindingDisclosure(ctx context.Context, finding *types.Finding) error {
+	if finding.DisclosureDeadline == nil {
+		return nil
+	}
+	key := collections.Join(*finding.DisclosureDeadline, finding.FindingId)
+	var err error
+	if finding.DisclosureOverdue {
+		err = k.OverdueDisclosures.Remove(ctx, key)
+	} else {
+		err = k.DisclosureQueue.Remove(ctx, key)
+	}
+	if err != nil {
+		return err
+	}
+	finding.DisclosureDeadline = nil
+	finding.DisclosureOverdue = false
+	return nil
+}
+
+// FlagOverdueDisclosures flags the findings whose disclosure embargo ended before the given time as
+// overdue for disclosure and returns them.
+func (k Keeper) FlagOverdueDisclosures(ctx context.Context, blockTime time.Time) ([]types.Finding, error) {
+	var keys []collections.Pair[time.Time, string]
+	rng := collections.NewPrefixUntilPairRange[time.Time, string](blockTime)
+	err := k.DisclosureQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, string]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var overdue []types.Finding
+	for _, key := range keys {
+		if err = k.DisclosureQueue.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+		if err = k.OverdueDisclosures.Set(ctx, key); err != nil {
+			return nil, err
+		}
+
+		finding, err := k.Findings.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		finding.DisclosureOverdue = true
+		if err = k.Findings.Set(ctx, finding.FindingId, finding); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeDisclosureOverdue,
+				sdk.NewAttribute(types.AttributeKeyFindingID, finding.FindingId),
+				sdk.NewAttribute(types.AttributeKeyProgramID, finding.ProgramId),
+				sdk.NewAttribute(types.AttributeKeyDeadline, key.K1().String()),
+			),
+		)
+		overdue = append(overdue, finding)
+	}
+	return overdue, nil
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// ==========================================
+// Finding Dispute Operations
+// ==========================================
+
+// OpenDispute moves a closed finding into the disputed status and schedules the tally of the
+// bounty admin votes at the end of the dispute window.
+func (k Keeper) OpenDispute(ctx context.Context, finding *types.Finding, reason string) (types.Dispute, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Dispute{}, err
+	}
+
+	// votes of a previously overturned dispute do not carry over
+	rng := collections.NewPrefixedPairRange[string, sdk.AccAddress](finding.FindingId)
+	if err = k.DisputeVotes.Clear(ctx, rng); err != nil {
+		return types.Dispute{}, err
+	}
+
+	createTime := sdkCtx.BlockTime()
+	dispute := types.Dispute{
+		FindingId:       finding.FindingId,
+		ProgramId:       finding.ProgramId,
+		DisputerAddress: finding.SubmitterAddress,
+		Reason:          reason,
+		Status:          types.DisputeStatusVoting,
+		CreateTime:      createTime,
+		EndTime:         createTime.Add(*params.DisputeWindow),
+	}
+	if err = k.Disputes.Set(ctx, dispute.FindingId, dispute); err != nil {
+		return types.Dispute{}, err
+	}
+	if err = k.ActiveDisputesQueue.Set(ctx, collections.Join(dispute.EndTime, dispute.FindingId)); err != nil {
+		return types.Dispute{}, err
+	}
+
+	// the embargo restarts if the closure is upheld
+	if err = k.RemoveFindingDisclosure(ctx, finding); err != nil {
+		return types.Dispute{}, err
+	}
+	finding.Status = types.FindingStatusDisputed
+	if err = k.Findings.Set(ctx, finding.FindingId, *finding); err != nil {
+		return types.Dispute{}, err
+	}
+	return dispute, nil
+}
+
+// TallyDispute counts the uphold and overturn votes of a dispute.
+func (k Keeper) TallyDispute(ctx context.Context, findingID string) (uphold, overturn uint64, err error) {
+	rng := collections.NewPrefixedPairRange[string, sdk.AccAddress](findingID)
+	err = k.DisputeVotes.Walk(ctx, rng, func(_ collections.Pair[string, sdk.AccAddress], vote types.DisputeVote) (bool, error) {
+		switch vote.Option {
+		case types.DisputeVoteOptionUphold:
+			uphold++
+		case types.DisputeVoteOptionOverturn:
+			overturn++
+		}
+		return false, nil
+	})
+	return uphold, overturn, err
+}
+
+// ResolveDispute tallies the votes of a dispute and applies the outcome. The closure is overturned
+// and the finding reactivated only if a strict majority of the votes cast is to overturn.
+func (k Keeper) ResolveDispute(ctx context.Context, dispute types.Dispute) (types.Dispute, error) {
+	uphold, overturn, err := k.TallyDispute(ctx, dispute.FindingId)
+	if err != nil {
+		return dispute, err
+	}
+
+	finding, err := k.Findings.Get(ctx, dispute.FindingId)
+	if err != nil {
+		return dispute, err
+	}
+
+	dispute.UpholdVotes, dispute.OverturnVotes = uphold, overturn
+	if overturn > uphold {
+		dispute.Status = types.DisputeStatusOverturned
+		finding.Status = types.FindingStatusActive
+
+		// the reactivated finding is back under the confirmation SLA of its program
+		program, err := k.Programs.Get(ctx, finding.ProgramId)
+		if err != nil {
+			return dispute, err
+		}
+		if err = k.ScheduleFindingSLA(ctx, program, &finding); err != nil {
+			return dispute, err
+		}
+
+		// the closure no longer counts against the submitter
+		err = k.UpdateHackerReputation(ctx, finding.SubmitterAddress, func(reputation *types.HackerReputation) {
+			if reputation.ClosedFindings > 0 {
+				reputation.ClosedFindings--
+			}
+		})
+		if err != nil {
+			return dispute, err
+		}
+	} else {
+		dispute.Status = types.DisputeStatusUpheld
+		finding.Status = types.FindingStatusClosed
+
+		program, err := k.Programs.Get(ctx, finding.ProgramId)
+		if err != nil {
+			return dispute, err
+		}
+		if err = k.ScheduleFindingDisclosure(ctx, program, &finding); err != nil {
+			return dispute, err
+		}
+	}
+
+	if err = k.Findings.Set(ctx, finding.FindingId, finding); err != nil {
+		return dispute, err
+	}
+	if err = k.Disputes.Set(ctx, dispute.FindingId, dispute); err != nil {
+		return dispute, err
+	}
+	if err = k.ActiveDisputesQueue.Remove(ctx, collections.Join(dispute.EndTime, dispute.FindingId)); err != nil {
+		return dispute, err
+	}
+	return dispute, nil
+}
+
+// ResolveEndedDisputes resolves all the disputes whose window ended by the given time.
+func (k Keeper) ResolveEndedDisputes(ctx context.Context, endTime time.Time) ([]types.Dispute, error) {
+	var ended []string
+	rng := collections.NewPrefixUntilPairRange[time.Time, string](endTime)
+	err := k.ActiveDisputesQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, string]) (bool, error) {
+		ended = append(ended, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resolved := make([]types.Dispute, 0, len(ended))
+	for _, findingID := range ended {
+		dispute, err := k.Disputes.Get(ctx, findingID)
+		if err != nil {
+			return nil, err
+		}
+		if dispute, err = k.ResolveDispute(ctx, dispute); err != nil {
+			return nil, err
+		}
+		resolved = append(resolved, dispute)
+	}
+	return resolved, nil
+}
@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// ==========================================
+// Duplicate Finding Operations
+// ==========================================
+
+// MarkDuplicateFinding links a finding to the original finding it duplicates.
+func (k Keeper) MarkDuplicateFinding(ctx context.Context, finding *types.Finding, original types.Finding) error {
+	if err := k.RemoveFindingSLA(ctx, finding); err != nil {
+		return err
+	}
+	finding.Status = types.FindingStatusDuplicate
+	finding.DuplicateOf = original.FindingId
+	if err := k.Findings.Set(ctx, finding.FindingId, *finding); err != nil {
+		return err
+	}
+	if err := k.ClearFindingApprovals(ctx, finding.FindingId); err != nil {
+		return err
+	}
+	return k.DuplicateFindings.Set(ctx, collections.Join(original.FindingId, finding.FindingId))
+}
+
+// HasDuplicateFindings returns true if other findings are marked as duplicates of the finding.
+func (k Keeper) HasDuplicateFindings(ctx context.Context, findingID string) (bool, error) {
+	rng := collections.NewPrefixedPairRange[string, string](findingID)
+	iter, err := k.DuplicateFindings.Iterate(ctx, rng)
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
+
+// GetDuplicateFindings returns the findings marked as duplicates of the finding.
+func (k Keeper) GetDuplicateFindings(ctx context.Context, findingID string) ([]types.Finding, error) {
+	var duplicates []types.Finding
+	rng := collections.NewPrefixedPairRange[string, string](findingID)
+	err := k.DuplicateFindings.Walk(ctx, rng, func(key collections.Pair[string, string]) (bool, error) {
+		duplicate, err := k.Findings.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		duplicates = append(duplicates, duplicate)
+		return false, nil
+	})
+	return duplicates, err
+}
+
+// DistributeFindingReward pays the reward of a finding from the program escrow according to the
+// duplicate policy of the program. Under the equal split policy the reward is shared between the
+// finding and its duplicates, any remainder of the division goes to the original finding, and the
+// duplicates are paid along with it.
+func (k Keeper) DistributeFindingReward(ctx context.Context, program *types.Program, finding *types.Finding, amount sdk.Coins) error {
+	if program.DuplicatePolicy != types.DuplicatePolicyEqualSplit {
+		return k.PayFindingReward(ctx, program, finding, amount)
+	}
+
+	duplicates, err := k.GetDuplicateFindings(ctx, finding.FindingId)
+	if err != nil {
+		return err
+	}
+
+	share := sdk.NewCoins()
+	for _, coin := range amount {
+		share = share.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(int64(len(duplicates)+1))))
+	}
+	if len(duplicates) == 0 || share.IsZero() {
+		return k.PayFindingReward(ctx, program, finding, amount)
+	}
+
+	for i := range duplicates {
+		if err = k.PayFindingReward(ctx, program, &duplicates[i], share); err != nil {
+			return err
+		}
+		if err = k.markDuplicateFindingPaid(ctx, program, &duplicates[i]); err != nil {
+			return err
+		}
+	}
+
+	remainder := amount
+	for range duplicates {
+		remainder = remainder.Sub(share...)
+	}
+	return k.PayFindingReward(ctx, program, finding, remainder)
+}
+
+// markDuplicateFindingPaid marks a duplicate finding paid its share of the reward of the original
+// finding, starts its disclosure embargo, and counts it as a confirmed and paid finding of its submitter.
+func (k Keeper) markDuplicateFindingPaid(ctx context.Context, program *types.Program, duplicate *types.Finding) error {
+	duplicate.Status = types.FindingStatusPaid
+	if err := k.ScheduleFindingDisclosure(ctx, *program, duplicate); err != nil {
+		return err
+	}
+	if err := k.Findings.Set(ctx, duplicate.FindingId, *duplicate); err != nil {
+		return err
+	}
+	return k.UpdateHackerReputation(ctx, duplicate.SubmitterAddress, func(reputation *types.HackerReputation) {
+		reputation.AddConfirmed(duplicate.SeverityLevel)
+		reputation.PaidFindings++
+	})
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"strings"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
@@ -25,6 +27,22 @@ type importedReward struct {
 
 // AddGrant adds or updates a grant for a theorem
 func (k Keeper) AddGrant(ctx context.Context, theoremID uint64, grantor sdk.AccAddress, grantAmount sdk.Coins) error {
+	return k.addGrant(ctx, theoremID, grantor, grantAmount, types.GrantSource_GRANT_SOURCE_ACCOUNT)
+}
+
+// GrantFromCommunityPool adds a grant funded by the community pool to a theorem. The grant is
+// recorded with the module authority as grantor, and its refunds go back to the community pool.
+func (k Keeper) GrantFromCommunityPool(ctx context.Context, theoremID uint64, amount sdk.Coins) error {
+	authority, err := k.authKeeper.AddressCodec().StringToBytes(k.authority)
+	if err != nil {
+		return err
+	}
+	return k.addGrant(ctx, theoremID, authority, amount, types.GrantSource_GRANT_SOURCE_COMMUNITY_POOL)
+}
+
+// addGrant moves the granted funds from their source to the module account and adds them to the
+// grant of the grantor for a theorem
+func (k Keeper) addGrant(ctx context.Context, theoremID uint64, grantor sdk.AccAddress, grantAmount sdk.Coins, source types.GrantSource) error {
 	// Check if theorem exists and verify status
 	theorem, err := k.Theorems.Get(ctx, theoremID)
 	if err != nil {
@@ -34,8 +52,20 @@ func (k Keeper) AddGrant(ctx context.Context, theoremID uint64, grantor sdk.AccA
 		return errors.Wrapf(types.ErrTheoremProposal, "%d", theoremID)
 	}
 
+	// A grantor cannot mix funds of different sources in one grant, they are refunded to the source
+	grant, err := k.Grants.Get(ctx, collections.Join(theoremID, grantor))
+	if err == nil && grant.Source != source {
+		return errors.Wrapf(types.ErrGrantSourceMismatch, "grantor %s on theorem %d has a %s grant", grantor, theoremID, grant.Source)
+	}
+
 	// Transfer funds to module account
-	if err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, grantor, types.ModuleName, grantAmount); err != nil {
+	switch source {
+	case types.GrantSource_GRANT_SOURCE_COMMUNITY_POOL:
+		err = k.distrKeeper.DistributeFromFeePoolToModule(ctx, grantAmount, types.ModuleName)
+	default:
+		err = k.bankKeeper.SendCoinsFromAccountToModule(ctx, grantor, types.ModuleName, grantAmount)
+	}
+	if err != nil {
 		return err
 	}
 
@@ -46,7 +76,7 @@ func (k Keeper) AddGrant(ctx context.Context, theoremID uint64, grantor sdk.AccA
 	}
 
 	// Update or create grant record
-	if err = k.updateOrCreateGrant(ctx, theoremID, grantor, grantAmount); err != nil {
+	if err = k.updateOrCreateGrant(ctx, theoremID, grantor, grantAmount, source); err != nil {
 		return err
 	}
 
@@ -57,20 +87,36 @@ func (k Keeper) AddGrant(ctx context.Context, theoremID uint64, grantor sdk.AccA
 			sdk.NewAttribute(types.AttributeKeyTheoremGrantor, grantor.String()),
 			sdk.NewAttribute(sdk.AttributeKeyAmount, grantAmount.String()),
 			sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", theoremID)),
+			sdk.NewAttribute(types.AttributeKeyGrantSource, source.String()),
 		),
 	)
 
 	return nil
 }
 
+// refundGrant sends a refunded grant back to its source, the grantor account or the community pool.
+func (k Keeper) refundGrant(ctx context.Context, grant types.Grant, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	if grant.Source == types.GrantSource_GRANT_SOURCE_COMMUNITY_POOL {
+		return k.fundCommunityPool(ctx, amount)
+	}
+	grantor, err := k.authKeeper.AddressCodec().StringToBytes(grant.Grantor)
+	if err != nil {
+		return err
+	}
+	return k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, grantor, amount)
+}
+
 // updateOrCreateGrant updates an existing grant or creates a new one
-func (k Keeper) updateOrCreateGrant(ctx context.Context, theoremID uint64, grantor sdk.AccAddress, amount sdk.Coins) error {
+func (k Keeper) updateOrCreateGrant(ctx context.Context, theoremID uint64, grantor sdk.AccAddress, amount sdk.Coins, source types.GrantSource) error {
 	grant, err := k.Grants.Get(ctx, collections.Join(theoremID, grantor))
 	switch {
 	case err == nil:
 		grant.Amount = sdk.NewCoins(grant.Amount...).Add(amount...)
 	case errors.IsOf(err, collections.ErrNotFound):
-		grant = types.NewGrant(theoremID, grantor, amount)
+		grant = types.NewGrant(theoremID, grantor, amount, source)
 	default:
 		return fmt.Errorf("failed to get grant: %w", err)
 	}
@@ -78,11 +124,59 @@ func (k Keeper) updateOrCreateGrant(ctx context.Context, theoremID uint64, grant
 	return k.SetGrant(ctx, grant)
 }
 
-// RefundAndDeleteGrants refunds and deletes all the grants for a theorem
+// WithdrawGrant refunds the grant of a grantor on a theorem minus the withdrawal penalty, which is
+// sent to the community pool, and removes it from the theorem grant pool. A withdrawal leaving less
+// than the min grant in the pool is rejected, and the theorem is closed once its pool is empty.
+func (k Keeper) WithdrawGrant(ctx context.Context, theorem *types.Theorem, grantor sdk.AccAddress) (refund, penalty sdk.Coins, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	key := collections.Join(theorem.Id, grantor)
+	grant, err := k.Grants.Get(ctx, key)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, nil, errors.Wrapf(types.ErrGrantNotExist, "grantor %s on theorem %d", grantor, theorem.Id)
+		}
+		return nil, nil, err
+	}
+
+	amount := sdk.NewCoins(grant.Amount...)
+	remaining := sdk.NewCoins(theorem.TotalGrant...).Sub(amount...)
+	if !remaining.IsZero() && !remaining.IsAllGTE(params.MinGrant) {
+		return nil, nil, errors.Wrapf(types.ErrMinGrantTooSmall, "remaining grant of theorem %d was (%s), need (%s)", theorem.Id, remaining, params.MinGrant)
+	}
+
+	penalty, _ = sdk.NewDecCoinsFromCoins(amount...).MulDecTruncate(params.GrantWithdrawalPenalty).TruncateDecimal()
+	refund = amount.Sub(penalty...)
+	if err = k.refundGrant(ctx, grant, refund); err != nil {
+		return nil, nil, err
+	}
+	if err = k.fundCommunityPool(ctx, penalty); err != nil {
+		return nil, nil, err
+	}
+	if err = k.Grants.Remove(ctx, key); err != nil {
+		return nil, nil, err
+	}
+
+	if remaining.IsZero() {
+		err = k.CloseTheorem(ctx, theorem)
+	} else {
+		theorem.TotalGrant = remaining
+		err = k.Theorems.Set(ctx, theorem.Id, *theorem)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return refund, penalty, nil
+}
+
+// RefundAndDeleteGrants refunds and deletes all the grants for a theorem, the community pool
+// grants are refunded to the community pool
 func (k Keeper) RefundAndDeleteGrants(ctx context.Context, theoremID uint64) error {
 	return k.IterateGrants(ctx, theoremID, func(key collections.Pair[uint64, sdk.AccAddress], grant types.Grant) (bool, error) {
-		grantor := key.K2()
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, grantor, grant.Amount); err != nil {
+		if err := k.refundGrant(ctx, grant, grant.Amount); err != nil {
 			return false, err
 		}
 		return false, k.Grants.Remove(ctx, key)
@@ -95,8 +189,14 @@ func (k Keeper) IterateGrants(ctx context.Context, theoremID uint64, cb func(key
 	return k.Grants.Walk(ctx, rng, cb)
 }
 
-// DistributionGrants distributes rewards to checker, reference theorem proposers, and prover
-func (k Keeper) DistributionGrants(ctx context.Context, theorem types.Theorem, theoremType types.TheoremType, checker, prover sdk.AccAddress) error {
+// DistributionGrants distributes rewards to checkers, reference theorem proposers, and prover.
+// The checker reward is split evenly among the checkers who agreed on the proof, and the complexity
+// fee is the one of the theorem type.
+func (k Keeper) DistributionGrants(ctx context.Context, theorem types.Theorem, checkers []sdk.AccAddress, prover sdk.AccAddress) error {
+	if len(checkers) == 0 {
+		return errors.Wrap(types.ErrProofVerdictInvalid, "no checker to reward")
+	}
+
 	// ========== Phase 1: Collect and Calculate ==========
 
 	// Get parameters
@@ -107,7 +207,7 @@ func (k Keeper) DistributionGrants(ctx context.Context, theorem types.Theorem, t
 	currentComplexity := theorem.GetComplexity()
 
 	// Get type-specific complexity fee
-	complexityFee, err := param.GetComplexityFeeByType(theoremType)
+	complexityFee, err := param.GetComplexityFeeByType(theorem.TheoremType)
 	if err != nil {
 		return err
 	}
@@ -136,8 +236,10 @@ func (k Keeper) DistributionGrants(ctx context.Context, theorem types.Theorem, t
 	complexityFeeAmount := sdkmath.LegacyNewDecFromInt(complexityFee.Amount)
 
 	// 1. Checker rewards: current theorem's complexity * complexity_fee
+	// split evenly among the checkers, the rounding remainder is left to the prover
 	checkerRewardAmount := complexityFeeAmount.MulInt64(currentComplexity)
-	checkerRewards := sdk.NewDecCoins(sdk.NewDecCoinFromDec(complexityFee.Denom, checkerRewardAmount))
+	checkerShare := sdk.NewDecCoins(sdk.NewDecCoinFromDec(complexityFee.Denom, checkerRewardAmount.QuoInt64(int64(len(checkers)))))
+	checkerRewards := checkerShare.MulDec(sdkmath.LegacyNewDec(int64(len(checkers))))
 
 	// 2. Imported rewards: using inverse proportional function
 	// reward = (Complexity / (ImportedCount + 1)) * ComplexityFee
@@ -171,8 +273,12 @@ func (k Keeper) DistributionGrants(ctx context.Context, theorem types.Theorem, t
 
 	// ========== Phase 2: Update All Rewards ==========
 	// Update checker rewards
-	if err := k.updateReward(ctx, checker, checkerRewards); err != nil {
-		return fmt.Errorf("failed to update checker reward: %w", err)
+	checkerAddrs := make([]string, len(checkers))
+	for i, checker := range checkers {
+		if err := k.updateReward(ctx, checker, checkerShare); err != nil {
+			return fmt.Errorf("failed to update checker reward: %w", err)
+		}
+		checkerAddrs[i] = checker.String()
 	}
 
 	// Update imported rewards for all reference theorem proposers and increment imported counts
@@ -205,13 +311,18 @@ func (k Keeper) DistributionGrants(ctx context.Context, theorem types.Theorem, t
 		return fmt.Errorf("failed to update prover reward: %w", err)
 	}
 
+	// Update statistics
+	if err := k.recordRewardDistribution(ctx, theorem.TheoremType, checkers, checkerShare, importedRewards, prover, proverRewards); err != nil {
+		return fmt.Errorf("failed to update reward statistics: %w", err)
+	}
+
 	// Emit distribution event
 	sdkCtx.EventManager().EmitEvent(
 		sdk.NewEvent(
 			types.EventTypeDistributeReward,
 			sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", theorem.Id)),
-			sdk.NewAttribute(types.AttributeKeyChecker, checker.String()),
-			sdk.NewAttribute(types.AttributeKeyCheckerReward, checkerRewards.String()),
+			sdk.NewAttribute(types.AttributeKeyChecker, strings.Join(checkerAddrs, ",")),
+			sdk.NewAttribute(types.AttributeKeyCheckerReward, checkerShare.String()),
 			sdk.NewAttribute(types.AttributeKeyProver, prover.String()),
 			sdk.NewAttribute(types.AttributeKeyProverReward, proverRewards.String()),
 		),
@@ -254,6 +365,170 @@ func (k Keeper) updateImportedReward(ctx context.Context, addr sdk.AccAddress, r
 	return k.ImportedRewards.Set(ctx, addr, existingReward)
 }
 
+// PayReward pays an OpenMath or bounty reward from the module account. The part of each denom above the reward
+// vesting threshold vests on a new schedule of the recipient instead of being paid liquid.
+func (k Keeper) PayReward(ctx context.Context, recipient sdk.AccAddress, amount sdk.Coins) (liquid, vesting sdk.Coins, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	threshold := sdk.NewCoins(params.RewardVestingThreshold...)
+	liquid, vesting = sdk.NewCoins(), sdk.NewCoins()
+	for _, coin := range amount {
+		limit := threshold.AmountOf(coin.Denom)
+		if limit.IsPositive() && coin.Amount.GT(limit) {
+			liquid = liquid.Add(sdk.NewCoin(coin.Denom, limit))
+			vesting = vesting.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(limit)))
+		} else {
+			liquid = liquid.Add(coin)
+		}
+	}
+
+	if !liquid.IsZero() {
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, liquid); err != nil {
+			return nil, nil, err
+		}
+	}
+	if !vesting.IsZero() {
+		if err = k.addRewardVesting(ctx, recipient, vesting, *params.RewardVestingPeriod); err != nil {
+			return nil, nil, err
+		}
+	}
+	return liquid, vesting, nil
+}
+
+// addRewardVesting adds a vesting schedule of an amount over a period from the block time to
+// the schedules of an address.
+func (k Keeper) addRewardVesting(ctx context.Context, addr sdk.AccAddress, amount sdk.Coins, period time.Duration) error {
+	vesting, err := k.RewardVestings.Get(ctx, addr)
+	switch {
+	case errors.IsOf(err, collections.ErrNotFound):
+		vesting = types.RewardVesting{Address: addr.String()}
+	case err != nil:
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+	schedule := types.NewVestingSchedule(amount, blockTime, blockTime.Add(period))
+
+	// the amount is merged into the schedule ending at the same time, or into the schedule ending last
+	// once the address has the maximum number of schedules. The vested part of the merged schedule is
+	// released first, and its remainder vests along with the amount from the block time.
+	if i := mergeableVestingSchedule(vesting.Schedules, schedule.EndTime); i >= 0 {
+		merged := vesting.Schedules[i]
+		releasable := merged.ReleasableCoins(blockTime)
+		if !releasable.IsZero() {
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, releasable); err != nil {
+				return err
+			}
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeReleaseVestedReward,
+					sdk.NewAttribute(types.AttributeKeyAddress, vesting.Address),
+					sdk.NewAttribute(sdk.AttributeKeyAmount, releasable.String()),
+				),
+			)
+		}
+
+		remaining := merged.Total.Sub(merged.Released...).Sub(releasable...)
+		if merged.EndTime.After(schedule.EndTime) {
+			schedule.EndTime = merged.EndTime
+		}
+		schedule.Total = remaining.Add(amount...)
+		vesting.Schedules[i] = schedule
+	} else {
+		vesting.Schedules = append(vesting.Schedules, schedule)
+	}
+	if err = k.RewardVestings.Set(ctx, addr, vesting); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVestReward,
+			sdk.NewAttribute(types.AttributeKeyAddress, vesting.Address),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEndTime, schedule.EndTime.String()),
+		),
+	)
+	return nil
+}
+
+// mergeableVestingSchedule returns the index of the schedule a new schedule ending at endTime is
+// merged into: the schedule ending at the same time, or the schedule ending last when the maximum
+// number of schedules is reached. It returns -1 when the new schedule is added on its own.
+func mergeableVestingSchedule(schedules []types.VestingSchedule, endTime time.Time) int {
+	for i, schedule := range schedules {
+		if schedule.EndTime.Equal(endTime) {
+			return i
+		}
+	}
+	if len(schedules) < types.MaxRewardVestingSchedules {
+		return -1
+	}
+
+	last := 0
+	for i, schedule := range schedules {
+		if schedule.EndTime.After(schedules[last].EndTime) {
+			last = i
+		}
+	}
+	return last
+}
+
+// ReleaseVestedReward pays the vested rewards of the vesting schedules of an address not released yet.
+// Fully released schedules are removed, and so is the entry of the address once none is left.
+func (k Keeper) ReleaseVestedReward(ctx context.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	vesting, err := k.RewardVestings.Get(ctx, addr)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return sdk.NewCoins(), nil
+		}
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	released := sdk.NewCoins()
+	schedules := make([]types.VestingSchedule, 0, len(vesting.Schedules))
+	for _, schedule := range vesting.Schedules {
+		releasable := schedule.ReleasableCoins(sdkCtx.BlockTime())
+		released = released.Add(releasable...)
+		schedule.Released = schedule.Released.Add(releasable...)
+		if !schedule.Released.Equal(schedule.Total) {
+			schedules = append(schedules, schedule)
+		}
+	}
+
+	if !released.IsZero() {
+		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, released); err != nil {
+			return nil, err
+		}
+	}
+
+	vesting.Schedules = schedules
+	if len(vesting.Schedules) == 0 {
+		err = k.RewardVestings.Remove(ctx, addr)
+	} else {
+		err = k.RewardVestings.Set(ctx, addr, vesting)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if !released.IsZero() {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeReleaseVestedReward,
+				sdk.NewAttribute(types.AttributeKeyAddress, vesting.Address),
+				sdk.NewAttribute(sdk.AttributeKeyAmount, released.String()),
+			),
+		)
+	}
+	return released, nil
+}
+
 // SetGrant sets a grant in the store
 func (k Keeper) SetGrant(ctx context.Context, grant types.Grant) error {
 	grantor, err := k.authKeeper.AddressCodec().StringToBytes(grant.Grantor)
@@ -356,6 +631,282 @@ func (k Keeper) RefundAndDeleteDeposits(ctx context.Context, proofID string) err
 	})
 }
 
+// ForfeitDeposits slashes the deposits of a failed or expired proof and refunds the rest to their
+// depositors. The forfeited coins are split between the checkers who failed the proof, the grant
+// pool of the theorem and the community pool. Without checkers, or once the theorem left its proof
+// period, those shares go to the community pool as well.
+func (k Keeper) ForfeitDeposits(ctx context.Context, proof types.Proof, checkers []sdk.AccAddress) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var deposits []types.Deposit
+	err = k.IterateDeposits(ctx, proof.Id, func(_ collections.Pair[string, sdk.AccAddress], deposit types.Deposit) (bool, error) {
+		deposits = append(deposits, deposit)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	slashed := sdk.NewCoins()
+	refunded := sdk.NewCoins()
+	for _, deposit := range deposits {
+		depositor, err := k.authKeeper.AddressCodec().StringToBytes(deposit.Depositor)
+		if err != nil {
+			return err
+		}
+
+		amount := sdk.NewCoins(deposit.Amount...)
+		slash, _ := sdk.NewDecCoinsFromCoins(amount...).MulDecTruncate(params.ProofDepositSlashFraction).TruncateDecimal()
+		refund := amount.Sub(slash...)
+		if !refund.IsZero() {
+			if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, depositor, refund); err != nil {
+				return err
+			}
+		}
+		if err = k.Deposits.Remove(ctx, collections.Join(proof.Id, sdk.AccAddress(depositor))); err != nil {
+			return err
+		}
+		slashed = slashed.Add(slash...)
+		refunded = refunded.Add(refund...)
+	}
+
+	// checker share, split evenly among the checkers
+	checkerShare := sdk.NewDecCoins()
+	checkerShareTotal := sdk.NewCoins()
+	if len(checkers) > 0 {
+		checkerShareTotal, _ = sdk.NewDecCoinsFromCoins(slashed...).MulDecTruncate(params.ForfeitedDepositCheckerShare).TruncateDecimal()
+		checkerShare = sdk.NewDecCoinsFromCoins(checkerShareTotal...).QuoDecTruncate(sdkmath.LegacyNewDec(int64(len(checkers))))
+	}
+	if !checkerShare.IsZero() {
+		for _, checker := range checkers {
+			if err = k.updateReward(ctx, checker, checkerShare); err != nil {
+				return err
+			}
+		}
+	}
+
+	// grant share, added to the theorem grant pool while it is open to proofs
+	grantShare := sdk.NewCoins()
+	theorem, err := k.Theorems.Get(ctx, proof.TheoremId)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	if err == nil && theorem.Status == types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD {
+		grantShare, _ = sdk.NewDecCoinsFromCoins(slashed...).MulDecTruncate(params.ForfeitedDepositGrantShare).TruncateDecimal()
+		theorem.TotalGrant = sdk.NewCoins(theorem.TotalGrant...).Add(grantShare...)
+		theorem.ForfeitedDeposits = sdk.NewCoins(theorem.ForfeitedDeposits...).Add(grantShare...)
+		if err = k.Theorems.Set(ctx, theorem.Id, theorem); err != nil {
+			return err
+		}
+	}
+
+	// the remainder goes to the community pool
+	communityPoolShare := slashed.Sub(checkerShareTotal...).Sub(grantShare...)
+	if err = k.fundCommunityPool(ctx, communityPoolShare); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeForfeitProofDeposit,
+			sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", proof.TheoremId)),
+			sdk.NewAttribute(types.AttributeKeyProofID, proof.Id),
+			sdk.NewAttribute(types.AttributeKeyProver, proof.Prover),
+			sdk.NewAttribute(types.AttributeKeySlashed, slashed.String()),
+			sdk.NewAttribute(types.AttributeKeyRefunded, refunded.String()),
+			sdk.NewAttribute(types.AttributeKeyCheckerReward, checkerShare.String()),
+			sdk.NewAttribute(types.AttributeKeyGrantShare, grantShare.String()),
+			sdk.NewAttribute(types.AttributeKeyCommunityPoolShare, communityPoolShare.String()),
+		),
+	)
+	return nil
+}
+
+// ReleaseForfeitedDeposits sends the forfeited deposits added to the grant pool of a theorem that
+// is closed without a passing proof to the community pool, as they are owed to no grantor.
+func (k Keeper) ReleaseForfeitedDeposits(ctx context.Context, theorem types.Theorem) error {
+	return k.fundCommunityPool(ctx, sdk.NewCoins(theorem.ForfeitedDeposits...))
+}
+
+// fundCommunityPool moves coins from the bounty module account to the community pool
+func (k Keeper) fundCommunityPool(ctx context.Context, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	return k.distrKeeper.FundCommunityPool(ctx, amount, k.authKeeper.GetModuleAddress(types.ModuleName))
+}
+
+// ============================== Program Escrow Operations ==============================
+
+// LockProgramRewardPool moves funds from the depositor into the reward pool of a program and
+// records them as a sponsorship of the depositor
+func (k Keeper) LockProgramRewardPool(ctx context.Context, program *types.Program, depositor sdk.AccAddress, amount sdk.Coins) error {
+	if amount.Empty() {
+		return nil
+	}
+	if err := amount.Validate(); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, err.Error())
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.ModuleName, amount); err != nil {
+		return err
+	}
+	program.RewardPool = sdk.NewCoins(program.RewardPool...).Add(amount...)
+	if err := k.addSponsorship(ctx, program.ProgramId, depositor, amount); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeLockProgramRewardPool,
+			sdk.NewAttribute(types.AttributeKeyProgramID, program.ProgramId),
+			sdk.NewAttribute(sdk.AttributeKeySender, depositor.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
+// PayFindingReward pays the finding submitter from the reward pool of its program
+func (k Keeper) PayFindingReward(ctx context.Context, program *types.Program, finding *types.Finding, amount sdk.Coins) error {
+	if err := amount.Validate(); err != nil || amount.Empty() {
+		return errors.Wrapf(types.ErrFindingRewardInvalid, "%s", amount)
+	}
+
+	remaining, hasNegative := sdk.NewCoins(program.RewardPool...).SafeSub(amount...)
+	if hasNegative {
+		return errors.Wrapf(types.ErrProgramRewardPoolInsufficient, "pool (%s), reward (%s)", sdk.NewCoins(program.RewardPool...), amount)
+	}
+
+	submitter, err := k.authKeeper.AddressCodec().StringToBytes(finding.SubmitterAddress)
+	if err != nil {
+		return err
+	}
+	_, vesting, err := k.PayReward(ctx, submitter, amount)
+	if err != nil {
+		return err
+	}
+	program.RewardPool = remaining
+	finding.Reward = sdk.NewCoins(finding.Reward...).Add(amount...)
+	err = k.UpdateHackerReputation(ctx, finding.SubmitterAddress, func(reputation *types.HackerReputation) {
+		reputation.TotalPaid = reputation.TotalPaid.Add(amount...)
+	})
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypePayFindingReward,
+			sdk.NewAttribute(types.AttributeKeyProgramID, program.ProgramId),
+			sdk.NewAttribute(types.AttributeKeyFindingID, finding.FindingId),
+			sdk.NewAttribute(types.AttributeKeyRecipient, finding.SubmitterAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyVesting, vesting.String()),
+		),
+	)
+
+	return nil
+}
+
+// addSponsorship adds the amount to the sponsorship of a program by the sponsor
+func (k Keeper) addSponsorship(ctx context.Context, programID string, sponsor sdk.AccAddress, amount sdk.Coins) error {
+	sponsorship, err := k.Sponsorships.Get(ctx, collections.Join(programID, sponsor))
+	switch {
+	case err == nil:
+		sponsorship.Amount = sponsorship.Amount.Add(amount...)
+	case errors.IsOf(err, collections.ErrNotFound):
+		sponsorship = types.NewSponsorship(programID, sponsor, amount)
+	default:
+		return fmt.Errorf("failed to get sponsorship: %w", err)
+	}
+
+	return k.Sponsorships.Set(ctx, collections.Join(programID, sponsor), sponsorship)
+}
+
+// IterateSponsorships iterates over all the sponsorships of a program and performs a callback function
+func (k Keeper) IterateSponsorships(ctx context.Context, programID string, cb func(key collections.Pair[string, sdk.AccAddress], value types.Sponsorship) (bool, error)) error {
+	rng := collections.NewPrefixedPairRange[string, sdk.AccAddress](programID)
+	return k.Sponsorships.Walk(ctx, rng, cb)
+}
+
+// RefundProgramRewardPool returns the remaining reward pool of a program to its sponsors pro rata to
+// their sponsorship, and deletes the sponsorships. Funds no sponsorship accounts for, including the
+// rounding remainder, are returned to the program admin.
+func (k Keeper) RefundProgramRewardPool(ctx context.Context, program *types.Program) error {
+	pool := sdk.NewCoins(program.RewardPool...)
+
+	var keys []collections.Pair[string, sdk.AccAddress]
+	var sponsorships []types.Sponsorship
+	funded := sdk.NewCoins()
+	err := k.IterateSponsorships(ctx, program.ProgramId, func(key collections.Pair[string, sdk.AccAddress], sponsorship types.Sponsorship) (bool, error) {
+		keys = append(keys, key)
+		sponsorships = append(sponsorships, sponsorship)
+		funded = funded.Add(sponsorship.Amount...)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	remaining := pool
+	for i, sponsorship := range sponsorships {
+		refund := sdk.NewCoins()
+		for _, coin := range sponsorship.Amount {
+			share := pool.AmountOf(coin.Denom).Mul(coin.Amount).Quo(funded.AmountOf(coin.Denom))
+			refund = refund.Add(sdk.NewCoin(coin.Denom, share))
+		}
+		if err = k.refundProgramSponsor(ctx, program.ProgramId, keys[i].K2(), refund); err != nil {
+			return err
+		}
+		remaining = remaining.Sub(refund...)
+
+		if err = k.Sponsorships.Remove(ctx, keys[i]); err != nil {
+			return err
+		}
+	}
+
+	admin, err := k.authKeeper.AddressCodec().StringToBytes(program.AdminAddress)
+	if err != nil {
+		return err
+	}
+	if err = k.refundProgramSponsor(ctx, program.ProgramId, admin, remaining); err != nil {
+		return err
+	}
+	program.RewardPool = sdk.NewCoins()
+
+	return nil
+}
+
+// refundProgramSponsor returns part of the reward pool of a program to the recipient
+func (k Keeper) refundProgramSponsor(ctx context.Context, programID string, recipient sdk.AccAddress, amount sdk.Coins) error {
+	if amount.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, amount); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRefundProgramRewardPool,
+			sdk.NewAttribute(types.AttributeKeyProgramID, programID),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, amount.String()),
+		),
+	)
+
+	return nil
+}
+
 // ============================== Funds Validation ==============================
 
 // ValidateFunds validates funds amount and denomination against module parameters
@@ -337,6 +337,7 @@ func (suite *KeeperTestSuite) TestDistributionGrants() {
 		Description: "Test Description",
 		Proposer:    suite.programAddr.String(),
 		Status:      types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD,
+		TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
 		Complexity:  complexity,
 		Imports:     []uint64{refTheoremID}, // Reference the first theorem
 		TotalGrant:  sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(0))),
@@ -366,7 +367,7 @@ func (suite *KeeperTestSuite) TestDistributionGrants() {
 	checker := suite.whiteHatAddr
 	prover := suite.programAddr
 	theoremType := types.TheoremType_THEOREM_TYPE_ROCQ
-	err = suite.keeper.DistributionGrants(suite.ctx, theorem, theoremType, checker, prover)
+	err = suite.keeper.DistributionGrants(suite.ctx, theorem, []sdk.AccAddress{checker}, prover)
 	require.NoError(suite.T(), err)
 
 	// Calculate expected rewards based on actual implementation
@@ -450,6 +451,7 @@ func (suite *KeeperTestSuite) TestDistributionGrantsInsufficientFunds() {
 		Description: "Test insufficient funds for checker",
 		Proposer:    suite.programAddr.String(),
 		Status:      types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD,
+		TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
 		Complexity:  complexity1,
 		Imports:     []uint64{},
 		// Checker needs: 100 * 10000 = 1,000,000
@@ -458,7 +460,7 @@ func (suite *KeeperTestSuite) TestDistributionGrantsInsufficientFunds() {
 	}
 	require.NoError(suite.T(), suite.keeper.Theorems.Set(suite.ctx, theorem1.Id, theorem1))
 
-	err = suite.keeper.DistributionGrants(suite.ctx, theorem1, types.TheoremType_THEOREM_TYPE_ROCQ, suite.whiteHatAddr, suite.programAddr)
+	err = suite.keeper.DistributionGrants(suite.ctx, theorem1, []sdk.AccAddress{suite.whiteHatAddr}, suite.programAddr)
 	require.Error(suite.T(), err)
 	require.ErrorIs(suite.T(), err, types.ErrInsufficientGrantChecker)
 
@@ -484,6 +486,7 @@ func (suite *KeeperTestSuite) TestDistributionGrantsInsufficientFunds() {
 		Description: "Test insufficient funds for imported rewards",
 		Proposer:    suite.programAddr.String(),
 		Status:      types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD,
+		TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
 		Complexity:  complexity2,
 		Imports:     []uint64{refTheoremID2},
 		// Checker needs: 10 * 10000 = 100,000
@@ -494,7 +497,7 @@ func (suite *KeeperTestSuite) TestDistributionGrantsInsufficientFunds() {
 	}
 	require.NoError(suite.T(), suite.keeper.Theorems.Set(suite.ctx, theorem2.Id, theorem2))
 
-	err = suite.keeper.DistributionGrants(suite.ctx, theorem2, types.TheoremType_THEOREM_TYPE_ROCQ, suite.whiteHatAddr, suite.programAddr)
+	err = suite.keeper.DistributionGrants(suite.ctx, theorem2, []sdk.AccAddress{suite.whiteHatAddr}, suite.programAddr)
 	require.Error(suite.T(), err)
 	require.ErrorIs(suite.T(), err, types.ErrInsufficientGrantTotal)
 
@@ -509,6 +512,7 @@ func (suite *KeeperTestSuite) TestDistributionGrantsInsufficientFunds() {
 		Description: "Test exact sufficient funds",
 		Proposer:    suite.programAddr.String(),
 		Status:      types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD,
+		TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
 		Complexity:  complexity3,
 		Imports:     []uint64{},
 		TotalGrant:  sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100000))),
@@ -516,7 +520,7 @@ func (suite *KeeperTestSuite) TestDistributionGrantsInsufficientFunds() {
 	require.NoError(suite.T(), suite.keeper.Theorems.Set(suite.ctx, theorem3.Id, theorem3))
 
 	theoremType := types.TheoremType_THEOREM_TYPE_ROCQ
-	err = suite.keeper.DistributionGrants(suite.ctx, theorem3, theoremType, suite.whiteHatAddr, suite.programAddr)
+	err = suite.keeper.DistributionGrants(suite.ctx, theorem3, []sdk.AccAddress{suite.whiteHatAddr}, suite.programAddr)
 	require.NoError(suite.T(), err)
 
 	// Verify checker got exact amount
@@ -578,6 +582,7 @@ func (suite *KeeperTestSuite) TestDistributionGrantsMultipleImports() {
 		Description: "Test with multiple imported theorems",
 		Proposer:    suite.programAddr.String(),
 		Status:      types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD,
+		TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
 		Complexity:  complexity,
 		Imports:     refTheoremIDs,
 		// Grant should be large enough for all rewards
@@ -594,7 +599,7 @@ func (suite *KeeperTestSuite) TestDistributionGrantsMultipleImports() {
 	checker := suite.whiteHatAddr
 	prover := suite.normalAddr // Different from checker
 	theoremType := types.TheoremType_THEOREM_TYPE_ROCQ
-	err = suite.keeper.DistributionGrants(suite.ctx, theorem, theoremType, checker, prover)
+	err = suite.keeper.DistributionGrants(suite.ctx, theorem, []sdk.AccAddress{checker}, prover)
 	require.NoError(suite.T(), err)
 
 	// Calculate expected rewards
@@ -677,6 +682,7 @@ func (suite *KeeperTestSuite) TestDistributionGrantsNoImports() {
 		Description: "Test without imported theorems",
 		Proposer:    suite.programAddr.String(),
 		Status:      types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD,
+		TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
 		Complexity:  complexity,
 		Imports:     []uint64{}, // No imports
 		TotalGrant:  grantAmount,
@@ -691,7 +697,7 @@ func (suite *KeeperTestSuite) TestDistributionGrantsNoImports() {
 	checker := suite.whiteHatAddr
 	prover := suite.normalAddr
 	theoremType := types.TheoremType_THEOREM_TYPE_ROCQ
-	err = suite.keeper.DistributionGrants(suite.ctx, theorem, theoremType, checker, prover)
+	err = suite.keeper.DistributionGrants(suite.ctx, theorem, []sdk.AccAddress{checker}, prover)
 	require.NoError(suite.T(), err)
 
 	// Calculate expected rewards
@@ -1064,6 +1070,121 @@ func (suite *KeeperTestSuite) TestIterateDeposits() {
 	require.Equal(suite.T(), 2, errorCount)
 }
 
+// TestForfeitDeposits tests the split of a forfeited proof deposit between the checkers, the grant
+// pool of the theorem, the community pool and the refund to the prover
+func (suite *KeeperTestSuite) TestForfeitDeposits() {
+	testCases := []struct {
+		name          string
+		slashFraction math.LegacyDec
+		checkers      int
+		theoremStatus types.TheoremStatus
+	}{
+		{
+			"no checkers",
+			math.LegacyOneDec(),
+			0,
+			types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD,
+		},
+		{
+			"one checker",
+			math.LegacyOneDec(),
+			1,
+			types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD,
+		},
+		{
+			"two checkers with partial slash",
+			math.LegacyNewDecWithPrec(4, 1),
+			2,
+			types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD,
+		},
+		{
+			"nothing slashed",
+			math.LegacyZeroDec(),
+			1,
+			types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD,
+		},
+		{
+			"theorem out of proof period",
+			math.LegacyOneDec(),
+			1,
+			types.TheoremStatus_THEOREM_STATUS_PASSED,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			checkers := []sdk.AccAddress{suite.bountyAdminAddr, suite.normalAddr}[:tc.checkers]
+
+			params, err := suite.keeper.Params.Get(suite.ctx)
+			require.NoError(suite.T(), err)
+			params.ProofDepositSlashFraction = tc.slashFraction
+			require.NoError(suite.T(), suite.keeper.Params.Set(suite.ctx, params))
+
+			bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			require.NoError(suite.T(), err)
+			theoremID := suite.InitCreateTheorem()
+			proofID := suite.InitSubmitProofHash(theoremID)
+			proof, err := suite.keeper.Proofs.Get(suite.ctx, proofID)
+			require.NoError(suite.T(), err)
+
+			theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+			require.NoError(suite.T(), err)
+			theorem.Status = tc.theoremStatus
+			require.NoError(suite.T(), suite.keeper.Theorems.Set(suite.ctx, theoremID, theorem))
+
+			proverBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.whiteHatAddr, bondDenom)
+			feePool, err := suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+			require.NoError(suite.T(), err)
+			poolBefore := feePool.CommunityPool.AmountOf(bondDenom)
+
+			require.NoError(suite.T(), suite.keeper.ForfeitDeposits(suite.ctx, proof, checkers))
+
+			deposit := proof.Deposit[0].Amount
+			slashed := math.LegacyNewDecFromInt(deposit).Mul(tc.slashFraction).TruncateInt()
+			checkerShare := math.ZeroInt()
+			if len(checkers) > 0 {
+				checkerShare = slashed.MulRaw(2).QuoRaw(10)
+			}
+			grantShare := math.ZeroInt()
+			if tc.theoremStatus == types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD {
+				grantShare = slashed.MulRaw(5).QuoRaw(10)
+			}
+
+			// the deposit record is removed and the unslashed part refunded
+			has, err := suite.keeper.Deposits.Has(suite.ctx, collections.Join(proofID, suite.whiteHatAddr))
+			require.NoError(suite.T(), err)
+			require.False(suite.T(), has)
+			proverAfter := suite.app.BankKeeper.GetBalance(suite.ctx, suite.whiteHatAddr, bondDenom)
+			require.Equal(suite.T(), proverBefore.Amount.Add(deposit.Sub(slashed)), proverAfter.Amount)
+
+			// the checker share is split evenly among the checkers
+			for _, checker := range checkers {
+				reward, err := suite.keeper.Rewards.Get(suite.ctx, checker)
+				if checkerShare.IsZero() {
+					require.True(suite.T(), errors.IsOf(err, collections.ErrNotFound))
+					continue
+				}
+				require.NoError(suite.T(), err)
+				expected := math.LegacyNewDecFromInt(checkerShare).QuoInt64(int64(len(checkers)))
+				require.Equal(suite.T(), expected, reward.Reward.AmountOf(bondDenom))
+			}
+
+			// the grant share only goes to a theorem open to proofs
+			theorem, err = suite.keeper.Theorems.Get(suite.ctx, theoremID)
+			require.NoError(suite.T(), err)
+			require.Equal(suite.T(), grantShare, sdk.NewCoins(theorem.ForfeitedDeposits...).AmountOf(bondDenom))
+			require.Equal(suite.T(), math.NewInt(1e6).Add(grantShare), sdk.NewCoins(theorem.TotalGrant...).AmountOf(bondDenom))
+
+			// the community pool gets the rest
+			feePool, err = suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+			require.NoError(suite.T(), err)
+			communityPoolShare := slashed.Sub(checkerShare).Sub(grantShare)
+			require.Equal(suite.T(), poolBefore.Add(math.LegacyNewDecFromInt(communityPoolShare)), feePool.CommunityPool.AmountOf(bondDenom))
+		})
+	}
+}
+
 // TestValidateFunds tests fund validation functionality
 func (suite *KeeperTestSuite) TestValidateFunds() {
 	// Get bond denom
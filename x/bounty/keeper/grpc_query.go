@@ -2,7 +2,11 @@ package keeper
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"slices"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -30,11 +34,50 @@ func (q queryServer) Programs(c context.Context, req *types.QueryProgramsRequest
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	programs, pageRes, err := query.CollectionFilteredPaginate(c, q.k.Programs, req.Pagination, func(_ string, _ types.Program) (include bool, err error) {
-		return true, nil
-	}, func(_ string, value types.Program) (*types.Program, error) {
-		return &value, nil
-	})
+	var (
+		programStatus types.ProgramStatus
+		admin         sdk.AccAddress
+		err           error
+	)
+	if len(req.Status) != 0 {
+		if programStatus, err = types.ProgramStatusFromString(req.Status); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+	if len(req.AdminAddress) != 0 {
+		if admin, err = q.k.authKeeper.AddressCodec().StringToBytes(req.AdminAddress); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid admin address")
+		}
+	}
+
+	filter := func(p types.Program) bool {
+		return (len(req.Status) == 0 || p.Status == programStatus) &&
+			(len(req.AdminAddress) == 0 || p.AdminAddress == req.AdminAddress)
+	}
+	reverse := req.Pagination != nil && req.Pagination.Reverse
+
+	var (
+		programs []*types.Program
+		pageRes  *query.PageResponse
+	)
+	switch {
+	case len(req.AdminAddress) != 0:
+		programs, pageRes, err = paginateMultiIndex(c, q.k.Programs.Indexes.Admin, q.k.Programs.Get,
+			func(next *collections.Pair[sdk.AccAddress, string]) collections.Ranger[collections.Pair[sdk.AccAddress, string]] {
+				return newIndexPrefixRange(admin, next, reverse)
+			}, req.Pagination, filter)
+	case len(req.Status) != 0:
+		programs, pageRes, err = paginateMultiIndex(c, q.k.Programs.Indexes.Status, q.k.Programs.Get,
+			func(next *collections.Pair[int32, string]) collections.Ranger[collections.Pair[int32, string]] {
+				return newIndexPrefixRange(int32(programStatus), next, reverse)
+			}, req.Pagination, filter)
+	default:
+		programs, pageRes, err = query.CollectionFilteredPaginate(c, q.k.Programs, req.Pagination, func(_ string, _ types.Program) (include bool, err error) {
+			return true, nil
+		}, func(_ string, value types.Program) (*types.Program, error) {
+			return &value, nil
+		})
+	}
 
 	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -66,29 +109,130 @@ func (q queryServer) Program(c context.Context, req *types.QueryProgramRequest)
 	return &types.QueryProgramResponse{Program: &program}, nil
 }
 
+// ProgramMembers returns the team members of a program
+func (q queryServer) ProgramMembers(c context.Context, req *types.QueryProgramMembersRequest) (*types.QueryProgramMembersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.ProgramId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "program-id can not be empty")
+	}
+
+	members, pageRes, err := query.CollectionPaginate(c, q.k.ProgramMembers,
+		req.Pagination, func(_ collections.Pair[string, sdk.AccAddress], value types.ProgramMember) (types.ProgramMember, error) {
+			return value, nil
+		}, query.WithCollectionPaginationPairPrefix[string, sdk.AccAddress](req.ProgramId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProgramMembersResponse{
+		Members:    members,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (q queryServer) Findings(c context.Context, req *types.QueryFindingsRequest) (*types.QueryFindingsResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	if len(req.ProgramId) == 0 && len(req.SubmitterAddress) == 0 {
+	if len(req.ProgramId) == 0 && len(req.SubmitterAddress) == 0 && len(req.DuplicateOf) == 0 &&
+		len(req.Status) == 0 && len(req.SeverityLevel) == 0 && req.CreatedAfter == nil && req.CreatedBefore == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
+	if len(req.TargetId) != 0 && len(req.ProgramId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "program-id is required to filter by target")
+	}
 
-	findings, pageRes, err := query.CollectionFilteredPaginate(c, q.k.Findings, req.Pagination, func(_ string, f types.Finding) (include bool, err error) {
-		switch {
-		case len(req.ProgramId) != 0 && len(req.SubmitterAddress) != 0:
-			return f.ProgramId == req.ProgramId && f.SubmitterAddress == req.SubmitterAddress, nil
-		case len(req.ProgramId) != 0:
-			return f.ProgramId == req.ProgramId, nil
-		case len(req.SubmitterAddress) != 0:
-			return f.SubmitterAddress == req.SubmitterAddress, nil
-		default:
-			return true, nil
+	var (
+		findingStatus types.FindingStatus
+		severityLevel types.SeverityLevel
+		err           error
+	)
+	if len(req.Status) != 0 {
+		if findingStatus, err = types.FindingStatusFromString(req.Status); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
 		}
-	}, func(_ string, value types.Finding) (*types.Finding, error) {
-		return &value, nil
-	})
+	}
+	if len(req.SeverityLevel) != 0 {
+		if severityLevel, err = types.SeverityLevelFromString(types.NormalizeSeverityLevel(req.SeverityLevel)); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	filter := func(f types.Finding) bool {
+		return (len(req.ProgramId) == 0 || f.ProgramId == req.ProgramId) &&
+			(len(req.SubmitterAddress) == 0 || f.SubmitterAddress == req.SubmitterAddress) &&
+			(len(req.Status) == 0 || f.Status == findingStatus) &&
+			(len(req.SeverityLevel) == 0 || f.SeverityLevel == severityLevel) &&
+			(req.CreatedAfter == nil || !f.CreateTime.Before(*req.CreatedAfter)) &&
+			(req.CreatedBefore == nil || f.CreateTime.Before(*req.CreatedBefore))
+	}
+	reverse := req.Pagination != nil && req.Pagination.Reverse
+
+	var (
+		findings []*types.Finding
+		pageRes  *query.PageResponse
+	)
+	switch {
+	case len(req.DuplicateOf) != 0:
+		// duplicates are grouped under their original finding by the duplicate index
+		findings, pageRes, err = paginateIndexedFindings(c, q.k, q.k.DuplicateFindings, req.Pagination, filter,
+			func(key collections.Pair[string, string]) string { return key.K2() },
+			query.WithCollectionPaginationPairPrefix[string, string](req.DuplicateOf),
+		)
+	case len(req.TargetId) != 0:
+		findings, pageRes, err = paginateIndexedFindings(c, q.k, q.k.FindingTargets, req.Pagination, filter,
+			func(key collections.Triple[string, string, string]) string { return key.K3() },
+			func(o *query.CollectionsPaginateOptions[collections.Triple[string, string, string]]) {
+				prefix := collections.TripleSuperPrefix[string, string, string](req.ProgramId, req.TargetId)
+				o.Prefix = &prefix
+			},
+		)
+	case req.CreatedAfter != nil || req.CreatedBefore != nil:
+		findings, pageRes, err = paginateMultiIndex(c, q.k.Findings.Indexes.CreateTime, q.k.Findings.Get,
+			func(next *collections.Pair[time.Time, string]) collections.Ranger[collections.Pair[time.Time, string]] {
+				rng := new(collections.Range[collections.Pair[time.Time, string]])
+				if req.CreatedAfter != nil {
+					rng = rng.StartInclusive(collections.Join(*req.CreatedAfter, ""))
+				}
+				if req.CreatedBefore != nil {
+					rng = rng.EndExclusive(collections.Join(*req.CreatedBefore, ""))
+				}
+				if reverse {
+					rng = rng.Descending()
+					if next != nil {
+						rng = rng.EndInclusive(*next)
+					}
+				} else if next != nil {
+					rng = rng.StartInclusive(*next)
+				}
+				return rng
+			}, req.Pagination, filter)
+	case len(req.Status) != 0:
+		findings, pageRes, err = paginateMultiIndex(c, q.k.Findings.Indexes.Status, q.k.Findings.Get,
+			func(next *collections.Pair[int32, string]) collections.Ranger[collections.Pair[int32, string]] {
+				return newIndexPrefixRange(int32(findingStatus), next, reverse)
+			}, req.Pagination, filter)
+	case len(req.SeverityLevel) != 0:
+		findings, pageRes, err = paginateMultiIndex(c, q.k.Findings.Indexes.Severity, q.k.Findings.Get,
+			func(next *collections.Pair[int32, string]) collections.Ranger[collections.Pair[int32, string]] {
+				return newIndexPrefixRange(int32(severityLevel), next, reverse)
+			}, req.Pagination, filter)
+	case len(req.ProgramId) != 0:
+		findings, pageRes, err = paginateIndexedFindings(c, q.k, q.k.ProgramFindings, req.Pagination, filter,
+			func(key collections.Pair[string, string]) string { return key.K2() },
+			query.WithCollectionPaginationPairPrefix[string, string](req.ProgramId),
+		)
+	default:
+		findings, pageRes, err = query.CollectionFilteredPaginate(c, q.k.Findings, req.Pagination, func(_ string, f types.Finding) (include bool, err error) {
+			return filter(f), nil
+		}, func(_ string, value types.Finding) (*types.Finding, error) {
+			return &value, nil
+		})
+	}
 
 	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -100,6 +244,30 @@ func (q queryServer) Findings(c context.Context, req *types.QueryFindingsRequest
 	}, nil
 }
 
+func paginateIndexedFindings[K any, C query.Collection[K, collections.NoValue]](
+	ctx context.Context,
+	k *Keeper,
+	index C,
+	pageReq *query.PageRequest,
+	filter func(types.Finding) bool,
+	findingID func(K) string,
+	opts ...func(o *query.CollectionsPaginateOptions[K]),
+) ([]*types.Finding, *query.PageResponse, error) {
+	return query.CollectionFilteredPaginate(ctx, index, pageReq, func(key K, _ collections.NoValue) (include bool, err error) {
+		f, err := k.Findings.Get(ctx, findingID(key))
+		if err != nil {
+			return false, err
+		}
+		return filter(f), nil
+	}, func(key K, _ collections.NoValue) (*types.Finding, error) {
+		f, err := k.Findings.Get(ctx, findingID(key))
+		if err != nil {
+			return nil, err
+		}
+		return &f, nil
+	}, opts...)
+}
+
 func (q queryServer) Finding(c context.Context, req *types.QueryFindingRequest) (*types.QueryFindingResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -119,6 +287,126 @@ func (q queryServer) Finding(c context.Context, req *types.QueryFindingRequest)
 	return &types.QueryFindingResponse{Finding: &finding}, nil
 }
 
+// Disclosures returns the findings under disclosure embargo, or overdue for disclosure, ordered by deadline
+func (q queryServer) Disclosures(c context.Context, req *types.QueryDisclosuresRequest) (*types.QueryDisclosuresResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	queue := q.k.DisclosureQueue
+	if req.Overdue {
+		queue = q.k.OverdueDisclosures
+	}
+	findings, pageRes, err := query.CollectionPaginate(c, queue, req.Pagination,
+		func(key collections.Pair[time.Time, string], _ collections.NoValue) (types.Finding, error) {
+			return q.k.Findings.Get(c, key.K2())
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDisclosuresResponse{
+		Findings:   findings,
+		Pagination: pageRes,
+	}, nil
+}
+
+// Dispute returns the dispute of a finding and its votes
+func (q queryServer) Dispute(c context.Context, req *types.QueryDisputeRequest) (*types.QueryDisputeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.FindingId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "finding-id can not be empty")
+	}
+
+	dispute, err := q.k.Disputes.Get(c, req.FindingId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "dispute of finding %s doesn't exist", req.FindingId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	var votes []types.DisputeVote
+	rng := collections.NewPrefixedPairRange[string, sdk.AccAddress](req.FindingId)
+	err = q.k.DisputeVotes.Walk(c, rng, func(_ collections.Pair[string, sdk.AccAddress], vote types.DisputeVote) (bool, error) {
+		votes = append(votes, vote)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDisputeResponse{Dispute: &dispute, Votes: votes}, nil
+}
+
+// Sponsorships returns the sponsorships of a program
+func (q queryServer) Sponsorships(c context.Context, req *types.QuerySponsorshipsRequest) (*types.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if len(req.ProgramId) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "program-id can not be empty")
+	}
+
+	sponsorships, pageRes, err := query.CollectionPaginate(c, q.k.Sponsorships, req.Pagination,
+		func(_ collections.Pair[string, sdk.AccAddress], value types.Sponsorship) (types.Sponsorship, error) {
+			return value, nil
+		}, query.WithCollectionPaginationPairPrefix[string, sdk.AccAddress](req.ProgramId),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QuerySponsorshipsResponse{
+		Sponsorships: sponsorships,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (q queryServer) Hackers(c context.Context, req *types.QueryHackersRequest) (*types.QueryHackersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	hackers, pageRes, err := query.CollectionPaginate(c, q.k.HackerReputations,
+		req.Pagination, func(_ sdk.AccAddress, value types.HackerReputation) (types.HackerReputation, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHackersResponse{
+		Hackers:    hackers,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q queryServer) Hacker(c context.Context, req *types.QueryHackerRequest) (*types.QueryHackerResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	reputation, err := q.k.GetHackerReputation(c, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryHackerResponse{
+		Reputation:     reputation,
+		AcceptanceRate: reputation.AcceptanceRate(),
+	}, nil
+}
+
 func (q queryServer) FindingFingerprint(c context.Context, req *types.QueryFindingFingerprintRequest) (*types.QueryFindingFingerprintResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -164,8 +452,8 @@ func (q queryServer) Theorems(c context.Context, req *types.QueryTheoremsRequest
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	filteredTheorems, pageRes, err := query.CollectionFilteredPaginate(c, q.k.Theorems, req.Pagination, func(_ uint64, _ types.Theorem) (include bool, err error) {
-		return true, nil
+	filteredTheorems, pageRes, err := query.CollectionFilteredPaginate(c, q.k.Theorems, req.Pagination, func(_ uint64, theorem types.Theorem) (include bool, err error) {
+		return req.TheoremType == types.TheoremType_THEOREM_TYPE_UNSPECIFIED || theorem.TheoremType == req.TheoremType, nil
 	}, func(_ uint64, value types.Theorem) (*types.Theorem, error) {
 		return &value, nil
 	})
@@ -197,6 +485,134 @@ func (q queryServer) Theorem(c context.Context, req *types.QueryTheoremRequest)
 	return &types.QueryTheoremResponse{Theorem: &theorem}, nil
 }
 
+func (q queryServer) TheoremByCodeHash(c context.Context, req *types.QueryTheoremByCodeHashRequest) (*types.QueryTheoremByCodeHashResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	codeHash := strings.ToLower(req.CodeHash)
+	if bz, err := hex.DecodeString(codeHash); err != nil || len(bz) != sha256.Size {
+		return nil, status.Error(codes.InvalidArgument, "code hash must be a hex encoded sha256 hash")
+	}
+
+	theorem, err := q.k.GetTheoremByCodeHash(c, codeHash)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "no theorem with code hash %s", codeHash)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTheoremByCodeHashResponse{Theorem: &theorem}, nil
+}
+
+func (q queryServer) TheoremDependents(c context.Context, req *types.QueryTheoremDependentsRequest) (*types.QueryTheoremDependentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.TheoremId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "theorem id can not be 0")
+	}
+
+	dependents, pageRes, err := query.CollectionPaginate(c, q.k.TheoremDependents,
+		req.Pagination, func(key collections.Pair[uint64, uint64], _ collections.NoValue) (uint64, error) {
+			return key.K2(), nil
+		}, query.WithCollectionPaginationPairPrefix[uint64, uint64](req.TheoremId),
+	)
+	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTheoremDependentsResponse{TheoremIds: dependents, Pagination: pageRes}, nil
+}
+
+func (q queryServer) TheoremDependencies(c context.Context, req *types.QueryTheoremDependenciesRequest) (*types.QueryTheoremDependenciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.TheoremId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "theorem id can not be 0")
+	}
+
+	dependencies, err := q.k.GetTheoremDependencies(c, req.TheoremId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "theorem %d doesn't exist", req.TheoremId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTheoremDependenciesResponse{TheoremIds: dependencies}, nil
+}
+
+func (q queryServer) TheoremGraph(c context.Context, req *types.QueryTheoremGraphRequest) (*types.QueryTheoremGraphResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var (
+		nodeIDs []uint64
+		edges   []types.TheoremEdge
+		pageRes *query.PageResponse
+		err     error
+	)
+
+	if req.TheoremId == 0 {
+		// the edges of the whole library, ordered by imported theorem
+		edges, pageRes, err = query.CollectionPaginate(c, q.k.TheoremDependents,
+			req.Pagination, func(key collections.Pair[uint64, uint64], _ collections.NoValue) (types.TheoremEdge, error) {
+				return types.TheoremEdge{Importer: key.K2(), Imported: key.K1()}, nil
+			},
+		)
+		if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		seen := make(map[uint64]bool)
+		for _, edge := range edges {
+			for _, id := range []uint64{edge.Importer, edge.Imported} {
+				if !seen[id] {
+					seen[id] = true
+					nodeIDs = append(nodeIDs, id)
+				}
+			}
+		}
+		slices.Sort(nodeIDs)
+	} else {
+		dependencies, err := q.k.GetTheoremDependencies(c, req.TheoremId)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return nil, status.Errorf(codes.NotFound, "theorem %d doesn't exist", req.TheoremId)
+			}
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		nodeIDs = append([]uint64{req.TheoremId}, dependencies...)
+	}
+
+	nodes := make([]types.TheoremNode, 0, len(nodeIDs))
+	for _, id := range nodeIDs {
+		theorem, err := q.k.Theorems.Get(c, id)
+		if err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		nodes = append(nodes, types.TheoremNode{
+			Id:            theorem.Id,
+			Title:         theorem.Title,
+			Status:        theorem.Status,
+			Complexity:    theorem.Complexity,
+			ImportedCount: theorem.ImportedCount,
+		})
+		if req.TheoremId != 0 {
+			for _, importID := range theorem.Imports {
+				edges = append(edges, types.TheoremEdge{Importer: theorem.Id, Imported: importID})
+			}
+		}
+	}
+
+	return &types.QueryTheoremGraphResponse{Nodes: nodes, Edges: edges, Pagination: pageRes}, nil
+}
+
 func (q queryServer) Proof(c context.Context, req *types.QueryProofRequest) (*types.QueryProofResponse, error) {
 	if req == nil {
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
@@ -215,7 +631,41 @@ func (q queryServer) Proof(c context.Context, req *types.QueryProofRequest) (*ty
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	return &types.QueryProofResponse{Proof: &proof}, nil
+	verdicts, err := q.k.GetProofVerdicts(c, proof.Id)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProofResponse{Proof: &proof, Verdicts: verdicts}, nil
+}
+
+func (q queryServer) ProofChunk(c context.Context, req *types.QueryProofChunkRequest) (*types.QueryProofChunkResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	if req.ProofId == "" {
+		return nil, status.Error(codes.InvalidArgument, "proof id can not be empty")
+	}
+	req.ProofId = strings.ToLower(req.ProofId)
+
+	proof, err := q.k.Proofs.Get(c, req.ProofId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, status.Errorf(codes.NotFound, "proof %s doesn't exist", req.ProofId)
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	data, hash, err := q.k.GetProofChunk(c, proof, int(req.Index))
+	if err != nil {
+		if errors.IsOf(err, types.ErrProofChunkNotExist) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProofChunkResponse{Data: data, Hash: hash, Total: uint32(len(proof.DetailChunks))}, nil
 }
 
 func (q queryServer) Proofs(c context.Context, req *types.QueryProofsRequest) (*types.QueryProofsResponse, error) {
@@ -233,14 +683,18 @@ func (q queryServer) Proofs(c context.Context, req *types.QueryProofsRequest) (*
 		err     error
 	)
 
+	// proofs are listed in the order of submission
 	proofs, pageRes, err = query.CollectionPaginate(c, q.k.ProofsByTheorem,
-		req.Pagination, func(key collections.Pair[uint64, string], _ []byte) (*types.Proof, error) {
-			proof, err := q.k.Proofs.Get(c, key.K2())
+		req.Pagination, func(key collections.Triple[uint64, uint64, string], _ collections.NoValue) (*types.Proof, error) {
+			proof, err := q.k.Proofs.Get(c, key.K3())
 			if err != nil {
 				return nil, err
 			}
 			return &proof, nil
-		}, query.WithCollectionPaginationPairPrefix[uint64, string](req.TheoremId),
+		}, func(o *query.CollectionsPaginateOptions[collections.Triple[uint64, uint64, string]]) {
+			prefix := collections.TriplePrefix[uint64, uint64, string](req.TheoremId)
+			o.Prefix = &prefix
+		},
 	)
 	if err != nil && !errors.IsOf(err, collections.ErrInvalidIterator) {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -288,14 +742,27 @@ func (q queryServer) AllRewards(c context.Context, req *types.QueryRewardsReques
 		importedRewardCoins = importedRewards.Reward
 	}
 
-	// If both are empty, return not found error
-	if proofRewardCoins.IsZero() && importedRewardCoins.IsZero() {
+	// Get the rewards vesting schedule (if it exists)
+	var vesting *types.RewardVesting
+	releasable := sdk.NewCoins()
+	rewardVesting, err := q.k.RewardVestings.Get(c, addr)
+	if err == nil {
+		vesting = &rewardVesting
+		releasable = rewardVesting.ReleasableCoins(sdk.UnwrapSDKContext(c).BlockTime())
+	} else if !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// If all are empty, return not found error
+	if proofRewardCoins.IsZero() && importedRewardCoins.IsZero() && vesting == nil {
 		return nil, status.Errorf(codes.NotFound, "no rewards found for address %s", req.Address)
 	}
 
 	return &types.QueryRewardsResponse{
 		ProofRewards:    proofRewardCoins,
 		ImportedRewards: importedRewardCoins,
+		Vesting:         vesting,
+		Releasable:      releasable,
 	}, nil
 }
 
@@ -337,3 +804,78 @@ func (q queryServer) Grants(c context.Context, req *types.QueryGrantsRequest) (*
 		Pagination: pageRes,
 	}, nil
 }
+
+func (q queryServer) OpenMathStats(c context.Context, req *types.QueryOpenMathStatsRequest) (*types.QueryOpenMathStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := q.k.authKeeper.AddressCodec().StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	stats, err := q.k.GetOpenMathStats(c, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryOpenMathStatsResponse{Stats: stats}, nil
+}
+
+func (q queryServer) TheoremTypeStats(c context.Context, req *types.QueryTheoremTypeStatsRequest) (*types.QueryTheoremTypeStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var stats []types.TheoremTypeStats
+	err := q.k.TheoremTypeStats.Walk(c, nil, func(_ int32, value types.TheoremTypeStats) (bool, error) {
+		stats = append(stats, value)
+		return false, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryTheoremTypeStatsResponse{Stats: stats}, nil
+}
+
+// Leaderboard returns the addresses ranked by a metric, the highest score first unless reversed.
+func (q queryServer) Leaderboard(c context.Context, req *types.QueryLeaderboardRequest) (*types.QueryLeaderboardResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if _, ok := types.LeaderboardMetric_name[int32(req.Metric)]; !ok || req.Metric == types.LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED {
+		return nil, status.Error(codes.InvalidArgument, "invalid leaderboard metric")
+	}
+	if req.Metric.IsRewardMetric() {
+		if err := sdk.ValidateDenom(req.Denom); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "a valid denom is required for reward metrics")
+		}
+	}
+
+	// the leaderboards are stored by ascending score
+	pageReq := &query.PageRequest{}
+	if req.Pagination != nil {
+		*pageReq = *req.Pagination
+	}
+	pageReq.Reverse = !pageReq.Reverse
+
+	entries, pageRes, err := query.CollectionPaginate(c, q.k.Leaderboards,
+		pageReq, func(key collections.Pair[string, collections.Pair[uint64, sdk.AccAddress]], _ collections.NoValue) (types.LeaderboardEntry, error) {
+			stats, err := q.k.GetOpenMathStats(c, key.K2().K2())
+			if err != nil {
+				return types.LeaderboardEntry{}, err
+			}
+			return types.LeaderboardEntry{Score: key.K2().K1(), Stats: stats}, nil
+		}, query.WithCollectionPaginationPairPrefix[string, collections.Pair[uint64, sdk.AccAddress]](types.LeaderboardName(req.Metric, req.Denom)),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryLeaderboardResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
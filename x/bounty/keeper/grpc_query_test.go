@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/google/uuid"
 
@@ -253,6 +254,118 @@ func (suite *KeeperTestSuite) TestGRPCQueryFindings() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryIndexedPrograms() {
+	queryClient := suite.queryClient
+
+	activePid, inactivePid := uuid.NewString(), uuid.NewString()
+	suite.InitCreateProgram(activePid)
+	suite.InitActivateProgram(activePid)
+	suite.InitCreateProgram(inactivePid)
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	res, err := queryClient.Programs(ctx, &types.QueryProgramsRequest{Status: "active"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Programs, 1)
+	suite.Require().Equal(activePid, res.Programs[0].ProgramId)
+
+	res, err = queryClient.Programs(ctx, &types.QueryProgramsRequest{Status: "PROGRAM_STATUS_INACTIVE"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Programs, 1)
+	suite.Require().Equal(inactivePid, res.Programs[0].ProgramId)
+
+	res, err = queryClient.Programs(ctx, &types.QueryProgramsRequest{AdminAddress: suite.programAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Programs, 2)
+
+	res, err = queryClient.Programs(ctx, &types.QueryProgramsRequest{AdminAddress: suite.normalAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Programs)
+
+	_, err = queryClient.Programs(ctx, &types.QueryProgramsRequest{Status: "unknown"})
+	suite.Require().Error(err)
+
+	// the status index is updated when a program changes status
+	_, err = suite.msgServer.CloseProgram(ctx, types.NewMsgCloseProgram(activePid, suite.bountyAdminAddr))
+	suite.Require().NoError(err)
+	res, err = queryClient.Programs(ctx, &types.QueryProgramsRequest{Status: "active"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Programs)
+	res, err = queryClient.Programs(ctx, &types.QueryProgramsRequest{Status: "closed", AdminAddress: suite.programAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Programs, 1)
+	suite.Require().Equal(activePid, res.Programs[0].ProgramId)
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryIndexedFindings() {
+	queryClient := suite.queryClient
+
+	pid := uuid.NewString()
+	suite.InitCreateProgram(pid)
+	suite.InitActivateProgram(pid)
+
+	start := suite.ctx.BlockTime()
+	fids := make([]string, 3)
+	for i := range fids {
+		suite.ctx = suite.ctx.WithBlockTime(start.Add(time.Duration(i) * time.Hour))
+		fids[i] = suite.InitSubmitFinding(pid, uuid.NewString())
+	}
+	suite.InitActivateFinding(fids[0])
+	finding, err := suite.keeper.Findings.Get(suite.ctx, fids[0])
+	suite.Require().NoError(err)
+	suite.InitConfirmFinding(fids[0], suite.keeper.GetFindingFingerprintHash(&finding))
+
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	res, err := queryClient.Findings(ctx, &types.QueryFindingsRequest{Status: "confirmed"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Findings, 1)
+	suite.Require().Equal(fids[0], res.Findings[0].FindingId)
+
+	res, err = queryClient.Findings(ctx, &types.QueryFindingsRequest{Status: "submitted", SeverityLevel: "critical"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Findings, 2)
+
+	res, err = queryClient.Findings(ctx, &types.QueryFindingsRequest{SeverityLevel: "low"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Findings)
+
+	_, err = queryClient.Findings(ctx, &types.QueryFindingsRequest{SeverityLevel: "unknown"})
+	suite.Require().Error(err)
+
+	// create time range, newest first
+	after, before := start.Add(time.Hour), start.Add(3*time.Hour)
+	res, err = queryClient.Findings(ctx, &types.QueryFindingsRequest{
+		CreatedAfter:  &after,
+		CreatedBefore: &before,
+		Pagination:    &query.PageRequest{Reverse: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Findings, 2)
+	suite.Require().Equal(fids[2], res.Findings[0].FindingId)
+	suite.Require().Equal(fids[1], res.Findings[1].FindingId)
+
+	// key based pagination over the time index
+	var got []string
+	pageReq := &query.PageRequest{Limit: 1, CountTotal: true}
+	for {
+		res, err = queryClient.Findings(ctx, &types.QueryFindingsRequest{CreatedAfter: &start, Pagination: pageReq})
+		suite.Require().NoError(err)
+		for _, f := range res.Findings {
+			got = append(got, f.FindingId)
+		}
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	}
+	suite.Require().Equal(fids, got)
+
+	_, err = queryClient.Findings(ctx, &types.QueryFindingsRequest{
+		Status:     "submitted",
+		Pagination: &query.PageRequest{Key: []byte("key"), Offset: 1},
+	})
+	suite.Require().Error(err)
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryFindingFingerprint() {
 	queryClient := suite.queryClient
 
@@ -375,6 +488,18 @@ func (suite *KeeperTestSuite) TestGRPCQueryTheorems() {
 			1, // Should return only 1 theorem due to pagination limit
 			true,
 		},
+		{
+			"filter by theorem type",
+			&types.QueryTheoremsRequest{TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ},
+			2,
+			true,
+		},
+		{
+			"filter by other theorem type",
+			&types.QueryTheoremsRequest{TheoremType: types.TheoremType_THEOREM_TYPE_LEAN},
+			0,
+			true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -497,6 +622,133 @@ func (suite *KeeperTestSuite) TestGRPCQueryProof() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestGRPCQueryProofChunk() {
+	queryClient := suite.queryClient
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	prover := suite.whiteHatAddr.String()
+
+	theoremID := suite.InitCreateTheorem()
+	proofID := suite.InitSubmitProofHash(theoremID)
+	var hashes []string
+	for _, part := range []string{"This is a valid ", "proof detail"} {
+		res, err := suite.msgServer.UploadProofChunk(ctx, types.NewMsgUploadProofChunk(proofID, prover, []byte(part)))
+		suite.Require().NoError(err)
+		hashes = append(hashes, res.Hash)
+	}
+	_, err := suite.msgServer.SubmitProofDetailChunks(ctx, types.NewMsgSubmitProofDetailChunks(proofID, prover, hashes, false))
+	suite.Require().NoError(err)
+
+	testCases := []struct {
+		name    string
+		req     *types.QueryProofChunkRequest
+		expData string
+		expPass bool
+	}{
+		{"empty request", &types.QueryProofChunkRequest{}, "", false},
+		{"non-existent proof ID", &types.QueryProofChunkRequest{ProofId: "non-existent"}, "", false},
+		{"first chunk", &types.QueryProofChunkRequest{ProofId: proofID, Index: 0}, "This is a valid ", true},
+		{"last chunk", &types.QueryProofChunkRequest{ProofId: proofID, Index: 1}, "proof detail", true},
+		{"index out of range", &types.QueryProofChunkRequest{ProofId: proofID, Index: 2}, "", false},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			res, err := queryClient.ProofChunk(ctx, tc.req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expData, string(res.Data))
+				suite.Require().Equal(hashes[tc.req.Index], res.Hash)
+				suite.Require().Equal(uint32(2), res.Total)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryLeaderboard() {
+	queryClient := suite.queryClient
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	record := func(addr sdk.AccAddress, proven uint64, rewards int64) {
+		err := suite.keeper.UpdateOpenMathStats(ctx, addr, func(stats *types.OpenMathStats) {
+			stats.TheoremsProven += proven
+			stats.ProverRewards = stats.ProverRewards.Add(sdk.NewDecCoin("uctk", math.NewInt(rewards)))
+		})
+		suite.Require().NoError(err)
+	}
+	record(suite.whiteHatAddr, 1, 500)
+	record(suite.normalAddr, 2, 100)
+	record(suite.programAddr, 1, 50)
+	// the entry of the previous score is replaced
+	record(suite.whiteHatAddr, 2, 0)
+
+	addresses := func(entries []types.LeaderboardEntry) []string {
+		var addrs []string
+		for _, entry := range entries {
+			addrs = append(addrs, entry.Stats.Address)
+		}
+		return addrs
+	}
+
+	testCases := []struct {
+		name     string
+		req      *types.QueryLeaderboardRequest
+		expAddrs []string
+		expPass  bool
+	}{
+		{"unspecified metric", &types.QueryLeaderboardRequest{}, nil, false},
+		{"reward metric without denom", &types.QueryLeaderboardRequest{Metric: types.LeaderboardMetric_LEADERBOARD_METRIC_PROVER_REWARDS}, nil, false},
+		{
+			"theorems proven",
+			&types.QueryLeaderboardRequest{Metric: types.LeaderboardMetric_LEADERBOARD_METRIC_THEOREMS_PROVEN},
+			[]string{suite.whiteHatAddr.String(), suite.normalAddr.String(), suite.programAddr.String()},
+			true,
+		},
+		{
+			"theorems proven, reversed and paginated",
+			&types.QueryLeaderboardRequest{
+				Metric:     types.LeaderboardMetric_LEADERBOARD_METRIC_THEOREMS_PROVEN,
+				Pagination: &query.PageRequest{Limit: 1, Reverse: true},
+			},
+			[]string{suite.programAddr.String()},
+			true,
+		},
+		{
+			"prover rewards",
+			&types.QueryLeaderboardRequest{Metric: types.LeaderboardMetric_LEADERBOARD_METRIC_PROVER_REWARDS, Denom: "uctk"},
+			[]string{suite.whiteHatAddr.String(), suite.normalAddr.String(), suite.programAddr.String()},
+			true,
+		},
+		{
+			"prover rewards of another denom",
+			&types.QueryLeaderboardRequest{Metric: types.LeaderboardMetric_LEADERBOARD_METRIC_PROVER_REWARDS, Denom: "stake"},
+			nil,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			res, err := queryClient.Leaderboard(ctx, tc.req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expAddrs, addresses(res.Entries))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+
+	res, err := queryClient.Leaderboard(ctx, &types.QueryLeaderboardRequest{Metric: types.LeaderboardMetric_LEADERBOARD_METRIC_THEOREMS_PROVEN})
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(3), res.Entries[0].Score)
+}
+
 func (suite *KeeperTestSuite) TestGRPCQueryProofs() {
 	queryClient := suite.queryClient
 
@@ -744,3 +996,71 @@ func (suite *KeeperTestSuite) TestGRPCQueryGrants() {
 		})
 	}
 }
+
+// initTheoremLibrary stores theorem 1, theorem 2 importing 1, theorem 3 importing 2 and 1,
+// and theorem 4 without imports
+func (suite *KeeperTestSuite) initTheoremLibrary() {
+	library := map[uint64][]uint64{
+		1: nil,
+		2: {1},
+		3: {2, 1},
+		4: nil,
+	}
+	for id, imports := range library {
+		theorem := types.Theorem{
+			Id:       id,
+			Title:    fmt.Sprintf("Theorem %d", id),
+			Proposer: suite.programAddr.String(),
+			Status:   types.TheoremStatus_THEOREM_STATUS_PASSED,
+			Imports:  imports,
+		}
+		suite.Require().NoError(suite.keeper.Theorems.Set(suite.ctx, id, theorem))
+		suite.Require().NoError(suite.keeper.IndexTheoremImports(suite.ctx, theorem))
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryTheoremGraph() {
+	queryClient := suite.queryClient
+	suite.initTheoremLibrary()
+	ctx := sdk.WrapSDKContext(suite.ctx)
+
+	dependents, err := queryClient.TheoremDependents(ctx, &types.QueryTheoremDependentsRequest{TheoremId: 1})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{2, 3}, dependents.TheoremIds)
+
+	dependents, err = queryClient.TheoremDependents(ctx, &types.QueryTheoremDependentsRequest{TheoremId: 3})
+	suite.Require().NoError(err)
+	suite.Require().Empty(dependents.TheoremIds)
+
+	_, err = queryClient.TheoremDependents(ctx, &types.QueryTheoremDependentsRequest{})
+	suite.Require().Error(err)
+
+	dependencies, err := queryClient.TheoremDependencies(ctx, &types.QueryTheoremDependenciesRequest{TheoremId: 3})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]uint64{1, 2}, dependencies.TheoremIds)
+
+	_, err = queryClient.TheoremDependencies(ctx, &types.QueryTheoremDependenciesRequest{TheoremId: 9999})
+	suite.Require().Error(err)
+
+	// the graph of a theorem holds the theorem and its dependencies
+	graph, err := queryClient.TheoremGraph(ctx, &types.QueryTheoremGraphRequest{TheoremId: 3})
+	suite.Require().NoError(err)
+	suite.Require().Len(graph.Nodes, 3)
+	suite.Require().Equal(uint64(3), graph.Nodes[0].Id)
+	suite.Require().ElementsMatch([]types.TheoremEdge{
+		{Importer: 3, Imported: 2},
+		{Importer: 3, Imported: 1},
+		{Importer: 2, Imported: 1},
+	}, graph.Edges)
+
+	// the graph of the library holds every imported or importing theorem
+	graph, err = queryClient.TheoremGraph(ctx, &types.QueryTheoremGraphRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(graph.Nodes, 3)
+	suite.Require().Len(graph.Edges, 3)
+
+	graph, err = queryClient.TheoremGraph(ctx, &types.QueryTheoremGraphRequest{Pagination: &query.PageRequest{Limit: 2}})
+	suite.Require().NoError(err)
+	suite.Require().Len(graph.Edges, 2)
+	suite.Require().NotNil(graph.Pagination.NextKey)
+}
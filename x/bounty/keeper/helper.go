@@ -12,11 +12,12 @@ import (
 
 func (k Keeper) GetProgramFingerprintHash(program *types.Program) string {
 	programFingerprint := &types.ProgramFingerprint{
-		ProgramId:    program.ProgramId,
-		Name:         program.Name,
-		Detail:       program.Detail,
-		AdminAddress: program.AdminAddress,
-		Status:       program.Status,
+		ProgramId:      program.ProgramId,
+		Name:           program.Name,
+		Detail:         program.Detail,
+		AdminAddress:   program.AdminAddress,
+		Status:         program.Status,
+		RewardSchedule: program.RewardSchedule,
 	}
 
 	bz := k.cdc.MustMarshal(programFingerprint)
@@ -49,14 +50,15 @@ func (k Keeper) GetProofHash(theoremID uint64, prover, detail string) string {
 	return hex.EncodeToString(hash[:])
 }
 
-// HasActiveProofs checks if a theorem has any proofs in hash lock or detail period
+// HasActiveProofs checks if a theorem has any proofs in hash lock or detail period, and returns the
+// earliest submitted one
 func (k Keeper) HasActiveProofs(ctx context.Context, theoremID uint64) (bool, string, error) {
 	var activeProofID string
 	hasActiveProof := false
 
-	rng := collections.NewPrefixedPairRange[uint64, string](theoremID)
-	err := k.ProofsByTheorem.Walk(ctx, rng, func(key collections.Pair[uint64, string], _ []byte) (bool, error) {
-		proof, err := k.Proofs.Get(ctx, key.K2())
+	rng := collections.NewPrefixedTripleRange[uint64, uint64, string](theoremID)
+	err := k.ProofsByTheorem.Walk(ctx, rng, func(key collections.Triple[uint64, uint64, string]) (bool, error) {
+		proof, err := k.Proofs.Get(ctx, key.K3())
 		if err != nil {
 			return false, err
 		}
@@ -72,6 +74,27 @@ func (k Keeper) HasActiveProofs(ctx context.Context, theoremID uint64) (bool, st
 	return hasActiveProof, activeProofID, err
 }
 
+// NextRevealedProof returns the earliest submitted proof of a theorem in detail period, which is the
+// next to be verified. Proofs still in hash lock period are skipped, they are not ready for checkers.
+func (k Keeper) NextRevealedProof(ctx context.Context, theoremID uint64) (string, error) {
+	var proofID string
+
+	rng := collections.NewPrefixedTripleRange[uint64, uint64, string](theoremID)
+	err := k.ProofsByTheorem.Walk(ctx, rng, func(key collections.Triple[uint64, uint64, string]) (bool, error) {
+		proof, err := k.Proofs.Get(ctx, key.K3())
+		if err != nil {
+			return false, err
+		}
+		if proof.Status == types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD {
+			proofID = proof.Id
+			return true, nil
+		}
+		return false, nil
+	})
+
+	return proofID, err
+}
+
 // GetProgramFindings retrieves all findings associated with a program
 func (k Keeper) GetProgramFindings(ctx context.Context, programID string) ([]string, error) {
 	var findings []string
@@ -0,0 +1,213 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/collections/indexes"
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// ProgramIndexes defines the secondary indexes of the programs.
+type ProgramIndexes struct {
+	// Status key: (status, programID)
+	Status *indexes.Multi[int32, string, types.Program]
+	// Admin key: (admin, programID)
+	Admin *indexes.Multi[sdk.AccAddress, string, types.Program]
+}
+
+// IndexesList implements collections.Indexes.
+func (i ProgramIndexes) IndexesList() []collections.Index[string, types.Program] {
+	return []collections.Index[string, types.Program]{i.Status, i.Admin}
+}
+
+// NewProgramIndexes creates the secondary indexes of the programs.
+func NewProgramIndexes(sb *collections.SchemaBuilder, addressCodec address.Codec) ProgramIndexes {
+	return ProgramIndexes{
+		Status: indexes.NewMulti(sb, types.ProgramStatusIndexKey, "programs_by_status",
+			collections.Int32Key, collections.StringKey,
+			func(_ string, program types.Program) (int32, error) {
+				return int32(program.Status), nil
+			},
+		),
+		Admin: indexes.NewMulti(sb, types.ProgramAdminIndexKey, "programs_by_admin",
+			sdk.AccAddressKey, collections.StringKey,
+			func(_ string, program types.Program) (sdk.AccAddress, error) {
+				return addressCodec.StringToBytes(program.AdminAddress)
+			},
+		),
+	}
+}
+
+// FindingIndexes defines the secondary indexes of the findings.
+type FindingIndexes struct {
+	// Status key: (status, findingID)
+	Status *indexes.Multi[int32, string, types.Finding]
+	// Severity key: (severityLevel, findingID)
+	Severity *indexes.Multi[int32, string, types.Finding]
+	// CreateTime key: (createTime, findingID)
+	CreateTime *indexes.Multi[time.Time, string, types.Finding]
+}
+
+// IndexesList implements collections.Indexes.
+func (i FindingIndexes) IndexesList() []collections.Index[string, types.Finding] {
+	return []collections.Index[string, types.Finding]{i.Status, i.Severity, i.CreateTime}
+}
+
+// NewFindingIndexes creates the secondary indexes of the findings.
+func NewFindingIndexes(sb *collections.SchemaBuilder) FindingIndexes {
+	return FindingIndexes{
+		Status: indexes.NewMulti(sb, types.FindingStatusIndexKey, "findings_by_status",
+			collections.Int32Key, collections.StringKey,
+			func(_ string, finding types.Finding) (int32, error) {
+				return int32(finding.Status), nil
+			},
+		),
+		Severity: indexes.NewMulti(sb, types.FindingSeverityIndexKey, "findings_by_severity",
+			collections.Int32Key, collections.StringKey,
+			func(_ string, finding types.Finding) (int32, error) {
+				return int32(finding.SeverityLevel), nil
+			},
+		),
+		CreateTime: indexes.NewMulti(sb, types.FindingTimeIndexKey, "findings_by_create_time",
+			sdk.TimeKey, collections.StringKey,
+			func(_ string, finding types.Finding) (time.Time, error) {
+				return finding.CreateTime, nil
+			},
+		),
+	}
+}
+
+// RebuildIndexes sets every program and finding again, which writes their secondary indexes.
+func (k Keeper) RebuildIndexes(ctx context.Context) error {
+	programs, err := k.Programs.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	programKVs, err := programs.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range programKVs {
+		if err = k.Programs.Set(ctx, kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+
+	findings, err := k.Findings.Iterate(ctx, nil)
+	if err != nil {
+		return err
+	}
+	findingKVs, err := findings.KeyValues()
+	if err != nil {
+		return err
+	}
+	for _, kv := range findingKVs {
+		if err = k.Findings.Set(ctx, kv.Key, kv.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// paginateMultiIndex pages through the values referenced by a secondary index in the order of its
+// keys. The ranger bounds the index, it is given the key to resume from when paginating by key.
+func paginateMultiIndex[R, V any](
+	ctx context.Context,
+	index *indexes.Multi[R, string, V],
+	get func(context.Context, string) (V, error),
+	ranger func(next *collections.Pair[R, string]) collections.Ranger[collections.Pair[R, string]],
+	pageReq *query.PageRequest,
+	filter func(V) bool,
+) ([]*V, *query.PageResponse, error) {
+	if pageReq == nil {
+		pageReq = &query.PageRequest{}
+	}
+	if len(pageReq.Key) != 0 && pageReq.Offset > 0 {
+		return nil, nil, fmt.Errorf("invalid request, either offset or key is expected, got both")
+	}
+	limit := pageReq.Limit
+	if limit == 0 {
+		limit = query.DefaultLimit
+	}
+	countTotal := pageReq.CountTotal && len(pageReq.Key) == 0
+
+	var next *collections.Pair[R, string]
+	if len(pageReq.Key) != 0 {
+		_, key, err := index.KeyCodec().Decode(pageReq.Key)
+		if err != nil {
+			return nil, nil, err
+		}
+		next = &key
+	}
+
+	iter, err := index.Iterate(ctx, ranger(next))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer iter.Close()
+
+	var (
+		results []*V
+		matched uint64
+		nextKey []byte
+	)
+	for ; iter.Valid(); iter.Next() {
+		key, err := iter.FullKey()
+		if err != nil {
+			return nil, nil, err
+		}
+		value, err := get(ctx, key.K2())
+		if err != nil {
+			return nil, nil, err
+		}
+		if !filter(value) {
+			continue
+		}
+
+		matched++
+		if matched <= pageReq.Offset {
+			continue
+		}
+		if uint64(len(results)) < limit {
+			results = append(results, &value)
+			continue
+		}
+		if nextKey == nil {
+			if nextKey, err = collections.EncodeKeyWithPrefix(nil, index.KeyCodec(), key); err != nil {
+				return nil, nil, err
+			}
+		}
+		if !countTotal {
+			break
+		}
+	}
+
+	pageRes := &query.PageResponse{NextKey: nextKey}
+	if countTotal {
+		pageRes.Total = matched
+	}
+	return results, pageRes, nil
+}
+
+// newIndexPrefixRange returns the range of the index keys with the given reference key, in the
+// order of the request and resuming from the next key when set.
+func newIndexPrefixRange[R any](refKey R, next *collections.Pair[R, string], reverse bool) *collections.PairRange[R, string] {
+	rng := collections.NewPrefixedPairRange[R, string](refKey)
+	if reverse {
+		rng = rng.Descending()
+		if next != nil {
+			rng = rng.EndInclusive(next.K2())
+		}
+	} else if next != nil {
+		rng = rng.StartInclusive(next.K2())
+	}
+	return rng
+}
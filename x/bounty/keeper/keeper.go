@@ -18,10 +18,11 @@ type Keeper struct {
 	cdc codec.BinaryCodec // Codec for binary encoding/decoding
 
 	// External keepers
-	certKeeper types.CertKeeper
-	authKeeper types.AccountKeeper
-	bankKeeper types.BankKeeper
-	authority  string
+	certKeeper  types.CertKeeper
+	authKeeper  types.AccountKeeper
+	bankKeeper  types.BankKeeper
+	distrKeeper types.DistrKeeper
+	authority   string
 
 	storeService corestoretypes.KVStoreService // KVStore service for state persistence
 	Schema       collections.Schema            // Collections schema
@@ -30,21 +31,43 @@ type Keeper struct {
 	Params collections.Item[types.Params] // Global module parameters
 
 	// OpenBounty
-	Programs        collections.Map[string, types.Program]
-	Findings        collections.Map[string, types.Finding]
-	ProgramFindings collections.KeySet[collections.Pair[string, string]] // ProgramFindings key: (programID, findingID)
+	Programs            *collections.IndexedMap[string, types.Program, ProgramIndexes]
+	Findings            *collections.IndexedMap[string, types.Finding, FindingIndexes]
+	ProgramFindings     collections.KeySet[collections.Pair[string, string]]                           // ProgramFindings key: (programID, findingID)
+	ProgramMembers      collections.Map[collections.Pair[string, sdk.AccAddress], types.ProgramMember] // ProgramMembers key: (programID, member) | value: ProgramMember
+	FindingApprovals    collections.Map[collections.Pair[string, sdk.AccAddress], string]              // FindingApprovals key: (findingID, approver) | value: approved finding fingerprint
+	Disputes            collections.Map[string, types.Dispute]                                         // Disputes key: findingID | value: Dispute
+	DisputeVotes        collections.Map[collections.Pair[string, sdk.AccAddress], types.DisputeVote]   // DisputeVotes key: (findingID, voter) | value: DisputeVote
+	ActiveDisputesQueue collections.KeySet[collections.Pair[time.Time, string]]                        // ActiveDisputesQueue key: (endTime, findingID)
+	DuplicateFindings   collections.KeySet[collections.Pair[string, string]]                           // DuplicateFindings key: (originalFindingID, duplicateFindingID)
+	FindingSLAQueue     collections.KeySet[collections.Pair[time.Time, string]]                        // FindingSLAQueue key: (slaDeadline, findingID)
+	DisclosureQueue     collections.KeySet[collections.Pair[time.Time, string]]                        // DisclosureQueue key: (disclosureDeadline, findingID)
+	OverdueDisclosures  collections.KeySet[collections.Pair[time.Time, string]]                        // OverdueDisclosures key: (disclosureDeadline, findingID)
+	Sponsorships        collections.Map[collections.Pair[string, sdk.AccAddress], types.Sponsorship]   // Sponsorships key: (programID, sponsor) | value: Sponsorship
+	FindingTargets      collections.KeySet[collections.Triple[string, string, string]]                 // FindingTargets key: (programID, targetID, findingID)
+	HackerReputations   collections.Map[sdk.AccAddress, types.HackerReputation]                        // HackerReputations key: submitter | value: HackerReputation
 
 	// OpenMath
 	TheoremID           collections.Sequence
-	Theorems            collections.Map[uint64, types.Theorem]                                   // Theorems key: TheoremID | value: Theorem
-	Grants              collections.Map[collections.Pair[uint64, sdk.AccAddress], types.Grant]   // Grants key: TheoremID+Grantor | value: Grant
-	Deposits            collections.Map[collections.Pair[string, sdk.AccAddress], types.Deposit] // Deposits key: ProofID+Depositor | value: Deposit
-	Rewards             collections.Map[sdk.AccAddress, types.Reward]                            // Rewards key: address | value: Reward
-	ImportedRewards     collections.Map[sdk.AccAddress, types.Reward]                            // ImportedRewards key: address | value: Reward
-	Proofs              collections.Map[string, types.Proof]                                     // Proofs key: ProofID | value: Proof
-	ProofsByTheorem     collections.Map[collections.Pair[uint64, string], []byte]                // ProofsByTheorem key: TheoremID+ProofID | value: none used (index key for proofs by theorem index)
-	ActiveTheoremsQueue collections.Map[collections.Pair[time.Time, uint64], uint64]             // ActiveTheoremsQueue key: EndTime+TheoremID | value: TheoremID
-	ActiveProofsQueue   collections.Map[collections.Pair[time.Time, string], types.Proof]        // ActiveProofsQueue key: EndTime+ProofID | value: Proof
+	Theorems            collections.Map[uint64, types.Theorem]                                        // Theorems key: TheoremID | value: Theorem
+	Grants              collections.Map[collections.Pair[uint64, sdk.AccAddress], types.Grant]        // Grants key: TheoremID+Grantor | value: Grant
+	Deposits            collections.Map[collections.Pair[string, sdk.AccAddress], types.Deposit]      // Deposits key: ProofID+Depositor | value: Deposit
+	Rewards             collections.Map[sdk.AccAddress, types.Reward]                                 // Rewards key: address | value: Reward
+	RewardVestings      collections.Map[sdk.AccAddress, types.RewardVesting]                          // RewardVestings key: address | value: RewardVesting
+	ImportedRewards     collections.Map[sdk.AccAddress, types.Reward]                                 // ImportedRewards key: address | value: Reward
+	Proofs              collections.Map[string, types.Proof]                                          // Proofs key: ProofID | value: Proof
+	ProofsByTheorem     collections.KeySet[collections.Triple[uint64, uint64, string]]                // ProofsByTheorem key: TheoremID+Sequence+ProofID
+	ActiveTheoremsQueue collections.Map[collections.Pair[time.Time, uint64], uint64]                  // ActiveTheoremsQueue key: EndTime+TheoremID | value: TheoremID
+	ActiveProofsQueue   collections.KeySet[collections.Pair[time.Time, string]]                       // ActiveProofsQueue key: EndTime+ProofID
+	ProofVerdicts       collections.Map[collections.Pair[string, sdk.AccAddress], types.ProofVerdict] // ProofVerdicts key: ProofID+Checker | value: ProofVerdict
+	TheoremDependents   collections.KeySet[collections.Pair[uint64, uint64]]                          // TheoremDependents key: ImportedTheoremID+ImporterTheoremID
+	TheoremCodeHashes   collections.Map[string, uint64]                                               // TheoremCodeHashes key: normalized code hash | value: TheoremID
+	ProofChunks         collections.Map[collections.Pair[string, string], []byte]                     // ProofChunks key: ProofID+ChunkHash | value: chunk data
+
+	// OpenMath statistics
+	OpenMathStats    collections.Map[sdk.AccAddress, types.OpenMathStats]                                   // OpenMathStats key: address | value: OpenMathStats
+	TheoremTypeStats collections.Map[int32, types.TheoremTypeStats]                                         // TheoremTypeStats key: TheoremType | value: TheoremTypeStats
+	Leaderboards     collections.KeySet[collections.Pair[string, collections.Pair[uint64, sdk.AccAddress]]] // Leaderboards key: LeaderboardName+(Score+address)
 }
 
 // NewKeeper creates and initializes a new Keeper instance
@@ -54,6 +77,7 @@ func NewKeeper(
 	ak types.AccountKeeper,
 	ck types.CertKeeper,
 	bk types.BankKeeper,
+	dk types.DistrKeeper,
 	authority string,
 ) Keeper {
 	// Validate authority address
@@ -70,22 +94,43 @@ func NewKeeper(
 		certKeeper:          ck,
 		authKeeper:          ak,
 		bankKeeper:          bk,
+		distrKeeper:         dk,
 		authority:           authority,
 		storeService:        storeService,
 		Params:              collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		Programs:            collections.NewMap(sb, types.ProgramKeyPrefix, "programs", collections.StringKey, codec.CollValue[types.Program](cdc)),
-		Findings:            collections.NewMap(sb, types.FindingKeyPrefix, "findings", collections.StringKey, codec.CollValue[types.Finding](cdc)),
+		Programs:            collections.NewIndexedMap(sb, types.ProgramKeyPrefix, "programs", collections.StringKey, codec.CollValue[types.Program](cdc), NewProgramIndexes(sb, ak.AddressCodec())),
+		Findings:            collections.NewIndexedMap(sb, types.FindingKeyPrefix, "findings", collections.StringKey, codec.CollValue[types.Finding](cdc), NewFindingIndexes(sb)),
 		ProgramFindings:     collections.NewKeySet(sb, types.ProgramFindingListKey, "program_findings", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		ProgramMembers:      collections.NewMap(sb, types.ProgramMemberKeyPrefix, "program_members", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey), codec.CollValue[types.ProgramMember](cdc)),
+		FindingApprovals:    collections.NewMap(sb, types.FindingApprovalKeyPrefix, "finding_approvals", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey), collections.StringValue),
+		Disputes:            collections.NewMap(sb, types.DisputeKeyPrefix, "disputes", collections.StringKey, codec.CollValue[types.Dispute](cdc)),
+		DisputeVotes:        collections.NewMap(sb, types.DisputeVoteKeyPrefix, "dispute_votes", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey), codec.CollValue[types.DisputeVote](cdc)),
+		ActiveDisputesQueue: collections.NewKeySet(sb, types.ActiveDisputeQueueKey, "active_disputes_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		DuplicateFindings:   collections.NewKeySet(sb, types.DuplicateFindingKey, "duplicate_findings", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		FindingSLAQueue:     collections.NewKeySet(sb, types.FindingSLAQueueKey, "finding_sla_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		DisclosureQueue:     collections.NewKeySet(sb, types.DisclosureQueueKey, "disclosure_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		OverdueDisclosures:  collections.NewKeySet(sb, types.OverdueDisclosureKey, "overdue_disclosures", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		Sponsorships:        collections.NewMap(sb, types.SponsorshipKeyPrefix, "sponsorships", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey), codec.CollValue[types.Sponsorship](cdc)),
+		FindingTargets:      collections.NewKeySet(sb, types.FindingTargetKey, "finding_targets", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey)),
+		HackerReputations:   collections.NewMap(sb, types.HackerKeyPrefix, "hacker_reputations", sdk.AccAddressKey, codec.CollValue[types.HackerReputation](cdc)),
 		TheoremID:           collections.NewSequence(sb, types.TheoremIDKey, "theorem_id"),
 		Theorems:            collections.NewMap(sb, types.TheoremKeyPrefix, "theorems", collections.Uint64Key, codec.CollValue[types.Theorem](cdc)),
 		Grants:              collections.NewMap(sb, types.GrantKeyPrefix, "grants", collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey), codec.CollValue[types.Grant](cdc)),
 		Rewards:             collections.NewMap(sb, types.RewardKeyPrefix, "rewards", sdk.AccAddressKey, codec.CollValue[types.Reward](cdc)),
+		RewardVestings:      collections.NewMap(sb, types.RewardVestingKeyPrefix, "reward_vestings", sdk.AccAddressKey, codec.CollValue[types.RewardVesting](cdc)),
 		ImportedRewards:     collections.NewMap(sb, types.ImportedRewardKeyPrefix, "imported_rewards", sdk.AccAddressKey, codec.CollValue[types.Reward](cdc)),
 		Deposits:            collections.NewMap(sb, types.DepositKeyPrefix, "deposits", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey), codec.CollValue[types.Deposit](cdc)),
 		Proofs:              collections.NewMap(sb, types.ProofKeyPrefix, "proofs", collections.StringKey, codec.CollValue[types.Proof](cdc)),
-		ProofsByTheorem:     collections.NewMap(sb, types.ProofByTheoremPrefix, "proofs_by_theorem", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.BytesValue),
+		ProofsByTheorem:     collections.NewKeySet(sb, types.ProofByTheoremPrefix, "proofs_by_theorem", collections.TripleKeyCodec(collections.Uint64Key, collections.Uint64Key, collections.StringKey)),
 		ActiveTheoremsQueue: collections.NewMap(sb, types.ActiveTheoremQueueKey, "active_theorems_queue", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key), collections.Uint64Value),
-		ActiveProofsQueue:   collections.NewMap(sb, types.ActiveProofQueueKey, "active_proofs_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey), codec.CollValue[types.Proof](cdc)),
+		ActiveProofsQueue:   collections.NewKeySet(sb, types.ActiveProofQueueKey, "active_proofs_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey)),
+		ProofVerdicts:       collections.NewMap(sb, types.ProofVerdictKeyPrefix, "proof_verdicts", collections.PairKeyCodec(collections.StringKey, sdk.AccAddressKey), codec.CollValue[types.ProofVerdict](cdc)),
+		TheoremDependents:   collections.NewKeySet(sb, types.TheoremDependentKey, "theorem_dependents", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key)),
+		TheoremCodeHashes:   collections.NewMap(sb, types.TheoremCodeHashKey, "theorem_code_hashes", collections.StringKey, collections.Uint64Value),
+		ProofChunks:         collections.NewMap(sb, types.ProofChunkKeyPrefix, "proof_chunks", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.BytesValue),
+		OpenMathStats:       collections.NewMap(sb, types.OpenMathStatsKeyPrefix, "openmath_stats", sdk.AccAddressKey, codec.CollValue[types.OpenMathStats](cdc)),
+		TheoremTypeStats:    collections.NewMap(sb, types.TheoremTypeStatsKeyPrefix, "theorem_type_stats", collections.Int32Key, codec.CollValue[types.TheoremTypeStats](cdc)),
+		Leaderboards:        collections.NewKeySet(sb, types.LeaderboardKeyPrefix, "leaderboards", collections.PairKeyCodec(collections.StringKey, collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey))),
 	}
 
 	// Build and validate schema
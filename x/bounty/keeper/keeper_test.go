@@ -67,18 +67,34 @@ func (suite *KeeperTestSuite) SetupTest() {
 	minGrant := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(50)))
 	minDeposit := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(30)))
 	theoremMaxProofPeriod := 14 * 24 * time.Hour
+	theoremMaxTotalPeriod := 28 * 24 * time.Hour
+	rewardVestingPeriod := types.DefaultRewardVestingPeriod
+	proofVerificationPeriod := types.DefaultProofVerificationPeriod
 	proofMaxLockPeriod := 10 * time.Minute
 	complexityFee := sdk.NewCoin(bondDenom, math.NewInt(10000))
+	disputeWindow := types.DefaultDisputeWindow
 
 	params := types.Params{
-		MinGrant:              minGrant,
-		MinDeposit:            minDeposit,
-		TheoremMaxProofPeriod: &theoremMaxProofPeriod,
-		ProofMaxLockPeriod:    &proofMaxLockPeriod,
-		ComplexityFee:         complexityFee,
-		MaxComplexity:         1000000,
-		ComplexityFeeRocq:     complexityFee,
-		ComplexityFeeLean:     complexityFee,
+		MinGrant:                     minGrant,
+		MinDeposit:                   minDeposit,
+		TheoremMaxProofPeriod:        &theoremMaxProofPeriod,
+		ProofMaxLockPeriod:           &proofMaxLockPeriod,
+		ComplexityFee:                complexityFee,
+		MaxComplexity:                1000000,
+		ComplexityFeeRocq:            complexityFee,
+		ComplexityFeeLean:            complexityFee,
+		DisputeWindow:                &disputeWindow,
+		ProofVerificationQuorum:      1,
+		ProofDepositSlashFraction:    types.DefaultProofDepositSlashFraction,
+		ForfeitedDepositCheckerShare: types.DefaultForfeitedDepositCheckerShare,
+		ForfeitedDepositGrantShare:   types.DefaultForfeitedDepositGrantShare,
+		GrantWithdrawalPenalty:       types.DefaultGrantWithdrawalPenalty,
+		TheoremMaxTotalPeriod:        &theoremMaxTotalPeriod,
+		TheoremExtensionMinGrant:     sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100000))),
+		RewardVestingThreshold:       sdk.NewCoins(),
+		RewardVestingPeriod:          &rewardVestingPeriod,
+		ProofVerificationPeriod:      &proofVerificationPeriod,
+		ProofChunkDeposit:            sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(10))),
 	}
 	err = suite.keeper.Params.Set(suite.ctx, params)
 	suite.Require().NoError(err)
@@ -95,3 +111,19 @@ func (suite *KeeperTestSuite) issueOpenMathCertificate(addr sdk.AccAddress) {
 	_, err = suite.app.CertKeeper.IssueCertificate(suite.ctx, certificate)
 	suite.Require().NoError(err)
 }
+
+func (suite *KeeperTestSuite) issueBountyAdminCertificate(addr sdk.AccAddress) {
+	certificate, err := certTypes.NewCertificate(certTypes.BountyAdminCertificateTypeName, addr.String(), "", "", "", suite.address[2])
+	suite.Require().NoError(err)
+
+	_, err = suite.app.CertKeeper.IssueCertificate(suite.ctx, certificate)
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) issueIdentityCertificate(addr sdk.AccAddress) {
+	certificate, err := certTypes.NewCertificate(certTypes.IdentityCertificateTypeName, addr.String(), "", "", "", suite.address[2])
+	suite.Require().NoError(err)
+
+	_, err = suite.app.CertKeeper.IssueCertificate(suite.ctx, certificate)
+	suite.Require().NoError(err)
+}
@@ -8,6 +8,8 @@ import (
 	v3 "github.com/shentufoundation/shentu/v2/x/bounty/migrations/v3"
 	v4 "github.com/shentufoundation/shentu/v2/x/bounty/migrations/v4"
 	v5 "github.com/shentufoundation/shentu/v2/x/bounty/migrations/v5"
+	v6 "github.com/shentufoundation/shentu/v2/x/bounty/migrations/v6"
+	v7 "github.com/shentufoundation/shentu/v2/x/bounty/migrations/v7"
 )
 
 // Migrator is a struct for handling in-place store migrations.
@@ -45,3 +47,19 @@ func (m Migrator) Migrate4to5(ctx sdk.Context) error {
 func (m Migrator) Migrate5to6(ctx sdk.Context) error {
 	return v5.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
 }
+
+// Migrate6to7 migrates from version 6 to 7.
+// Sets the new params, builds the new state from the existing records and rebuilds the program
+// and finding indexes.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	if err := v6.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc); err != nil {
+		return err
+	}
+	return m.keeper.RebuildIndexes(ctx)
+}
+
+// Migrate7to8 migrates from version 7 to 8.
+// Indexes the theorems by normalized code hash.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"time"
+
+	"cosmossdk.io/collections"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/keeper"
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// TestMigrate6to7 tests the upgrade of a version 6 store: the new params are set, the proof index
+// is re-keyed by sequence, revealed proofs are queued and the program and finding indexes are rebuilt.
+func (suite *KeeperTestSuite) TestMigrate6to7() {
+	cdc := suite.app.AppCodec()
+	storeService := runtime.NewKVStoreService(suite.app.GetKey(types.StoreKey))
+	sb := collections.NewSchemaBuilder(storeService)
+	// version 6 layout of the collections changed since
+	programs := collections.NewMap(sb, types.ProgramKeyPrefix, "programs", collections.StringKey, codec.CollValue[types.Program](cdc))
+	findings := collections.NewMap(sb, types.FindingKeyPrefix, "findings", collections.StringKey, codec.CollValue[types.Finding](cdc))
+	proofsByTheorem := collections.NewMap(sb, types.ProofByTheoremPrefix, "proofs_by_theorem", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.BytesValue)
+
+	params, err := suite.keeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.keeper.Params.Set(suite.ctx, types.Params{
+		MinGrant:              params.MinGrant,
+		MinDeposit:            params.MinDeposit,
+		TheoremMaxProofPeriod: params.TheoremMaxProofPeriod,
+		ProofMaxLockPeriod:    params.ProofMaxLockPeriod,
+		ComplexityFee:         params.ComplexityFee,
+		MaxComplexity:         params.MaxComplexity,
+		ComplexityFeeRocq:     params.ComplexityFeeRocq,
+		ComplexityFeeLean:     params.ComplexityFeeLean,
+	}))
+
+	now := suite.ctx.BlockTime()
+	program := types.NewProgram("program-1", "name", "detail", suite.programAddr, types.ProgramStatusActive, now)
+	suite.Require().NoError(programs.Set(suite.ctx, program.ProgramId, program))
+	finding := types.NewFinding(program.ProgramId, "finding-1", "title", "detail", "hash", suite.whiteHatAddr, now, types.Critical)
+	finding.Status = types.FindingStatusPaid
+	suite.Require().NoError(findings.Set(suite.ctx, finding.FindingId, finding))
+
+	theoremID := suite.InitCreateTheorem()
+	proof := types.NewProof(theoremID, 0, "proof-1", suite.whiteHatAddr.String(), now, now.Add(time.Hour), nil)
+	proof.Status = types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD
+	suite.Require().NoError(suite.keeper.Proofs.Set(suite.ctx, proof.Id, proof))
+	suite.Require().NoError(proofsByTheorem.Set(suite.ctx, collections.Join(proof.TheoremId, proof.Id), []byte{}))
+
+	// theorems created before their type was declared
+	legacyTheorems := map[uint64]types.Theorem{
+		20: {Code: "Theorem t : True.\nProof.\nexact I.\nQed."},
+		21: {Code: "import Mathlib\ntheorem t : True := by trivial"},
+		22: {Code: "t : True", Imports: []uint64{21}, Status: types.TheoremStatus_THEOREM_STATUS_PASSED},
+		23: {Code: "t : True"},
+	}
+	for id, theorem := range legacyTheorems {
+		theorem.Id = id
+		theorem.Proposer = suite.programAddr.String()
+		suite.Require().NoError(suite.keeper.Theorems.Set(suite.ctx, id, theorem))
+	}
+
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate6to7(suite.ctx))
+
+	params, err = suite.keeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	suite.Require().NoError(params.Validate())
+	suite.Require().Equal(types.DefaultProofVerificationQuorum, params.ProofVerificationQuorum)
+
+	res, err := suite.queryClient.Programs(suite.ctx, &types.QueryProgramsRequest{Status: "active"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Programs, 1)
+	findingsRes, err := suite.queryClient.Findings(suite.ctx, &types.QueryFindingsRequest{Status: "paid"})
+	suite.Require().NoError(err)
+	suite.Require().Len(findingsRes.Findings, 1)
+
+	has, err := suite.keeper.ProofsByTheorem.Has(suite.ctx, collections.Join3(proof.TheoremId, uint64(1), proof.Id))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+	proof, err = suite.keeper.Proofs.Get(suite.ctx, proof.Id)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), proof.Sequence)
+	theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), theorem.ProofSequence)
+
+	// the revealed proof gets a verification deadline
+	suite.Require().Equal(now.Add(*params.ProofVerificationPeriod), *proof.EndTime)
+	has, err = suite.keeper.ActiveProofsQueue.Has(suite.ctx, collections.Join(*proof.EndTime, proof.Id))
+	suite.Require().NoError(err)
+	suite.Require().True(has)
+
+	// the type of the legacy theorems is inferred from their code or imports when conclusive
+	for id, expType := range map[uint64]types.TheoremType{
+		20: types.TheoremType_THEOREM_TYPE_ROCQ,
+		21: types.TheoremType_THEOREM_TYPE_LEAN,
+		22: types.TheoremType_THEOREM_TYPE_LEAN,
+		23: types.TheoremType_THEOREM_TYPE_UNSPECIFIED,
+	} {
+		legacyTheorem, err := suite.keeper.Theorems.Get(suite.ctx, id)
+		suite.Require().NoError(err)
+		suite.Require().Equal(expType, legacyTheorem.TheoremType, "theorem %d", id)
+	}
+	theorem, err = suite.keeper.Theorems.Get(suite.ctx, theoremID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.TheoremType_THEOREM_TYPE_ROCQ, theorem.TheoremType)
+
+	reputation, err := suite.keeper.HackerReputations.Get(suite.ctx, suite.whiteHatAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), reputation.PaidFindings)
+}
+
+// TestMigrate7to8 tests the indexing of the existing theorems by normalized code hash: among the
+// theorems sharing a code, the oldest theorem in proof period keeps the index.
+func (suite *KeeperTestSuite) TestMigrate7to8() {
+	theorems := []types.Theorem{
+		{Id: 30, Code: "theorem a : True := trivial", Status: types.TheoremStatus_THEOREM_STATUS_PASSED},
+		{Id: 31, Code: "theorem a : True := trivial  \r\n\n", Status: types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD},
+		{Id: 32, Code: "theorem a : True := trivial", Status: types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD},
+		{Id: 33, Code: "theorem b : True := trivial", Status: types.TheoremStatus_THEOREM_STATUS_PASSED},
+	}
+	for _, theorem := range theorems {
+		theorem.Proposer = suite.programAddr.String()
+		suite.Require().NoError(suite.keeper.Theorems.Set(suite.ctx, theorem.Id, theorem))
+	}
+
+	suite.Require().NoError(keeper.NewMigrator(suite.keeper).Migrate7to8(suite.ctx))
+
+	for code, expID := range map[string]uint64{
+		"theorem a : True := trivial": 31,
+		"theorem b : True := trivial": 33,
+	} {
+		id, err := suite.keeper.TheoremCodeHashes.Get(suite.ctx, types.TheoremCodeHash(code))
+		suite.Require().NoError(err)
+		suite.Require().Equal(expID, id)
+	}
+}
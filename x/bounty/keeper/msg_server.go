@@ -48,6 +48,30 @@ func (k msgServer) CreateProgram(goCtx context.Context, msg *types.MsgCreateProg
 		return nil, err
 	}
 
+	if err = types.ValidateRewardSchedule(msg.RewardSchedule); err != nil {
+		return nil, err
+	}
+
+	duplicatePolicy := msg.DuplicatePolicy
+	if duplicatePolicy == types.DuplicatePolicyUnspecified {
+		duplicatePolicy = types.DuplicatePolicyFirstReporter
+	}
+	if !types.ValidDuplicatePolicy(duplicatePolicy) {
+		return nil, types.ErrProgramDuplicatePolicyInvalid
+	}
+	if err = types.ValidateFindingSLA(msg.ActivationSla); err != nil {
+		return nil, err
+	}
+	if err = types.ValidateFindingSLA(msg.ConfirmationSla); err != nil {
+		return nil, err
+	}
+	if err = types.ValidateScope(msg.Scope); err != nil {
+		return nil, err
+	}
+	if err = types.ValidateDisclosureEmbargo(msg.DisclosureEmbargo); err != nil {
+		return nil, err
+	}
+
 	exist, err := k.Programs.Has(ctx, msg.ProgramId)
 	if err != nil {
 		return nil, err
@@ -58,6 +82,19 @@ func (k msgServer) CreateProgram(goCtx context.Context, msg *types.MsgCreateProg
 
 	createTime := ctx.BlockHeader().Time
 	program := types.NewProgram(msg.ProgramId, msg.Name, msg.Detail, operatorAddr, types.ProgramStatusInactive, createTime)
+	program.RewardSchedule = msg.RewardSchedule
+	program.CriticalApprovals = msg.CriticalApprovals
+	program.DuplicatePolicy = duplicatePolicy
+	program.ActivationSla = msg.ActivationSla
+	program.ConfirmationSla = msg.ConfirmationSla
+	program.Scope = msg.Scope
+	program.SubmissionRequirements = msg.SubmissionRequirements
+	program.DisclosureEmbargo = msg.DisclosureEmbargo
+
+	// lock the initial reward pool in escrow
+	if err = k.LockProgramRewardPool(ctx, &program, operatorAddr, msg.RewardPool); err != nil {
+		return nil, err
+	}
 
 	if err = k.Programs.Set(ctx, program.ProgramId, program); err != nil {
 		return nil, err
@@ -111,6 +148,48 @@ func (k msgServer) EditProgram(goCtx context.Context, msg *types.MsgEditProgram)
 	if len(msg.Detail) > 0 {
 		program.Detail = msg.Detail
 	}
+	if len(msg.RewardSchedule) > 0 {
+		if err = types.ValidateRewardSchedule(msg.RewardSchedule); err != nil {
+			return nil, err
+		}
+		program.RewardSchedule = msg.RewardSchedule
+	}
+	if msg.CriticalApprovals > 0 {
+		program.CriticalApprovals = msg.CriticalApprovals
+	}
+	if msg.DuplicatePolicy != types.DuplicatePolicyUnspecified {
+		if !types.ValidDuplicatePolicy(msg.DuplicatePolicy) {
+			return nil, types.ErrProgramDuplicatePolicyInvalid
+		}
+		program.DuplicatePolicy = msg.DuplicatePolicy
+	}
+	if msg.ActivationSla != nil {
+		if err = types.ValidateFindingSLA(msg.ActivationSla); err != nil {
+			return nil, err
+		}
+		program.ActivationSla = msg.ActivationSla
+	}
+	if msg.ConfirmationSla != nil {
+		if err = types.ValidateFindingSLA(msg.ConfirmationSla); err != nil {
+			return nil, err
+		}
+		program.ConfirmationSla = msg.ConfirmationSla
+	}
+	if len(msg.Scope) > 0 {
+		if err = types.ValidateScope(msg.Scope); err != nil {
+			return nil, err
+		}
+		program.Scope = msg.Scope
+	}
+	if msg.SubmissionRequirements != nil {
+		program.SubmissionRequirements = *msg.SubmissionRequirements
+	}
+	if msg.DisclosureEmbargo != nil {
+		if err = types.ValidateDisclosureEmbargo(msg.DisclosureEmbargo); err != nil {
+			return nil, err
+		}
+		program.DisclosureEmbargo = msg.DisclosureEmbargo
+	}
 
 	if err = k.Programs.Set(ctx, program.ProgramId, program); err != nil {
 		return nil, err
@@ -194,7 +273,7 @@ func (k msgServer) CloseProgram(goCtx context.Context, msg *types.MsgCloseProgra
 	}
 
 	// the program cannot be closed if there are findings in certain states
-	// there are 3 finding states: FindingStatusSubmitted FindingStatusActive FindingStatusConfirmed
+	// there are 5 finding states: FindingStatusSubmitted FindingStatusActive FindingStatusConfirmed FindingStatusDisputed FindingStatusEscalated
 	fidsList, err := k.getProgramFindings(ctx, msg.ProgramId)
 	if err != nil {
 		return nil, err
@@ -206,7 +285,9 @@ func (k msgServer) CloseProgram(goCtx context.Context, msg *types.MsgCloseProgra
 		}
 		if finding.Status == types.FindingStatusSubmitted ||
 			finding.Status == types.FindingStatusActive ||
-			finding.Status == types.FindingStatusConfirmed {
+			finding.Status == types.FindingStatusConfirmed ||
+			finding.Status == types.FindingStatusDisputed ||
+			finding.Status == types.FindingStatusEscalated {
 			return nil, types.ErrProgramCloseNotAllowed
 		}
 	}
@@ -216,6 +297,11 @@ func (k msgServer) CloseProgram(goCtx context.Context, msg *types.MsgCloseProgra
 		return nil, types.ErrProgramOperatorNotAllowed
 	}
 
+	// return the leftover escrow to the program sponsors
+	if err = k.RefundProgramRewardPool(ctx, &program); err != nil {
+		return nil, err
+	}
+
 	// close the program and update its status
 	program.Status = types.ProgramStatusClosed
 	if err = k.Programs.Set(ctx, program.ProgramId, program); err != nil {
@@ -228,6 +314,159 @@ func (k msgServer) CloseProgram(goCtx context.Context, msg *types.MsgCloseProgra
 	return &types.MsgCloseProgramResponse{}, nil
 }
 
+// FundProgram tops up the reward pool of an active program
+// Any account can sponsor a program, it is refunded pro rata when the program closes
+func (k msgServer) FundProgram(goCtx context.Context, msg *types.MsgFundProgram) (*types.MsgFundProgramResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// validate basic message fields
+	if err := validateMsgFields(map[string]string{
+		"programId": msg.ProgramId,
+	}); err != nil {
+		return nil, err
+	}
+
+	sponsorAddr, err := k.validateAddress(msg.SponsorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	amount := sdk.Coins(msg.Amount)
+	if amount.Empty() {
+		return nil, errors.Wrap(types.ErrProgramSponsorshipInvalid, "amount cannot be empty")
+	}
+
+	program, err := k.Programs.Get(ctx, msg.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+	if program.Status != types.ProgramStatusActive {
+		return nil, types.ErrProgramNotActive
+	}
+
+	if err = k.LockProgramRewardPool(ctx, &program, sponsorAddr, amount); err != nil {
+		return nil, err
+	}
+	if err = k.Programs.Set(ctx, program.ProgramId, program); err != nil {
+		return nil, err
+	}
+
+	// emit event
+	k.emitProgramEvent(ctx, types.EventTypeFundProgram, msg.ProgramId, msg.SponsorAddress)
+
+	return &types.MsgFundProgramResponse{}, nil
+}
+
+// AddProgramMember adds a member to a program team or updates the role of an existing member
+// Only the program admin and admin members can manage the team
+func (k msgServer) AddProgramMember(goCtx context.Context, msg *types.MsgAddProgramMember) (*types.MsgAddProgramMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// validate basic message fields
+	if err := validateMsgFields(map[string]string{
+		"programId": msg.ProgramId,
+	}); err != nil {
+		return nil, err
+	}
+
+	if !types.ValidProgramRole(msg.Role) {
+		return nil, errors.Wrap(types.ErrProgramMemberInvalid, msg.Role.String())
+	}
+
+	if _, err := k.validateAddress(msg.OperatorAddress); err != nil {
+		return nil, err
+	}
+	memberAddr, err := k.validateAddress(msg.MemberAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	program, err := k.Programs.Get(ctx, msg.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+	if program.Status == types.ProgramStatusClosed {
+		return nil, types.ErrProgramAlreadyClosed
+	}
+
+	// the program admin owns the program and cannot be given another role
+	if program.AdminAddress == msg.MemberAddress {
+		return nil, errors.Wrap(types.ErrProgramMemberInvalid, "program admin is already a member")
+	}
+
+	isAdmin, err := k.HasProgramRole(ctx, program, msg.OperatorAddress, types.ProgramRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
+		return nil, types.ErrProgramOperatorNotAllowed
+	}
+
+	member := types.NewProgramMember(msg.ProgramId, memberAddr, msg.Role)
+	if err = k.ProgramMembers.Set(ctx, collections.Join(msg.ProgramId, memberAddr), member); err != nil {
+		return nil, err
+	}
+
+	// emit event
+	k.emitProgramMemberEvent(ctx, types.EventTypeAddProgramMember, member, msg.OperatorAddress)
+
+	return &types.MsgAddProgramMemberResponse{}, nil
+}
+
+// RemoveProgramMember removes a member from a program team
+// The program admin and admin members can remove any member, and members can remove themselves
+func (k msgServer) RemoveProgramMember(goCtx context.Context, msg *types.MsgRemoveProgramMember) (*types.MsgRemoveProgramMemberResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// validate basic message fields
+	if err := validateMsgFields(map[string]string{
+		"programId": msg.ProgramId,
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.validateAddress(msg.OperatorAddress); err != nil {
+		return nil, err
+	}
+	memberAddr, err := k.validateAddress(msg.MemberAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	program, err := k.Programs.Get(ctx, msg.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(msg.ProgramId, memberAddr)
+	member, err := k.ProgramMembers.Get(ctx, key)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, types.ErrProgramMemberNotExists
+		}
+		return nil, err
+	}
+
+	if msg.MemberAddress != msg.OperatorAddress {
+		isAdmin, err := k.HasProgramRole(ctx, program, msg.OperatorAddress, types.ProgramRoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			return nil, types.ErrProgramOperatorNotAllowed
+		}
+	}
+
+	if err = k.ProgramMembers.Remove(ctx, key); err != nil {
+		return nil, err
+	}
+
+	// emit event
+	k.emitProgramMemberEvent(ctx, types.EventTypeRemoveProgramMember, member, msg.OperatorAddress)
+
+	return &types.MsgRemoveProgramMemberResponse{}, nil
+}
+
 // SubmitFinding creates a new security finding for a program
 func (k msgServer) SubmitFinding(goCtx context.Context, msg *types.MsgSubmitFinding) (*types.MsgSubmitFindingResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -245,16 +484,34 @@ func (k msgServer) SubmitFinding(goCtx context.Context, msg *types.MsgSubmitFind
 		return nil, errors.Wrap(types.ErrFindingSeverityLevelInvalid, msg.SeverityLevel.String())
 	}
 
+	if err := types.ValidateEncryptedPayload(msg.EncryptedPayload); err != nil {
+		return nil, err
+	}
+
 	operatorAddr, err := k.validateAddress(msg.OperatorAddress)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = k.validateProgramStatus(ctx, msg.ProgramId, types.ProgramStatusActive)
+	program, err := k.validateProgramStatus(ctx, msg.ProgramId, types.ProgramStatusActive)
 	if err != nil {
 		return nil, err
 	}
 
+	if err = k.CheckSubmissionRequirements(ctx, *program, operatorAddr); err != nil {
+		return nil, err
+	}
+
+	// the confidential report must be readable by the whole program team
+	if err = k.ValidateFindingPayloadRecipients(ctx, *program, msg.OperatorAddress, msg.EncryptedPayload); err != nil {
+		return nil, err
+	}
+
+	// findings must be reported on an in-scope target of the program
+	if err = program.ValidateFindingTarget(msg.TargetId, msg.SeverityLevel); err != nil {
+		return nil, err
+	}
+
 	// check if finding already exists - corrected logic
 	exist, err := k.Findings.Has(ctx, msg.FindingId)
 	if err != nil {
@@ -266,10 +523,22 @@ func (k msgServer) SubmitFinding(goCtx context.Context, msg *types.MsgSubmitFind
 
 	createTime := ctx.BlockHeader().Time
 	finding := types.NewFinding(msg.ProgramId, msg.FindingId, "", "", msg.FindingHash, operatorAddr, createTime, msg.SeverityLevel)
+	finding.EncryptedPayload = msg.EncryptedPayload
+	finding.TargetId = msg.TargetId
+
+	// the program team has to activate the finding within the activation SLA
+	if err = k.ScheduleFindingSLA(ctx, *program, &finding); err != nil {
+		return nil, err
+	}
 
 	if err = k.ProgramFindings.Set(ctx, collections.Join(msg.ProgramId, msg.FindingId)); err != nil {
 		return nil, err
 	}
+	if len(finding.TargetId) != 0 {
+		if err = k.FindingTargets.Set(ctx, collections.Join3(finding.ProgramId, finding.TargetId, finding.FindingId)); err != nil {
+			return nil, err
+		}
+	}
 
 	if err = k.Findings.Set(ctx, finding.FindingId, finding); err != nil {
 		return nil, err
@@ -296,6 +565,10 @@ func (k msgServer) EditFinding(goCtx context.Context, msg *types.MsgEditFinding)
 		return nil, errors.Wrap(types.ErrFindingSeverityLevelInvalid, msg.SeverityLevel.String())
 	}
 
+	if err := types.ValidateEncryptedPayload(msg.EncryptedPayload); err != nil {
+		return nil, err
+	}
+
 	// validate operator address
 	if _, err := k.validateAddress(msg.OperatorAddress); err != nil {
 		return nil, err
@@ -336,10 +609,23 @@ func (k msgServer) EditFinding(goCtx context.Context, msg *types.MsgEditFinding)
 	if finding.SubmitterAddress != msg.OperatorAddress {
 		return nil, types.ErrFindingOperatorNotAllowed
 	}
+	// the confidential report of a finding must match its hash, it is replaced along with it
+	if len(msg.FindingHash) > 0 && msg.FindingHash != finding.FindingHash && finding.EncryptedPayload != nil && msg.EncryptedPayload == nil {
+		return nil, errors.Wrap(types.ErrFindingPayloadInvalid, "the encrypted payload must be replaced along with the finding hash")
+	}
 	if len(msg.FindingHash) > 0 {
 		finding.FindingHash = msg.FindingHash
 	}
+	if msg.EncryptedPayload != nil {
+		if err = k.ValidateFindingPayloadRecipients(ctx, *program, msg.OperatorAddress, msg.EncryptedPayload); err != nil {
+			return nil, err
+		}
+		finding.EncryptedPayload = msg.EncryptedPayload
+	}
 	if msg.SeverityLevel != types.Unspecified {
+		if target, ok := program.GetScopeTarget(finding.TargetId); ok && !target.AllowsSeverity(msg.SeverityLevel) {
+			return nil, errors.Wrapf(types.ErrFindingSeverityLevelInvalid, "target %s accepts findings up to %s", target.TargetId, target.MaxSeverity)
+		}
 		finding.SeverityLevel = msg.SeverityLevel
 	}
 
@@ -376,8 +662,8 @@ func (k msgServer) ActivateFinding(goCtx context.Context, msg *types.MsgActivate
 		return nil, err
 	}
 
-	// only StatusSubmitted can activate
-	if finding.Status != types.FindingStatusSubmitted {
+	// only StatusSubmitted and StatusEscalated can activate
+	if finding.Status != types.FindingStatusSubmitted && finding.Status != types.FindingStatusEscalated {
 		return nil, types.ErrFindingStatusInvalid
 	}
 
@@ -389,13 +675,22 @@ func (k msgServer) ActivateFinding(goCtx context.Context, msg *types.MsgActivate
 		return nil, types.ErrProgramNotActive
 	}
 
-	// check permissions
-	if program.AdminAddress != msg.OperatorAddress && !k.certKeeper.IsBountyAdmin(ctx, operatorAddr) {
-		return nil, types.ErrFindingOperatorNotAllowed
+	// check permissions: program triagers or bounty admins, escalated findings are reviewed by bounty admins only
+	if !k.certKeeper.IsBountyAdmin(ctx, operatorAddr) {
+		isTriager, err := k.HasProgramRole(ctx, program, msg.OperatorAddress, types.ProgramRoleTriager)
+		if err != nil {
+			return nil, err
+		}
+		if !isTriager || finding.Status == types.FindingStatusEscalated {
+			return nil, types.ErrFindingOperatorNotAllowed
+		}
 	}
 
-	// update finding status
+	// update finding status, the program team then has to confirm it within the confirmation SLA
 	finding.Status = types.FindingStatusActive
+	if err = k.ScheduleFindingSLA(ctx, program, &finding); err != nil {
+		return nil, err
+	}
 	if err = k.Findings.Set(ctx, finding.FindingId, finding); err != nil {
 		return nil, err
 	}
@@ -419,7 +714,8 @@ func (k msgServer) ConfirmFinding(goCtx context.Context, msg *types.MsgConfirmFi
 	}
 
 	// validate operator address
-	if _, err := k.validateAddress(msg.OperatorAddress); err != nil {
+	operatorAddr, err := k.validateAddress(msg.OperatorAddress)
+	if err != nil {
 		return nil, err
 	}
 
@@ -443,8 +739,12 @@ func (k msgServer) ConfirmFinding(goCtx context.Context, msg *types.MsgConfirmFi
 		return nil, types.ErrProgramNotActive
 	}
 
-	// only program admin can confirm finding
-	if program.AdminAddress != msg.OperatorAddress {
+	// only program admins can confirm finding
+	isAdmin, err := k.HasProgramRole(ctx, program, msg.OperatorAddress, types.ProgramRoleAdmin)
+	if err != nil {
+		return nil, err
+	}
+	if !isAdmin {
 		return nil, types.ErrProgramOperatorNotAllowed
 	}
 
@@ -454,14 +754,70 @@ func (k msgServer) ConfirmFinding(goCtx context.Context, msg *types.MsgConfirmFi
 		return nil, types.ErrFindingHashInvalid
 	}
 
+	// critical findings may need the approval of several admins
+	if finding.SeverityLevel == types.Critical && program.CriticalApprovals > 1 {
+		approvals, err := k.ApproveFinding(ctx, program, finding, operatorAddr, fingerprintHash)
+		if err != nil {
+			return nil, err
+		}
+		if approvals < program.CriticalApprovals {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeApproveFinding,
+					sdk.NewAttribute(types.AttributeKeyFindingID, finding.FindingId),
+					sdk.NewAttribute(types.AttributeKeyProgramID, finding.ProgramId),
+					sdk.NewAttribute(types.AttributeKeyApprovals, fmt.Sprintf("%d/%d", approvals, program.CriticalApprovals)),
+					sdk.NewAttribute(sdk.AttributeKeySender, msg.OperatorAddress),
+				),
+			)
+			return &types.MsgConfirmFindingResponse{}, nil
+		}
+	}
+	if err = k.ClearFindingApprovals(ctx, finding.FindingId); err != nil {
+		return nil, err
+	}
+
 	// update finding status
+	if err = k.RemoveFindingSLA(ctx, &finding); err != nil {
+		return nil, err
+	}
 	finding.Status = types.FindingStatusConfirmed
+
+	// pay the submitter straight from the program escrow
+	if len(msg.Reward) > 0 {
+		if err = k.validateFindingReward(program, finding, msg.Reward); err != nil {
+			return nil, err
+		}
+		if err = k.DistributeFindingReward(ctx, &program, &finding, msg.Reward); err != nil {
+			return nil, err
+		}
+		if err = k.Programs.Set(ctx, program.ProgramId, program); err != nil {
+			return nil, err
+		}
+		finding.Status = types.FindingStatusPaid
+		if err = k.ScheduleFindingDisclosure(ctx, program, &finding); err != nil {
+			return nil, err
+		}
+	}
+
 	if err = k.Findings.Set(ctx, finding.FindingId, finding); err != nil {
 		return nil, err
 	}
+	err = k.UpdateHackerReputation(ctx, finding.SubmitterAddress, func(reputation *types.HackerReputation) {
+		reputation.AddConfirmed(finding.SeverityLevel)
+		if finding.Status == types.FindingStatusPaid {
+			reputation.PaidFindings++
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	// emit event
 	k.emitFindingEvent(ctx, types.EventTypeConfirmFinding, finding, msg.OperatorAddress)
+	if finding.Status == types.FindingStatusPaid {
+		k.emitFindingEvent(ctx, types.EventTypeConfirmFindingPaid, finding, msg.OperatorAddress)
+	}
 
 	return &types.MsgConfirmFindingResponse{}, nil
 }
@@ -489,15 +845,35 @@ func (k msgServer) ConfirmFindingPaid(goCtx context.Context, msg *types.MsgConfi
 	}
 	finding := *findingPtr
 
-	// check operator permissions: finding owner or bounty admin
+	program, err := k.Programs.Get(ctx, finding.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+
+	// check operator permissions: finding owner, program admins or bounty admin
 	if finding.SubmitterAddress != msg.OperatorAddress && !k.certKeeper.IsBountyAdmin(ctx, operatorAddr) {
-		return nil, types.ErrFindingOperatorNotAllowed
+		isAdmin, err := k.HasProgramRole(ctx, program, msg.OperatorAddress, types.ProgramRoleAdmin)
+		if err != nil {
+			return nil, err
+		}
+		if !isAdmin {
+			return nil, types.ErrFindingOperatorNotAllowed
+		}
 	}
 
 	finding.Status = types.FindingStatusPaid
+	if err = k.ScheduleFindingDisclosure(ctx, program, &finding); err != nil {
+		return nil, err
+	}
 	if err = k.Findings.Set(ctx, finding.FindingId, finding); err != nil {
 		return nil, err
 	}
+	err = k.UpdateHackerReputation(ctx, finding.SubmitterAddress, func(reputation *types.HackerReputation) {
+		reputation.PaidFindings++
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	// emit event
 	k.emitFindingEvent(ctx, types.EventTypeConfirmFindingPaid, finding, msg.OperatorAddress)
@@ -528,8 +904,10 @@ func (k msgServer) CloseFinding(goCtx context.Context, msg *types.MsgCloseFindin
 		return nil, err
 	}
 
-	// check finding status: StatusSubmitted and StatusActive can be closed
-	if finding.Status != types.FindingStatusSubmitted && finding.Status != types.FindingStatusActive {
+	// check finding status: StatusSubmitted, StatusActive and StatusEscalated can be closed
+	if finding.Status != types.FindingStatusSubmitted &&
+		finding.Status != types.FindingStatusActive &&
+		finding.Status != types.FindingStatusEscalated {
 		return nil, types.ErrFindingStatusInvalid
 	}
 	// get program
@@ -539,14 +917,35 @@ func (k msgServer) CloseFinding(goCtx context.Context, msg *types.MsgCloseFindin
 	}
 
 	// check operator
-	// program, certificate, finding owner
-	if finding.SubmitterAddress != msg.OperatorAddress && !k.certKeeper.IsBountyAdmin(ctx, operatorAddr) && program.AdminAddress != msg.OperatorAddress {
-		return nil, types.ErrFindingOperatorNotAllowed
+	// program triagers, certificate, finding owner; escalated findings are out of the program team's hands
+	if finding.SubmitterAddress != msg.OperatorAddress && !k.certKeeper.IsBountyAdmin(ctx, operatorAddr) {
+		isTriager, err := k.HasProgramRole(ctx, program, msg.OperatorAddress, types.ProgramRoleTriager)
+		if err != nil {
+			return nil, err
+		}
+		if !isTriager || finding.Status == types.FindingStatusEscalated {
+			return nil, types.ErrFindingOperatorNotAllowed
+		}
+	}
+	if err = k.RemoveFindingSLA(ctx, &finding); err != nil {
+		return nil, err
 	}
 	finding.Status = types.FindingStatusClosed
+	if err = k.ScheduleFindingDisclosure(ctx, program, &finding); err != nil {
+		return nil, err
+	}
 	if err = k.Findings.Set(ctx, finding.FindingId, finding); err != nil {
 		return nil, err
 	}
+	if err = k.ClearFindingApprovals(ctx, finding.FindingId); err != nil {
+		return nil, err
+	}
+	err = k.UpdateHackerReputation(ctx, finding.SubmitterAddress, func(reputation *types.HackerReputation) {
+		reputation.ClosedFindings++
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	// emit event
 	k.emitFindingEvent(ctx, types.EventTypeCloseFinding, finding, msg.OperatorAddress)
@@ -589,17 +988,28 @@ func (k msgServer) PublishFinding(goCtx context.Context, msg *types.MsgPublishFi
 
 	// close，finding owner can release
 	// paid, program admin can release
-	switch finding.Status {
-	case types.FindingStatusClosed:
+	// overdue for disclosure, finding owner or program admins can release
+	switch {
+	case finding.Status != types.FindingStatusClosed && finding.Status != types.FindingStatusPaid:
+		return nil, types.ErrFindingStatusInvalid
+	case finding.DisclosureOverdue:
+		if finding.SubmitterAddress != msg.OperatorAddress {
+			isAdmin, err := k.HasProgramRole(ctx, program, msg.OperatorAddress, types.ProgramRoleAdmin)
+			if err != nil {
+				return nil, err
+			}
+			if !isAdmin {
+				return nil, types.ErrFindingOperatorNotAllowed
+			}
+		}
+	case finding.Status == types.FindingStatusClosed:
 		if finding.SubmitterAddress != msg.OperatorAddress {
 			return nil, types.ErrFindingOperatorNotAllowed
 		}
-	case types.FindingStatusPaid:
+	case finding.Status == types.FindingStatusPaid:
 		if program.AdminAddress != msg.OperatorAddress {
 			return nil, types.ErrProgramOperatorNotAllowed
 		}
-	default:
-		return nil, types.ErrFindingStatusInvalid
 	}
 
 	// verify hash
@@ -613,6 +1023,9 @@ func (k msgServer) PublishFinding(goCtx context.Context, msg *types.MsgPublishFi
 	finding.Detail = msg.Detail
 	finding.Description = msg.Description
 	finding.ProofOfConcept = msg.ProofOfConcept
+	if err = k.RemoveFindingDisclosure(ctx, &finding); err != nil {
+		return nil, err
+	}
 	if err = k.Findings.Set(ctx, finding.FindingId, finding); err != nil {
 		return nil, err
 	}
@@ -623,6 +1036,212 @@ func (k msgServer) PublishFinding(goCtx context.Context, msg *types.MsgPublishFi
 	return &types.MsgPublishFindingResponse{}, nil
 }
 
+// MarkDuplicateFinding marks a finding as a duplicate of an earlier finding of the same program
+// Only program triagers and bounty admins can mark duplicates
+func (k msgServer) MarkDuplicateFinding(goCtx context.Context, msg *types.MsgMarkDuplicateFinding) (*types.MsgMarkDuplicateFindingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// validate basic message fields
+	if err := validateMsgFields(map[string]string{
+		"findingId":   msg.FindingId,
+		"duplicateOf": msg.DuplicateOf,
+	}); err != nil {
+		return nil, err
+	}
+
+	operatorAddr, err := k.validateAddress(msg.OperatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.FindingId == msg.DuplicateOf {
+		return nil, errors.Wrap(types.ErrFindingDuplicateInvalid, "finding cannot be a duplicate of itself")
+	}
+
+	// only StatusSubmitted and StatusActive can be marked as duplicate
+	finding, err := k.validateFindingStatus(ctx, msg.FindingId, types.FindingStatusSubmitted, types.FindingStatusActive)
+	if err != nil {
+		return nil, err
+	}
+
+	// the original finding must be an earlier report of the same program that is not paid yet
+	original, err := k.Findings.Get(ctx, msg.DuplicateOf)
+	if err != nil {
+		return nil, err
+	}
+	if original.ProgramId != finding.ProgramId {
+		return nil, errors.Wrap(types.ErrFindingDuplicateInvalid, "findings belong to different programs")
+	}
+	if original.Status != types.FindingStatusSubmitted &&
+		original.Status != types.FindingStatusActive &&
+		original.Status != types.FindingStatusConfirmed {
+		return nil, errors.Wrapf(types.ErrFindingDuplicateInvalid, "original finding status %s", original.Status)
+	}
+	if original.CreateTime.After(finding.CreateTime) {
+		return nil, errors.Wrap(types.ErrFindingDuplicateInvalid, "original finding was submitted later")
+	}
+
+	// duplicates are linked to a single original finding
+	hasDuplicates, err := k.HasDuplicateFindings(ctx, finding.FindingId)
+	if err != nil {
+		return nil, err
+	}
+	if hasDuplicates {
+		return nil, errors.Wrap(types.ErrFindingDuplicateInvalid, "finding has duplicates")
+	}
+
+	// check operator: program triagers or bounty admin
+	program, err := k.Programs.Get(ctx, finding.ProgramId)
+	if err != nil {
+		return nil, err
+	}
+	if !k.certKeeper.IsBountyAdmin(ctx, operatorAddr) {
+		isTriager, err := k.HasProgramRole(ctx, program, msg.OperatorAddress, types.ProgramRoleTriager)
+		if err != nil {
+			return nil, err
+		}
+		if !isTriager {
+			return nil, types.ErrFindingOperatorNotAllowed
+		}
+	}
+
+	if err = k.Keeper.MarkDuplicateFinding(ctx, finding, original); err != nil {
+		return nil, err
+	}
+
+	// emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMarkDuplicateFinding,
+			sdk.NewAttribute(types.AttributeKeyFindingID, finding.FindingId),
+			sdk.NewAttribute(types.AttributeKeyProgramID, finding.ProgramId),
+			sdk.NewAttribute(types.AttributeKeyDuplicate, original.FindingId),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OperatorAddress),
+		),
+	)
+
+	return &types.MsgMarkDuplicateFindingResponse{}, nil
+}
+
+// DisputeFinding lets the submitter dispute the closure of a finding
+// The closure is then arbitrated by bounty admins until the end of the dispute window
+func (k msgServer) DisputeFinding(goCtx context.Context, msg *types.MsgDisputeFinding) (*types.MsgDisputeFindingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// validate basic message fields
+	if err := validateMsgFields(map[string]string{
+		"findingId": msg.FindingId,
+		"reason":    msg.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := k.validateAddress(msg.OperatorAddress); err != nil {
+		return nil, err
+	}
+
+	findingPtr, err := k.validateFindingStatus(ctx, msg.FindingId, types.FindingStatusClosed)
+	if err != nil {
+		return nil, err
+	}
+	finding := *findingPtr
+
+	// only the finding owner can dispute
+	if finding.SubmitterAddress != msg.OperatorAddress {
+		return nil, types.ErrFindingOperatorNotAllowed
+	}
+
+	if _, err = k.validateProgramStatus(ctx, finding.ProgramId, types.ProgramStatusActive); err != nil {
+		return nil, err
+	}
+
+	// an upheld closure is final
+	previous, err := k.Disputes.Get(ctx, msg.FindingId)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return nil, err
+	}
+	if err == nil && previous.Status == types.DisputeStatusUpheld {
+		return nil, errors.Wrap(types.ErrFindingDisputeNotAllowed, "closure already upheld")
+	}
+
+	dispute, err := k.OpenDispute(ctx, &finding, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	// emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDisputeFinding,
+			sdk.NewAttribute(types.AttributeKeyFindingID, finding.FindingId),
+			sdk.NewAttribute(types.AttributeKeyProgramID, finding.ProgramId),
+			sdk.NewAttribute(types.AttributeKeyEndTime, dispute.EndTime.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.OperatorAddress),
+		),
+	)
+
+	return &types.MsgDisputeFindingResponse{}, nil
+}
+
+// VoteDispute records the vote of a bounty admin on a finding dispute
+// A voter can change its vote until the end of the dispute window
+func (k msgServer) VoteDispute(goCtx context.Context, msg *types.MsgVoteDispute) (*types.MsgVoteDisputeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// validate basic message fields
+	if err := validateMsgFields(map[string]string{
+		"findingId": msg.FindingId,
+	}); err != nil {
+		return nil, err
+	}
+
+	if !types.ValidDisputeVoteOption(msg.Option) {
+		return nil, errors.Wrap(types.ErrDisputeVoteInvalid, msg.Option.String())
+	}
+
+	voterAddr, err := k.validateAddress(msg.Voter)
+	if err != nil {
+		return nil, err
+	}
+
+	// only bounty admins arbitrate disputes
+	if !k.certKeeper.IsBountyAdmin(ctx, voterAddr) {
+		return nil, types.ErrFindingOperatorNotAllowed
+	}
+
+	dispute, err := k.Disputes.Get(ctx, msg.FindingId)
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, types.ErrDisputeNotExists
+		}
+		return nil, err
+	}
+	if dispute.Status != types.DisputeStatusVoting || !ctx.BlockTime().Before(dispute.EndTime) {
+		return nil, errors.Wrap(types.ErrDisputeVoteInvalid, "dispute voting has ended")
+	}
+
+	vote := types.DisputeVote{
+		FindingId: msg.FindingId,
+		Voter:     msg.Voter,
+		Option:    msg.Option,
+	}
+	if err = k.DisputeVotes.Set(ctx, collections.Join(msg.FindingId, voterAddr), vote); err != nil {
+		return nil, err
+	}
+
+	// emit event
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVoteDispute,
+			sdk.NewAttribute(types.AttributeKeyFindingID, msg.FindingId),
+			sdk.NewAttribute(types.AttributeKeyOption, msg.Option.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Voter),
+		),
+	)
+
+	return &types.MsgVoteDisputeResponse{}, nil
+}
+
 func (k msgServer) CreateTheorem(goCtx context.Context, msg *types.MsgCreateTheorem) (*types.MsgCreateTheoremResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
@@ -635,79 +1254,199 @@ func (k msgServer) CreateTheorem(goCtx context.Context, msg *types.MsgCreateTheo
 		return nil, err
 	}
 
-	proposer, err := k.validateAddress(msg.Proposer)
-	if err != nil {
+	if err := types.ValidateTheoremType(msg.TheoremType); err != nil {
+		return nil, err
+	}
+
+	proposer, err := k.validateAddress(msg.Proposer)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate grant funds
+	params, err := k.ValidateFunds(ctx, msg.InitialGrant, types.FundTypeGrant)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = k.CheckDuplicateTheorem(ctx, msg.Code); err != nil {
+		return nil, err
+	}
+
+	submitTime := ctx.BlockHeader().Time
+	endTime := submitTime.Add(*params.TheoremMaxProofPeriod)
+	theoremID, err := k.TheoremID.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	theorem := types.NewTheorem(
+		theoremID,
+		proposer,
+		msg.Title,
+		msg.Description,
+		msg.Code,
+		msg.TheoremType,
+		msg.RequireOpenmathCert,
+		submitTime,
+		endTime,
+	)
+
+	if err = k.Theorems.Set(ctx, theorem.Id, theorem); err != nil {
+		return nil, err
+	}
+	if err = k.IndexTheoremCode(ctx, theorem); err != nil {
+		return nil, err
+	}
+	if err = k.ActiveTheoremsQueue.Set(ctx, collections.Join(endTime, theoremID), theoremID); err != nil {
+		return nil, err
+	}
+	if err = k.Keeper.AddGrant(ctx, theorem.Id, proposer, msg.InitialGrant); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(types.EventTypeCreateTheorem,
+			sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", theorem.Id)),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer),
+			sdk.NewAttribute(types.AttributeKeyInitialGrant, sdk.NewCoins(msg.InitialGrant...).String()),
+			sdk.NewAttribute(types.AttributeKeyTheoremType, msg.TheoremType.String()),
+			sdk.NewAttribute(types.AttributeKeyRequireOpenmathCert, fmt.Sprintf("%t", msg.RequireOpenmathCert)),
+		),
+	)
+
+	return &types.MsgCreateTheoremResponse{
+		TheoremId: theorem.Id,
+	}, nil
+}
+
+func (k msgServer) Grant(goCtx context.Context, msg *types.MsgGrant) (*types.MsgGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantor, err := k.validateAddress(msg.Grantor)
+	if err != nil {
+		return nil, err
+	}
+
+	// validate grant funds
+	_, err = k.ValidateFunds(ctx, msg.Amount, types.FundTypeGrant)
+	if err != nil {
+		return nil, err
+	}
+
+	err = k.Keeper.AddGrant(ctx, msg.TheoremId, grantor, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	// a large enough grant keeps a popular theorem open
+	if err = k.Keeper.ExtendTheoremByGrant(ctx, msg.TheoremId, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgGrantResponse{}, nil
+}
+
+// GrantFromCommunityPool grants a theorem from the community pool, executed by the module authority
+func (k msgServer) GrantFromCommunityPool(goCtx context.Context, msg *types.MsgGrantFromCommunityPool) (*types.MsgGrantFromCommunityPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if k.authority != msg.Authority {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	// validate grant funds
+	if _, err := k.ValidateFunds(ctx, msg.Amount, types.FundTypeGrant); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.GrantFromCommunityPool(ctx, msg.TheoremId, msg.Amount); err != nil {
 		return nil, err
 	}
 
-	// validate grant funds
-	params, err := k.ValidateFunds(ctx, msg.InitialGrant, types.FundTypeGrant)
-	if err != nil {
+	// a large enough grant keeps a popular theorem open
+	if err := k.Keeper.ExtendTheoremByGrant(ctx, msg.TheoremId, msg.Amount); err != nil {
 		return nil, err
 	}
 
-	submitTime := ctx.BlockHeader().Time
-	endTime := submitTime.Add(*params.TheoremMaxProofPeriod)
-	theoremID, err := k.TheoremID.Next(ctx)
+	return &types.MsgGrantFromCommunityPoolResponse{}, nil
+}
+
+// WithdrawGrant withdraws the grant of a grantor from a theorem without a proof in progress
+func (k msgServer) WithdrawGrant(goCtx context.Context, msg *types.MsgWithdrawGrant) (*types.MsgWithdrawGrantResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	grantor, err := k.validateAddress(msg.Grantor)
 	if err != nil {
 		return nil, err
 	}
 
-	theorem := types.NewTheorem(
-		theoremID,
-		proposer,
-		msg.Title,
-		msg.Description,
-		msg.Code,
-		msg.RequireOpenmathCert,
-		submitTime,
-		endTime,
-	)
-
-	if err = k.Theorems.Set(ctx, theorem.Id, theorem); err != nil {
+	theorem, err := k.validateTheoremStatus(ctx, msg.TheoremId)
+	if err != nil {
 		return nil, err
 	}
-	if err = k.ActiveTheoremsQueue.Set(ctx, collections.Join(endTime, theoremID), theoremID); err != nil {
+	if err = k.requireNoActiveProof(ctx, theorem.Id); err != nil {
 		return nil, err
 	}
-	if err = k.Keeper.AddGrant(ctx, theorem.Id, proposer, msg.InitialGrant); err != nil {
+
+	refund, penalty, err := k.Keeper.WithdrawGrant(ctx, theorem, grantor)
+	if err != nil {
 		return nil, err
 	}
 
 	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(types.EventTypeCreateTheorem,
+		sdk.NewEvent(
+			types.EventTypeWithdrawGrant,
 			sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", theorem.Id)),
-			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer),
-			sdk.NewAttribute(types.AttributeKeyInitialGrant, sdk.NewCoins(msg.InitialGrant...).String()),
-			sdk.NewAttribute(types.AttributeKeyRequireOpenmathCert, fmt.Sprintf("%t", msg.RequireOpenmathCert)),
+			sdk.NewAttribute(types.AttributeKeyTheoremGrantor, msg.Grantor),
+			sdk.NewAttribute(types.AttributeKeyRefunded, refund.String()),
+			sdk.NewAttribute(types.AttributeKeyPenalty, penalty.String()),
 		),
 	)
+	if theorem.Status == types.TheoremStatus_THEOREM_STATUS_CLOSED {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCloseTheorem,
+				sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", theorem.Id)),
+			),
+		)
+	}
 
-	return &types.MsgCreateTheoremResponse{
-		TheoremId: theorem.Id,
-	}, nil
+	return &types.MsgWithdrawGrantResponse{Refunded: refund, Penalty: penalty}, nil
 }
 
-func (k msgServer) Grant(goCtx context.Context, msg *types.MsgGrant) (*types.MsgGrantResponse, error) {
+// CloseTheorem closes a theorem without a proof in progress and refunds all its grants
+func (k msgServer) CloseTheorem(goCtx context.Context, msg *types.MsgCloseTheorem) (*types.MsgCloseTheoremResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	grantor, err := k.validateAddress(msg.Grantor)
-	if err != nil {
+	if _, err := k.validateAddress(msg.Proposer); err != nil {
 		return nil, err
 	}
 
-	// validate grant funds
-	_, err = k.ValidateFunds(ctx, msg.Amount, types.FundTypeGrant)
+	theorem, err := k.validateTheoremStatus(ctx, msg.TheoremId)
 	if err != nil {
 		return nil, err
 	}
+	if theorem.Proposer != msg.Proposer {
+		return nil, errors.Wrapf(types.ErrTheoremOperatorNotAllowed, "only the proposer can close theorem %d", theorem.Id)
+	}
+	if err = k.requireNoActiveProof(ctx, theorem.Id); err != nil {
+		return nil, err
+	}
 
-	err = k.Keeper.AddGrant(ctx, msg.TheoremId, grantor, msg.Amount)
-	if err != nil {
+	if err = k.Keeper.CloseTheorem(ctx, theorem); err != nil {
 		return nil, err
 	}
 
-	return &types.MsgGrantResponse{}, nil
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCloseTheorem,
+			sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", theorem.Id)),
+			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer),
+		),
+	)
+
+	return &types.MsgCloseTheoremResponse{}, nil
 }
 
 func (k msgServer) SubmitProofHash(goCtx context.Context, msg *types.MsgSubmitProofHash) (*types.MsgSubmitProofHashResponse, error) {
@@ -746,14 +1485,8 @@ func (k msgServer) SubmitProofHash(goCtx context.Context, msg *types.MsgSubmitPr
 		return nil, err
 	}
 
-	// check if there are any proofs in hash lock or detail period for this theorem
-	hasActiveProof, activeProofID, err := k.Keeper.HasActiveProofs(ctx, msg.TheoremId)
-	if err != nil {
-		return nil, err
-	}
-	if hasActiveProof {
-		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "proof %s is in progress", activeProofID)
-	}
+	// several proofs can be hash locked for the theorem at the same time, they are verified
+	// in the order of submission
 
 	// validate deposit funds
 	params, err := k.ValidateFunds(ctx, msg.Deposit, types.FundTypeDeposit)
@@ -772,14 +1505,21 @@ func (k msgServer) SubmitProofHash(goCtx context.Context, msg *types.MsgSubmitPr
 		)
 	}
 
-	proof := types.NewProof(msg.TheoremId, msg.ProofHash, msg.Prover, submitTime, endTime, msg.Deposit)
+	// number the proof among the proofs of the theorem, proofs submitted in the same block
+	// keep the order of their transactions
+	theorem.ProofSequence++
+	if err = k.Theorems.Set(ctx, theorem.Id, *theorem); err != nil {
+		return nil, err
+	}
+
+	proof := types.NewProof(msg.TheoremId, theorem.ProofSequence, msg.ProofHash, msg.Prover, submitTime, endTime, msg.Deposit)
 	if err = k.Proofs.Set(ctx, proof.Id, proof); err != nil {
 		return nil, err
 	}
-	if err = k.ActiveProofsQueue.Set(ctx, collections.Join(endTime, msg.ProofHash), proof); err != nil {
+	if err = k.ActiveProofsQueue.Set(ctx, collections.Join(endTime, msg.ProofHash)); err != nil {
 		return nil, err
 	}
-	if err = k.ProofsByTheorem.Set(ctx, collections.Join(msg.TheoremId, msg.ProofHash), []byte{}); err != nil {
+	if err = k.ProofsByTheorem.Set(ctx, collections.Join3(msg.TheoremId, proof.Sequence, msg.ProofHash)); err != nil {
 		return nil, err
 	}
 	if err = k.Keeper.AddDeposit(ctx, proof.Id, proposer, msg.Deposit); err != nil {
@@ -846,31 +1586,99 @@ func (k msgServer) SubmitProofDetail(goCtx context.Context, msg *types.MsgSubmit
 		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "proof hash inconsistent")
 	}
 
-	// Remove from active proofs queue since we're transitioning out of hash lock period
-	// The proof will no longer need to be tracked for potential expiration
-	if err = k.ActiveProofsQueue.Remove(ctx, collections.Join(*proof.EndTime, proof.Id)); err != nil {
+	proof.Detail = msg.Detail
+	if err = k.revealProofDetail(ctx, proof); err != nil {
 		return nil, err
 	}
 
-	// Update proof
-	proof.Detail = msg.Detail
-	proof.Status = types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD
+	return &types.MsgSubmitProofDetailResponse{}, nil
+}
 
-	// Save updated proof
-	if err = k.Proofs.Set(ctx, proof.Id, proof); err != nil {
+// UploadProofChunk uploads a chunk of the detail of a hash locked proof
+func (k msgServer) UploadProofChunk(goCtx context.Context, msg *types.MsgUploadProofChunk) (*types.MsgUploadProofChunkResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msg.ProofId = strings.ToLower(msg.ProofId)
+	proof, err := k.validateProofStatus(ctx, msg.ProofId, types.ProofStatus_PROOF_STATUS_HASH_LOCK_PERIOD)
+	if err != nil {
+		return nil, err
+	}
+	proverAddr, err := k.validateAddress(msg.Prover)
+	if err != nil {
+		return nil, err
+	}
+	if proof.Prover != msg.Prover {
+		return nil, types.ErrProofOperatorNotAllowed
+	}
+
+	hash, added, err := k.SetProofChunk(ctx, proof.Id, msg.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	// Every stored chunk adds to the deposit of the proof
+	params, err := k.Params.Get(ctx)
+	if err != nil {
 		return nil, err
 	}
+	if chunkDeposit := sdk.NewCoins(params.ProofChunkDeposit...); added && !chunkDeposit.IsZero() {
+		if err = k.AddDeposit(ctx, proof.Id, proverAddr, chunkDeposit); err != nil {
+			return nil, err
+		}
+	}
 
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
-			types.EventTypeSubmitProofDetail,
-			sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", proof.TheoremId)),
-			sdk.NewAttribute(types.AttributeKeyProofID, msg.ProofId),
+			types.EventTypeUploadProofChunk,
+			sdk.NewAttribute(types.AttributeKeyProofID, proof.Id),
 			sdk.NewAttribute(types.AttributeKeyProver, msg.Prover),
+			sdk.NewAttribute(types.AttributeKeyChunkHash, hash),
 		),
 	)
 
-	return &types.MsgSubmitProofDetailResponse{}, nil
+	return &types.MsgUploadProofChunkResponse{Hash: hash}, nil
+}
+
+// SubmitProofDetailChunks submits the detail of a proof as the concatenation of its uploaded chunks
+func (k msgServer) SubmitProofDetailChunks(goCtx context.Context, msg *types.MsgSubmitProofDetailChunks) (*types.MsgSubmitProofDetailChunksResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	msg.ProofId = strings.ToLower(msg.ProofId)
+	proof, err := k.validateProofStatus(ctx, msg.ProofId, types.ProofStatus_PROOF_STATUS_HASH_LOCK_PERIOD)
+	if err != nil {
+		return nil, err
+	}
+
+	theorem, err := k.Theorems.Get(ctx, proof.TheoremId)
+	if err != nil {
+		return nil, err
+	}
+	proverAddr, err := k.validateAddress(msg.Prover)
+	if err != nil {
+		return nil, err
+	}
+	if err = k.requireOpenMathCertificate(ctx, theorem, proverAddr); err != nil {
+		return nil, err
+	}
+
+	detail, err := k.AssembleProofDetail(ctx, proof.Id, msg.ChunkHashes, msg.Compressed)
+	if err != nil {
+		return nil, err
+	}
+	if proof.Id != k.GetProofHash(proof.TheoremId, msg.Prover, detail) {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "proof hash inconsistent")
+	}
+	if err = k.PruneProofChunks(ctx, proof.Id, msg.ChunkHashes); err != nil {
+		return nil, err
+	}
+
+	proof.DetailChunks = msg.ChunkHashes
+	proof.DetailCompressed = msg.Compressed
+	if err = k.revealProofDetail(ctx, *proof); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSubmitProofDetailChunksResponse{}, nil
 }
 
 // SubmitProofVerification submits a proof verification for a theorem
@@ -904,14 +1712,35 @@ func (k msgServer) SubmitProofVerification(goCtx context.Context, msg *types.Msg
 		return nil, err
 	}
 
+	// revealed proofs are verified in the order of submission, so the first valid proof wins
+	nextProofID, err := k.NextRevealedProof(ctx, proof.TheoremId)
+	if err != nil {
+		return nil, err
+	}
+	if nextProofID != proof.Id {
+		return nil, errors.Wrapf(types.ErrProofOutOfOrder, "proof %s of theorem %d must be verified first", nextProofID, proof.TheoremId)
+	}
+
 	// Get prover address
 	proverAddr, err := k.validateAddress(proof.Prover)
 	if err != nil {
 		return nil, err
 	}
 
-	// Handle proof verification based on status
-	if err = k.handleProofVerification(ctx, msg.Status, *proof, checkerAddr, proverAddr, msg.Complexity, msg.Imports, msg.TheoremType); err != nil {
+	// Record the verdict of the checker, the proof is decided once enough checkers agree
+	verdict := types.ProofVerdict{
+		ProofId:     proof.Id,
+		Checker:     msg.Checker,
+		Status:      msg.Status,
+		Complexity:  msg.Complexity,
+		Imports:     msg.Imports,
+		TheoremType: msg.TheoremType,
+	}
+	if err = k.validateProofVerdict(ctx, proof.TheoremId, verdict); err != nil {
+		return nil, err
+	}
+	agreeing, err := k.RecordProofVerdict(ctx, checkerAddr, verdict)
+	if err != nil {
 		return nil, err
 	}
 
@@ -926,6 +1755,13 @@ func (k msgServer) SubmitProofVerification(goCtx context.Context, msg *types.Msg
 		),
 	)
 
+	// Handle proof verification based on the agreed status
+	if len(agreeing) > 0 {
+		if err = k.handleProofVerification(ctx, *proof, proverAddr, agreeing); err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.MsgSubmitProofVerificationResponse{}, nil
 }
 
@@ -1028,17 +1864,24 @@ func (k msgServer) WithdrawReward(goCtx context.Context, msg *types.MsgWithdrawR
 		return nil, err
 	}
 
+	// Release the vested rewards of the vesting schedules of the address
+	released, err := k.ReleaseVestedReward(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if there are any rewards to withdraw
-	if totalRewards.IsZero() {
+	if totalRewards.IsZero() && released.IsZero() {
 		return nil, fmt.Errorf("no rewards available for withdrawal")
 	}
 
 	// Convert to regular coins (truncate decimals)
 	finalRewards, _ := totalRewards.TruncateDecimal()
 
-	// Send rewards to the user
+	// Send rewards to the user, vesting the part above the threshold
+	liquid, vesting := sdk.NewCoins(), sdk.NewCoins()
 	if !finalRewards.IsZero() {
-		if err = k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, finalRewards); err != nil {
+		if liquid, vesting, err = k.PayReward(ctx, addr, finalRewards); err != nil {
 			return nil, err
 		}
 	}
@@ -1048,10 +1891,11 @@ func (k msgServer) WithdrawReward(goCtx context.Context, msg *types.MsgWithdrawR
 			types.EventTypeWithdrawReward,
 			sdk.NewAttribute(types.AttributeKeyReward, finalRewards.String()),
 			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyVesting, vesting.String()),
 		),
 	)
 
-	return &types.MsgWithdrawRewardResponse{}, nil
+	return &types.MsgWithdrawRewardResponse{Amount: liquid.Add(released...), Vesting: vesting}, nil
 }
 
 // validateAddress validates the address string and returns the decoded address
@@ -1118,6 +1962,19 @@ func (k msgServer) validateTheoremStatus(ctx sdk.Context, theoremID uint64) (*ty
 	return &theorem, nil
 }
 
+// requireNoActiveProof checks that no proof of the theorem is in hash lock or detail period, so that
+// its grant pool cannot change under a prover
+func (k msgServer) requireNoActiveProof(ctx sdk.Context, theoremID uint64) error {
+	hasActiveProof, activeProofID, err := k.HasActiveProofs(ctx, theoremID)
+	if err != nil {
+		return err
+	}
+	if hasActiveProof {
+		return errors.Wrapf(types.ErrTheoremProofInProgress, "proof %s of theorem %d", activeProofID, theoremID)
+	}
+	return nil
+}
+
 func (k msgServer) requireOpenMathCertificate(ctx sdk.Context, theorem types.Theorem, prover sdk.AccAddress) error {
 	if !theorem.RequireOpenmathCert {
 		return nil
@@ -1128,24 +1985,31 @@ func (k msgServer) requireOpenMathCertificate(ctx sdk.Context, theorem types.The
 	return types.ErrProofOpenMathCertNeeded
 }
 
+// validateFindingReward checks the awarded amount against the program reward schedule.
+// Programs without a reward schedule accept any amount.
+func (k msgServer) validateFindingReward(program types.Program, finding types.Finding, reward sdk.Coins) error {
+	if err := reward.Validate(); err != nil {
+		return errors.Wrapf(types.ErrFindingRewardInvalid, "%s", err)
+	}
+	if len(program.RewardSchedule) == 0 {
+		return nil
+	}
+
+	severityReward, found := program.GetSeverityReward(finding.SeverityLevel)
+	if !found {
+		return errors.Wrapf(types.ErrFindingRewardInvalid, "program %s has no reward for %s", program.ProgramId, finding.SeverityLevel)
+	}
+	return severityReward.ValidateAmount(reward)
+}
+
 // isValidProofStatus checks if the given proof status is valid
 func isValidProofStatus(status types.ProofStatus) bool {
 	return status == types.ProofStatus_PROOF_STATUS_PASSED ||
 		status == types.ProofStatus_PROOF_STATUS_FAILED
 }
 
-// handleProofVerification processes proof verification based on status
-// For passed proofs, updates theorem status and distributes rewards
-// For failed proofs, deletes the proof and removes theorem-proof mapping
-func (k msgServer) handleProofVerification(
-	ctx sdk.Context,
-	status types.ProofStatus,
-	proof types.Proof,
-	checkerAddr, proverAddr sdk.AccAddress,
-	complexity int64,
-	referenceTheorems []uint64,
-	theoremType types.TheoremType,
-) error {
+// validateProofVerdict validates the complexity, theorem type and imports reported by a checker
+func (k msgServer) validateProofVerdict(ctx sdk.Context, theoremID uint64, verdict types.ProofVerdict) error {
 	// Get params for max complexity validation
 	params, err := k.Params.Get(ctx)
 	if err != nil {
@@ -1153,26 +2017,64 @@ func (k msgServer) handleProofVerification(
 	}
 
 	// Validate complexity
-	if err := types.ValidateComplexity(complexity, params.MaxComplexity); err != nil {
+	if err := types.ValidateComplexity(verdict.Complexity, params.MaxComplexity); err != nil {
 		return err
 	}
 
-	// Validate theorem type
-	if err := types.ValidateTheoremType(theoremType); err != nil {
+	// Validate theorem imports
+	if err := types.ValidateTheoremImports(theoremID, verdict.Imports); err != nil {
+		return err
+	}
+	if err := k.ValidateImportsAcyclic(ctx, theoremID, verdict.Imports); err != nil {
 		return err
 	}
 
-	// Validate theorem imports
-	if err := types.ValidateTheoremImports(proof.TheoremId, referenceTheorems); err != nil {
+	// Validate theorem type, it must match the type stored with the theorem and the type of its imports
+	if err := types.ValidateTheoremType(verdict.TheoremType); err != nil {
+		return err
+	}
+	theorem, err := k.Theorems.Get(ctx, theoremID)
+	if err != nil {
+		return err
+	}
+	if verdict.TheoremType != theorem.TheoremType {
+		return errors.Wrapf(types.ErrTheoremTypeMismatch, "theorem %d is %s, got %s", theoremID, theorem.TheoremType, verdict.TheoremType)
+	}
+	importsType, err := k.InferTheoremType(ctx, verdict.Imports)
+	if err != nil {
 		return err
 	}
+	if importsType != types.TheoremType_THEOREM_TYPE_UNSPECIFIED && importsType != theorem.TheoremType {
+		return errors.Wrapf(types.ErrTheoremTypeMismatch, "theorem %d is %s, its imports are %s", theoremID, theorem.TheoremType, importsType)
+	}
+	return nil
+}
+
+// handleProofVerification processes proof verification based on the status agreed by the checkers
+// For passed proofs, updates theorem status with the median complexity and the imports reported by
+// all agreeing checkers, and distributes rewards
+// For failed proofs, deletes the proof and removes theorem-proof mapping
+func (k msgServer) handleProofVerification(
+	ctx sdk.Context,
+	proof types.Proof,
+	proverAddr sdk.AccAddress,
+	verdicts []types.ProofVerdict,
+) error {
+	checkerAddrs := make([]sdk.AccAddress, 0, len(verdicts))
+	for _, verdict := range verdicts {
+		checkerAddr, err := k.validateAddress(verdict.Checker)
+		if err != nil {
+			return err
+		}
+		checkerAddrs = append(checkerAddrs, checkerAddr)
+	}
 
-	proof.Status = status
-	switch status {
+	proof.Status = verdicts[0].Status
+	switch proof.Status {
 	case types.ProofStatus_PROOF_STATUS_PASSED:
-		return k.handlePassedProof(ctx, proof, checkerAddr, proverAddr, complexity, referenceTheorems, theoremType)
+		return k.handlePassedProof(ctx, proof, checkerAddrs, proverAddr, medianComplexity(verdicts), commonImports(verdicts))
 	case types.ProofStatus_PROOF_STATUS_FAILED:
-		return k.handleFailedProof(ctx, proof)
+		return k.handleFailedProof(ctx, proof, checkerAddrs)
 	default:
 		return types.ErrProofStatusInvalid
 	}
@@ -1182,10 +2084,10 @@ func (k msgServer) handleProofVerification(
 func (k msgServer) handlePassedProof(
 	ctx sdk.Context,
 	proof types.Proof,
-	checkerAddr, proverAddr sdk.AccAddress,
+	checkerAddrs []sdk.AccAddress,
+	proverAddr sdk.AccAddress,
 	complexity int64,
 	referenceTheorems []uint64,
-	theoremType types.TheoremType,
 ) error {
 	// update proof status
 	if err := k.Proofs.Set(ctx, proof.Id, proof); err != nil {
@@ -1204,6 +2106,9 @@ func (k msgServer) handlePassedProof(
 	if err = k.Theorems.Set(ctx, theorem.Id, theorem); err != nil {
 		return err
 	}
+	if err = k.IndexTheoremImports(ctx, theorem); err != nil {
+		return err
+	}
 
 	// remove from active theorems queue
 	if err = k.ActiveTheoremsQueue.Remove(ctx, collections.Join(*theorem.EndTime, theorem.Id)); err != nil {
@@ -1215,7 +2120,16 @@ func (k msgServer) handlePassedProof(
 		return err
 	}
 
-	if err = k.DistributionGrants(ctx, theorem, theoremType, checkerAddr, proverAddr); err != nil {
+	// the later proofs of the theorem are superseded
+	if err = k.RefundAndDeleteActiveProofs(ctx, theorem.Id); err != nil {
+		return err
+	}
+
+	if err = k.DistributionGrants(ctx, theorem, checkerAddrs, proverAddr); err != nil {
+		return err
+	}
+
+	if err = k.recordProofVerification(ctx, proverAddr, checkerAddrs, theorem.TheoremType, true); err != nil {
 		return err
 	}
 
@@ -1233,15 +2147,33 @@ func (k msgServer) handlePassedProof(
 	return nil
 }
 
-// handleFailedProof processes a proof that failed verification
+// handleFailedProof processes a proof that failed verification, its deposit is forfeited
 func (k msgServer) handleFailedProof(
 	ctx sdk.Context,
 	proof types.Proof,
+	checkerAddrs []sdk.AccAddress,
 ) error {
+	if err := k.ForfeitDeposits(ctx, proof, checkerAddrs); err != nil {
+		return err
+	}
+
 	if err := k.DeleteProof(ctx, proof.Id); err != nil {
 		return err
 	}
 
+	proverAddr, err := k.authKeeper.AddressCodec().StringToBytes(proof.Prover)
+	if err != nil {
+		return err
+	}
+	// the theorem may have been removed since the proof was submitted
+	theorem, err := k.Theorems.Get(ctx, proof.TheoremId)
+	if err != nil && !errors.IsOf(err, collections.ErrNotFound) {
+		return err
+	}
+	if err = k.recordProofVerification(ctx, proverAddr, checkerAddrs, theorem.TheoremType, false); err != nil {
+		return err
+	}
+
 	// emit event for proof failing
 	ctx.EventManager().EmitEvent(
 		sdk.NewEvent(
@@ -1255,6 +2187,39 @@ func (k msgServer) handleFailedProof(
 	return nil
 }
 
+// revealProofDetail moves a proof whose detail matches its hash out of the hash lock period. It is
+// re-queued in the active proofs queue with the deadline checkers have to decide it.
+func (k msgServer) revealProofDetail(ctx sdk.Context, proof types.Proof) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err = k.ActiveProofsQueue.Remove(ctx, collections.Join(*proof.EndTime, proof.Id)); err != nil {
+		return err
+	}
+
+	endTime := ctx.BlockTime().Add(*params.ProofVerificationPeriod)
+	proof.Status = types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD
+	proof.EndTime = &endTime
+	if err = k.Proofs.Set(ctx, proof.Id, proof); err != nil {
+		return err
+	}
+	if err = k.ActiveProofsQueue.Set(ctx, collections.Join(endTime, proof.Id)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeSubmitProofDetail,
+			sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", proof.TheoremId)),
+			sdk.NewAttribute(types.AttributeKeyProofID, proof.Id),
+			sdk.NewAttribute(types.AttributeKeyProver, proof.Prover),
+			sdk.NewAttribute(types.AttributeKeyChunks, fmt.Sprintf("%d", len(proof.DetailChunks))),
+		),
+	)
+	return nil
+}
+
 // validateMsgFields validates that required fields are not empty
 func validateMsgFields(fields map[string]string) error {
 	for fieldName, fieldValue := range fields {
@@ -1276,6 +2241,19 @@ func (k msgServer) emitProgramEvent(ctx sdk.Context, eventType string, programID
 	)
 }
 
+// emitProgramMemberEvent emits a standardized event for program team operations
+func (k msgServer) emitProgramMemberEvent(ctx sdk.Context, eventType string, member types.ProgramMember, operatorAddress string) {
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyProgramID, member.ProgramId),
+			sdk.NewAttribute(types.AttributeKeyMember, member.Address),
+			sdk.NewAttribute(types.AttributeKeyRole, member.Role.String()),
+			sdk.NewAttribute(sdk.AttributeKeySender, operatorAddress),
+		),
+	)
+}
+
 // emitFindingEvent emits a standardized event for finding-related operations
 func (k msgServer) emitFindingEvent(ctx sdk.Context, eventType string, finding types.Finding, operatorAddress string) {
 	ctx.EventManager().EmitEvent(
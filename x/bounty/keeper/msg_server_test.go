@@ -8,12 +8,17 @@ import (
 	"time"
 
 	"github.com/google/uuid"
+	"github.com/klauspost/compress/zstd"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/shentufoundation/shentu/v2/x/bounty/types"
 )
@@ -653,6 +658,761 @@ func (suite *KeeperTestSuite) TestPublishConfirmFinding() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestEncryptedFinding() {
+	pid, fid := uuid.NewString(), uuid.NewString()
+	suite.InitCreateProgram(pid)
+	suite.InitActivateProgram(pid)
+	triagerAddr, outsiderAddr := suite.normalAddr, suite.address[2]
+	_, err := suite.msgServer.AddProgramMember(suite.ctx, types.NewMsgAddProgramMember(pid, triagerAddr, types.ProgramRoleTriager, suite.programAddr))
+	suite.Require().NoError(err)
+
+	adminKey, triagerKey, outsiderKey := secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()
+	for addr, key := range map[string]*secp256k1.PrivKey{suite.programAddr.String(): adminKey, triagerAddr.String(): triagerKey} {
+		account := suite.app.AccountKeeper.GetAccount(suite.ctx, sdk.MustAccAddressFromBech32(addr))
+		suite.Require().NoError(account.SetPubKey(key.PubKey()))
+		suite.app.AccountKeeper.SetAccount(suite.ctx, account)
+	}
+	admin := types.PayloadRecipient{Address: suite.programAddr.String(), PubKey: adminKey.PubKey().Bytes()}
+	triager := types.PayloadRecipient{Address: triagerAddr.String(), PubKey: triagerKey.PubKey().Bytes()}
+	outsider := types.PayloadRecipient{Address: outsiderAddr.String(), PubKey: outsiderKey.PubKey().Bytes()}
+	plaintext := types.FindingPayload{Title: "title", Description: "desc", ProofOfConcept: "poc"}
+	encrypt := func(plaintext types.FindingPayload, recipients ...types.PayloadRecipient) *types.EncryptedFindingPayload {
+		payload, err := types.EncryptFindingPayload(recipients, plaintext)
+		suite.Require().NoError(err)
+		return payload
+	}
+	payload := encrypt(plaintext, admin, triager)
+
+	// the payload must be well formed and encrypted to the whole program team only
+	testCases := []struct {
+		name    string
+		payload *types.EncryptedFindingPayload
+	}{
+		{"malformed payload", &types.EncryptedFindingPayload{Keys: payload.Keys, Ciphertext: []byte("payload")}},
+		{"payload without a team member", encrypt(plaintext, admin)},
+		{"payload to an outsider", encrypt(plaintext, admin, triager, outsider)},
+	}
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			_, err := suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(pid, fid, "", plaintext.Hash(suite.whiteHatAddr.String()),
+				suite.whiteHatAddr, types.Critical, tc.payload))
+			suite.Require().ErrorIs(err, types.ErrFindingPayloadInvalid)
+		})
+	}
+
+	// a team member without a public key on chain cannot be encrypted to and is not required
+	_, err = suite.msgServer.AddProgramMember(suite.ctx, types.NewMsgAddProgramMember(pid, suite.address[3], types.ProgramRoleTriager, suite.programAddr))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(pid, fid, "", plaintext.Hash(suite.whiteHatAddr.String()),
+		suite.whiteHatAddr, types.Critical, payload))
+	suite.Require().NoError(err)
+
+	// every team member can decrypt the stored payload, no one else
+	finding, err := suite.keeper.Findings.Get(suite.ctx, fid)
+	suite.Require().NoError(err)
+	suite.Require().Equal(payload, finding.EncryptedPayload)
+	_, err = types.DecryptFindingPayload(outsiderAddr.String(), outsiderKey.Bytes(), finding.EncryptedPayload)
+	suite.Require().ErrorIs(err, types.ErrFindingPayloadInvalid)
+	_, err = types.DecryptFindingPayload(triagerAddr.String(), outsiderKey.Bytes(), finding.EncryptedPayload)
+	suite.Require().ErrorIs(err, types.ErrFindingPayloadInvalid)
+	for _, recipient := range []struct {
+		address string
+		key     []byte
+	}{{admin.Address, adminKey.Bytes()}, {triager.Address, triagerKey.Bytes()}} {
+		decrypted, err := types.DecryptFindingPayload(recipient.address, recipient.key, finding.EncryptedPayload)
+		suite.Require().NoError(err)
+		suite.Require().Equal(plaintext, decrypted)
+	}
+
+	// the payload is replaced along with the finding hash
+	edited := types.FindingPayload{Title: "title", Description: "new desc", ProofOfConcept: "new poc"}
+	_, err = suite.msgServer.EditFinding(suite.ctx, types.NewMsgEditFinding(fid, edited.Hash(suite.whiteHatAddr.String()), "", suite.whiteHatAddr, types.Unspecified, nil))
+	suite.Require().ErrorIs(err, types.ErrFindingPayloadInvalid)
+	editedPayload := encrypt(edited, admin, triager)
+	_, err = suite.msgServer.EditFinding(suite.ctx, types.NewMsgEditFinding(fid, edited.Hash(suite.whiteHatAddr.String()), "", suite.whiteHatAddr, types.Unspecified, editedPayload))
+	suite.Require().NoError(err)
+	finding, err = suite.keeper.Findings.Get(suite.ctx, fid)
+	suite.Require().NoError(err)
+	suite.Require().Equal(editedPayload, finding.EncryptedPayload)
+	decrypted, err := types.DecryptFindingPayload(triager.Address, triagerKey.Bytes(), finding.EncryptedPayload)
+	suite.Require().NoError(err)
+	suite.Require().Equal(edited, decrypted)
+
+	// the decrypted plaintext matches the committed hash on publish
+	suite.InitActivateFinding(fid)
+	finding, err = suite.keeper.Findings.Get(suite.ctx, fid)
+	suite.Require().NoError(err)
+	suite.InitConfirmFinding(fid, suite.keeper.GetFindingFingerprintHash(&finding))
+	suite.InitConfirmFindingPaid(fid)
+	_, err = suite.msgServer.PublishFinding(suite.ctx, types.NewMsgPublishFinding(fid, decrypted.Description, decrypted.ProofOfConcept, suite.programAddr))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestProgramMembers() {
+	pid := uuid.NewString()
+	suite.InitCreateProgram(pid)
+	suite.InitActivateProgram(pid)
+	triagerAddr, adminAddr := suite.normalAddr, suite.address[2]
+
+	// outsiders cannot triage findings nor manage the team
+	fid := uuid.NewString()
+	suite.InitSubmitFinding(pid, fid)
+	_, err := suite.msgServer.ActivateFinding(suite.ctx, &types.MsgActivateFinding{FindingId: fid, OperatorAddress: triagerAddr.String()})
+	suite.Require().ErrorIs(err, types.ErrFindingOperatorNotAllowed)
+	_, err = suite.msgServer.AddProgramMember(suite.ctx, types.NewMsgAddProgramMember(pid, triagerAddr, types.ProgramRoleTriager, triagerAddr))
+	suite.Require().ErrorIs(err, types.ErrProgramOperatorNotAllowed)
+	_, err = suite.msgServer.AddProgramMember(suite.ctx, types.NewMsgAddProgramMember(pid, triagerAddr, types.ProgramRoleUnspecified, suite.programAddr))
+	suite.Require().ErrorIs(err, types.ErrProgramMemberInvalid)
+	_, err = suite.msgServer.AddProgramMember(suite.ctx, types.NewMsgAddProgramMember(pid, suite.programAddr, types.ProgramRoleAdmin, suite.programAddr))
+	suite.Require().ErrorIs(err, types.ErrProgramMemberInvalid)
+
+	_, err = suite.msgServer.AddProgramMember(suite.ctx, types.NewMsgAddProgramMember(pid, triagerAddr, types.ProgramRoleTriager, suite.programAddr))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.AddProgramMember(suite.ctx, types.NewMsgAddProgramMember(pid, adminAddr, types.ProgramRoleAdmin, suite.programAddr))
+	suite.Require().NoError(err)
+	res, err := suite.queryClient.ProgramMembers(suite.ctx, &types.QueryProgramMembersRequest{ProgramId: pid})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Members, 2)
+
+	// triagers cannot manage the team
+	_, err = suite.msgServer.RemoveProgramMember(suite.ctx, types.NewMsgRemoveProgramMember(pid, adminAddr, triagerAddr))
+	suite.Require().ErrorIs(err, types.ErrProgramOperatorNotAllowed)
+
+	// triagers activate findings but cannot confirm them
+	_, err = suite.msgServer.ActivateFinding(suite.ctx, &types.MsgActivateFinding{FindingId: fid, OperatorAddress: triagerAddr.String()})
+	suite.Require().NoError(err)
+	finding, err := suite.keeper.Findings.Get(suite.ctx, fid)
+	suite.Require().NoError(err)
+	fingerprint := suite.keeper.GetFindingFingerprintHash(&finding)
+	_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(fid, fingerprint, triagerAddr, nil))
+	suite.Require().ErrorIs(err, types.ErrProgramOperatorNotAllowed)
+
+	// critical findings need two distinct admin approvals
+	_, err = suite.msgServer.EditProgram(suite.ctx, types.NewMsgEditProgram(pid, "", "", suite.bountyAdminAddr, nil, 2, types.DuplicatePolicyUnspecified))
+	suite.Require().NoError(err)
+	for i := 0; i < 2; i++ {
+		_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(fid, fingerprint, suite.programAddr, nil))
+		suite.Require().NoError(err)
+		finding, err = suite.keeper.Findings.Get(suite.ctx, fid)
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.FindingStatusActive, finding.Status)
+	}
+	_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(fid, fingerprint, adminAddr, nil))
+	suite.Require().NoError(err)
+	finding, err = suite.keeper.Findings.Get(suite.ctx, fid)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FindingStatusConfirmed, finding.Status)
+	_, err = suite.keeper.FindingApprovals.Get(suite.ctx, collections.Join(fid, suite.programAddr))
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
+
+	// admin members can mark findings paid
+	_, err = suite.msgServer.ConfirmFindingPaid(suite.ctx, &types.MsgConfirmFindingPaid{FindingId: fid, OperatorAddress: adminAddr.String()})
+	suite.Require().NoError(err)
+
+	// triagers close findings
+	fid = uuid.NewString()
+	suite.InitSubmitFinding(pid, fid)
+	_, err = suite.msgServer.CloseFinding(suite.ctx, &types.MsgCloseFinding{FindingId: fid, OperatorAddress: triagerAddr.String()})
+	suite.Require().NoError(err)
+
+	// members can leave the team, after which they lose their permissions
+	_, err = suite.msgServer.RemoveProgramMember(suite.ctx, types.NewMsgRemoveProgramMember(pid, triagerAddr, triagerAddr))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.RemoveProgramMember(suite.ctx, types.NewMsgRemoveProgramMember(pid, triagerAddr, suite.programAddr))
+	suite.Require().ErrorIs(err, types.ErrProgramMemberNotExists)
+	fid = uuid.NewString()
+	suite.InitSubmitFinding(pid, fid)
+	_, err = suite.msgServer.CloseFinding(suite.ctx, &types.MsgCloseFinding{FindingId: fid, OperatorAddress: triagerAddr.String()})
+	suite.Require().ErrorIs(err, types.ErrFindingOperatorNotAllowed)
+}
+
+func (suite *KeeperTestSuite) TestDisputeFinding() {
+	pid, fid := uuid.NewString(), uuid.NewString()
+	suite.InitCreateProgram(pid)
+	suite.InitActivateProgram(pid)
+	suite.InitSubmitFinding(pid, fid)
+
+	closeFinding := func() {
+		_, err := suite.msgServer.CloseFinding(suite.ctx, &types.MsgCloseFinding{FindingId: fid, OperatorAddress: suite.programAddr.String()})
+		suite.Require().NoError(err)
+	}
+	resolve := func() types.Finding {
+		params, err := suite.keeper.Params.Get(suite.ctx)
+		suite.Require().NoError(err)
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(*params.DisputeWindow))
+		_, err = suite.keeper.ResolveEndedDisputes(suite.ctx, suite.ctx.BlockTime())
+		suite.Require().NoError(err)
+		finding, err := suite.keeper.Findings.Get(suite.ctx, fid)
+		suite.Require().NoError(err)
+		return finding
+	}
+
+	// only closed findings can be disputed
+	_, err := suite.msgServer.DisputeFinding(suite.ctx, types.NewMsgDisputeFinding(fid, "reason", suite.whiteHatAddr))
+	suite.Require().ErrorIs(err, types.ErrFindingStatusInvalid)
+	closeFinding()
+
+	// only by their submitter
+	_, err = suite.msgServer.DisputeFinding(suite.ctx, types.NewMsgDisputeFinding(fid, "reason", suite.normalAddr))
+	suite.Require().ErrorIs(err, types.ErrFindingOperatorNotAllowed)
+	_, err = suite.msgServer.DisputeFinding(suite.ctx, types.NewMsgDisputeFinding(fid, "reason", suite.whiteHatAddr))
+	suite.Require().NoError(err)
+	finding, err := suite.keeper.Findings.Get(suite.ctx, fid)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FindingStatusDisputed, finding.Status)
+
+	// disputed findings keep the program open
+	_, err = suite.msgServer.CloseProgram(suite.ctx, types.NewMsgCloseProgram(pid, suite.programAddr))
+	suite.Require().ErrorIs(err, types.ErrProgramCloseNotAllowed)
+
+	// only bounty admins vote
+	_, err = suite.msgServer.VoteDispute(suite.ctx, types.NewMsgVoteDispute(fid, types.DisputeVoteOptionOverturn, suite.normalAddr))
+	suite.Require().ErrorIs(err, types.ErrFindingOperatorNotAllowed)
+	_, err = suite.msgServer.VoteDispute(suite.ctx, types.NewMsgVoteDispute(fid, types.DisputeVoteOptionUnspecified, suite.bountyAdminAddr))
+	suite.Require().ErrorIs(err, types.ErrDisputeVoteInvalid)
+	_, err = suite.msgServer.VoteDispute(suite.ctx, types.NewMsgVoteDispute(fid, types.DisputeVoteOptionOverturn, suite.bountyAdminAddr))
+	suite.Require().NoError(err)
+
+	res, err := suite.queryClient.Dispute(suite.ctx, &types.QueryDisputeRequest{FindingId: fid})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DisputeStatusVoting, res.Dispute.Status)
+	suite.Require().Len(res.Votes, 1)
+
+	// an overturned closure reactivates the finding
+	finding = resolve()
+	suite.Require().Equal(types.FindingStatusActive, finding.Status)
+	_, err = suite.msgServer.VoteDispute(suite.ctx, types.NewMsgVoteDispute(fid, types.DisputeVoteOptionUphold, suite.bountyAdminAddr))
+	suite.Require().ErrorIs(err, types.ErrDisputeVoteInvalid)
+
+	// without a majority to overturn, the closure is upheld and final
+	closeFinding()
+	_, err = suite.msgServer.DisputeFinding(suite.ctx, types.NewMsgDisputeFinding(fid, "reason", suite.whiteHatAddr))
+	suite.Require().NoError(err)
+	res, err = suite.queryClient.Dispute(suite.ctx, &types.QueryDisputeRequest{FindingId: fid})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Votes)
+
+	finding = resolve()
+	suite.Require().Equal(types.FindingStatusClosed, finding.Status)
+	res, err = suite.queryClient.Dispute(suite.ctx, &types.QueryDisputeRequest{FindingId: fid})
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.DisputeStatusUpheld, res.Dispute.Status)
+	_, err = suite.msgServer.DisputeFinding(suite.ctx, types.NewMsgDisputeFinding(fid, "reason", suite.whiteHatAddr))
+	suite.Require().ErrorIs(err, types.ErrFindingDisputeNotAllowed)
+}
+
+func (suite *KeeperTestSuite) TestDuplicateFinding() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(amount)))
+	}
+
+	pid := uuid.NewString()
+	_, err = suite.msgServer.CreateProgram(suite.ctx, types.NewMsgCreateProgram(pid, "name", "detail", suite.programAddr, coins(1000), nil, 0, types.DuplicatePolicyEqualSplit))
+	suite.Require().NoError(err)
+	suite.InitActivateProgram(pid)
+
+	original, duplicate, other := uuid.NewString(), uuid.NewString(), uuid.NewString()
+	suite.InitSubmitFinding(pid, original)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	_, err = suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(pid, duplicate, "", "hash", suite.normalAddr, types.Critical, nil))
+	suite.Require().NoError(err)
+	suite.InitSubmitFinding(pid, other)
+
+	testCases := []struct {
+		name        string
+		findingID   string
+		duplicateOf string
+		operator    sdk.AccAddress
+		expErr      error
+	}{
+		{"submitter is not a triager", duplicate, original, suite.normalAddr, types.ErrFindingOperatorNotAllowed},
+		{"duplicate of itself", duplicate, duplicate, suite.programAddr, types.ErrFindingDuplicateInvalid},
+		{"original submitted later", original, duplicate, suite.programAddr, types.ErrFindingDuplicateInvalid},
+		{"valid duplicate", duplicate, original, suite.programAddr, nil},
+		{"original is a duplicate", other, duplicate, suite.programAddr, types.ErrFindingDuplicateInvalid},
+		{"already a duplicate", duplicate, original, suite.bountyAdminAddr, types.ErrFindingStatusInvalid},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.MarkDuplicateFinding(suite.ctx, types.NewMsgMarkDuplicateFinding(tc.findingID, tc.duplicateOf, tc.operator))
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+		})
+	}
+
+	finding, err := suite.keeper.Findings.Get(suite.ctx, duplicate)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FindingStatusDuplicate, finding.Status)
+	suite.Require().Equal(original, finding.DuplicateOf)
+
+	// duplicates are grouped under the original finding
+	res, err := suite.queryClient.Findings(suite.ctx, &types.QueryFindingsRequest{DuplicateOf: original})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Findings, 1)
+	suite.Require().Equal(duplicate, res.Findings[0].FindingId)
+
+	// the reward of the original is split equally, the remainder goes to the first reporter
+	whiteHatBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.whiteHatAddr, bondDenom)
+	normalBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.normalAddr, bondDenom)
+	suite.InitActivateFinding(original)
+	finding, err = suite.keeper.Findings.Get(suite.ctx, original)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(original, suite.keeper.GetFindingFingerprintHash(&finding), suite.programAddr, coins(301)))
+	suite.Require().NoError(err)
+
+	suite.Require().Equal(whiteHatBalance.AddAmount(math.NewInt(151)), suite.app.BankKeeper.GetBalance(suite.ctx, suite.whiteHatAddr, bondDenom))
+	suite.Require().Equal(normalBalance.AddAmount(math.NewInt(150)), suite.app.BankKeeper.GetBalance(suite.ctx, suite.normalAddr, bondDenom))
+	finding, err = suite.keeper.Findings.Get(suite.ctx, duplicate)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FindingStatusPaid, finding.Status)
+	suite.Require().Equal(original, finding.DuplicateOf)
+	suite.Require().Equal(coins(150), sdk.NewCoins(finding.Reward...))
+	suite.Require().NoError(types.ValidateFinding(&finding))
+
+	// the paid duplicate counts in the reputation of its submitter
+	reputation, err := suite.keeper.HackerReputations.Get(suite.ctx, suite.normalAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(1), reputation.ConfirmedFindings())
+	suite.Require().Equal(uint64(1), reputation.PaidFindings)
+	suite.Require().Equal(coins(150), reputation.TotalPaid)
+	suite.Require().NoError(types.ValidateHackerReputation(&reputation))
+	program, err := suite.keeper.Programs.Get(suite.ctx, pid)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins(699), sdk.NewCoins(program.RewardPool...))
+
+	// under the first reporter policy duplicates are not paid
+	_, err = suite.msgServer.EditProgram(suite.ctx, types.NewMsgEditProgram(pid, "", "", suite.bountyAdminAddr, nil, 0, types.DuplicatePolicyFirstReporter))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.MarkDuplicateFinding(suite.ctx, types.NewMsgMarkDuplicateFinding(other, duplicate, suite.programAddr))
+	suite.Require().ErrorIs(err, types.ErrFindingDuplicateInvalid)
+	third := uuid.NewString()
+	_, err = suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(pid, third, "", "hash", suite.normalAddr, types.Critical, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.MarkDuplicateFinding(suite.ctx, types.NewMsgMarkDuplicateFinding(third, other, suite.programAddr))
+	suite.Require().NoError(err)
+
+	normalBalance = suite.app.BankKeeper.GetBalance(suite.ctx, suite.normalAddr, bondDenom)
+	suite.InitActivateFinding(other)
+	finding, err = suite.keeper.Findings.Get(suite.ctx, other)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(other, suite.keeper.GetFindingFingerprintHash(&finding), suite.programAddr, coins(100)))
+	suite.Require().NoError(err)
+	suite.Require().Equal(normalBalance, suite.app.BankKeeper.GetBalance(suite.ctx, suite.normalAddr, bondDenom))
+
+	// duplicates do not keep the program open
+	_, err = suite.msgServer.CloseProgram(suite.ctx, types.NewMsgCloseProgram(pid, suite.programAddr))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestFindingSLA() {
+	activationSLA, confirmationSLA := time.Hour, 2*time.Hour
+	pid := uuid.NewString()
+	msg := types.NewMsgCreateProgram(pid, "name", "detail", suite.programAddr, nil, nil, 0, types.DuplicatePolicyUnspecified)
+	negative := -time.Hour
+	msg.ActivationSla = &negative
+	_, err := suite.msgServer.CreateProgram(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrProgramSLAInvalid)
+	msg.ActivationSla, msg.ConfirmationSla = &activationSLA, &confirmationSLA
+	_, err = suite.msgServer.CreateProgram(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.InitActivateProgram(pid)
+
+	escalate := func(d time.Duration) []types.Finding {
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(d)).WithEventManager(sdk.NewEventManager())
+		escalated, err := suite.keeper.EscalateBreachedFindings(suite.ctx, suite.ctx.BlockTime())
+		suite.Require().NoError(err)
+		for _, finding := range escalated {
+			suite.Require().Equal(types.FindingStatusEscalated, finding.Status)
+			suite.Require().Nil(finding.SlaDeadline)
+		}
+		return escalated
+	}
+
+	late, handled := uuid.NewString(), uuid.NewString()
+	suite.InitSubmitFinding(pid, late)
+	suite.InitSubmitFinding(pid, handled)
+	finding, err := suite.keeper.Findings.Get(suite.ctx, late)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(activationSLA), *finding.SlaDeadline)
+
+	// activation moves the finding under the confirmation SLA
+	suite.InitActivateFinding(handled)
+	finding, err = suite.keeper.Findings.Get(suite.ctx, handled)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(confirmationSLA), *finding.SlaDeadline)
+
+	// findings not activated in time are escalated to bounty admins
+	escalated := escalate(activationSLA)
+	suite.Require().Len(escalated, 1)
+	suite.Require().Equal(late, escalated[0].FindingId)
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Len(events, 1)
+	suite.Require().Equal(types.EventTypeFindingSLABreached, events[0].Type)
+	sla, ok := events[0].GetAttribute(types.AttributeKeySLA)
+	suite.Require().True(ok)
+	suite.Require().Equal(types.FindingSLAActivation, sla.Value)
+
+	// escalated findings are out of the program team's hands
+	_, err = suite.msgServer.ActivateFinding(suite.ctx, types.NewMsgActivateFinding(late, suite.programAddr))
+	suite.Require().ErrorIs(err, types.ErrFindingOperatorNotAllowed)
+	_, err = suite.msgServer.CloseFinding(suite.ctx, types.NewMsgCloseFinding(late, suite.programAddr))
+	suite.Require().ErrorIs(err, types.ErrFindingOperatorNotAllowed)
+	_, err = suite.msgServer.CloseProgram(suite.ctx, types.NewMsgCloseProgram(pid, suite.programAddr))
+	suite.Require().ErrorIs(err, types.ErrProgramCloseNotAllowed)
+	suite.InitActivateFinding(late)
+
+	// active findings not confirmed in time are escalated as well
+	escalated = escalate(confirmationSLA - activationSLA)
+	suite.Require().Len(escalated, 1)
+	suite.Require().Equal(handled, escalated[0].FindingId)
+	sla, ok = suite.ctx.EventManager().Events()[0].GetAttribute(types.AttributeKeySLA)
+	suite.Require().True(ok)
+	suite.Require().Equal(types.FindingSLAConfirmation, sla.Value)
+	_, err = suite.msgServer.CloseFinding(suite.ctx, types.NewMsgCloseFinding(handled, suite.whiteHatAddr))
+	suite.Require().NoError(err)
+
+	// handled findings leave the SLA queue
+	finding, err = suite.keeper.Findings.Get(suite.ctx, late)
+	suite.Require().NoError(err)
+	suite.InitConfirmFinding(late, suite.keeper.GetFindingFingerprintHash(&finding))
+	finding, err = suite.keeper.Findings.Get(suite.ctx, late)
+	suite.Require().NoError(err)
+	suite.Require().Nil(finding.SlaDeadline)
+	suite.Require().Empty(escalate(confirmationSLA))
+}
+
+func (suite *KeeperTestSuite) TestDuplicateFindingDisclosure() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+
+	embargo := 30 * 24 * time.Hour
+	pid := uuid.NewString()
+	msg := types.NewMsgCreateProgram(pid, "name", "detail", suite.programAddr, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000))), nil, 0, types.DuplicatePolicyEqualSplit)
+	msg.DisclosureEmbargo = &embargo
+	_, err = suite.msgServer.CreateProgram(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.InitActivateProgram(pid)
+
+	original, duplicate := uuid.NewString(), uuid.NewString()
+	suite.InitSubmitFinding(pid, original)
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Second))
+	_, err = suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(pid, duplicate, "", "hash", suite.normalAddr, types.Critical, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.MarkDuplicateFinding(suite.ctx, types.NewMsgMarkDuplicateFinding(duplicate, original, suite.programAddr))
+	suite.Require().NoError(err)
+
+	// the duplicate paid its share of the reward is embargoed along with the original
+	suite.InitActivateFinding(original)
+	finding, err := suite.keeper.Findings.Get(suite.ctx, original)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(original, suite.keeper.GetFindingFingerprintHash(&finding), suite.programAddr, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(300)))))
+	suite.Require().NoError(err)
+
+	deadline := suite.ctx.BlockTime().Add(embargo)
+	for _, fid := range []string{original, duplicate} {
+		finding, err = suite.keeper.Findings.Get(suite.ctx, fid)
+		suite.Require().NoError(err)
+		suite.Require().Equal(types.FindingStatusPaid, finding.Status)
+		suite.Require().NotNil(finding.DisclosureDeadline)
+		suite.Require().Equal(deadline, *finding.DisclosureDeadline)
+		has, err := suite.keeper.DisclosureQueue.Has(suite.ctx, collections.Join(deadline, fid))
+		suite.Require().NoError(err)
+		suite.Require().True(has)
+	}
+	res, err := suite.queryClient.Disclosures(suite.ctx, &types.QueryDisclosuresRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Findings, 2)
+}
+
+func (suite *KeeperTestSuite) TestFindingDisclosureEmbargo() {
+	embargo := 30 * 24 * time.Hour
+	pid := uuid.NewString()
+	msg := types.NewMsgCreateProgram(pid, "name", "detail", suite.programAddr, nil, nil, 0, types.DuplicatePolicyUnspecified)
+	negative := -time.Hour
+	msg.DisclosureEmbargo = &negative
+	_, err := suite.msgServer.CreateProgram(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrProgramEmbargoInvalid)
+	msg.DisclosureEmbargo = &embargo
+	_, err = suite.msgServer.CreateProgram(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.InitActivateProgram(pid)
+
+	// the embargo starts once a finding is paid or closed
+	paid, closed := uuid.NewString(), uuid.NewString()
+	suite.InitSubmitFinding(pid, paid)
+	suite.InitSubmitFinding(pid, closed)
+	suite.InitActivateFinding(paid)
+	finding, err := suite.keeper.Findings.Get(suite.ctx, paid)
+	suite.Require().NoError(err)
+	suite.InitConfirmFinding(paid, suite.keeper.GetFindingFingerprintHash(&finding))
+	finding, err = suite.keeper.Findings.Get(suite.ctx, paid)
+	suite.Require().NoError(err)
+	suite.Require().Nil(finding.DisclosureDeadline)
+	suite.InitConfirmFindingPaid(paid)
+	_, err = suite.msgServer.CloseFinding(suite.ctx, types.NewMsgCloseFinding(closed, suite.whiteHatAddr))
+	suite.Require().NoError(err)
+
+	deadline := suite.ctx.BlockTime().Add(embargo)
+	for _, fid := range []string{paid, closed} {
+		finding, err = suite.keeper.Findings.Get(suite.ctx, fid)
+		suite.Require().NoError(err)
+		suite.Require().Equal(deadline, *finding.DisclosureDeadline)
+		suite.Require().False(finding.DisclosureOverdue)
+	}
+	res, err := suite.queryClient.Disclosures(suite.ctx, &types.QueryDisclosuresRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Findings, 2)
+
+	// only the program admin can publish a paid finding during the embargo
+	_, err = suite.msgServer.PublishFinding(suite.ctx, types.NewMsgPublishFinding(paid, "desc", "poc", suite.whiteHatAddr))
+	suite.Require().ErrorIs(err, types.ErrProgramOperatorNotAllowed)
+
+	// nothing is overdue before the deadline
+	overdue, err := suite.keeper.FlagOverdueDisclosures(suite.ctx, deadline.Add(-time.Second))
+	suite.Require().NoError(err)
+	suite.Require().Empty(overdue)
+
+	suite.ctx = suite.ctx.WithBlockTime(deadline).WithEventManager(sdk.NewEventManager())
+	overdue, err = suite.keeper.FlagOverdueDisclosures(suite.ctx, suite.ctx.BlockTime())
+	suite.Require().NoError(err)
+	suite.Require().Len(overdue, 2)
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Len(events, 2)
+	suite.Require().Equal(types.EventTypeDisclosureOverdue, events[0].Type)
+
+	res, err = suite.queryClient.Disclosures(suite.ctx, &types.QueryDisclosuresRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Findings)
+	res, err = suite.queryClient.Disclosures(suite.ctx, &types.QueryDisclosuresRequest{Overdue: true})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Findings, 2)
+	suite.Require().True(res.Findings[0].DisclosureOverdue)
+
+	// once overdue, either party can publish without the other
+	_, err = suite.msgServer.PublishFinding(suite.ctx, types.NewMsgPublishFinding(paid, "desc", "poc", suite.normalAddr))
+	suite.Require().ErrorIs(err, types.ErrFindingOperatorNotAllowed)
+	_, err = suite.msgServer.PublishFinding(suite.ctx, types.NewMsgPublishFinding(paid, "desc", "poc", suite.whiteHatAddr))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.PublishFinding(suite.ctx, types.NewMsgPublishFinding(closed, "desc", "poc", suite.programAddr))
+	suite.Require().NoError(err)
+
+	for _, fid := range []string{paid, closed} {
+		finding, err = suite.keeper.Findings.Get(suite.ctx, fid)
+		suite.Require().NoError(err)
+		suite.Require().Equal("desc", finding.Description)
+		suite.Require().Nil(finding.DisclosureDeadline)
+		suite.Require().False(finding.DisclosureOverdue)
+	}
+	res, err = suite.queryClient.Disclosures(suite.ctx, &types.QueryDisclosuresRequest{Overdue: true})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Findings)
+}
+
+func (suite *KeeperTestSuite) TestProgramRewardEscrow() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+
+	pid, fid := uuid.NewString(), uuid.NewString()
+	rewardPool := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000)))
+	adminBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.programAddr, bondDenom)
+	moduleBalance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bondDenom)
+
+	_, err = suite.msgServer.CreateProgram(suite.ctx, types.NewMsgCreateProgram(pid, "name", "detail", suite.programAddr, rewardPool, nil, 0, types.DuplicatePolicyUnspecified))
+	suite.Require().NoError(err)
+	program, err := suite.keeper.Programs.Get(suite.ctx, pid)
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewardPool, sdk.NewCoins(program.RewardPool...))
+	suite.Require().Equal(moduleBalance.AddAmount(math.NewInt(1000)), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bondDenom))
+
+	suite.InitActivateProgram(pid)
+	suite.InitSubmitFinding(pid, fid)
+	suite.InitActivateFinding(fid)
+	finding, err := suite.keeper.Findings.Get(suite.ctx, fid)
+	suite.Require().NoError(err)
+	fingerprint := suite.keeper.GetFindingFingerprintHash(&finding)
+
+	// reward larger than the pool is rejected
+	tooMuch := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1001)))
+	_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(fid, fingerprint, suite.programAddr, tooMuch))
+	suite.Require().ErrorIs(err, types.ErrProgramRewardPoolInsufficient)
+
+	// the submitter is paid from escrow, the reward above the vesting threshold vests
+	params, err := suite.keeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.RewardVestingThreshold = sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100)))
+	suite.Require().NoError(suite.keeper.Params.Set(suite.ctx, params))
+	whiteHatBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.whiteHatAddr, bondDenom)
+	reward := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(400)))
+	_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(fid, fingerprint, suite.programAddr, reward))
+	suite.Require().NoError(err)
+
+	finding, err = suite.keeper.Findings.Get(suite.ctx, fid)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.FindingStatusPaid, finding.Status)
+	suite.Require().Equal(reward, sdk.NewCoins(finding.Reward...))
+	suite.Require().Equal(whiteHatBalance.AddAmount(math.NewInt(100)), suite.app.BankKeeper.GetBalance(suite.ctx, suite.whiteHatAddr, bondDenom))
+	vesting, err := suite.keeper.RewardVestings.Get(suite.ctx, suite.whiteHatAddr)
+	suite.Require().NoError(err)
+	suite.Require().Len(vesting.Schedules, 1)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(300))), vesting.Schedules[0].Total)
+
+	program, err = suite.keeper.Programs.Get(suite.ctx, pid)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(600))), sdk.NewCoins(program.RewardPool...))
+
+	// leftover escrow goes back to the admin on close
+	_, err = suite.msgServer.CloseProgram(suite.ctx, types.NewMsgCloseProgram(pid, suite.programAddr))
+	suite.Require().NoError(err)
+	program, err = suite.keeper.Programs.Get(suite.ctx, pid)
+	suite.Require().NoError(err)
+	suite.Require().True(sdk.NewCoins(program.RewardPool...).IsZero())
+	suite.Require().Equal(adminBalance.SubAmount(math.NewInt(400)), suite.app.BankKeeper.GetBalance(suite.ctx, suite.programAddr, bondDenom))
+	suite.Require().Equal(moduleBalance.AddAmount(math.NewInt(300)), suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bondDenom))
+}
+
+func (suite *KeeperTestSuite) TestProgramSponsorship() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(amount)))
+	}
+
+	pid, fid := uuid.NewString(), uuid.NewString()
+	_, err = suite.msgServer.CreateProgram(suite.ctx, types.NewMsgCreateProgram(pid, "name", "detail", suite.programAddr, coins(1000), nil, 0, types.DuplicatePolicyUnspecified))
+	suite.Require().NoError(err)
+
+	// only active programs can be sponsored
+	_, err = suite.msgServer.FundProgram(suite.ctx, types.NewMsgFundProgram(pid, suite.normalAddr, coins(1000)))
+	suite.Require().ErrorIs(err, types.ErrProgramNotActive)
+	suite.InitActivateProgram(pid)
+	_, err = suite.msgServer.FundProgram(suite.ctx, types.NewMsgFundProgram(pid, suite.normalAddr, nil))
+	suite.Require().ErrorIs(err, types.ErrProgramSponsorshipInvalid)
+
+	// sponsorships add up per sponsor
+	adminBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.programAddr, bondDenom)
+	sponsorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.normalAddr, bondDenom)
+	_, err = suite.msgServer.FundProgram(suite.ctx, types.NewMsgFundProgram(pid, suite.normalAddr, coins(1000)))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.FundProgram(suite.ctx, types.NewMsgFundProgram(pid, suite.normalAddr, coins(2000)))
+	suite.Require().NoError(err)
+	program, err := suite.keeper.Programs.Get(suite.ctx, pid)
+	suite.Require().NoError(err)
+	suite.Require().Equal(coins(4000), sdk.NewCoins(program.RewardPool...))
+
+	res, err := suite.queryClient.Sponsorships(suite.ctx, &types.QuerySponsorshipsRequest{ProgramId: pid})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Sponsorships, 2)
+	amounts := map[string]sdk.Coins{}
+	for _, sponsorship := range res.Sponsorships {
+		amounts[sponsorship.Sponsor] = sponsorship.Amount
+	}
+	suite.Require().Equal(coins(1000), amounts[suite.programAddr.String()])
+	suite.Require().Equal(coins(3000), amounts[suite.normalAddr.String()])
+
+	// sponsored funds pay findings like the rest of the pool
+	suite.InitSubmitFinding(pid, fid)
+	suite.InitActivateFinding(fid)
+	finding, err := suite.keeper.Findings.Get(suite.ctx, fid)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(fid, suite.keeper.GetFindingFingerprintHash(&finding), suite.programAddr, coins(401)))
+	suite.Require().NoError(err)
+
+	// the leftover 3599 is refunded pro rata, the rounding remainder goes to the admin
+	_, err = suite.msgServer.CloseProgram(suite.ctx, types.NewMsgCloseProgram(pid, suite.programAddr))
+	suite.Require().NoError(err)
+	suite.Require().Equal(adminBalance.AddAmount(math.NewInt(900)), suite.app.BankKeeper.GetBalance(suite.ctx, suite.programAddr, bondDenom))
+	suite.Require().Equal(sponsorBalance.SubAmount(math.NewInt(3000-2699)), suite.app.BankKeeper.GetBalance(suite.ctx, suite.normalAddr, bondDenom))
+
+	res, err = suite.queryClient.Sponsorships(suite.ctx, &types.QuerySponsorshipsRequest{ProgramId: pid})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Sponsorships)
+}
+
+func (suite *KeeperTestSuite) TestProgramRewardSchedule() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(amount)))
+	}
+
+	// invalid schedules are rejected
+	invalidSchedules := [][]types.SeverityReward{
+		{types.NewSeverityReward(types.Unspecified, coins(1), coins(2))},
+		{types.NewSeverityReward(types.High, coins(1), coins(2)), types.NewSeverityReward(types.High, coins(1), coins(2))},
+		{types.NewSeverityReward(types.High, coins(5), coins(2))},
+		{types.NewSeverityReward(types.High, nil, nil)},
+	}
+	for _, schedule := range invalidSchedules {
+		_, err = suite.msgServer.CreateProgram(suite.ctx, types.NewMsgCreateProgram(uuid.NewString(), "name", "detail", suite.programAddr, nil, schedule, 0, types.DuplicatePolicyUnspecified))
+		suite.Require().Error(err)
+	}
+
+	pid := uuid.NewString()
+	schedule := []types.SeverityReward{
+		types.NewSeverityReward(types.Critical, coins(500), coins(1000)),
+		types.NewSeverityReward(types.Low, coins(10), coins(10)),
+	}
+	_, err = suite.msgServer.CreateProgram(suite.ctx, types.NewMsgCreateProgram(pid, "name", "detail", suite.programAddr, coins(5000), schedule, 0, types.DuplicatePolicyUnspecified))
+	suite.Require().NoError(err)
+	suite.InitActivateProgram(pid)
+
+	// the schedule is published through the program query and its fingerprint
+	res, err := suite.queryClient.Program(suite.ctx, &types.QueryProgramRequest{ProgramId: pid})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Program.RewardSchedule, 2)
+	fingerprint := suite.keeper.GetProgramFingerprintHash(res.Program)
+	_, err = suite.msgServer.EditProgram(suite.ctx, types.NewMsgEditProgram(pid, "", "", suite.bountyAdminAddr, schedule[:1], 0, types.DuplicatePolicyUnspecified))
+	suite.Require().NoError(err)
+	program, err := suite.keeper.Programs.Get(suite.ctx, pid)
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(fingerprint, suite.keeper.GetProgramFingerprintHash(&program))
+
+	testCases := []struct {
+		name    string
+		reward  sdk.Coins
+		expPass bool
+	}{
+		{"below the critical range", coins(499), false},
+		{"above the critical range", coins(1001), false},
+		{"wrong denom", sdk.NewCoins(sdk.NewCoin("foo", math.NewInt(600))), false},
+		{"within the critical range", coins(600), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			fid := uuid.NewString()
+			suite.InitSubmitFinding(pid, fid)
+			suite.InitActivateFinding(fid)
+			finding, err := suite.keeper.Findings.Get(suite.ctx, fid)
+			suite.Require().NoError(err)
+			fingerprint := suite.keeper.GetFindingFingerprintHash(&finding)
+
+			_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(fid, fingerprint, suite.programAddr, tc.reward))
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, types.ErrFindingRewardInvalid)
+			}
+		})
+	}
+
+	// severities missing from the schedule cannot be awarded on chain
+	fid := uuid.NewString()
+	_, err = suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(pid, fid, "", "hash", suite.whiteHatAddr, types.Low, nil))
+	suite.Require().NoError(err)
+	suite.InitActivateFinding(fid)
+	finding, err := suite.keeper.Findings.Get(suite.ctx, fid)
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(fid, suite.keeper.GetFindingFingerprintHash(&finding), suite.programAddr, coins(10)))
+	suite.Require().ErrorIs(err, types.ErrFindingRewardInvalid)
+}
+
 func (suite *KeeperTestSuite) InitCreateProgram(pid string) {
 	msgCreateProgram := &types.MsgCreateProgram{
 		ProgramId:       pid,
@@ -835,6 +1595,18 @@ func (suite *KeeperTestSuite) TestCreateTheorem() {
 			},
 			false,
 		},
+		{
+			"invalid theorem type",
+			&types.MsgCreateTheorem{
+				Title:        "Test Theorem",
+				Description:  "A test theorem description",
+				Code:         "function example() { return true; }",
+				InitialGrant: sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1e6))),
+				Proposer:     suite.programAddr.String(),
+				TheoremType:  types.TheoremType(99),
+			},
+			false,
+		},
 		{
 			"valid request",
 			&types.MsgCreateTheorem{
@@ -844,6 +1616,7 @@ func (suite *KeeperTestSuite) TestCreateTheorem() {
 				InitialGrant:        sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1e6))),
 				Proposer:            suite.programAddr.String(),
 				RequireOpenmathCert: true,
+				TheoremType:         types.TheoremType_THEOREM_TYPE_LEAN,
 			},
 			true,
 		},
@@ -876,6 +1649,7 @@ func (suite *KeeperTestSuite) TestCreateTheorem() {
 			suite.Require().Equal(types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD, theorem.Status)
 			suite.Require().Equal(testCase.req.Proposer, theorem.Proposer)
 			suite.Require().Equal(testCase.req.RequireOpenmathCert, theorem.RequireOpenmathCert)
+			suite.Require().Equal(testCase.req.TheoremType, theorem.TheoremType)
 
 			// Verify grant was created
 			grant, err := suite.keeper.Grants.Get(suite.ctx, collections.Join(resp.TheoremId, sdk.MustAccAddressFromBech32(testCase.req.Proposer)))
@@ -897,6 +1671,56 @@ func (suite *KeeperTestSuite) TestCreateTheorem() {
 	}
 }
 
+// TestCreateTheoremDuplicate tests the rejection of theorems with the code of a theorem in proof period
+func (suite *KeeperTestSuite) TestCreateTheoremDuplicate() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	newMsg := func(code string) *types.MsgCreateTheorem {
+		return &types.MsgCreateTheorem{
+			Title:        "Test Theorem",
+			Description:  "A test theorem description",
+			Code:         code,
+			InitialGrant: sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1e6))),
+			Proposer:     suite.programAddr.String(),
+			TheoremType:  types.TheoremType_THEOREM_TYPE_LEAN,
+		}
+	}
+
+	code := "theorem add_zero (n : Nat) : n + 0 = n := by\n  simp\n"
+	res, err := suite.msgServer.CreateTheorem(suite.ctx, newMsg(code))
+	suite.Require().NoError(err)
+	originalID := res.TheoremId
+
+	// the same code with other line endings, trailing spaces and blank lines is a duplicate
+	_, err = suite.msgServer.CreateTheorem(suite.ctx, newMsg("theorem add_zero (n : Nat) : n + 0 = n := by  \r\n\r\n  simp"))
+	suite.Require().ErrorIs(err, types.ErrTheoremDuplicate)
+	suite.Require().Contains(err.Error(), fmt.Sprintf("theorem %d", originalID))
+
+	// a different indentation is a different theorem
+	_, err = suite.msgServer.CreateTheorem(suite.ctx, newMsg("theorem add_zero (n : Nat) : n + 0 = n := by\nsimp"))
+	suite.Require().NoError(err)
+
+	queryRes, err := suite.queryClient.TheoremByCodeHash(suite.ctx, &types.QueryTheoremByCodeHashRequest{CodeHash: types.TheoremCodeHash(code)})
+	suite.Require().NoError(err)
+	suite.Require().Equal(originalID, queryRes.Theorem.Id)
+
+	// the code can be posted again once the original theorem left its proof period
+	_, err = suite.msgServer.CloseTheorem(suite.ctx, types.NewMsgCloseTheorem(originalID, suite.programAddr.String()))
+	suite.Require().NoError(err)
+	res, err = suite.msgServer.CreateTheorem(suite.ctx, newMsg(code))
+	suite.Require().NoError(err)
+	suite.Require().NotEqual(originalID, res.TheoremId)
+
+	queryRes, err = suite.queryClient.TheoremByCodeHash(suite.ctx, &types.QueryTheoremByCodeHashRequest{CodeHash: strings.ToUpper(types.TheoremCodeHash(code))})
+	suite.Require().NoError(err)
+	suite.Require().Equal(res.TheoremId, queryRes.Theorem.Id)
+
+	_, err = suite.queryClient.TheoremByCodeHash(suite.ctx, &types.QueryTheoremByCodeHashRequest{CodeHash: "invalid"})
+	suite.Require().Error(err)
+	_, err = suite.queryClient.TheoremByCodeHash(suite.ctx, &types.QueryTheoremByCodeHashRequest{CodeHash: types.TheoremCodeHash("unknown")})
+	suite.Require().Error(err)
+}
+
 // TestGrant tests the Grant message handler
 func (suite *KeeperTestSuite) TestGrant() {
 	// Create a theorem first
@@ -1090,10 +1914,11 @@ func (suite *KeeperTestSuite) InitCreateTheoremWithOpenMathRequirement(requireOp
 	createReq := &types.MsgCreateTheorem{
 		Title:               "Test Theorem",
 		Description:         "A test theorem description",
-		Code:                "function test() { return true; }",
+		Code:                "function test() { return true; } // " + uuid.NewString(),
 		InitialGrant:        sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1e6))),
 		Proposer:            suite.programAddr.String(),
 		RequireOpenmathCert: requireOpenMathCert,
+		TheoremType:         types.TheoremType_THEOREM_TYPE_ROCQ,
 	}
 
 	resp, err := suite.msgServer.CreateTheorem(ctx, createReq)
@@ -1209,7 +2034,7 @@ func (suite *KeeperTestSuite) TestSubmitProofHash() {
 
 			// Verify proof-theorem relationship was established
 			hasRelationship, err := suite.keeper.ProofsByTheorem.Has(suite.ctx,
-				collections.Join(testCase.req.TheoremId, testCase.req.ProofHash))
+				collections.Join3(testCase.req.TheoremId, proof.Sequence, testCase.req.ProofHash))
 			suite.Require().NoError(err)
 			suite.Require().True(hasRelationship)
 
@@ -1327,11 +2152,14 @@ func (suite *KeeperTestSuite) TestSubmitProofDetail() {
 				suite.Require().Equal(testCase.req.Detail, proof.Detail)
 				suite.Require().Equal(types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD, proof.Status)
 
-				// Verify proof is no longer in active proofs queue
+				// Verify proof is queued with the verification deadline
+				params, err := suite.keeper.Params.Get(suite.ctx)
+				suite.Require().NoError(err)
+				suite.Require().Equal(suite.ctx.BlockTime().Add(*params.ProofVerificationPeriod), *proof.EndTime)
 				hasInActiveQueue, err := suite.keeper.ActiveProofsQueue.Has(suite.ctx,
 					collections.Join(*proof.EndTime, proof.Id))
 				suite.Require().NoError(err)
-				suite.Require().False(hasInActiveQueue)
+				suite.Require().True(hasInActiveQueue)
 			} else {
 				suite.Require().Error(err)
 
@@ -1354,6 +2182,7 @@ func (suite *KeeperTestSuite) TestSubmitProofDetail_OpenMathCertificateRequired(
 
 	proof := types.NewProof(
 		theoremID,
+		1,
 		proofHash,
 		suite.whiteHatAddr.String(),
 		suite.ctx.BlockHeader().Time,
@@ -1362,9 +2191,9 @@ func (suite *KeeperTestSuite) TestSubmitProofDetail_OpenMathCertificateRequired(
 	)
 	err := suite.keeper.Proofs.Set(suite.ctx, proof.Id, proof)
 	suite.Require().NoError(err)
-	err = suite.keeper.ProofsByTheorem.Set(suite.ctx, collections.Join(theoremID, proof.Id), []byte{})
+	err = suite.keeper.ProofsByTheorem.Set(suite.ctx, collections.Join3(theoremID, proof.Sequence, proof.Id))
 	suite.Require().NoError(err)
-	err = suite.keeper.ActiveProofsQueue.Set(suite.ctx, collections.Join(*proof.EndTime, proof.Id), proof)
+	err = suite.keeper.ActiveProofsQueue.Set(suite.ctx, collections.Join(*proof.EndTime, proof.Id))
 	suite.Require().NoError(err)
 
 	req := &types.MsgSubmitProofDetail{
@@ -1382,6 +2211,104 @@ func (suite *KeeperTestSuite) TestSubmitProofDetail_OpenMathCertificateRequired(
 	suite.Require().NoError(err)
 }
 
+func (suite *KeeperTestSuite) TestSubmitProofDetailChunks() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	prover := suite.whiteHatAddr.String()
+	parts := []string{"This is a ", "valid proof", " detail"}
+
+	testCases := []struct {
+		name       string
+		compressed bool
+	}{
+		{"plain chunks", false},
+		{"zstd compressed chunks", true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			theoremID := suite.InitCreateTheorem()
+			proofID := suite.InitSubmitProofHash(theoremID)
+
+			encoder, err := zstd.NewWriter(nil)
+			suite.Require().NoError(err)
+			defer encoder.Close()
+
+			var hashes []string
+			for _, part := range parts {
+				data := []byte(part)
+				if tc.compressed {
+					data = encoder.EncodeAll(data, nil)
+				}
+				res, err := suite.msgServer.UploadProofChunk(ctx, types.NewMsgUploadProofChunk(proofID, prover, data))
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.ProofChunkHash(data), res.Hash)
+				hashes = append(hashes, res.Hash)
+			}
+
+			// only the prover uploads chunks
+			_, err = suite.msgServer.UploadProofChunk(ctx, types.NewMsgUploadProofChunk(proofID, suite.programAddr.String(), []byte("chunk")))
+			suite.Require().ErrorIs(err, types.ErrProofOperatorNotAllowed)
+			_, err = suite.msgServer.UploadProofChunk(ctx, types.NewMsgUploadProofChunk(proofID, prover, make([]byte, types.MaxProofChunkSize+1)))
+			suite.Require().ErrorIs(err, types.ErrProofChunkInvalid)
+
+			// an unused chunk is pruned on submission
+			unused, err := suite.msgServer.UploadProofChunk(ctx, types.NewMsgUploadProofChunk(proofID, prover, []byte("unused")))
+			suite.Require().NoError(err)
+
+			// every stored chunk adds to the deposit, uploading a chunk again does not
+			_, err = suite.msgServer.UploadProofChunk(ctx, types.NewMsgUploadProofChunk(proofID, prover, []byte("unused")))
+			suite.Require().NoError(err)
+			params, err := suite.keeper.Params.Get(suite.ctx)
+			suite.Require().NoError(err)
+			deposit, err := suite.keeper.Deposits.Get(suite.ctx, collections.Join(proofID, suite.whiteHatAddr))
+			suite.Require().NoError(err)
+			chunkDeposits := sdk.NewCoins(params.ProofChunkDeposit...).MulInt(math.NewInt(int64(len(parts) + 1)))
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(500000))).Add(chunkDeposits...), sdk.NewCoins(deposit.Amount...))
+
+			// the chunks must be uploaded and assemble the hash locked detail
+			_, err = suite.msgServer.SubmitProofDetailChunks(ctx, types.NewMsgSubmitProofDetailChunks(proofID, prover, append([]string{types.ProofChunkHash([]byte("missing"))}, hashes...), tc.compressed))
+			suite.Require().ErrorIs(err, types.ErrProofChunkNotExist)
+			_, err = suite.msgServer.SubmitProofDetailChunks(ctx, types.NewMsgSubmitProofDetailChunks(proofID, prover, []string{hashes[1], hashes[0], hashes[2]}, tc.compressed))
+			suite.Require().Error(err)
+			_, err = suite.msgServer.SubmitProofDetailChunks(ctx, types.NewMsgSubmitProofDetailChunks(proofID, prover, hashes, !tc.compressed))
+			suite.Require().Error(err)
+
+			_, err = suite.msgServer.SubmitProofDetailChunks(ctx, types.NewMsgSubmitProofDetailChunks(proofID, prover, hashes, tc.compressed))
+			suite.Require().NoError(err)
+
+			proof, err := suite.keeper.Proofs.Get(suite.ctx, proofID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD, proof.Status)
+			suite.Require().Empty(proof.Detail)
+			suite.Require().Equal(hashes, proof.DetailChunks)
+			suite.Require().Equal(tc.compressed, proof.DetailCompressed)
+
+			has, err := suite.keeper.ProofChunks.Has(suite.ctx, collections.Join(proofID, unused.Hash))
+			suite.Require().NoError(err)
+			suite.Require().False(has)
+
+			for i, part := range parts {
+				data, hash, err := suite.keeper.GetProofChunk(suite.ctx, proof, i)
+				suite.Require().NoError(err)
+				suite.Require().Equal(hashes[i], hash)
+				suite.Require().Equal(part, string(data))
+			}
+
+			// chunks are no longer accepted once the detail is submitted
+			_, err = suite.msgServer.UploadProofChunk(ctx, types.NewMsgUploadProofChunk(proofID, prover, []byte("late")))
+			suite.Require().ErrorIs(err, types.ErrProofStatusInvalid)
+
+			// chunks are removed with the proof
+			suite.Require().NoError(suite.keeper.DeleteProof(suite.ctx, proofID))
+			has, err = suite.keeper.ProofChunks.Has(suite.ctx, collections.Join(proofID, hashes[0]))
+			suite.Require().NoError(err)
+			suite.Require().False(has)
+		})
+	}
+}
+
 // Helper function to initialize proof hash submission
 func (suite *KeeperTestSuite) InitSubmitProofHash(theoremID uint64) string {
 	ctx := sdk.WrapSDKContext(suite.ctx)
@@ -1564,6 +2491,17 @@ func (suite *KeeperTestSuite) TestSubmitProofVerification() {
 			},
 			false,
 		},
+		{
+			"theorem type mismatch",
+			&types.MsgSubmitProofVerification{
+				ProofId:     validHash,
+				Status:      types.ProofStatus_PROOF_STATUS_PASSED,
+				Checker:     suite.bountyAdminAddr.String(),
+				Complexity:  1,
+				TheoremType: types.TheoremType_THEOREM_TYPE_LEAN,
+			},
+			false,
+		},
 		{
 			"valid verification - passed",
 			&types.MsgSubmitProofVerification{
@@ -1593,139 +2531,549 @@ func (suite *KeeperTestSuite) TestSubmitProofVerification() {
 			proverAddr, _ := sdk.AccAddressFromBech32(proof.Prover)
 			initialProverBalance := suite.app.BankKeeper.GetBalance(suite.ctx, proverAddr, bondDenom)
 
-			// Store the deposit amount for later verification
-			depositAmount := sdk.NewCoins(proof.Deposit...)
-
+			// Store the deposit amount for later verification
+			depositAmount := sdk.NewCoins(proof.Deposit...)
+
+			moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
+			initialModuleBalance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bondDenom)
+
+			_, err = suite.msgServer.SubmitProofVerification(wrappedCtx, testCase.req)
+			suite.Require().NoError(err)
+
+			// Verify proof status was updated
+			proof, err = suite.keeper.Proofs.Get(suite.ctx, testCase.req.ProofId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(testCase.req.Status, proof.Status)
+
+			// Verify reward records were created but balances remain unchanged
+			hasProverReward, err := suite.keeper.Rewards.Has(suite.ctx, proverAddr)
+			suite.Require().NoError(err)
+			suite.Require().True(hasProverReward, "Prover should have received a reward record")
+
+			// Verify checker reward record was created
+			checkerAddr, _ := sdk.AccAddressFromBech32(testCase.req.Checker)
+			hasCheckerReward, err := suite.keeper.Rewards.Has(suite.ctx, checkerAddr)
+			suite.Require().NoError(err)
+			suite.Require().True(hasCheckerReward, "Checker should have received a reward record")
+
+			// Verify deposit was returned to prover
+			finalProverBalance := suite.app.BankKeeper.GetBalance(suite.ctx, proverAddr, bondDenom)
+			expectedProverBalance := initialProverBalance.AddAmount(depositAmount.AmountOf(bondDenom))
+			suite.Require().Equal(expectedProverBalance.Amount.String(), finalProverBalance.Amount.String(),
+				"Prover should have received back the deposit when proof is passed")
+
+			// Verify module account decreased by the deposit amount
+			finalModuleBalance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bondDenom)
+			expectedModuleBalance := initialModuleBalance.SubAmount(depositAmount.AmountOf(bondDenom))
+			suite.Require().Equal(expectedModuleBalance.Amount.String(), finalModuleBalance.Amount.String(),
+				"Module account should have returned the deposit when proof is passed")
+
+			// Verify reward records contain correct amounts
+			proverReward, err := suite.keeper.Rewards.Get(suite.ctx, proverAddr)
+			suite.Require().NoError(err)
+			suite.Require().True(proverReward.Reward.IsValid(), "Prover reward should be valid")
+			suite.Require().False(proverReward.Reward.IsZero(), "Prover reward should not be zero")
+
+			checkerReward, err := suite.keeper.Rewards.Get(suite.ctx, checkerAddr)
+			suite.Require().NoError(err)
+			suite.Require().True(checkerReward.Reward.IsValid(), "Checker reward should be valid")
+			suite.Require().False(checkerReward.Reward.IsZero(), "Checker reward should not be zero")
+		})
+	}
+}
+
+// TestSubmitProofVerificationFailed tests the SubmitProofVerification message handler for failed proofs
+func (suite *KeeperTestSuite) TestSubmitProofVerificationFailed() {
+	testCases := []struct {
+		name          string
+		slashFraction math.LegacyDec
+	}{
+		{"deposit fully forfeited", math.LegacyOneDec()},
+		{"deposit partially forfeited", math.LegacyNewDecWithPrec(4, 1)},
+		{"deposit not forfeited", math.LegacyZeroDec()},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			params, err := suite.keeper.Params.Get(suite.ctx)
+			suite.Require().NoError(err)
+			params.ProofDepositSlashFraction = tc.slashFraction
+			suite.Require().NoError(suite.keeper.Params.Set(suite.ctx, params))
+
+			// Create a theorem, submit a proof hash and detail
+			theoremID := suite.InitCreateTheorem()
+			validHash := suite.InitSubmitProofHash(theoremID)
+			suite.InitSubmitProofDetail(validHash)
+
+			bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			suite.Require().NoError(err)
+
+			wrappedCtx := sdk.WrapSDKContext(suite.ctx)
+
+			proof, err := suite.keeper.Proofs.Get(suite.ctx, validHash)
+			suite.Require().NoError(err)
+
+			// Get initial balances for verification
+			proverAddr, _ := sdk.AccAddressFromBech32(proof.Prover)
+			initialProverBalance := suite.app.BankKeeper.GetBalance(suite.ctx, proverAddr, bondDenom)
+
 			moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
 			initialModuleBalance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bondDenom)
 
-			_, err = suite.msgServer.SubmitProofVerification(wrappedCtx, testCase.req)
+			feePool, err := suite.app.DistrKeeper.FeePool.Get(suite.ctx)
 			suite.Require().NoError(err)
+			initialCommunityPool := feePool.CommunityPool.AmountOf(bondDenom)
 
-			// Verify proof status was updated
-			proof, err = suite.keeper.Proofs.Get(suite.ctx, testCase.req.ProofId)
+			// Verify that the deposit exists in the Deposits collection before verification
+			hasDeposit, err := suite.keeper.Deposits.Has(suite.ctx, collections.Join(validHash, proverAddr))
 			suite.Require().NoError(err)
-			suite.Require().Equal(testCase.req.Status, proof.Status)
+			suite.Require().True(hasDeposit, "Deposit should exist in Deposits collection before verification")
 
-			// Verify reward records were created but balances remain unchanged
-			hasProverReward, err := suite.keeper.Rewards.Has(suite.ctx, proverAddr)
+			// Create verification request for failed proof
+			verifyReq := &types.MsgSubmitProofVerification{
+				ProofId:     validHash,
+				Status:      types.ProofStatus_PROOF_STATUS_FAILED,
+				Checker:     suite.bountyAdminAddr.String(),
+				TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
+			}
+
+			// Submit verification
+			_, err = suite.msgServer.SubmitProofVerification(wrappedCtx, verifyReq)
 			suite.Require().NoError(err)
-			suite.Require().True(hasProverReward, "Prover should have received a reward record")
 
-			// Verify checker reward record was created
-			checkerAddr, _ := sdk.AccAddressFromBech32(testCase.req.Checker)
-			hasCheckerReward, err := suite.keeper.Rewards.Has(suite.ctx, checkerAddr)
+			// Verify proof was deleted
+			_, err = suite.keeper.Proofs.Get(suite.ctx, validHash)
+			suite.Require().Error(err, "Proof should be deleted after failed verification")
+			suite.Require().True(errors.IsOf(err, collections.ErrNotFound), "Expected ErrNotFound for deleted proof")
+
+			// Verify the proof-theorem relationship was removed
+			hasRelationship, err := suite.keeper.ProofsByTheorem.Has(suite.ctx,
+				collections.Join3(theoremID, proof.Sequence, validHash))
 			suite.Require().NoError(err)
-			suite.Require().True(hasCheckerReward, "Checker should have received a reward record")
+			suite.Require().False(hasRelationship, "Proof-theorem relationship should be removed")
 
-			// Verify deposit was returned to prover
-			finalProverBalance := suite.app.BankKeeper.GetBalance(suite.ctx, proverAddr, bondDenom)
-			expectedProverBalance := initialProverBalance.AddAmount(depositAmount.AmountOf(bondDenom))
-			suite.Require().Equal(expectedProverBalance.Amount.String(), finalProverBalance.Amount.String(),
-				"Prover should have received back the deposit when proof is passed")
+			// Verify that the deposit record in Deposits collection is deleted
+			hasDeposit, err = suite.keeper.Deposits.Has(suite.ctx, collections.Join(validHash, proverAddr))
+			suite.Require().NoError(err)
+			suite.Require().False(hasDeposit, "Deposit should be deleted from Deposits collection for failed proof")
+
+			// The slashed part of the deposit is split between the checker, the grant pool and the
+			// community pool, the rest is refunded to the prover
+			deposit := proof.Deposit[0].Amount
+			slashed := math.LegacyNewDecFromInt(deposit).Mul(tc.slashFraction).TruncateInt()
+			refund := deposit.Sub(slashed)
+			checkerShare := slashed.MulRaw(2).QuoRaw(10)
+			grantShare := slashed.MulRaw(5).QuoRaw(10)
+			communityPoolShare := slashed.Sub(checkerShare).Sub(grantShare)
+
+			// Verify only the unslashed part of the deposit is returned to the prover
+			proverBalance := suite.app.BankKeeper.GetBalance(suite.ctx, proverAddr, bondDenom)
+			suite.Require().Equal(initialProverBalance.Amount.Add(refund).String(), proverBalance.Amount.String(),
+				"Prover balance should only increase by the refund for failed proof")
+
+			// Verify module account balance decreased by the refund and the community pool share
+			moduleFinalBalance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bondDenom)
+			suite.Require().Equal(initialModuleBalance.Amount.Sub(refund).Sub(communityPoolShare).String(), moduleFinalBalance.Amount.String(),
+				"Module account balance should decrease by the refund and the community pool share for failed proof")
 
-			// Verify module account decreased by the deposit amount
-			finalModuleBalance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bondDenom)
-			expectedModuleBalance := initialModuleBalance.SubAmount(depositAmount.AmountOf(bondDenom))
-			suite.Require().Equal(expectedModuleBalance.Amount.String(), finalModuleBalance.Amount.String(),
-				"Module account should have returned the deposit when proof is passed")
+			// Verify the community pool received its share
+			feePool, err = suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+			suite.Require().NoError(err)
+			suite.Require().Equal(initialCommunityPool.Add(math.LegacyNewDecFromInt(communityPoolShare)), feePool.CommunityPool.AmountOf(bondDenom))
 
-			// Verify reward records contain correct amounts
-			proverReward, err := suite.keeper.Rewards.Get(suite.ctx, proverAddr)
+			// Verify no prover reward record was created
+			hasProverReward, err := suite.keeper.Rewards.Has(suite.ctx, proverAddr)
 			suite.Require().NoError(err)
-			suite.Require().True(proverReward.Reward.IsValid(), "Prover reward should be valid")
-			suite.Require().False(proverReward.Reward.IsZero(), "Prover reward should not be zero")
+			suite.Require().False(hasProverReward, "Prover should not have received a reward record for failed proof")
 
-			checkerReward, err := suite.keeper.Rewards.Get(suite.ctx, checkerAddr)
+			// Verify checker only received its share of the forfeited deposit
+			checkerAddr, _ := sdk.AccAddressFromBech32(verifyReq.Checker)
+			if checkerShare.IsZero() {
+				hasCheckerReward, err := suite.keeper.Rewards.Has(suite.ctx, checkerAddr)
+				suite.Require().NoError(err)
+				suite.Require().False(hasCheckerReward, "Checker should not have received a reward record for failed proof")
+			} else {
+				checkerReward, err := suite.keeper.Rewards.Get(suite.ctx, checkerAddr)
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, checkerShare)), checkerReward.Reward)
+			}
+
+			// Verify theorem status was not changed and its grant pool got its share
+			theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
 			suite.Require().NoError(err)
-			suite.Require().True(checkerReward.Reward.IsValid(), "Checker reward should be valid")
-			suite.Require().False(checkerReward.Reward.IsZero(), "Checker reward should not be zero")
+			suite.Require().Equal(types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD, theorem.Status,
+				"Theorem status should not change for failed proof")
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, grantShare)), sdk.NewCoins(theorem.ForfeitedDeposits...))
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1e6).Add(grantShare))), sdk.NewCoins(theorem.TotalGrant...))
 		})
 	}
 }
 
-// TestSubmitProofVerificationFailed tests the SubmitProofVerification message handler for failed proofs
-func (suite *KeeperTestSuite) TestSubmitProofVerificationFailed() {
-	// Create a theorem, submit a proof hash and detail
+// TestWithdrawGrant tests the withdrawal of a grant from a theorem
+func (suite *KeeperTestSuite) TestWithdrawGrant() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
 	theoremID := suite.InitCreateTheorem()
-	validHash := suite.InitSubmitProofHash(theoremID)
-	suite.InitSubmitProofDetail(validHash)
+	grant := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000)))
+	_, err = suite.msgServer.Grant(suite.ctx, types.NewMsgGrant(theoremID, suite.normalAddr.String(), grant))
+	suite.Require().NoError(err)
+	balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.normalAddr, bondDenom)
+
+	// the grant is refunded minus the withdrawal penalty
+	res, err := suite.msgServer.WithdrawGrant(suite.ctx, types.NewMsgWithdrawGrant(theoremID, suite.normalAddr.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(900))), sdk.NewCoins(res.Refunded...))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100))), sdk.NewCoins(res.Penalty...))
+	balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, suite.normalAddr, bondDenom)
+	suite.Require().Equal(balanceBefore.Amount.AddRaw(900), balanceAfter.Amount)
+
+	theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1e6))), sdk.NewCoins(theorem.TotalGrant...))
+
+	// the grant cannot be withdrawn twice
+	_, err = suite.msgServer.WithdrawGrant(suite.ctx, types.NewMsgWithdrawGrant(theoremID, suite.normalAddr.String()))
+	suite.Require().ErrorIs(err, types.ErrGrantNotExist)
+
+	// grants are locked while a proof is in progress
+	suite.InitSubmitProofHash(theoremID)
+	_, err = suite.msgServer.WithdrawGrant(suite.ctx, types.NewMsgWithdrawGrant(theoremID, suite.programAddr.String()))
+	suite.Require().ErrorIs(err, types.ErrTheoremProofInProgress)
+}
 
+// TestWithdrawGrantRemainingPool tests the grant pool left by the withdrawal of a grant
+func (suite *KeeperTestSuite) TestWithdrawGrantRemainingPool() {
 	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
 	suite.Require().NoError(err)
+	theoremID := suite.InitCreateTheorem()
+	grant := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000)))
+	_, err = suite.msgServer.Grant(suite.ctx, types.NewMsgGrant(theoremID, suite.normalAddr.String(), grant))
+	suite.Require().NoError(err)
 
-	wrappedCtx := sdk.WrapSDKContext(suite.ctx)
+	// a withdrawal leaving less than the min grant in the pool is rejected
+	params, err := suite.keeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.MinGrant = sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(2000)))
+	suite.Require().NoError(suite.keeper.Params.Set(suite.ctx, params))
+	_, err = suite.msgServer.WithdrawGrant(suite.ctx, types.NewMsgWithdrawGrant(theoremID, suite.programAddr.String()))
+	suite.Require().ErrorIs(err, types.ErrMinGrantTooSmall)
+	theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1e6+1000))), sdk.NewCoins(theorem.TotalGrant...))
 
-	// Reset proof status to HASH_DETAIL_PERIOD
-	proof, err := suite.keeper.Proofs.Get(suite.ctx, validHash)
+	_, err = suite.msgServer.WithdrawGrant(suite.ctx, types.NewMsgWithdrawGrant(theoremID, suite.normalAddr.String()))
 	suite.Require().NoError(err)
 
-	// Get initial balances for verification
-	proverAddr, _ := sdk.AccAddressFromBech32(proof.Prover)
-	initialProverBalance := suite.app.BankKeeper.GetBalance(suite.ctx, proverAddr, bondDenom)
+	// the withdrawal of the last grant closes the theorem
+	suite.ctx = suite.ctx.WithEventManager(sdk.NewEventManager())
+	_, err = suite.msgServer.WithdrawGrant(suite.ctx, types.NewMsgWithdrawGrant(theoremID, suite.programAddr.String()))
+	suite.Require().NoError(err)
+	theorem, err = suite.keeper.Theorems.Get(suite.ctx, theoremID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.TheoremStatus_THEOREM_STATUS_CLOSED, theorem.Status)
+	suite.Require().True(sdk.NewCoins(theorem.TotalGrant...).IsZero())
+	has, err := suite.keeper.ActiveTheoremsQueue.Has(suite.ctx, collections.Join(*theorem.EndTime, theoremID))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+	events := suite.ctx.EventManager().Events()
+	suite.Require().Equal(types.EventTypeCloseTheorem, events[len(events)-1].Type)
 
-	moduleAddr := suite.app.AccountKeeper.GetModuleAddress(types.ModuleName)
-	initialModuleBalance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bondDenom)
+	_, err = suite.msgServer.SubmitProofHash(suite.ctx, &types.MsgSubmitProofHash{
+		TheoremId: theoremID,
+		Prover:    suite.whiteHatAddr.String(),
+		ProofHash: suite.keeper.GetProofHash(theoremID, suite.whiteHatAddr.String(), "This is a valid proof detail"),
+		Deposit:   sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(500000))),
+	})
+	suite.Require().Error(err)
+}
 
-	// Verify that the deposit exists in the Deposits collection before verification
-	hasDeposit, err := suite.keeper.Deposits.Has(suite.ctx, collections.Join(validHash, proverAddr))
+// TestGrantFromCommunityPool tests the governance grants funded by the community pool
+func (suite *KeeperTestSuite) TestGrantFromCommunityPool() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
 	suite.Require().NoError(err)
-	suite.Require().True(hasDeposit, "Deposit should exist in Deposits collection before verification")
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName)
+	communityPool := func() math.Int {
+		feePool, err := suite.app.DistrKeeper.FeePool.Get(suite.ctx)
+		suite.Require().NoError(err)
+		return feePool.CommunityPool.AmountOf(bondDenom).TruncateInt()
+	}
 
-	// Create verification request for failed proof
-	verifyReq := &types.MsgSubmitProofVerification{
-		ProofId:     validHash,
-		Status:      types.ProofStatus_PROOF_STATUS_FAILED,
-		Checker:     suite.bountyAdminAddr.String(),
-		TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
+	theoremID := suite.InitCreateTheorem()
+	grant := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000)))
+	suite.Require().NoError(suite.app.DistrKeeper.FundCommunityPool(suite.ctx, grant, suite.normalAddr))
+	poolBefore := communityPool()
+
+	// only the authority can grant from the community pool
+	_, err = suite.msgServer.GrantFromCommunityPool(suite.ctx, types.NewMsgGrantFromCommunityPool(suite.normalAddr.String(), theoremID, grant))
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the grant cannot exceed the community pool
+	_, err = suite.msgServer.GrantFromCommunityPool(suite.ctx, types.NewMsgGrantFromCommunityPool(authority.String(), theoremID, grant.MulInt(poolBefore)))
+	suite.Require().Error(err)
+
+	_, err = suite.msgServer.GrantFromCommunityPool(suite.ctx, types.NewMsgGrantFromCommunityPool(authority.String(), theoremID, grant))
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolBefore.SubRaw(1000).String(), communityPool().String())
+
+	recorded, err := suite.keeper.Grants.Get(suite.ctx, collections.Join(theoremID, authority))
+	suite.Require().NoError(err)
+	suite.Require().Equal(authority.String(), recorded.Grantor)
+	suite.Require().Equal(types.GrantSource_GRANT_SOURCE_COMMUNITY_POOL, recorded.Source)
+	suite.Require().Equal(grant, sdk.NewCoins(recorded.Amount...))
+	theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1e6+1000))), sdk.NewCoins(theorem.TotalGrant...))
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, authority).IsZero())
+
+	// a grant from the authority account cannot be merged into the community pool grant
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, suite.normalAddr, authority, grant))
+	err = suite.keeper.AddGrant(suite.ctx, theoremID, authority, grant)
+	suite.Require().ErrorIs(err, types.ErrGrantSourceMismatch)
+	suite.Require().NoError(suite.app.BankKeeper.SendCoins(suite.ctx, authority, suite.normalAddr, grant))
+
+	// the grant is refunded to the community pool when the theorem is closed
+	_, err = suite.msgServer.CloseTheorem(suite.ctx, types.NewMsgCloseTheorem(theoremID, suite.programAddr.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(poolBefore.String(), communityPool().String())
+	suite.Require().True(suite.app.BankKeeper.GetAllBalances(suite.ctx, authority).IsZero())
+}
+
+// TestGrantExtendsTheorem tests the extension of the proof period of a theorem by large grants
+func (suite *KeeperTestSuite) TestGrantExtendsTheorem() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	theoremID := suite.InitCreateTheorem()
+	theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+	suite.Require().NoError(err)
+	submitTime, initialEndTime := *theorem.SubmitTime, *theorem.EndTime
+
+	endTimeAfterGrant := func(amount int64) time.Time {
+		grant := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(amount)))
+		_, err := suite.msgServer.Grant(suite.ctx, types.NewMsgGrant(theoremID, suite.normalAddr.String(), grant))
+		suite.Require().NoError(err)
+		theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+		suite.Require().NoError(err)
+		has, err := suite.keeper.ActiveTheoremsQueue.Has(suite.ctx, collections.Join(*theorem.EndTime, theoremID))
+		suite.Require().NoError(err)
+		suite.Require().True(has)
+		return *theorem.EndTime
 	}
 
-	// Submit verification
-	_, err = suite.msgServer.SubmitProofVerification(wrappedCtx, verifyReq)
+	// a small grant does not extend the theorem
+	suite.ctx = suite.ctx.WithBlockTime(submitTime.Add(10 * 24 * time.Hour))
+	suite.Require().Equal(initialEndTime, endTimeAfterGrant(1000))
+
+	// a large grant restarts a full proof period
+	extendedEndTime := endTimeAfterGrant(100000)
+	suite.Require().Equal(submitTime.Add(24*24*time.Hour), extendedEndTime)
+	has, err := suite.keeper.ActiveTheoremsQueue.Has(suite.ctx, collections.Join(initialEndTime, theoremID))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	// the extension is capped by the theorem max total period
+	suite.ctx = suite.ctx.WithBlockTime(submitTime.Add(20 * 24 * time.Hour))
+	suite.Require().Equal(submitTime.Add(28*24*time.Hour), endTimeAfterGrant(100000))
+	suite.Require().Equal(submitTime.Add(28*24*time.Hour), endTimeAfterGrant(100000))
+}
+
+// TestCloseTheorem tests the closure of a theorem by its proposer
+func (suite *KeeperTestSuite) TestCloseTheorem() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	theoremID := suite.InitCreateTheorem()
+	grant := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000)))
+	_, err = suite.msgServer.Grant(suite.ctx, types.NewMsgGrant(theoremID, suite.normalAddr.String(), grant))
+	suite.Require().NoError(err)
+	proposerBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.programAddr, bondDenom)
+	grantorBalance := suite.app.BankKeeper.GetBalance(suite.ctx, suite.normalAddr, bondDenom)
+
+	// only the proposer can close the theorem
+	_, err = suite.msgServer.CloseTheorem(suite.ctx, types.NewMsgCloseTheorem(theoremID, suite.normalAddr.String()))
+	suite.Require().ErrorIs(err, types.ErrTheoremOperatorNotAllowed)
+
+	_, err = suite.msgServer.CloseTheorem(suite.ctx, types.NewMsgCloseTheorem(theoremID, suite.programAddr.String()))
+	suite.Require().NoError(err)
+
+	theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
 	suite.Require().NoError(err)
+	suite.Require().Equal(types.TheoremStatus_THEOREM_STATUS_CLOSED, theorem.Status)
+	has, err := suite.keeper.ActiveTheoremsQueue.Has(suite.ctx, collections.Join(*theorem.EndTime, theoremID))
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+
+	// every grant is refunded in full
+	suite.Require().Equal(proposerBalance.Amount.Add(math.NewInt(1e6)), suite.app.BankKeeper.GetBalance(suite.ctx, suite.programAddr, bondDenom).Amount)
+	suite.Require().Equal(grantorBalance.Amount.Add(math.NewInt(1000)), suite.app.BankKeeper.GetBalance(suite.ctx, suite.normalAddr, bondDenom).Amount)
+
+	// the closed theorem no longer accepts proofs
+	_, err = suite.msgServer.SubmitProofHash(suite.ctx, &types.MsgSubmitProofHash{
+		TheoremId: theoremID,
+		Prover:    suite.whiteHatAddr.String(),
+		ProofHash: suite.app.BountyKeeper.GetProofHash(theoremID, suite.whiteHatAddr.String(), "late proof"),
+		Deposit:   sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(500000))),
+	})
+	suite.Require().ErrorIs(err, types.ErrTheoremProofStatusInvalid)
+}
+
+// TestConcurrentProofs tests that several proofs of a theorem can be hash locked at the same time
+// and are verified in the order of submission
+func (suite *KeeperTestSuite) TestConcurrentProofs() {
+	testCases := []struct {
+		name        string
+		sameBlock   bool
+		revealFirst bool
+	}{
+		{"both proofs revealed", false, true},
+		{"earlier proof unrevealed", false, false},
+		{"proofs submitted in the same block", true, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			theoremID := suite.InitCreateTheorem()
+			bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			suite.Require().NoError(err)
+			deposit := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(500000)))
+
+			firstHash := suite.InitSubmitProofHash(theoremID)
+
+			// a second prover locks another proof of the same theorem, with a hash ordered before
+			// the first one so that the order of submission is not the order of the hashes
+			if !tc.sameBlock {
+				suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Minute))
+			}
+			var secondDetail, secondHash string
+			for i := 0; secondHash == "" || secondHash > firstHash; i++ {
+				secondDetail = fmt.Sprintf("Another valid proof detail %d", i)
+				secondHash = suite.app.BountyKeeper.GetProofHash(theoremID, suite.normalAddr.String(), secondDetail)
+			}
+			_, err = suite.msgServer.SubmitProofHash(suite.ctx, &types.MsgSubmitProofHash{
+				TheoremId: theoremID,
+				Prover:    suite.normalAddr.String(),
+				ProofHash: secondHash,
+				Deposit:   deposit,
+			})
+			suite.Require().NoError(err)
+			_, err = suite.msgServer.SubmitProofDetail(suite.ctx, &types.MsgSubmitProofDetail{
+				ProofId: secondHash,
+				Prover:  suite.normalAddr.String(),
+				Detail:  secondDetail,
+			})
+			suite.Require().NoError(err)
+			if tc.revealFirst {
+				suite.InitSubmitProofDetail(firstHash)
+			}
+
+			// the proofs are numbered and listed in the order of submission
+			res, err := suite.queryClient.Proofs(suite.ctx, &types.QueryProofsRequest{TheoremId: theoremID})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Proofs, 2)
+			suite.Require().Equal(firstHash, res.Proofs[0].Id)
+			suite.Require().Equal(uint64(1), res.Proofs[0].Sequence)
+			suite.Require().Equal(secondHash, res.Proofs[1].Id)
+			suite.Require().Equal(uint64(2), res.Proofs[1].Sequence)
+
+			// an unrevealed proof does not hold back the verification of the later revealed proofs
+			winner, superseded, supersededProver := secondHash, firstHash, suite.whiteHatAddr
+			if tc.revealFirst {
+				// the later proof cannot be verified before the earlier revealed one
+				_, err = suite.msgServer.SubmitProofVerification(suite.ctx, &types.MsgSubmitProofVerification{
+					ProofId:     secondHash,
+					Status:      types.ProofStatus_PROOF_STATUS_PASSED,
+					Checker:     suite.bountyAdminAddr.String(),
+					Complexity:  1,
+					TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
+				})
+				suite.Require().ErrorIs(err, types.ErrProofOutOfOrder)
+				winner, superseded, supersededProver = firstHash, secondHash, suite.normalAddr
+			}
+
+			// the first passing proof wins and the other proof is refunded
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, supersededProver, bondDenom)
+			suite.InitVerifyProof(winner, types.ProofStatus_PROOF_STATUS_PASSED)
 
-	// Verify proof was deleted
-	_, err = suite.keeper.Proofs.Get(suite.ctx, validHash)
-	suite.Require().Error(err, "Proof should be deleted after failed verification")
-	suite.Require().True(errors.IsOf(err, collections.ErrNotFound), "Expected ErrNotFound for deleted proof")
+			theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.TheoremStatus_THEOREM_STATUS_PASSED, theorem.Status)
+
+			_, err = suite.keeper.Proofs.Get(suite.ctx, superseded)
+			suite.Require().ErrorIs(err, collections.ErrNotFound)
+			balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, supersededProver, bondDenom)
+			suite.Require().Equal(balanceBefore.Add(deposit[0]), balanceAfter)
+		})
+	}
+}
+
+// TestProofVerificationQuorum tests that a proof is decided once enough checkers agree on it
+func (suite *KeeperTestSuite) TestProofVerificationQuorum() {
+	params, err := suite.keeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.ProofVerificationQuorum = 2
+	suite.Require().NoError(suite.keeper.Params.Set(suite.ctx, params))
+	suite.issueBountyAdminCertificate(suite.normalAddr)
+	suite.issueBountyAdminCertificate(suite.programAddr)
 
-	// Verify the proof-theorem relationship was removed
-	hasRelationship, err := suite.keeper.ProofsByTheorem.Has(suite.ctx,
-		collections.Join(theoremID, validHash))
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
 	suite.Require().NoError(err)
-	suite.Require().False(hasRelationship, "Proof-theorem relationship should be removed")
+	theoremID := suite.InitCreateTheorem()
+	proofID := suite.InitSubmitProofHash(theoremID)
+	suite.InitSubmitProofDetail(proofID)
+
+	verify := func(checker sdk.AccAddress, status types.ProofStatus, complexity int64) error {
+		_, err := suite.msgServer.SubmitProofVerification(suite.ctx, &types.MsgSubmitProofVerification{
+			ProofId:     proofID,
+			Status:      status,
+			Checker:     checker.String(),
+			Complexity:  complexity,
+			TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
+		})
+		return err
+	}
 
-	// Verify that the deposit record in Deposits collection is deleted
-	hasDeposit, err = suite.keeper.Deposits.Has(suite.ctx, collections.Join(validHash, proverAddr))
+	// a single passing verdict does not decide the proof
+	suite.Require().NoError(verify(suite.bountyAdminAddr, types.ProofStatus_PROOF_STATUS_PASSED, 10))
+	proof, err := suite.keeper.Proofs.Get(suite.ctx, proofID)
 	suite.Require().NoError(err)
-	suite.Require().False(hasDeposit, "Deposit should be deleted from Deposits collection for failed proof")
+	suite.Require().Equal(types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD, proof.Status)
 
-	// Verify deposit is deleted (not returned to prover)
-	proverBalance := suite.app.BankKeeper.GetBalance(suite.ctx, proverAddr, bondDenom)
-	suite.Require().Equal(initialProverBalance.Amount.String(), proverBalance.Amount.String(),
-		"Prover balance should remain unchanged for failed proof")
+	// a checker cannot vote twice
+	err = verify(suite.bountyAdminAddr, types.ProofStatus_PROOF_STATUS_PASSED, 10)
+	suite.Require().ErrorIs(err, types.ErrProofVerdictInvalid)
 
-	// Verify module account balance decreased by the deposit amount
-	moduleFinalBalance := suite.app.BankKeeper.GetBalance(suite.ctx, moduleAddr, bondDenom)
-	suite.Require().Equal(initialModuleBalance.Amount.String(), moduleFinalBalance.Amount.String(),
-		"Module account balance should remain unchanged for failed proof")
+	// a dissenting verdict does not count towards the quorum
+	suite.Require().NoError(verify(suite.normalAddr, types.ProofStatus_PROOF_STATUS_FAILED, 0))
+	proof, err = suite.keeper.Proofs.Get(suite.ctx, proofID)
+	suite.Require().NoError(err)
+	suite.Require().Equal(types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD, proof.Status)
 
-	// Verify no reward records were created
-	hasProverReward, err := suite.keeper.Rewards.Has(suite.ctx, proverAddr)
+	// the second passing verdict reaches the quorum
+	suite.Require().NoError(verify(suite.programAddr, types.ProofStatus_PROOF_STATUS_PASSED, 20))
+	proof, err = suite.keeper.Proofs.Get(suite.ctx, proofID)
 	suite.Require().NoError(err)
-	suite.Require().False(hasProverReward, "Prover should not have received a reward record for failed proof")
+	suite.Require().Equal(types.ProofStatus_PROOF_STATUS_PASSED, proof.Status)
 
-	// Verify checker did not receive a reward
-	checkerAddr, _ := sdk.AccAddressFromBech32(verifyReq.Checker)
-	hasCheckerReward, err := suite.keeper.Rewards.Has(suite.ctx, checkerAddr)
+	res, err := suite.queryClient.Proof(suite.ctx, &types.QueryProofRequest{ProofId: proofID})
 	suite.Require().NoError(err)
-	suite.Require().False(hasCheckerReward, "Checker should not have received a reward record for failed proof")
+	suite.Require().Len(res.Verdicts, 3)
 
-	// Verify theorem status was not changed
+	// the theorem gets the median complexity of the agreeing checkers
 	theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
 	suite.Require().NoError(err)
-	suite.Require().Equal(types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD, theorem.Status,
-		"Theorem status should not change for failed proof")
+	suite.Require().Equal(types.TheoremStatus_THEOREM_STATUS_PASSED, theorem.Status)
+	suite.Require().Equal(int64(15), theorem.Complexity)
+
+	// the checker reward is split among the agreeing checkers
+	share := sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.NewInt(10000*15/2)))
+	for _, checker := range []sdk.AccAddress{suite.bountyAdminAddr, suite.programAddr} {
+		reward, err := suite.keeper.Rewards.Get(suite.ctx, checker)
+		suite.Require().NoError(err)
+		suite.Require().Equal(share, reward.Reward)
+	}
+	_, err = suite.keeper.Rewards.Get(suite.ctx, suite.normalAddr)
+	suite.Require().ErrorIs(err, collections.ErrNotFound)
 }
 
 // TestWithdrawReward tests the WithdrawReward message handler
@@ -1864,3 +3212,345 @@ func (suite *KeeperTestSuite) TestWithdrawReward() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestWithdrawRewardVesting() {
+	ctx := sdk.WrapSDKContext(suite.ctx)
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+
+	params, err := suite.keeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.RewardVestingThreshold = sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000000)))
+	vestingPeriod := 100 * time.Hour
+	params.RewardVestingPeriod = &vestingPeriod
+	suite.Require().NoError(suite.keeper.Params.Set(suite.ctx, params))
+
+	addr := suite.normalAddr
+	setReward := func(amount int64) {
+		err := suite.keeper.Rewards.Set(suite.ctx, addr, types.Reward{
+			Address: addr.String(),
+			Reward:  sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.NewInt(amount))),
+		})
+		suite.Require().NoError(err)
+		err = suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(amount))))
+		suite.Require().NoError(err)
+	}
+	balance := func() math.Int {
+		return suite.app.BankKeeper.GetBalance(suite.ctx, addr, bondDenom).Amount
+	}
+
+	// the reward above the threshold vests
+	setReward(5000000)
+	initialBalance := balance()
+	res, err := suite.msgServer.WithdrawReward(ctx, types.NewMsgWithdrawReward(addr.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000000))), res.Amount)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(4000000))), res.Vesting)
+	suite.Require().Equal(initialBalance.Add(math.NewInt(1000000)), balance())
+
+	vesting, err := suite.keeper.RewardVestings.Get(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Len(vesting.Schedules, 1)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(4000000))), vesting.Schedules[0].Total)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(vestingPeriod), vesting.Schedules[0].EndTime)
+
+	// nothing is vested yet
+	_, err = suite.msgServer.WithdrawReward(ctx, types.NewMsgWithdrawReward(addr.String()))
+	suite.Require().Error(err)
+
+	// a quarter of the period releases a quarter of the vesting rewards
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(vestingPeriod / 4))
+	ctx = sdk.WrapSDKContext(suite.ctx)
+	res, err = suite.msgServer.WithdrawReward(ctx, types.NewMsgWithdrawReward(addr.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000000))), res.Amount)
+	suite.Require().True(res.Vesting.IsZero())
+	suite.Require().Equal(initialBalance.Add(math.NewInt(2000000)), balance())
+
+	rewards, err := suite.queryClient.AllRewards(ctx, &types.QueryRewardsRequest{Address: addr.String()})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(rewards.Vesting)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000000))), rewards.Vesting.Schedules[0].Released)
+
+	// a new reward above the threshold vests on its own schedule, the first one is unchanged
+	setReward(3000000)
+	_, err = suite.msgServer.WithdrawReward(ctx, types.NewMsgWithdrawReward(addr.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(initialBalance.Add(math.NewInt(3000000)), balance())
+
+	firstEnd := vesting.Schedules[0].EndTime
+	vesting, err = suite.keeper.RewardVestings.Get(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Len(vesting.Schedules, 2)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(4000000))), vesting.Schedules[0].Total)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000000))), vesting.Schedules[0].Released)
+	suite.Require().Equal(firstEnd, vesting.Schedules[0].EndTime)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(2000000))), vesting.Schedules[1].Total)
+	suite.Require().True(vesting.Schedules[1].Released.IsZero())
+	suite.Require().Equal(suite.ctx.BlockTime().Add(vestingPeriod), vesting.Schedules[1].EndTime)
+
+	// the end of the first schedule releases its rest and three quarters of the second one
+	suite.ctx = suite.ctx.WithBlockTime(firstEnd)
+	ctx = sdk.WrapSDKContext(suite.ctx)
+	res, err = suite.msgServer.WithdrawReward(ctx, types.NewMsgWithdrawReward(addr.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(4500000))), res.Amount)
+	suite.Require().Equal(initialBalance.Add(math.NewInt(7500000)), balance())
+
+	vesting, err = suite.keeper.RewardVestings.Get(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Len(vesting.Schedules, 1)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1500000))), vesting.Schedules[0].Released)
+
+	// the vesting is removed once all schedules are fully released
+	suite.ctx = suite.ctx.WithBlockTime(vesting.Schedules[0].EndTime)
+	ctx = sdk.WrapSDKContext(suite.ctx)
+	res, err = suite.msgServer.WithdrawReward(ctx, types.NewMsgWithdrawReward(addr.String()))
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(500000))), res.Amount)
+	suite.Require().Equal(initialBalance.Add(math.NewInt(8000000)), balance())
+
+	has, err := suite.keeper.RewardVestings.Has(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().False(has)
+}
+
+func (suite *KeeperTestSuite) TestRewardVestingSchedules() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(amount)))
+	}
+
+	params, err := suite.keeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	params.RewardVestingThreshold = coins(1000)
+	vestingPeriod := 100 * time.Hour
+	params.RewardVestingPeriod = &vestingPeriod
+	suite.Require().NoError(suite.keeper.Params.Set(suite.ctx, params))
+
+	addr := suite.normalAddr
+	pay := func(amount int64) {
+		suite.Require().NoError(suite.app.BankKeeper.MintCoins(suite.ctx, types.ModuleName, coins(amount)))
+		_, _, err := suite.keeper.PayReward(suite.ctx, addr, coins(amount))
+		suite.Require().NoError(err)
+	}
+
+	// payouts ending at the same time share a schedule
+	pay(5000)
+	pay(3000)
+	vesting, err := suite.keeper.RewardVestings.Get(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Len(vesting.Schedules, 1)
+	suite.Require().Equal(coins(6000), vesting.Schedules[0].Total)
+
+	for i := 1; i < types.MaxRewardVestingSchedules; i++ {
+		suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(time.Hour))
+		pay(2000)
+	}
+	vesting, err = suite.keeper.RewardVestings.Get(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Len(vesting.Schedules, types.MaxRewardVestingSchedules)
+	last := vesting.Schedules[types.MaxRewardVestingSchedules-1]
+
+	// at the cap the payout is merged into the schedule ending last, after releasing its vested part
+	suite.ctx = suite.ctx.WithBlockTime(suite.ctx.BlockTime().Add(vestingPeriod / 4))
+	balance := suite.app.BankKeeper.GetBalance(suite.ctx, addr, bondDenom)
+	pay(3000)
+	vesting, err = suite.keeper.RewardVestings.Get(suite.ctx, addr)
+	suite.Require().NoError(err)
+	suite.Require().Len(vesting.Schedules, types.MaxRewardVestingSchedules)
+	suite.Require().NoError(types.ValidateRewardVesting(&vesting))
+
+	merged := vesting.Schedules[types.MaxRewardVestingSchedules-1]
+	suite.Require().Equal(coins(750+2000), merged.Total)
+	suite.Require().True(merged.Released.IsZero())
+	suite.Require().Equal(suite.ctx.BlockTime(), merged.StartTime)
+	suite.Require().Equal(suite.ctx.BlockTime().Add(vestingPeriod), merged.EndTime)
+	suite.Require().True(merged.EndTime.After(last.EndTime))
+	suite.Require().Equal(balance.AddAmount(math.NewInt(1000+250)), suite.app.BankKeeper.GetBalance(suite.ctx, addr, bondDenom))
+}
+
+func (suite *KeeperTestSuite) TestProgramScope() {
+	pid := uuid.NewString()
+	scope := []types.ScopeTarget{
+		{TargetId: "vault", AssetType: types.AssetTypeContract, Location: "shentu1vault", ChainId: "shentu-2.2", InScope: true, MaxSeverity: types.High},
+		{TargetId: "docs", AssetType: types.AssetTypeDomain, Location: "docs.example.com", InScope: false},
+	}
+
+	// an invalid scope is rejected
+	msg := types.NewMsgCreateProgram(pid, "name", "detail", suite.programAddr, nil, nil, 0, types.DuplicatePolicyUnspecified)
+	msg.Scope = []types.ScopeTarget{{TargetId: "vault", AssetType: types.AssetTypeContract, Location: "shentu1vault", InScope: true}}
+	_, err := suite.msgServer.CreateProgram(suite.ctx, msg)
+	suite.Require().ErrorIs(err, types.ErrProgramScopeInvalid)
+
+	msg.Scope = scope
+	_, err = suite.msgServer.CreateProgram(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.InitActivateProgram(pid)
+
+	testCases := []struct {
+		name     string
+		targetID string
+		level    types.SeverityLevel
+		expErr   error
+	}{
+		{"missing target", "", types.High, types.ErrFindingTargetInvalid},
+		{"unknown target", "bridge", types.High, types.ErrFindingTargetInvalid},
+		{"out of scope target", "docs", types.High, types.ErrFindingTargetInvalid},
+		{"severity above the target cap", "vault", types.Critical, types.ErrFindingSeverityLevelInvalid},
+	}
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			_, err := suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(pid, uuid.NewString(), tc.targetID, "hash", suite.whiteHatAddr, tc.level, nil))
+			suite.Require().ErrorIs(err, tc.expErr)
+		})
+	}
+
+	fid := uuid.NewString()
+	_, err = suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(pid, fid, "vault", "hash", suite.whiteHatAddr, types.High, nil))
+	suite.Require().NoError(err)
+
+	// findings are queryable by target
+	res, err := suite.queryClient.Findings(suite.ctx, &types.QueryFindingsRequest{ProgramId: pid, TargetId: "vault"})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Findings, 1)
+	suite.Require().Equal(fid, res.Findings[0].FindingId)
+	res, err = suite.queryClient.Findings(suite.ctx, &types.QueryFindingsRequest{ProgramId: pid, TargetId: "docs"})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Findings)
+	_, err = suite.queryClient.Findings(suite.ctx, &types.QueryFindingsRequest{TargetId: "vault"})
+	suite.Require().Error(err)
+
+	// the severity cap also applies when editing the finding
+	_, err = suite.msgServer.EditFinding(suite.ctx, types.NewMsgEditFinding(fid, "hash", "", suite.whiteHatAddr, types.Critical, nil))
+	suite.Require().ErrorIs(err, types.ErrFindingSeverityLevelInvalid)
+	_, err = suite.msgServer.EditFinding(suite.ctx, types.NewMsgEditFinding(fid, "hash", "", suite.whiteHatAddr, types.Medium, nil))
+	suite.Require().NoError(err)
+}
+
+func (suite *KeeperTestSuite) TestHackerReputation() {
+	bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+	suite.Require().NoError(err)
+	coins := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(amount)))
+	}
+
+	pid := uuid.NewString()
+	_, err = suite.msgServer.CreateProgram(suite.ctx, types.NewMsgCreateProgram(pid, "name", "detail", suite.programAddr, coins(1000), nil, 0, types.DuplicatePolicyUnspecified))
+	suite.Require().NoError(err)
+	suite.InitActivateProgram(pid)
+
+	// an unknown hacker has an empty record
+	res, err := suite.queryClient.Hacker(suite.ctx, &types.QueryHackerRequest{Address: suite.whiteHatAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Empty(res.Reputation.Confirmed)
+	suite.Require().True(res.AcceptanceRate.IsZero())
+
+	confirm := func(reward sdk.Coins) {
+		fid := uuid.NewString()
+		suite.InitSubmitFinding(pid, fid)
+		suite.InitActivateFinding(fid)
+		finding, err := suite.keeper.Findings.Get(suite.ctx, fid)
+		suite.Require().NoError(err)
+		_, err = suite.msgServer.ConfirmFinding(suite.ctx, types.NewMsgConfirmFinding(fid, suite.keeper.GetFindingFingerprintHash(&finding), suite.programAddr, reward))
+		suite.Require().NoError(err)
+	}
+	// paid from the escrow on confirmation
+	confirm(coins(300))
+	// confirmed and paid off chain
+	confirm(nil)
+	paid := uuid.NewString()
+	suite.InitSubmitFinding(pid, paid)
+	suite.InitActivateFinding(paid)
+	finding, err := suite.keeper.Findings.Get(suite.ctx, paid)
+	suite.Require().NoError(err)
+	suite.InitConfirmFinding(paid, suite.keeper.GetFindingFingerprintHash(&finding))
+	suite.InitConfirmFindingPaid(paid)
+	// closed without confirmation
+	closed := uuid.NewString()
+	suite.InitSubmitFinding(pid, closed)
+	_, err = suite.msgServer.CloseFinding(suite.ctx, &types.MsgCloseFinding{FindingId: closed, OperatorAddress: suite.programAddr.String()})
+	suite.Require().NoError(err)
+
+	res, err = suite.queryClient.Hacker(suite.ctx, &types.QueryHackerRequest{Address: suite.whiteHatAddr.String()})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SeverityCount{{SeverityLevel: types.Critical, Count: 3}}, res.Reputation.Confirmed)
+	suite.Require().Equal(uint64(2), res.Reputation.PaidFindings)
+	suite.Require().Equal(uint64(1), res.Reputation.ClosedFindings)
+	suite.Require().Equal(coins(300), res.Reputation.TotalPaid)
+	suite.Require().Equal(math.LegacyNewDecWithPrec(75, 2), res.AcceptanceRate)
+
+	hackers, err := suite.queryClient.Hackers(suite.ctx, &types.QueryHackersRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(hackers.Hackers, 1)
+
+	// programs can restrict submissions to hackers with a track record
+	restricted := uuid.NewString()
+	msg := types.NewMsgCreateProgram(restricted, "name", "detail", suite.programAddr, nil, nil, 0, types.DuplicatePolicyUnspecified)
+	msg.SubmissionRequirements = types.SubmissionRequirements{MinConfirmedFindings: 3, RequireIdentityCert: true}
+	_, err = suite.msgServer.CreateProgram(suite.ctx, msg)
+	suite.Require().NoError(err)
+	suite.InitActivateProgram(restricted)
+
+	_, err = suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(restricted, uuid.NewString(), "", "hash", suite.whiteHatAddr, types.High, nil))
+	suite.Require().ErrorIs(err, types.ErrFindingSubmitterNotEligible)
+	suite.issueIdentityCertificate(suite.whiteHatAddr)
+	suite.issueIdentityCertificate(suite.normalAddr)
+	_, err = suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(restricted, uuid.NewString(), "", "hash", suite.whiteHatAddr, types.High, nil))
+	suite.Require().NoError(err)
+	_, err = suite.msgServer.SubmitFinding(suite.ctx, types.NewMsgSubmitFinding(restricted, uuid.NewString(), "", "hash", suite.normalAddr, types.High, nil))
+	suite.Require().ErrorIs(err, types.ErrFindingSubmitterNotEligible)
+}
+
+func (suite *KeeperTestSuite) TestOpenMathStats() {
+	// a failed proof counts for the prover, the checker and the theorem type
+	failedTheoremID := suite.InitCreateTheorem()
+	failedHash := suite.InitSubmitProofHash(failedTheoremID)
+	suite.InitSubmitProofDetail(failedHash)
+	suite.InitVerifyProof(failedHash, types.ProofStatus_PROOF_STATUS_FAILED)
+
+	proverStats, err := suite.keeper.GetOpenMathStats(suite.ctx, suite.whiteHatAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), proverStats.TheoremsProven)
+	suite.Require().Equal(uint64(1), proverStats.ProofsFailed)
+	suite.Require().True(proverStats.ProverRewards.IsZero())
+
+	// a passed proof counts the distributed rewards as well
+	passedTheoremID := suite.InitCreateTheorem()
+	passedHash := suite.InitSubmitProofHash(passedTheoremID)
+	suite.InitSubmitProofDetail(passedHash)
+	suite.InitVerifyProof(passedHash, types.ProofStatus_PROOF_STATUS_PASSED)
+
+	res, err := suite.queryClient.OpenMathStats(suite.ctx, &types.QueryOpenMathStatsRequest{Address: suite.whiteHatAddr.String()})
+	suite.Require().NoError(err)
+	proverStats = res.Stats
+	suite.Require().Equal(uint64(1), proverStats.TheoremsProven)
+	suite.Require().Equal(uint64(1), proverStats.ProofsFailed)
+	proverReward, err := suite.keeper.Rewards.Get(suite.ctx, suite.whiteHatAddr)
+	suite.Require().NoError(err)
+	suite.Require().Equal(proverReward.Reward, proverStats.ProverRewards)
+
+	res, err = suite.queryClient.OpenMathStats(suite.ctx, &types.QueryOpenMathStatsRequest{Address: suite.bountyAdminAddr.String()})
+	suite.Require().NoError(err)
+	checkerStats := res.Stats
+	suite.Require().Equal(uint64(2), checkerStats.ProofsChecked)
+	suite.Require().Equal(uint64(0), checkerStats.TheoremsProven)
+	// the forfeited deposit share of the failed proof is not a checker reward
+	params, err := suite.keeper.Params.Get(suite.ctx)
+	suite.Require().NoError(err)
+	complexityFee, err := params.GetComplexityFeeByType(types.TheoremType_THEOREM_TYPE_ROCQ)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinsFromCoins(complexityFee), checkerStats.CheckerRewards)
+
+	typeRes, err := suite.queryClient.TheoremTypeStats(suite.ctx, &types.QueryTheoremTypeStatsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(typeRes.Stats, 1)
+	typeStats := typeRes.Stats[0]
+	suite.Require().Equal(types.TheoremType_THEOREM_TYPE_ROCQ, typeStats.TheoremType)
+	suite.Require().Equal(uint64(1), typeStats.TheoremsProven)
+	suite.Require().Equal(uint64(1), typeStats.ProofsFailed)
+	suite.Require().Equal(proverStats.ProverRewards, typeStats.ProverRewards)
+	suite.Require().Equal(checkerStats.CheckerRewards, typeStats.CheckerRewards)
+	suite.Require().True(typeStats.ImportedRewards.IsZero())
+}
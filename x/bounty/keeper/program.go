@@ -0,0 +1,144 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// ==========================================
+// Program Team Operations
+// ==========================================
+
+// HasProgramRole returns true if the address holds the required role in the program team.
+// The program admin address owns the program and holds every role.
+func (k Keeper) HasProgramRole(ctx context.Context, program types.Program, address string, required types.ProgramRole) (bool, error) {
+	if program.AdminAddress == address {
+		return true, nil
+	}
+
+	addr, err := k.authKeeper.AddressCodec().StringToBytes(address)
+	if err != nil {
+		return false, err
+	}
+	member, err := k.ProgramMembers.Get(ctx, collections.Join(program.ProgramId, sdk.AccAddress(addr)))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return member.Role.HasRole(required), nil
+}
+
+// GetProgramMembers returns all the members of a program team.
+func (k Keeper) GetProgramMembers(ctx context.Context, programID string) ([]types.ProgramMember, error) {
+	var members []types.ProgramMember
+	rng := collections.NewPrefixedPairRange[string, sdk.AccAddress](programID)
+	err := k.ProgramMembers.Walk(ctx, rng, func(_ collections.Pair[string, sdk.AccAddress], member types.ProgramMember) (bool, error) {
+		members = append(members, member)
+		return false, nil
+	})
+	return members, err
+}
+
+// ValidateFindingPayloadRecipients checks that the content key of an encrypted finding payload is
+// wrapped for the program admin and every member of the program team, and for no one else than
+// them and the submitter. A payload cannot be encrypted to a team member without a secp256k1 public
+// key on chain, so such a member is not required as a recipient; once it has signed a transaction,
+// the submitter can encrypt the finding to it again with an edit.
+func (k Keeper) ValidateFindingPayloadRecipients(ctx context.Context, program types.Program, submitter string, payload *types.EncryptedFindingPayload) error {
+	if payload == nil {
+		return nil
+	}
+
+	addressCodec := k.authKeeper.AddressCodec()
+	recipients := make(map[string]string, len(payload.Keys))
+	for _, recipient := range payload.Recipients() {
+		addr, err := addressCodec.StringToBytes(recipient)
+		if err != nil {
+			return errors.Wrapf(types.ErrFindingPayloadInvalid, "recipient %s: %s", recipient, err)
+		}
+		if _, ok := recipients[string(addr)]; ok {
+			return errors.Wrapf(types.ErrFindingPayloadInvalid, "duplicate recipient %s", recipient)
+		}
+		recipients[string(addr)] = recipient
+	}
+
+	members, err := k.GetProgramMembers(ctx, program.ProgramId)
+	if err != nil {
+		return err
+	}
+	team := []string{program.AdminAddress}
+	for _, member := range members {
+		team = append(team, member.Address)
+	}
+	for _, address := range team {
+		addr, err := addressCodec.StringToBytes(address)
+		if err != nil {
+			return err
+		}
+		if _, ok := recipients[string(addr)]; !ok && k.hasPayloadPubKey(ctx, addr) {
+			return errors.Wrapf(types.ErrFindingPayloadInvalid, "payload is not encrypted to team member %s", address)
+		}
+		delete(recipients, string(addr))
+	}
+
+	submitterAddr, err := addressCodec.StringToBytes(submitter)
+	if err != nil {
+		return err
+	}
+	delete(recipients, string(submitterAddr))
+	for _, recipient := range recipients {
+		return errors.Wrapf(types.ErrFindingPayloadInvalid, "recipient %s is not a member of program %s", recipient, program.ProgramId)
+	}
+	return nil
+}
+
+// hasPayloadPubKey returns true if the account of an address has a secp256k1 public key on chain a
+// finding payload can be encrypted to.
+func (k Keeper) hasPayloadPubKey(ctx context.Context, addr sdk.AccAddress) bool {
+	account := k.authKeeper.GetAccount(ctx, addr)
+	if account == nil {
+		return false
+	}
+	pubKey := account.GetPubKey()
+	return pubKey != nil && pubKey.Type() == "secp256k1"
+}
+
+// ApproveFinding records the approval of a program admin for the finding with the given fingerprint
+// and returns the number of approvals of the current fingerprint given by addresses still holding the
+// admin role.
+func (k Keeper) ApproveFinding(ctx context.Context, program types.Program, finding types.Finding, approver sdk.AccAddress, fingerprint string) (uint32, error) {
+	if err := k.FindingApprovals.Set(ctx, collections.Join(finding.FindingId, approver), fingerprint); err != nil {
+		return 0, err
+	}
+
+	var approvals uint32
+	rng := collections.NewPrefixedPairRange[string, sdk.AccAddress](finding.FindingId)
+	err := k.FindingApprovals.Walk(ctx, rng, func(key collections.Pair[string, sdk.AccAddress], value string) (bool, error) {
+		if value != fingerprint {
+			return false, nil
+		}
+		isAdmin, err := k.HasProgramRole(ctx, program, key.K2().String(), types.ProgramRoleAdmin)
+		if err != nil {
+			return true, err
+		}
+		if isAdmin {
+			approvals++
+		}
+		return false, nil
+	})
+	return approvals, err
+}
+
+// ClearFindingApprovals removes all recorded approvals of a finding.
+func (k Keeper) ClearFindingApprovals(ctx context.Context, findingID string) error {
+	rng := collections.NewPrefixedPairRange[string, sdk.AccAddress](findingID)
+	return k.FindingApprovals.Clear(ctx, rng)
+}
@@ -2,12 +2,15 @@ package keeper
 
 import (
 	"context"
+	"fmt"
+	"sort"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -33,7 +36,17 @@ func (k Keeper) DeleteProof(ctx context.Context, proofID string) error {
 		return err
 	}
 
-	err = k.ProofsByTheorem.Remove(ctx, collections.Join(proof.TheoremId, proof.Id))
+	err = k.ProofsByTheorem.Remove(ctx, collections.Join3(proof.TheoremId, proof.Sequence, proof.Id))
+	if err != nil {
+		return err
+	}
+
+	err = k.ProofVerdicts.Clear(ctx, collections.NewPrefixedPairRange[string, sdk.AccAddress](proof.Id))
+	if err != nil {
+		return err
+	}
+
+	err = k.ProofChunks.Clear(ctx, collections.NewPrefixedPairRange[string, string](proof.Id))
 	if err != nil {
 		return err
 	}
@@ -57,3 +70,283 @@ func (k Keeper) DeleteProof(ctx context.Context, proofID string) error {
 
 	return nil
 }
+
+// RefundAndDeleteActiveProofs refunds the deposits of the proofs of a theorem still in hash lock or
+// detail period and deletes them. They are superseded once an earlier proof passes.
+func (k Keeper) RefundAndDeleteActiveProofs(ctx context.Context, theoremID uint64) error {
+	var proofIDs []string
+	rng := collections.NewPrefixedTripleRange[uint64, uint64, string](theoremID)
+	err := k.ProofsByTheorem.Walk(ctx, rng, func(key collections.Triple[uint64, uint64, string]) (bool, error) {
+		proof, err := k.Proofs.Get(ctx, key.K3())
+		if err != nil {
+			return false, err
+		}
+		if proof.Status == types.ProofStatus_PROOF_STATUS_HASH_LOCK_PERIOD ||
+			proof.Status == types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD {
+			proofIDs = append(proofIDs, proof.Id)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, proofID := range proofIDs {
+		if err = k.RefundAndDeleteDeposits(ctx, proofID); err != nil {
+			return err
+		}
+		if err = k.DeleteProof(ctx, proofID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExpireRevealedProof settles a revealed proof checkers did not decide within the verification
+// period. Without verdicts the proof expires, with verdicts split below the quorum it is undecided.
+// Either way the prover is not at fault: the deposits are refunded, nothing is forfeited, the proof
+// is not counted as failed in the OpenMath statistics, and it is deleted so that the next revealed
+// proof of the theorem can be verified.
+func (k Keeper) ExpireRevealedProof(ctx context.Context, proof types.Proof) error {
+	verdicts, err := k.GetProofVerdicts(ctx, proof.Id)
+	if err != nil {
+		return err
+	}
+	if err = k.RefundAndDeleteDeposits(ctx, proof.Id); err != nil {
+		return err
+	}
+	if err = k.DeleteProof(ctx, proof.Id); err != nil {
+		return err
+	}
+
+	eventType := types.EventTypeProofVerificationExpired
+	if len(verdicts) > 0 {
+		eventType = types.EventTypeProofUndecided
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			eventType,
+			sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", proof.TheoremId)),
+			sdk.NewAttribute(types.AttributeKeyProofID, proof.Id),
+			sdk.NewAttribute(types.AttributeKeyProver, proof.Prover),
+			sdk.NewAttribute(types.AttributeKeyVerdicts, fmt.Sprintf("%d", len(verdicts))),
+		),
+	)
+	return nil
+}
+
+// GetProofVerdicts returns the checker verdicts recorded on a proof.
+func (k Keeper) GetProofVerdicts(ctx context.Context, proofID string) ([]types.ProofVerdict, error) {
+	var verdicts []types.ProofVerdict
+	rng := collections.NewPrefixedPairRange[string, sdk.AccAddress](proofID)
+	err := k.ProofVerdicts.Walk(ctx, rng, func(_ collections.Pair[string, sdk.AccAddress], verdict types.ProofVerdict) (bool, error) {
+		verdicts = append(verdicts, verdict)
+		return false, nil
+	})
+	return verdicts, err
+}
+
+// RecordProofVerdict records the verdict of a checker on a proof. Once the number of agreeing
+// verdicts reaches the verification quorum, it returns them to decide the proof. Verdicts still
+// split below the quorum at the end of the verification period leave the proof undecided, see ExpireRevealedProof.
+func (k Keeper) RecordProofVerdict(ctx context.Context, checker sdk.AccAddress, verdict types.ProofVerdict) ([]types.ProofVerdict, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	key := collections.Join(verdict.ProofId, checker)
+	has, err := k.ProofVerdicts.Has(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errors.Wrapf(types.ErrProofVerdictInvalid, "checker %s already submitted a verdict on proof %s", verdict.Checker, verdict.ProofId)
+	}
+	if err = k.ProofVerdicts.Set(ctx, key, verdict); err != nil {
+		return nil, err
+	}
+
+	verdicts, err := k.GetProofVerdicts(ctx, verdict.ProofId)
+	if err != nil {
+		return nil, err
+	}
+	var agreeing []types.ProofVerdict
+	for _, v := range verdicts {
+		// passing verdicts only agree on the same theorem type
+		if v.Status == verdict.Status &&
+			(v.Status != types.ProofStatus_PROOF_STATUS_PASSED || v.TheoremType == verdict.TheoremType) {
+			agreeing = append(agreeing, v)
+		}
+	}
+	if uint32(len(agreeing)) < params.ProofVerificationQuorum {
+		return nil, nil
+	}
+	return agreeing, nil
+}
+
+// medianComplexity returns the median of the complexities of the verdicts, rounded down.
+func medianComplexity(verdicts []types.ProofVerdict) int64 {
+	complexities := make([]int64, len(verdicts))
+	for i, verdict := range verdicts {
+		complexities[i] = verdict.Complexity
+	}
+	sort.Slice(complexities, func(i, j int) bool { return complexities[i] < complexities[j] })
+
+	mid := len(complexities) / 2
+	if len(complexities)%2 == 1 {
+		return complexities[mid]
+	}
+	return complexities[mid-1] + (complexities[mid]-complexities[mid-1])/2
+}
+
+// commonImports returns the imports reported by all the verdicts, in the order of the first one.
+func commonImports(verdicts []types.ProofVerdict) []uint64 {
+	counts := make(map[uint64]int)
+	for _, verdict := range verdicts {
+		for _, id := range verdict.Imports {
+			counts[id]++
+		}
+	}
+
+	var imports []uint64
+	for _, id := range verdicts[0].Imports {
+		if counts[id] == len(verdicts) {
+			imports = append(imports, id)
+		}
+	}
+	return imports
+}
+
+// SetProofChunk stores a chunk of the detail of a proof under its content hash and returns the hash,
+// and whether the chunk is new. Uploading the same chunk twice is a no-op.
+func (k Keeper) SetProofChunk(ctx context.Context, proofID string, data []byte) (string, bool, error) {
+	if len(data) == 0 || len(data) > types.MaxProofChunkSize {
+		return "", false, errors.Wrapf(types.ErrProofChunkInvalid, "chunk size must be between 1 and %d bytes, got %d", types.MaxProofChunkSize, len(data))
+	}
+
+	hash := types.ProofChunkHash(data)
+	key := collections.Join(proofID, hash)
+	has, err := k.ProofChunks.Has(ctx, key)
+	if err != nil || has {
+		return hash, false, err
+	}
+
+	count, err := k.countProofChunks(ctx, proofID)
+	if err != nil {
+		return "", false, err
+	}
+	if count >= types.MaxProofChunks {
+		return "", false, errors.Wrapf(types.ErrProofChunkInvalid, "proof %s already has %d chunks", proofID, count)
+	}
+	return hash, true, k.ProofChunks.Set(ctx, key, data)
+}
+
+// AssembleProofDetail concatenates the chunks of a proof in the given order, decompressing them if
+// needed, and returns the detail. Compressed chunks are decompressed block by block, consuming gas
+// for every decompressed byte and failing as soon as the detail exceeds MaxProofDetailSize.
+func (k Keeper) AssembleProofDetail(ctx context.Context, proofID string, chunkHashes []string, compressed bool) (string, error) {
+	if len(chunkHashes) == 0 || len(chunkHashes) > types.MaxProofChunks {
+		return "", errors.Wrapf(types.ErrProofChunkInvalid, "chunk count must be between 1 and %d, got %d", types.MaxProofChunks, len(chunkHashes))
+	}
+
+	detail := &proofDetailWriter{gasMeter: sdk.UnwrapSDKContext(ctx).GasMeter()}
+	for _, hash := range chunkHashes {
+		data, err := k.ProofChunks.Get(ctx, collections.Join(proofID, hash))
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return "", errors.Wrapf(types.ErrProofChunkNotExist, "chunk %s of proof %s", hash, proofID)
+			}
+			return "", err
+		}
+		if compressed {
+			err = types.DecompressProofChunk(detail, data)
+		} else {
+			err = detail.append(data)
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return string(detail.data), nil
+}
+
+// proofDetailWriter assembles a proof detail bounded by MaxProofDetailSize. Writes to it are the
+// decompressed blocks of chunks, which consume gas.
+type proofDetailWriter struct {
+	gasMeter storetypes.GasMeter
+	data     []byte
+}
+
+func (w *proofDetailWriter) append(p []byte) error {
+	if len(w.data)+len(p) > types.MaxProofDetailSize {
+		return errors.Wrapf(types.ErrProofChunkInvalid, "proof detail exceeds %d bytes", types.MaxProofDetailSize)
+	}
+	w.data = append(w.data, p...)
+	return nil
+}
+
+func (w *proofDetailWriter) Write(p []byte) (int, error) {
+	w.gasMeter.ConsumeGas(uint64(len(p))*storetypes.KVGasConfig().ReadCostPerByte, "proof chunk decompression")
+	if err := w.append(p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+// PruneProofChunks removes the chunks of a proof that are not part of its detail.
+func (k Keeper) PruneProofChunks(ctx context.Context, proofID string, chunkHashes []string) error {
+	keep := make(map[string]bool, len(chunkHashes))
+	for _, hash := range chunkHashes {
+		keep[hash] = true
+	}
+
+	var unused []string
+	rng := collections.NewPrefixedPairRange[string, string](proofID)
+	err := k.ProofChunks.Walk(ctx, rng, func(key collections.Pair[string, string], _ []byte) (bool, error) {
+		if !keep[key.K2()] {
+			unused = append(unused, key.K2())
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, hash := range unused {
+		if err = k.ProofChunks.Remove(ctx, collections.Join(proofID, hash)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetProofChunk returns the content of the chunk at the given position of the detail of a proof,
+// decompressed, along with its hash.
+func (k Keeper) GetProofChunk(ctx context.Context, proof types.Proof, index int) ([]byte, string, error) {
+	if index < 0 || index >= len(proof.DetailChunks) {
+		return nil, "", errors.Wrapf(types.ErrProofChunkNotExist, "proof %s has %d chunks, index %d", proof.Id, len(proof.DetailChunks), index)
+	}
+
+	hash := proof.DetailChunks[index]
+	data, err := k.ProofChunks.Get(ctx, collections.Join(proof.Id, hash))
+	if err != nil {
+		if errors.IsOf(err, collections.ErrNotFound) {
+			return nil, "", errors.Wrapf(types.ErrProofChunkNotExist, "chunk %s of proof %s", hash, proof.Id)
+		}
+		return nil, "", err
+	}
+	data, err = types.DecodeProofChunk(data, proof.DetailCompressed)
+	return data, hash, err
+}
+
+func (k Keeper) countProofChunks(ctx context.Context, proofID string) (int, error) {
+	count := 0
+	rng := collections.NewPrefixedPairRange[string, string](proofID)
+	err := k.ProofChunks.Walk(ctx, rng, func(_ collections.Pair[string, string], _ []byte) (bool, error) {
+		count++
+		return false, nil
+	})
+	return count, err
+}
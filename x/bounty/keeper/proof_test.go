@@ -2,9 +2,343 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
+
+	"github.com/klauspost/compress/zstd"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty"
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
 )
 
 func (suite *KeeperTestSuite) TestHash() {
 	hash := suite.keeper.GetProofHash(1, "shentu14ayuhu60zyc7a5chxy65s5g2cfamvufwm7vd52", "test")
 	fmt.Println(hash)
 }
+
+func (suite *KeeperTestSuite) TestValidateImportsAcyclic() {
+	suite.initTheoremLibrary()
+
+	// theorem 1 is a dependency of theorem 3
+	err := suite.keeper.ValidateImportsAcyclic(suite.ctx, 1, []uint64{3})
+	suite.Require().ErrorIs(err, types.ErrTheoremImportCycle)
+	err = suite.keeper.ValidateImportsAcyclic(suite.ctx, 2, []uint64{4, 3})
+	suite.Require().ErrorIs(err, types.ErrTheoremImportCycle)
+
+	suite.Require().NoError(suite.keeper.ValidateImportsAcyclic(suite.ctx, 4, []uint64{3}))
+	suite.Require().NoError(suite.keeper.ValidateImportsAcyclic(suite.ctx, 5, nil))
+
+	err = suite.keeper.ValidateImportsAcyclic(suite.ctx, 4, []uint64{9999})
+	suite.Require().ErrorIs(err, types.ErrInvalidContent)
+}
+
+// TestAssembleProofDetail tests that compressed chunks are metered and bounded while they are
+// decompressed, whatever their compressed size
+func (suite *KeeperTestSuite) TestAssembleProofDetail() {
+	testCases := []struct {
+		name     string
+		size     int
+		gasLimit uint64
+		expErr   error
+		expPanic bool
+	}{
+		{"detail within limits", 1024 * 1024, 0, nil, false},
+		{"detail over the size limit", types.MaxProofDetailSize + 1, 0, types.ErrProofChunkInvalid, false},
+		{"decompression out of gas", types.MaxProofDetailSize, 1000000, nil, true},
+	}
+
+	encoder, err := zstd.NewWriter(nil)
+	suite.Require().NoError(err)
+	defer encoder.Close()
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+
+			// a small chunk decompressing to the whole size
+			data := encoder.EncodeAll(make([]byte, tc.size), nil)
+			suite.Require().Less(len(data), types.MaxProofChunkSize)
+			hash, _, err := suite.keeper.SetProofChunk(suite.ctx, "proof", data)
+			suite.Require().NoError(err)
+
+			gasMeter := storetypes.NewInfiniteGasMeter()
+			if tc.gasLimit > 0 {
+				gasMeter = storetypes.NewGasMeter(tc.gasLimit)
+			}
+			ctx := suite.ctx.WithGasMeter(gasMeter)
+			if tc.expPanic {
+				suite.Require().PanicsWithValue(storetypes.ErrorOutOfGas{Descriptor: "proof chunk decompression"}, func() {
+					_, _ = suite.keeper.AssembleProofDetail(ctx, "proof", []string{hash}, true)
+				})
+				return
+			}
+
+			detail, err := suite.keeper.AssembleProofDetail(ctx, "proof", []string{hash}, true)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Len(detail, tc.size)
+			suite.Require().GreaterOrEqual(gasMeter.GasConsumed(), uint64(tc.size)*storetypes.KVGasConfig().ReadCostPerByte)
+		})
+	}
+}
+
+// TestExpireRevealedProof tests that a revealed proof checkers do not decide within the verification
+// period, including with split verdicts, is refunded and no longer keeps its theorem alive
+func (suite *KeeperTestSuite) TestExpireRevealedProof() {
+	testCases := []struct {
+		name          string
+		splitVerdicts bool
+		blockTime     func(proof types.Proof, theorem types.Theorem) time.Time
+		proofExists   bool
+		theoremExists bool
+		expEvent      string
+	}{
+		{
+			"within the verification period",
+			false,
+			func(proof types.Proof, _ types.Theorem) time.Time { return proof.EndTime.Add(-time.Second) },
+			true,
+			true,
+			"",
+		},
+		{
+			"split verdicts within the verification period",
+			true,
+			func(proof types.Proof, _ types.Theorem) time.Time { return proof.EndTime.Add(-time.Second) },
+			true,
+			true,
+			"",
+		},
+		{
+			"after the verification period",
+			false,
+			func(proof types.Proof, _ types.Theorem) time.Time { return proof.EndTime.Add(time.Second) },
+			false,
+			true,
+			types.EventTypeProofVerificationExpired,
+		},
+		{
+			"split verdicts after the verification period",
+			true,
+			func(proof types.Proof, _ types.Theorem) time.Time { return proof.EndTime.Add(time.Second) },
+			false,
+			true,
+			types.EventTypeProofUndecided,
+		},
+		{
+			"after the theorem end time",
+			false,
+			func(_ types.Proof, theorem types.Theorem) time.Time { return theorem.EndTime.Add(time.Second) },
+			false,
+			false,
+			types.EventTypeProofVerificationExpired,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			suite.Require().NoError(err)
+			theoremID := suite.InitCreateTheorem()
+			proofID := suite.InitSubmitProofHash(theoremID)
+			suite.InitSubmitProofDetail(proofID)
+
+			if tc.splitVerdicts {
+				// two checkers disagree and the quorum of two agreeing verdicts is never reached
+				params, err := suite.keeper.Params.Get(suite.ctx)
+				suite.Require().NoError(err)
+				params.ProofVerificationQuorum = 2
+				suite.Require().NoError(suite.keeper.Params.Set(suite.ctx, params))
+				suite.issueBountyAdminCertificate(suite.normalAddr)
+
+				suite.InitVerifyProof(proofID, types.ProofStatus_PROOF_STATUS_PASSED)
+				_, err = suite.msgServer.SubmitProofVerification(suite.ctx, &types.MsgSubmitProofVerification{
+					ProofId:     proofID,
+					Status:      types.ProofStatus_PROOF_STATUS_FAILED,
+					Checker:     suite.normalAddr.String(),
+					TheoremType: types.TheoremType_THEOREM_TYPE_ROCQ,
+				})
+				suite.Require().NoError(err)
+			}
+
+			proof, err := suite.keeper.Proofs.Get(suite.ctx, proofID)
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD, proof.Status)
+			theorem, err := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+			suite.Require().NoError(err)
+			balanceBefore := suite.app.BankKeeper.GetBalance(suite.ctx, suite.whiteHatAddr, bondDenom)
+
+			suite.ctx = suite.ctx.WithBlockTime(tc.blockTime(proof, theorem)).WithEventManager(sdk.NewEventManager())
+			suite.Require().NoError(bounty.EndBlocker(suite.ctx, &suite.keeper))
+
+			_, err = suite.keeper.Proofs.Get(suite.ctx, proofID)
+			_, theoremErr := suite.keeper.Theorems.Get(suite.ctx, theoremID)
+			balanceAfter := suite.app.BankKeeper.GetBalance(suite.ctx, suite.whiteHatAddr, bondDenom)
+			if tc.proofExists {
+				suite.Require().NoError(err)
+				suite.Require().Equal(balanceBefore, balanceAfter)
+			} else {
+				// the prover is not at fault, the whole deposit is refunded
+				suite.Require().ErrorIs(err, collections.ErrNotFound)
+				suite.Require().Equal(balanceBefore.Add(proof.Deposit[0]), balanceAfter)
+				verdicts, err := suite.keeper.GetProofVerdicts(suite.ctx, proofID)
+				suite.Require().NoError(err)
+				suite.Require().Empty(verdicts)
+			}
+			// an undecided proof is not a failed proof in the OpenMath statistics
+			stats, err := suite.keeper.GetOpenMathStats(suite.ctx, suite.whiteHatAddr)
+			suite.Require().NoError(err)
+			suite.Require().Zero(stats.ProofsFailed)
+			typeStats, err := suite.keeper.GetTheoremTypeStats(suite.ctx, types.TheoremType_THEOREM_TYPE_ROCQ)
+			suite.Require().NoError(err)
+			suite.Require().Zero(typeStats.ProofsFailed)
+
+			if tc.theoremExists {
+				suite.Require().NoError(theoremErr)
+			} else {
+				suite.Require().ErrorIs(theoremErr, collections.ErrNotFound)
+			}
+
+			var events []string
+			for _, event := range suite.ctx.EventManager().Events() {
+				if event.Type == types.EventTypeProofVerificationExpired || event.Type == types.EventTypeProofUndecided || event.Type == types.EventTypeProofFailed {
+					events = append(events, event.Type)
+				}
+			}
+			if tc.expEvent == "" {
+				suite.Require().Empty(events)
+			} else {
+				suite.Require().Equal([]string{tc.expEvent}, events)
+			}
+		})
+	}
+}
+
+// initTypedTheorems stores passed theorems 10 and 11 of declared types and theorem 12 without type
+func (suite *KeeperTestSuite) initTypedTheorems() {
+	for id, theoremType := range map[uint64]types.TheoremType{
+		10: types.TheoremType_THEOREM_TYPE_ROCQ,
+		11: types.TheoremType_THEOREM_TYPE_LEAN,
+		12: types.TheoremType_THEOREM_TYPE_UNSPECIFIED,
+	} {
+		theorem := types.Theorem{
+			Id:          id,
+			Title:       fmt.Sprintf("Theorem %d", id),
+			Proposer:    suite.programAddr.String(),
+			Status:      types.TheoremStatus_THEOREM_STATUS_PASSED,
+			TheoremType: theoremType,
+		}
+		suite.Require().NoError(suite.keeper.Theorems.Set(suite.ctx, id, theorem))
+	}
+}
+
+func (suite *KeeperTestSuite) TestInferTheoremType() {
+	suite.initTypedTheorems()
+
+	testCases := []struct {
+		name    string
+		imports []uint64
+		expType types.TheoremType
+		expErr  error
+	}{
+		{"no imports", nil, types.TheoremType_THEOREM_TYPE_UNSPECIFIED, nil},
+		{"imports without type", []uint64{12}, types.TheoremType_THEOREM_TYPE_UNSPECIFIED, nil},
+		{"rocq imports", []uint64{12, 10}, types.TheoremType_THEOREM_TYPE_ROCQ, nil},
+		{"lean imports", []uint64{11}, types.TheoremType_THEOREM_TYPE_LEAN, nil},
+		{"mixed imports", []uint64{10, 11}, types.TheoremType_THEOREM_TYPE_UNSPECIFIED, types.ErrTheoremTypeMismatch},
+		{"unknown import", []uint64{9999}, types.TheoremType_THEOREM_TYPE_UNSPECIFIED, collections.ErrNotFound},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			theoremType, err := suite.keeper.InferTheoremType(suite.ctx, tc.imports)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expType, theoremType)
+		})
+	}
+}
+
+// TestVerifyTheoremType tests that verifications must report the type stored with the theorem and
+// that theorems cannot be created, nor verified, without a type
+func (suite *KeeperTestSuite) TestVerifyTheoremType() {
+	testCases := []struct {
+		name        string
+		declared    types.TheoremType
+		imports     []uint64
+		theoremType types.TheoremType
+		expErr      error
+	}{
+		{"declared type", types.TheoremType_THEOREM_TYPE_LEAN, []uint64{11}, types.TheoremType_THEOREM_TYPE_LEAN, nil},
+		{"imports without type", types.TheoremType_THEOREM_TYPE_ROCQ, []uint64{12}, types.TheoremType_THEOREM_TYPE_ROCQ, nil},
+		{"other type than declared", types.TheoremType_THEOREM_TYPE_LEAN, nil, types.TheoremType_THEOREM_TYPE_ROCQ, types.ErrTheoremTypeMismatch},
+		{"other type than the imports", types.TheoremType_THEOREM_TYPE_ROCQ, []uint64{11}, types.TheoremType_THEOREM_TYPE_ROCQ, types.ErrTheoremTypeMismatch},
+		{"missing verdict type", types.TheoremType_THEOREM_TYPE_ROCQ, nil, types.TheoremType_THEOREM_TYPE_UNSPECIFIED, types.ErrInvalidContent},
+		{"theorem without type", types.TheoremType_THEOREM_TYPE_UNSPECIFIED, nil, types.TheoremType_THEOREM_TYPE_ROCQ, types.ErrTheoremTypeMismatch},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest()
+			suite.initTypedTheorems()
+			bondDenom, err := suite.app.StakingKeeper.BondDenom(suite.ctx)
+			suite.Require().NoError(err)
+
+			createReq := &types.MsgCreateTheorem{
+				Title:        "Typed Theorem",
+				Description:  "A theorem with a declared type",
+				Code:         "theorem typed : True := trivial",
+				InitialGrant: sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1e6))),
+				Proposer:     suite.programAddr.String(),
+				TheoremType:  tc.declared,
+			}
+			if tc.declared == types.TheoremType_THEOREM_TYPE_UNSPECIFIED {
+				// a theorem created before the type was declared and whose type was not inferred
+				_, err = suite.msgServer.CreateTheorem(suite.ctx, createReq)
+				suite.Require().ErrorIs(err, types.ErrInvalidContent)
+				createReq.TheoremType = types.TheoremType_THEOREM_TYPE_ROCQ
+			}
+			res, err := suite.msgServer.CreateTheorem(suite.ctx, createReq)
+			suite.Require().NoError(err)
+			if tc.declared == types.TheoremType_THEOREM_TYPE_UNSPECIFIED {
+				theorem, err := suite.keeper.Theorems.Get(suite.ctx, res.TheoremId)
+				suite.Require().NoError(err)
+				theorem.TheoremType = types.TheoremType_THEOREM_TYPE_UNSPECIFIED
+				suite.Require().NoError(suite.keeper.Theorems.Set(suite.ctx, theorem.Id, theorem))
+			}
+			proofID := suite.InitSubmitProofHash(res.TheoremId)
+			suite.InitSubmitProofDetail(proofID)
+
+			_, err = suite.msgServer.SubmitProofVerification(suite.ctx, &types.MsgSubmitProofVerification{
+				ProofId:     proofID,
+				Status:      types.ProofStatus_PROOF_STATUS_PASSED,
+				Checker:     suite.bountyAdminAddr.String(),
+				Complexity:  1,
+				Imports:     tc.imports,
+				TheoremType: tc.theoremType,
+			})
+			theorem, getErr := suite.keeper.Theorems.Get(suite.ctx, res.TheoremId)
+			suite.Require().NoError(getErr)
+			suite.Require().Equal(tc.declared, theorem.TheoremType)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+				suite.Require().Equal(types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD, theorem.Status)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(types.TheoremStatus_THEOREM_STATUS_PASSED, theorem.Status)
+		})
+	}
+}
@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// ==========================================
+// Hacker Reputation Operations
+// ==========================================
+
+// GetHackerReputation returns the reputation of a finding submitter, empty if none of the
+// findings of the submitter was resolved yet.
+func (k Keeper) GetHackerReputation(ctx context.Context, hacker sdk.AccAddress) (types.HackerReputation, error) {
+	reputation, err := k.HackerReputations.Get(ctx, hacker)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.NewHackerReputation(hacker.String()), nil
+	}
+	return reputation, err
+}
+
+// UpdateHackerReputation applies the update to the reputation of the submitter of a finding.
+func (k Keeper) UpdateHackerReputation(ctx context.Context, submitter string, update func(*types.HackerReputation)) error {
+	hacker, err := k.authKeeper.AddressCodec().StringToBytes(submitter)
+	if err != nil {
+		return err
+	}
+	reputation, err := k.GetHackerReputation(ctx, hacker)
+	if err != nil {
+		return err
+	}
+	update(&reputation)
+	return k.HackerReputations.Set(ctx, hacker, reputation)
+}
+
+// CheckSubmissionRequirements returns an error if the hacker does not meet the submission
+// requirements of the program.
+func (k Keeper) CheckSubmissionRequirements(ctx context.Context, program types.Program, hacker sdk.AccAddress) error {
+	requirements := program.SubmissionRequirements
+	if requirements.RequireIdentityCert && !k.certKeeper.IsIdentityCertified(ctx, hacker) {
+		return errors.Wrap(types.ErrFindingSubmitterNotEligible, "identity certificate required")
+	}
+	if requirements.MinConfirmedFindings == 0 {
+		return nil
+	}
+
+	reputation, err := k.GetHackerReputation(ctx, hacker)
+	if err != nil {
+		return err
+	}
+	if reputation.ConfirmedFindings() < requirements.MinConfirmedFindings {
+		return errors.Wrapf(types.ErrFindingSubmitterNotEligible, "%d confirmed findings, %d required",
+			reputation.ConfirmedFindings(), requirements.MinConfirmedFindings)
+	}
+	return nil
+}
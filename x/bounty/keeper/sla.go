@@ -0,0 +1,102 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// ==========================================
+// Finding SLA Operations
+// ==========================================
+
+// ScheduleFindingSLA replaces the SLA deadline of a finding with the one of the program SLA for
+// its current status. The caller is responsible for storing the finding.
+func (k Keeper) ScheduleFindingSLA(ctx context.Context, program types.Program, finding *types.Finding) error {
+	if err := k.RemoveFindingSLA(ctx, finding); err != nil {
+		return err
+	}
+
+	_, sla, ok := program.FindingSLA(finding.Status)
+	if !ok {
+		return nil
+	}
+	deadline := sdk.UnwrapSDKContext(ctx).BlockTime().Add(sla)
+	finding.SlaDeadline = &deadline
+	return k.FindingSLAQueue.Set(ctx, collections.Join(deadline, finding.FindingId))
+}
+
+// RemoveFindingSLA clears the SLA deadline of a finding handled by the program team.
+// The caller is responsible for storing the finding.
+func (k Keeper) RemoveFindingSLA(ctx context.Context, finding *types.Finding) error {
+	if finding.SlaDeadline == nil {
+		return nil
+	}
+	if err := k.FindingSLAQueue.Remove(ctx, collections.Join(*finding.SlaDeadline, finding.FindingId)); err != nil {
+		return err
+	}
+	finding.SlaDeadline = nil
+	return nil
+}
+
+// EscalateBreachedFindings escalates the findings whose SLA deadline passed before the given time
+// to bounty admin review and returns them.
+func (k Keeper) EscalateBreachedFindings(ctx context.Context, blockTime time.Time) ([]types.Finding, error) {
+	var keys []collections.Pair[time.Time, string]
+	rng := collections.NewPrefixUntilPairRange[time.Time, string](blockTime)
+	err := k.FindingSLAQueue.Walk(ctx, rng, func(key collections.Pair[time.Time, string]) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	var escalated []types.Finding
+	for _, key := range keys {
+		if err = k.FindingSLAQueue.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+
+		finding, err := k.Findings.Get(ctx, key.K2())
+		if err != nil {
+			return nil, err
+		}
+		var sla string
+		switch finding.Status {
+		case types.FindingStatusSubmitted:
+			sla = types.FindingSLAActivation
+		case types.FindingStatusActive:
+			sla = types.FindingSLAConfirmation
+		default:
+			continue
+		}
+
+		finding.Status = types.FindingStatusEscalated
+		finding.SlaDeadline = nil
+		if err = k.Findings.Set(ctx, finding.FindingId, finding); err != nil {
+			return nil, err
+		}
+		if err = k.ClearFindingApprovals(ctx, finding.FindingId); err != nil {
+			return nil, err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeFindingSLABreached,
+				sdk.NewAttribute(types.AttributeKeyFindingID, finding.FindingId),
+				sdk.NewAttribute(types.AttributeKeyProgramID, finding.ProgramId),
+				sdk.NewAttribute(types.AttributeKeySLA, sla),
+				sdk.NewAttribute(types.AttributeKeyDeadline, key.K1().String()),
+			),
+		)
+		escalated = append(escalated, finding)
+	}
+	return escalated, nil
+}
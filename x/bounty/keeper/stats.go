@@ -0,0 +1,170 @@
+package keeper
+
+import (
+	"context"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// ==========================================
+// OpenMath Statistics Operations
+// ==========================================
+
+// GetOpenMathStats returns the OpenMath statistics of an address, empty if the address never
+// proved, checked or earned anything.
+func (k Keeper) GetOpenMathStats(ctx context.Context, addr sdk.AccAddress) (types.OpenMathStats, error) {
+	stats, err := k.OpenMathStats.Get(ctx, addr)
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.NewOpenMathStats(addr.String()), nil
+	}
+	return stats, err
+}
+
+// UpdateOpenMathStats applies the update to the OpenMath statistics of an address and moves the
+// address on the leaderboards whose score changed.
+func (k Keeper) UpdateOpenMathStats(ctx context.Context, addr sdk.AccAddress, update func(*types.OpenMathStats)) error {
+	stats, err := k.GetOpenMathStats(ctx, addr)
+	if err != nil {
+		return err
+	}
+	oldScores := stats.LeaderboardScores()
+	update(&stats)
+	if err = k.OpenMathStats.Set(ctx, addr, stats); err != nil {
+		return err
+	}
+	return k.updateLeaderboards(ctx, addr, oldScores, stats.LeaderboardScores())
+}
+
+// SetOpenMathStats sets the OpenMath statistics of an address and its leaderboard entries.
+func (k Keeper) SetOpenMathStats(ctx context.Context, addr sdk.AccAddress, stats types.OpenMathStats) error {
+	if err := k.OpenMathStats.Set(ctx, addr, stats); err != nil {
+		return err
+	}
+	return k.updateLeaderboards(ctx, addr, nil, stats.LeaderboardScores())
+}
+
+// updateLeaderboards replaces the leaderboard entries of an address with the old scores by the new ones.
+func (k Keeper) updateLeaderboards(ctx context.Context, addr sdk.AccAddress, oldScores, newScores map[string]uint64) error {
+	for _, board := range slices.Sorted(maps.Keys(oldScores)) {
+		if score, ok := newScores[board]; ok && score == oldScores[board] {
+			continue
+		}
+		if err := k.Leaderboards.Remove(ctx, collections.Join(board, collections.Join(oldScores[board], addr))); err != nil {
+			return err
+		}
+	}
+	for _, board := range slices.Sorted(maps.Keys(newScores)) {
+		if score, ok := oldScores[board]; ok && score == newScores[board] {
+			continue
+		}
+		if err := k.Leaderboards.Set(ctx, collections.Join(board, collections.Join(newScores[board], addr))); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetTheoremTypeStats returns the OpenMath statistics of a theorem type.
+func (k Keeper) GetTheoremTypeStats(ctx context.Context, theoremType types.TheoremType) (types.TheoremTypeStats, error) {
+	stats, err := k.TheoremTypeStats.Get(ctx, int32(theoremType))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return types.NewTheoremTypeStats(theoremType), nil
+	}
+	return stats, err
+}
+
+// UpdateTheoremTypeStats applies the update to the OpenMath statistics of a theorem type.
+func (k Keeper) UpdateTheoremTypeStats(ctx context.Context, theoremType types.TheoremType, update func(*types.TheoremTypeStats)) error {
+	stats, err := k.GetTheoremTypeStats(ctx, theoremType)
+	if err != nil {
+		return err
+	}
+	update(&stats)
+	return k.TheoremTypeStats.Set(ctx, int32(theoremType), stats)
+}
+
+// recordProofVerification counts a verified proof in the statistics of its prover, its checkers
+// and its theorem type.
+func (k Keeper) recordProofVerification(ctx context.Context, prover sdk.AccAddress, checkers []sdk.AccAddress, theoremType types.TheoremType, passed bool) error {
+	err := k.UpdateOpenMathStats(ctx, prover, func(stats *types.OpenMathStats) {
+		if passed {
+			stats.TheoremsProven++
+		} else {
+			stats.ProofsFailed++
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, checker := range checkers {
+		err = k.UpdateOpenMathStats(ctx, checker, func(stats *types.OpenMathStats) {
+			stats.ProofsChecked++
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return k.UpdateTheoremTypeStats(ctx, theoremType, func(stats *types.TheoremTypeStats) {
+		if passed {
+			stats.TheoremsProven++
+		} else {
+			stats.ProofsFailed++
+		}
+	})
+}
+
+// recordRewardDistribution adds the rewards distributed for a proven theorem to the statistics of
+// their recipients and of the theorem type.
+func (k Keeper) recordRewardDistribution(
+	ctx context.Context,
+	theoremType types.TheoremType,
+	checkers []sdk.AccAddress,
+	checkerShare sdk.DecCoins,
+	importedRewards []importedReward,
+	prover sdk.AccAddress,
+	proverRewards sdk.DecCoins,
+) error {
+	checkerRewards := sdk.NewDecCoins()
+	for _, checker := range checkers {
+		err := k.UpdateOpenMathStats(ctx, checker, func(stats *types.OpenMathStats) {
+			stats.CheckerRewards = stats.CheckerRewards.Add(checkerShare...)
+		})
+		if err != nil {
+			return err
+		}
+		checkerRewards = checkerRewards.Add(checkerShare...)
+	}
+
+	totalImportedRewards := sdk.NewDecCoins()
+	for _, imported := range importedRewards {
+		err := k.UpdateOpenMathStats(ctx, imported.proposer, func(stats *types.OpenMathStats) {
+			stats.ImportedRewards = stats.ImportedRewards.Add(imported.reward)
+		})
+		if err != nil {
+			return err
+		}
+		totalImportedRewards = totalImportedRewards.Add(imported.reward)
+	}
+
+	err := k.UpdateOpenMathStats(ctx, prover, func(stats *types.OpenMathStats) {
+		stats.ProverRewards = stats.ProverRewards.Add(proverRewards...)
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.UpdateTheoremTypeStats(ctx, theoremType, func(stats *types.TheoremTypeStats) {
+		stats.ProverRewards = stats.ProverRewards.Add(proverRewards...)
+		stats.CheckerRewards = stats.CheckerRewards.Add(checkerRewards...)
+		stats.ImportedRewards = stats.ImportedRewards.Add(totalImportedRewards...)
+	})
+}
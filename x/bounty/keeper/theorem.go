@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -32,6 +33,17 @@ func (k Keeper) DeleteTheorem(ctx context.Context, theoremID uint64) error {
 	if err != nil {
 		return err
 	}
+	codeHash := types.TheoremCodeHash(theorem.Code)
+	if indexedID, err := k.TheoremCodeHashes.Get(ctx, codeHash); err == nil && indexedID == theorem.Id {
+		if err = k.TheoremCodeHashes.Remove(ctx, codeHash); err != nil {
+			return err
+		}
+	}
+	for _, importID := range theorem.Imports {
+		if err = k.TheoremDependents.Remove(ctx, collections.Join(importID, theorem.Id)); err != nil {
+			return err
+		}
+	}
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	sdkCtx.EventManager().EmitEvent(
@@ -43,3 +55,200 @@ func (k Keeper) DeleteTheorem(ctx context.Context, theoremID uint64) error {
 
 	return nil
 }
+
+// CloseTheorem moves a theorem in proof period to the closed status, refunds all its grants and
+// releases the forfeited deposits of its grant pool to the community pool.
+func (k Keeper) CloseTheorem(ctx context.Context, theorem *types.Theorem) error {
+	if err := k.ActiveTheoremsQueue.Remove(ctx, collections.Join(*theorem.EndTime, theorem.Id)); err != nil {
+		return err
+	}
+	if err := k.RefundAndDeleteGrants(ctx, theorem.Id); err != nil {
+		return err
+	}
+	if err := k.ReleaseForfeitedDeposits(ctx, *theorem); err != nil {
+		return err
+	}
+
+	theorem.Status = types.TheoremStatus_THEOREM_STATUS_CLOSED
+	theorem.TotalGrant = nil
+	theorem.ForfeitedDeposits = nil
+	return k.Theorems.Set(ctx, theorem.Id, *theorem)
+}
+
+// ExtendTheoremByGrant pushes the end of the proof period of a theorem to a full proof period from
+// now when it receives a single grant of at least the extension min grant. The end time is capped
+// by the theorem max total period from the theorem submission and is never moved earlier.
+func (k Keeper) ExtendTheoremByGrant(ctx context.Context, theoremID uint64, grant sdk.Coins) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	minGrant := sdk.NewCoins(params.TheoremExtensionMinGrant...)
+	if minGrant.Empty() || !grant.IsAllGTE(minGrant) {
+		return nil
+	}
+
+	theorem, err := k.Theorems.Get(ctx, theoremID)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	endTime := sdkCtx.BlockTime().Add(*params.TheoremMaxProofPeriod)
+	if maxEndTime := theorem.SubmitTime.Add(*params.TheoremMaxTotalPeriod); endTime.After(maxEndTime) {
+		endTime = maxEndTime
+	}
+	if !endTime.After(*theorem.EndTime) {
+		return nil
+	}
+
+	if err = k.ActiveTheoremsQueue.Remove(ctx, collections.Join(*theorem.EndTime, theorem.Id)); err != nil {
+		return err
+	}
+	if err = k.ActiveTheoremsQueue.Set(ctx, collections.Join(endTime, theorem.Id), theorem.Id); err != nil {
+		return err
+	}
+	theorem.EndTime = &endTime
+	if err = k.Theorems.Set(ctx, theorem.Id, theorem); err != nil {
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExtendTheorem,
+			sdk.NewAttribute(types.AttributeKeyTheoremID, fmt.Sprintf("%d", theorem.Id)),
+			sdk.NewAttribute(types.AttributeKeyEndTime, endTime.String()),
+		),
+	)
+
+	return nil
+}
+
+// IndexTheoremImports records a theorem as a dependent of each theorem it imports.
+func (k Keeper) IndexTheoremImports(ctx context.Context, theorem types.Theorem) error {
+	for _, importID := range theorem.Imports {
+		if err := k.TheoremDependents.Set(ctx, collections.Join(importID, theorem.Id)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetTheoremByCodeHash returns the theorem indexed by the hash of its normalized code.
+func (k Keeper) GetTheoremByCodeHash(ctx context.Context, codeHash string) (types.Theorem, error) {
+	theoremID, err := k.TheoremCodeHashes.Get(ctx, codeHash)
+	if err != nil {
+		return types.Theorem{}, err
+	}
+	return k.Theorems.Get(ctx, theoremID)
+}
+
+// CheckDuplicateTheorem returns an error with the id of the existing theorem if a theorem with the
+// same normalized code is in proof period, as a duplicate would split its grants.
+func (k Keeper) CheckDuplicateTheorem(ctx context.Context, code string) error {
+	theorem, err := k.GetTheoremByCodeHash(ctx, types.TheoremCodeHash(code))
+	if errors.IsOf(err, collections.ErrNotFound) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if theorem.Status == types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD {
+		return errors.Wrapf(types.ErrTheoremDuplicate, "same code as theorem %d", theorem.Id)
+	}
+	return nil
+}
+
+// IndexTheoremCode indexes a theorem by the hash of its normalized code. A theorem in proof period
+// keeps the index, otherwise it is taken over by the newly indexed theorem.
+func (k Keeper) IndexTheoremCode(ctx context.Context, theorem types.Theorem) error {
+	codeHash := types.TheoremCodeHash(theorem.Code)
+	indexed, err := k.GetTheoremByCodeHash(ctx, codeHash)
+	switch {
+	case err == nil:
+		if indexed.Id != theorem.Id && indexed.Status == types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD {
+			return nil
+		}
+	case !errors.IsOf(err, collections.ErrNotFound):
+		return err
+	}
+	return k.TheoremCodeHashes.Set(ctx, codeHash, theorem.Id)
+}
+
+// GetTheoremDependencies returns the theorems imported directly or indirectly by a theorem, in
+// ascending order.
+func (k Keeper) GetTheoremDependencies(ctx context.Context, theoremID uint64) ([]uint64, error) {
+	theorem, err := k.Theorems.Get(ctx, theoremID)
+	if err != nil {
+		return nil, err
+	}
+	closure, err := k.importClosure(ctx, theorem.Imports)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencies := make([]uint64, 0, len(closure))
+	for id := range closure {
+		dependencies = append(dependencies, id)
+	}
+	slices.Sort(dependencies)
+	return dependencies, nil
+}
+
+// InferTheoremType returns the type shared by the imported theorems with a declared type, or
+// unspecified when none of them has one. Imports of different types cannot be mixed.
+func (k Keeper) InferTheoremType(ctx context.Context, imports []uint64) (types.TheoremType, error) {
+	theoremType := types.TheoremType_THEOREM_TYPE_UNSPECIFIED
+	for _, id := range imports {
+		imported, err := k.Theorems.Get(ctx, id)
+		if err != nil {
+			return theoremType, err
+		}
+		if imported.TheoremType == types.TheoremType_THEOREM_TYPE_UNSPECIFIED {
+			continue
+		}
+		if theoremType != types.TheoremType_THEOREM_TYPE_UNSPECIFIED && imported.TheoremType != theoremType {
+			return types.TheoremType_THEOREM_TYPE_UNSPECIFIED, errors.Wrapf(types.ErrTheoremTypeMismatch,
+				"imported theorem %d is %s, other imports are %s", id, imported.TheoremType, theoremType)
+		}
+		theoremType = imported.TheoremType
+	}
+	return theoremType, nil
+}
+
+// ValidateImportsAcyclic checks that a theorem importing the given theorems does not close a cycle
+// in the theorem import graph, i.e. that none of the imports depends on the theorem itself.
+func (k Keeper) ValidateImportsAcyclic(ctx context.Context, theoremID uint64, imports []uint64) error {
+	closure, err := k.importClosure(ctx, imports)
+	if err != nil {
+		return err
+	}
+	if closure[theoremID] {
+		return errors.Wrapf(types.ErrTheoremImportCycle, "theorem %d is a dependency of its imports", theoremID)
+	}
+	return nil
+}
+
+// importClosure returns the given theorems and every theorem they import transitively.
+func (k Keeper) importClosure(ctx context.Context, roots []uint64) (map[uint64]bool, error) {
+	visited := make(map[uint64]bool)
+	pending := slices.Clone(roots)
+	for len(pending) > 0 {
+		id := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+		if visited[id] {
+			continue
+		}
+		visited[id] = true
+
+		theorem, err := k.Theorems.Get(ctx, id)
+		if err != nil {
+			if errors.IsOf(err, collections.ErrNotFound) {
+				return nil, errors.Wrapf(types.ErrInvalidContent, "imported theorem %d doesn't exist", id)
+			}
+			return nil, err
+		}
+		pending = append(pending, theorem.Imports...)
+	}
+	return visited, nil
+}
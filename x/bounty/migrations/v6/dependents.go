@@ -0,0 +1,38 @@
+package v6
+
+import (
+	"cosmossdk.io/collections"
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// buildTheoremDependents builds the reverse import index of the theorems from their recorded imports.
+func buildTheoremDependents(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	theorems := collections.NewMap(sb, types.TheoremKeyPrefix, "theorems", collections.Uint64Key, codec.CollValue[types.Theorem](cdc))
+	theoremDependents := collections.NewKeySet(sb, types.TheoremDependentKey, "theorem_dependents", collections.PairKeyCodec(collections.Uint64Key, collections.Uint64Key))
+
+	var edges []collections.Pair[uint64, uint64]
+	err := theorems.Walk(ctx, nil, func(id uint64, theorem types.Theorem) (bool, error) {
+		for _, importID := range theorem.Imports {
+			edges = append(edges, collections.Join(importID, id))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, edge := range edges {
+		if err = theoremDependents.Set(ctx, edge); err != nil {
+			return err
+		}
+	}
+
+	ctx.Logger().Info("migrated bounty theorem imports v6->v7", "imports", len(edges))
+	return nil
+}
@@ -0,0 +1,21 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// migrateDisputeParams sets the finding dispute window param to its default value.
+func migrateDisputeParams(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("migrating bounty dispute params v6->v7")
+	return updateParams(ctx, storeService, cdc, func(params *types.Params) {
+		if params.DisputeWindow == nil {
+			disputeWindow := types.DefaultDisputeWindow
+			params.DisputeWindow = &disputeWindow
+		}
+	})
+}
@@ -0,0 +1,27 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// migrateForfeitureParams sets the proof deposit forfeiture params to their default values.
+func migrateForfeitureParams(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("migrating bounty proof deposit forfeiture params v6->v7")
+	return updateParams(ctx, storeService, cdc, func(params *types.Params) {
+		defaults := types.DefaultParams()
+		if params.ProofDepositSlashFraction.IsNil() {
+			params.ProofDepositSlashFraction = defaults.ProofDepositSlashFraction
+		}
+		if params.ForfeitedDepositCheckerShare.IsNil() {
+			params.ForfeitedDepositCheckerShare = defaults.ForfeitedDepositCheckerShare
+		}
+		if params.ForfeitedDepositGrantShare.IsNil() {
+			params.ForfeitedDepositGrantShare = defaults.ForfeitedDepositGrantShare
+		}
+	})
+}
@@ -0,0 +1,21 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// migrateGrantWithdrawalParams sets the grant withdrawal penalty param to its default value.
+func migrateGrantWithdrawalParams(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("migrating bounty grant withdrawal penalty param v6->v7")
+	return updateParams(ctx, storeService, cdc, func(params *types.Params) {
+		defaults := types.DefaultParams()
+		if params.GrantWithdrawalPenalty.IsNil() {
+			params.GrantWithdrawalPenalty = defaults.GrantWithdrawalPenalty
+		}
+	})
+}
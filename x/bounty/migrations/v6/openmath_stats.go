@@ -0,0 +1,84 @@
+package v6
+
+import (
+	"maps"
+	"slices"
+
+	"cosmossdk.io/collections"
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// buildOpenMathStats backfills the OpenMath statistics and leaderboards from the passed proofs: the
+// theorems proven by each prover and theorem type. Failed proofs are not kept in the store, and
+// checks and rewards are only counted from this upgrade on.
+func buildOpenMathStats(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	theorems := collections.NewMap(sb, types.TheoremKeyPrefix, "theorems", collections.Uint64Key, codec.CollValue[types.Theorem](cdc))
+	proofs := collections.NewMap(sb, types.ProofKeyPrefix, "proofs", collections.StringKey, codec.CollValue[types.Proof](cdc))
+	openMathStats := collections.NewMap(sb, types.OpenMathStatsKeyPrefix, "openmath_stats", sdk.AccAddressKey, codec.CollValue[types.OpenMathStats](cdc))
+	theoremTypeStats := collections.NewMap(sb, types.TheoremTypeStatsKeyPrefix, "theorem_type_stats", collections.Int32Key, codec.CollValue[types.TheoremTypeStats](cdc))
+	leaderboards := collections.NewKeySet(sb, types.LeaderboardKeyPrefix, "leaderboards", collections.PairKeyCodec(collections.StringKey, collections.PairKeyCodec(collections.Uint64Key, sdk.AccAddressKey)))
+
+	var passed []types.Proof
+	err := proofs.Walk(ctx, nil, func(_ string, proof types.Proof) (bool, error) {
+		if proof.Status == types.ProofStatus_PROOF_STATUS_PASSED {
+			passed = append(passed, proof)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	addrStats := make(map[string]*types.OpenMathStats)
+	typeStats := make(map[types.TheoremType]*types.TheoremTypeStats)
+	for _, proof := range passed {
+		theorem, err := theorems.Get(ctx, proof.TheoremId)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := addrStats[proof.Prover]; !ok {
+			stats := types.NewOpenMathStats(proof.Prover)
+			addrStats[proof.Prover] = &stats
+		}
+		addrStats[proof.Prover].TheoremsProven++
+		if _, ok := typeStats[theorem.TheoremType]; !ok {
+			stats := types.NewTheoremTypeStats(theorem.TheoremType)
+			typeStats[theorem.TheoremType] = &stats
+		}
+		typeStats[theorem.TheoremType].TheoremsProven++
+	}
+
+	for _, address := range slices.Sorted(maps.Keys(addrStats)) {
+		addr, err := sdk.AccAddressFromBech32(address)
+		if err != nil {
+			return err
+		}
+		stats := addrStats[address]
+		if err = openMathStats.Set(ctx, addr, *stats); err != nil {
+			return err
+		}
+
+		scores := stats.LeaderboardScores()
+		for _, board := range slices.Sorted(maps.Keys(scores)) {
+			if err = leaderboards.Set(ctx, collections.Join(board, collections.Join(scores[board], addr))); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, theoremType := range slices.Sorted(maps.Keys(typeStats)) {
+		if err = theoremTypeStats.Set(ctx, int32(theoremType), *typeStats[theoremType]); err != nil {
+			return err
+		}
+	}
+
+	ctx.Logger().Info("migrated bounty openmath statistics v6->v7", "proofs", len(passed), "addresses", len(addrStats))
+	return nil
+}
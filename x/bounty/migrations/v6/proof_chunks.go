@@ -0,0 +1,21 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// migrateProofChunkParams sets the proof chunk deposit param to its default value.
+func migrateProofChunkParams(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("migrating bounty proof chunk deposit param v6->v7")
+	return updateParams(ctx, storeService, cdc, func(params *types.Params) {
+		defaults := types.DefaultParams()
+		if params.ProofChunkDeposit == nil {
+			params.ProofChunkDeposit = defaults.ProofChunkDeposit
+		}
+	})
+}
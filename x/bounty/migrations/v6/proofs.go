@@ -0,0 +1,162 @@
+package v6
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"cosmossdk.io/collections"
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// migrateProofIndexes numbers the proofs of every theorem in order of submission, re-keys the
+// proofs by theorem index by that sequence, and drops the proof values stored in the active
+// proofs queue.
+func migrateProofIndexes(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	oldSb := collections.NewSchemaBuilder(storeService)
+	oldProofsByTheorem := collections.NewMap(oldSb, types.ProofByTheoremPrefix, "proofs_by_theorem", collections.PairKeyCodec(collections.Uint64Key, collections.StringKey), collections.BytesValue)
+	oldActiveProofsQueue := collections.NewMap(oldSb, types.ActiveProofQueueKey, "active_proofs_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey), codec.CollValue[types.Proof](cdc))
+
+	sb := collections.NewSchemaBuilder(storeService)
+	theorems := collections.NewMap(sb, types.TheoremKeyPrefix, "theorems", collections.Uint64Key, codec.CollValue[types.Theorem](cdc))
+	proofs := collections.NewMap(sb, types.ProofKeyPrefix, "proofs", collections.StringKey, codec.CollValue[types.Proof](cdc))
+	proofsByTheorem := collections.NewKeySet(sb, types.ProofByTheoremPrefix, "proofs_by_theorem", collections.TripleKeyCodec(collections.Uint64Key, collections.Uint64Key, collections.StringKey))
+	activeProofsQueue := collections.NewKeySet(sb, types.ActiveProofQueueKey, "active_proofs_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey))
+
+	var oldIndexKeys []collections.Pair[uint64, string]
+	err := oldProofsByTheorem.Walk(ctx, nil, func(key collections.Pair[uint64, string], _ []byte) (bool, error) {
+		oldIndexKeys = append(oldIndexKeys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var queueKeys []collections.Pair[time.Time, string]
+	err = oldActiveProofsQueue.Walk(ctx, nil, func(key collections.Pair[time.Time, string], _ types.Proof) (bool, error) {
+		queueKeys = append(queueKeys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	theoremProofs := make(map[uint64][]types.Proof)
+	for _, key := range oldIndexKeys {
+		if err = oldProofsByTheorem.Remove(ctx, key); err != nil {
+			return err
+		}
+		proof, err := proofs.Get(ctx, key.K2())
+		if err != nil {
+			return err
+		}
+		theoremProofs[proof.TheoremId] = append(theoremProofs[proof.TheoremId], proof)
+	}
+
+	for _, theoremID := range slices.Sorted(maps.Keys(theoremProofs)) {
+		theoremProofs := theoremProofs[theoremID]
+		slices.SortFunc(theoremProofs, func(a, b types.Proof) int {
+			if c := submittedAt(a).Compare(submittedAt(b)); c != 0 {
+				return c
+			}
+			return strings.Compare(a.Id, b.Id)
+		})
+
+		for i, proof := range theoremProofs {
+			proof.Sequence = uint64(i + 1)
+			if err = proofs.Set(ctx, proof.Id, proof); err != nil {
+				return err
+			}
+			if err = proofsByTheorem.Set(ctx, collections.Join3(theoremID, proof.Sequence, proof.Id)); err != nil {
+				return err
+			}
+		}
+
+		theorem, err := theorems.Get(ctx, theoremID)
+		if err != nil {
+			return err
+		}
+		theorem.ProofSequence = uint64(len(theoremProofs))
+		if err = theorems.Set(ctx, theoremID, theorem); err != nil {
+			return err
+		}
+	}
+
+	for _, key := range queueKeys {
+		if err = activeProofsQueue.Set(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	ctx.Logger().Info("migrated bounty proof indexes v6->v7", "proofs", len(oldIndexKeys), "active_proofs", len(queueKeys))
+	return nil
+}
+
+// migrateProofVerificationParams sets the proof verification period param to its default value.
+func migrateProofVerificationParams(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("migrating bounty proof verification params v6->v7")
+	return updateParams(ctx, storeService, cdc, func(params *types.Params) {
+		if params.ProofVerificationPeriod == nil {
+			params.ProofVerificationPeriod = types.DefaultParams().ProofVerificationPeriod
+		}
+	})
+}
+
+// queueRevealedProofs gives the proofs revealed before the upgrade a full verification period from the
+// upgrade time and queues them for expiration, so that undecided proofs no longer keep their theorem
+// alive forever.
+func queueRevealedProofs(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsItem := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+	proofs := collections.NewMap(sb, types.ProofKeyPrefix, "proofs", collections.StringKey, codec.CollValue[types.Proof](cdc))
+	activeProofsQueue := collections.NewKeySet(sb, types.ActiveProofQueueKey, "active_proofs_queue", collections.PairKeyCodec(sdk.TimeKey, collections.StringKey))
+
+	params, err := paramsItem.Get(ctx)
+	if err != nil {
+		return err
+	}
+	endTime := ctx.BlockTime().Add(*params.ProofVerificationPeriod)
+
+	var revealed []types.Proof
+	err = proofs.Walk(ctx, nil, func(_ string, proof types.Proof) (bool, error) {
+		if proof.Status == types.ProofStatus_PROOF_STATUS_HASH_DETAIL_PERIOD {
+			revealed = append(revealed, proof)
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, proof := range revealed {
+		if proof.EndTime != nil {
+			if err = activeProofsQueue.Remove(ctx, collections.Join(*proof.EndTime, proof.Id)); err != nil {
+				return err
+			}
+		}
+		proof.EndTime = &endTime
+		if err = proofs.Set(ctx, proof.Id, proof); err != nil {
+			return err
+		}
+		if err = activeProofsQueue.Set(ctx, collections.Join(endTime, proof.Id)); err != nil {
+			return err
+		}
+	}
+
+	ctx.Logger().Info("queued revealed bounty proofs v6->v7", "proofs", len(revealed))
+	return nil
+}
+
+// submittedAt returns the submit time of a proof, or the zero time for proofs stored without one.
+func submittedAt(proof types.Proof) time.Time {
+	if proof.SubmitTime == nil {
+		return time.Time{}
+	}
+	return *proof.SubmitTime
+}
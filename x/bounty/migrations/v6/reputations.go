@@ -0,0 +1,60 @@
+package v6
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+
+	"cosmossdk.io/collections"
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// buildHackerReputations builds the hacker reputation records from the existing findings.
+func buildHackerReputations(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	findings := collections.NewMap(sb, types.FindingKeyPrefix, "findings", collections.StringKey, codec.CollValue[types.Finding](cdc))
+	hackers := collections.NewMap(sb, types.HackerKeyPrefix, "hacker_reputations", sdk.AccAddressKey, codec.CollValue[types.HackerReputation](cdc))
+
+	reputations := make(map[string]*types.HackerReputation)
+	err := findings.Walk(ctx, nil, func(_ string, finding types.Finding) (bool, error) {
+		reputation, ok := reputations[finding.SubmitterAddress]
+		if !ok {
+			newReputation := types.NewHackerReputation(finding.SubmitterAddress)
+			reputation = &newReputation
+			reputations[finding.SubmitterAddress] = reputation
+		}
+
+		reputation.TotalPaid = reputation.TotalPaid.Add(finding.Reward...)
+		switch finding.Status {
+		case types.FindingStatusConfirmed:
+			reputation.AddConfirmed(finding.SeverityLevel)
+		case types.FindingStatusPaid:
+			reputation.AddConfirmed(finding.SeverityLevel)
+			reputation.PaidFindings++
+		case types.FindingStatusClosed:
+			reputation.ClosedFindings++
+		}
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, submitter := range slices.Sorted(maps.Keys(reputations)) {
+		hacker, err := sdk.AccAddressFromBech32(submitter)
+		if err != nil {
+			return fmt.Errorf("invalid submitter address %s: %w", submitter, err)
+		}
+		if err = hackers.Set(ctx, hacker, *reputations[submitter]); err != nil {
+			return err
+		}
+	}
+
+	ctx.Logger().Info("migrated bounty hacker reputations v6->v7", "hackers", len(reputations))
+	return nil
+}
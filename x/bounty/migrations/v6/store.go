@@ -0,0 +1,58 @@
+package v6
+
+import (
+	"fmt"
+
+	"cosmossdk.io/collections"
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// migrationStep migrates the part of the bounty module state changed by one feature.
+type migrationStep func(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error
+
+// MigrateStore migrates the bounty module state from version 6 to version 7.
+// It runs the migration step of every feature that changed the store since version 6, in the
+// order they were added. The secondary indexes of the programs and findings are rebuilt by the
+// keeper afterwards.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	steps := []migrationStep{
+		migrateDisputeParams,
+		buildHackerReputations,
+		migrateProofIndexes,
+		migrateProofVerificationParams,
+		queueRevealedProofs,
+		migrateQuorumParams,
+		migrateForfeitureParams,
+		migrateGrantWithdrawalParams,
+		buildTheoremDependents,
+		inferTheoremTypes,
+		migrateTheoremExtensionParams,
+		migrateProofChunkParams,
+		migrateVestingParams,
+		buildOpenMathStats,
+	}
+	for _, step := range steps {
+		if err := step(ctx, storeService, cdc); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// updateParams applies update to the stored params.
+func updateParams(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec, update func(*types.Params)) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	paramsItem := collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc))
+
+	params, err := paramsItem.Get(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get params: %w", err)
+	}
+	update(&params)
+	return paramsItem.Set(ctx, params)
+}
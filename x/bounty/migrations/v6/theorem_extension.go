@@ -0,0 +1,29 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// migrateTheoremExtensionParams sets the theorem extension params to their default values, the max
+// total period being no shorter than the max proof period.
+func migrateTheoremExtensionParams(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("migrating bounty theorem extension params v6->v7")
+	return updateParams(ctx, storeService, cdc, func(params *types.Params) {
+		defaults := types.DefaultParams()
+		if params.TheoremMaxTotalPeriod == nil {
+			theoremMaxTotalPeriod := *defaults.TheoremMaxTotalPeriod
+			if params.TheoremMaxProofPeriod != nil && *params.TheoremMaxProofPeriod > theoremMaxTotalPeriod {
+				theoremMaxTotalPeriod = *params.TheoremMaxProofPeriod
+			}
+			params.TheoremMaxTotalPeriod = &theoremMaxTotalPeriod
+		}
+		if params.TheoremExtensionMinGrant == nil {
+			params.TheoremExtensionMinGrant = defaults.TheoremExtensionMinGrant
+		}
+	})
+}
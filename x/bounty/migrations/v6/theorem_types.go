@@ -0,0 +1,119 @@
+package v6
+
+import (
+	"strings"
+
+	"cosmossdk.io/collections"
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+var (
+	// rocqMarkers are line prefixes specific to Rocq (Coq) sources.
+	rocqMarkers = []string{"Require ", "From ", "Theorem ", "Lemma ", "Proof.", "Qed.", "Admitted.", "Definition ", "Fixpoint ", "Inductive "}
+	// leanMarkers are line prefixes specific to Lean sources.
+	leanMarkers = []string{"import ", "open ", "theorem ", "lemma ", "def ", "example ", "namespace ", "#eval", "#check"}
+)
+
+// inferTheoremTypes sets the type of the existing theorems, inferred from their code or else from
+// the type shared by their recorded imports. Theorems whose type cannot be inferred are left
+// unspecified, verifications of those theorems are rejected and their grants are refunded when
+// they expire.
+func inferTheoremTypes(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	theorems := collections.NewMap(sb, types.TheoremKeyPrefix, "theorems", collections.Uint64Key, codec.CollValue[types.Theorem](cdc))
+
+	var all []types.Theorem
+	err := theorems.Walk(ctx, nil, func(_ uint64, theorem types.Theorem) (bool, error) {
+		all = append(all, theorem)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	theoremTypes := make(map[uint64]types.TheoremType, len(all))
+	for _, theorem := range all {
+		theoremTypes[theorem.Id] = theorem.TheoremType
+		if theorem.TheoremType == types.TheoremType_THEOREM_TYPE_UNSPECIFIED {
+			theoremTypes[theorem.Id] = inferTheoremType(theorem.Code)
+		}
+	}
+	for _, theorem := range all {
+		if theoremTypes[theorem.Id] == types.TheoremType_THEOREM_TYPE_UNSPECIFIED {
+			theoremTypes[theorem.Id] = importsTheoremType(theorem.Imports, theoremTypes)
+		}
+	}
+
+	inferred, unspecified := 0, 0
+	for _, theorem := range all {
+		theoremType := theoremTypes[theorem.Id]
+		if theoremType == types.TheoremType_THEOREM_TYPE_UNSPECIFIED {
+			unspecified++
+			continue
+		}
+		if theoremType == theorem.TheoremType {
+			continue
+		}
+		theorem.TheoremType = theoremType
+		if err = theorems.Set(ctx, theorem.Id, theorem); err != nil {
+			return err
+		}
+		inferred++
+	}
+
+	ctx.Logger().Info("migrated bounty theorem types v6->v7", "inferred", inferred, "unspecified", unspecified)
+	return nil
+}
+
+// inferTheoremType guesses the language of theorem code from the line prefixes specific to Rocq
+// and Lean, and returns unspecified when neither language dominates.
+func inferTheoremType(code string) types.TheoremType {
+	rocq, lean := 0, 0
+	for _, line := range strings.Split(code, "\n") {
+		line = strings.TrimSpace(line)
+		for _, marker := range rocqMarkers {
+			if strings.HasPrefix(line, marker) {
+				rocq++
+			}
+		}
+		for _, marker := range leanMarkers {
+			if strings.HasPrefix(line, marker) {
+				lean++
+			}
+		}
+		if strings.Contains(line, ":= by") {
+			lean++
+		}
+	}
+
+	switch {
+	case rocq > lean:
+		return types.TheoremType_THEOREM_TYPE_ROCQ
+	case lean > rocq:
+		return types.TheoremType_THEOREM_TYPE_LEAN
+	default:
+		return types.TheoremType_THEOREM_TYPE_UNSPECIFIED
+	}
+}
+
+// importsTheoremType returns the type shared by the imports with a known type, or unspecified when
+// none of them has one or their types differ.
+func importsTheoremType(imports []uint64, theoremTypes map[uint64]types.TheoremType) types.TheoremType {
+	theoremType := types.TheoremType_THEOREM_TYPE_UNSPECIFIED
+	for _, id := range imports {
+		importType := theoremTypes[id]
+		if importType == types.TheoremType_THEOREM_TYPE_UNSPECIFIED {
+			continue
+		}
+		if theoremType != types.TheoremType_THEOREM_TYPE_UNSPECIFIED && importType != theoremType {
+			return types.TheoremType_THEOREM_TYPE_UNSPECIFIED
+		}
+		theoremType = importType
+	}
+	return theoremType
+}
@@ -0,0 +1,21 @@
+package v6
+
+import (
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// migrateVestingParams sets the reward vesting period param to its default value. The reward vesting
+// threshold is left empty, which keeps the vesting of rewards disabled.
+func migrateVestingParams(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	ctx.Logger().Info("migrating bounty reward vesting params v6->v7")
+	return updateParams(ctx, storeService, cdc, func(params *types.Params) {
+		if params.RewardVestingPeriod == nil {
+			params.RewardVestingPeriod = types.DefaultParams().RewardVestingPeriod
+		}
+	})
+}
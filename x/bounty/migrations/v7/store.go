@@ -0,0 +1,42 @@
+package v7
+
+import (
+	"cosmossdk.io/collections"
+	corestoretypes "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/shentufoundation/shentu/v2/x/bounty/types"
+)
+
+// MigrateStore migrates the bounty module state from version 7 to version 8.
+// It indexes the existing theorems by the hash of their normalized code. When several theorems
+// share a code, the oldest theorem in proof period keeps the index, otherwise the newest theorem.
+// Duplicates created before the index are left untouched.
+func MigrateStore(ctx sdk.Context, storeService corestoretypes.KVStoreService, cdc codec.BinaryCodec) error {
+	sb := collections.NewSchemaBuilder(storeService)
+	theorems := collections.NewMap(sb, types.TheoremKeyPrefix, "theorems", collections.Uint64Key, codec.CollValue[types.Theorem](cdc))
+	theoremCodeHashes := collections.NewMap(sb, types.TheoremCodeHashKey, "theorem_code_hashes", collections.StringKey, collections.Uint64Value)
+
+	indexed := make(map[string]types.Theorem)
+	total, duplicates := 0, 0
+	err := theorems.Walk(ctx, nil, func(_ uint64, theorem types.Theorem) (bool, error) {
+		total++
+		codeHash := types.TheoremCodeHash(theorem.Code)
+		if existing, ok := indexed[codeHash]; ok {
+			duplicates++
+			if existing.Status == types.TheoremStatus_THEOREM_STATUS_PROOF_PERIOD {
+				return false, nil
+			}
+		}
+		indexed[codeHash] = theorem
+		return false, theoremCodeHashes.Set(ctx, codeHash, theorem.Id)
+	})
+	if err != nil {
+		return err
+	}
+
+	ctx.Logger().Info("migrated bounty theorem code hashes v7->v8", "theorems", total, "duplicates", duplicates)
+	return nil
+}
@@ -22,7 +22,7 @@ import (
 	"github.com/shentufoundation/shentu/v2/x/bounty/types"
 )
 
-const ConsensusVersion = 6
+const ConsensusVersion = 8
 
 var (
 	_ module.AppModuleBasic      = AppModuleBasic{}
@@ -145,6 +145,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err != nil {
 		panic(fmt.Sprintf("failed to migrate x/bounty from version 5 to 6: %v", err))
 	}
+	err = cfg.RegisterMigration(types.ModuleName, 6, m.Migrate6to7)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bounty from version 6 to 7: %v", err))
+	}
+	err = cfg.RegisterMigration(types.ModuleName, 7, m.Migrate7to8)
+	if err != nil {
+		panic(fmt.Sprintf("failed to migrate x/bounty from version 7 to 8: %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the bounty module. It returns
@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
@@ -60,6 +61,70 @@ func (ProgramStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_36e6d679af1b94c6, []int{0}
 }
 
+type AssetType int32
+
+const (
+	AssetTypeUnspecified AssetType = 0
+	// a smart contract address on a chain.
+	AssetTypeContract AssetType = 1
+	// a git repository at a commit.
+	AssetTypeRepository AssetType = 2
+	// a domain name.
+	AssetTypeDomain AssetType = 3
+)
+
+var AssetType_name = map[int32]string{
+	0: "ASSET_TYPE_UNSPECIFIED",
+	1: "ASSET_TYPE_CONTRACT",
+	2: "ASSET_TYPE_REPOSITORY",
+	3: "ASSET_TYPE_DOMAIN",
+}
+
+var AssetType_value = map[string]int32{
+	"ASSET_TYPE_UNSPECIFIED": 0,
+	"ASSET_TYPE_CONTRACT":    1,
+	"ASSET_TYPE_REPOSITORY":  2,
+	"ASSET_TYPE_DOMAIN":      3,
+}
+
+func (x AssetType) String() string {
+	return proto.EnumName(AssetType_name, int32(x))
+}
+
+func (AssetType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{1}
+}
+
+type ProgramRole int32
+
+const (
+	ProgramRoleUnspecified ProgramRole = 0
+	// triagers can activate and close findings.
+	ProgramRoleTriager ProgramRole = 1
+	// admins can additionally confirm and pay findings.
+	ProgramRoleAdmin ProgramRole = 2
+)
+
+var ProgramRole_name = map[int32]string{
+	0: "PROGRAM_ROLE_UNSPECIFIED",
+	1: "PROGRAM_ROLE_TRIAGER",
+	2: "PROGRAM_ROLE_ADMIN",
+}
+
+var ProgramRole_value = map[string]int32{
+	"PROGRAM_ROLE_UNSPECIFIED": 0,
+	"PROGRAM_ROLE_TRIAGER":     1,
+	"PROGRAM_ROLE_ADMIN":       2,
+}
+
+func (x ProgramRole) String() string {
+	return proto.EnumName(ProgramRole_name, int32(x))
+}
+
+func (ProgramRole) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{2}
+}
+
 type SeverityLevel int32
 
 const (
@@ -94,7 +159,7 @@ func (x SeverityLevel) String() string {
 }
 
 func (SeverityLevel) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{1}
+	return fileDescriptor_36e6d679af1b94c6, []int{3}
 }
 
 type FindingStatus int32
@@ -105,6 +170,12 @@ const (
 	FindingStatusConfirmed FindingStatus = 2
 	FindingStatusPaid      FindingStatus = 3
 	FindingStatusClosed    FindingStatus = 4
+	// a closed finding whose closure is being arbitrated by bounty admins.
+	FindingStatusDisputed FindingStatus = 5
+	// a finding reporting the same issue as an earlier finding of the program.
+	FindingStatusDuplicate FindingStatus = 6
+	// a finding the program team did not handle within its SLA, pending bounty admin review.
+	FindingStatusEscalated FindingStatus = 7
 )
 
 var FindingStatus_name = map[int32]string{
@@ -113,6 +184,9 @@ var FindingStatus_name = map[int32]string{
 	2: "FINDING_STATUS_CONFIRMED",
 	3: "FINDING_STATUS_PAID",
 	4: "FINDING_STATUS_CLOSED",
+	5: "FINDING_STATUS_DISPUTED",
+	6: "FINDING_STATUS_DUPLICATE",
+	7: "FINDING_STATUS_ESCALATED",
 }
 
 var FindingStatus_value = map[string]int32{
@@ -121,6 +195,9 @@ var FindingStatus_value = map[string]int32{
 	"FINDING_STATUS_CONFIRMED": 2,
 	"FINDING_STATUS_PAID":      3,
 	"FINDING_STATUS_CLOSED":    4,
+	"FINDING_STATUS_DISPUTED":  5,
+	"FINDING_STATUS_DUPLICATE": 6,
+	"FINDING_STATUS_ESCALATED": 7,
 }
 
 func (x FindingStatus) String() string {
@@ -128,7 +205,95 @@ func (x FindingStatus) String() string {
 }
 
 func (FindingStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{2}
+	return fileDescriptor_36e6d679af1b94c6, []int{4}
+}
+
+type DuplicatePolicy int32
+
+const (
+	DuplicatePolicyUnspecified DuplicatePolicy = 0
+	// only the submitter of the original finding is paid.
+	DuplicatePolicyFirstReporter DuplicatePolicy = 1
+	// the reward is split equally between the original finding and its duplicates.
+	DuplicatePolicyEqualSplit DuplicatePolicy = 2
+)
+
+var DuplicatePolicy_name = map[int32]string{
+	0: "DUPLICATE_POLICY_UNSPECIFIED",
+	1: "DUPLICATE_POLICY_FIRST_REPORTER",
+	2: "DUPLICATE_POLICY_EQUAL_SPLIT",
+}
+
+var DuplicatePolicy_value = map[string]int32{
+	"DUPLICATE_POLICY_UNSPECIFIED":    0,
+	"DUPLICATE_POLICY_FIRST_REPORTER": 1,
+	"DUPLICATE_POLICY_EQUAL_SPLIT":    2,
+}
+
+func (x DuplicatePolicy) String() string {
+	return proto.EnumName(DuplicatePolicy_name, int32(x))
+}
+
+func (DuplicatePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{5}
+}
+
+type DisputeStatus int32
+
+const (
+	DisputeStatusVoting DisputeStatus = 0
+	// the closure of the finding was upheld.
+	DisputeStatusUpheld DisputeStatus = 1
+	// the closure of the finding was overturned and the finding reactivated.
+	DisputeStatusOverturned DisputeStatus = 2
+)
+
+var DisputeStatus_name = map[int32]string{
+	0: "DISPUTE_STATUS_VOTING",
+	1: "DISPUTE_STATUS_UPHELD",
+	2: "DISPUTE_STATUS_OVERTURNED",
+}
+
+var DisputeStatus_value = map[string]int32{
+	"DISPUTE_STATUS_VOTING":     0,
+	"DISPUTE_STATUS_UPHELD":     1,
+	"DISPUTE_STATUS_OVERTURNED": 2,
+}
+
+func (x DisputeStatus) String() string {
+	return proto.EnumName(DisputeStatus_name, int32(x))
+}
+
+func (DisputeStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{6}
+}
+
+type DisputeVoteOption int32
+
+const (
+	DisputeVoteOptionUnspecified DisputeVoteOption = 0
+	DisputeVoteOptionUphold      DisputeVoteOption = 1
+	DisputeVoteOptionOverturn    DisputeVoteOption = 2
+)
+
+var DisputeVoteOption_name = map[int32]string{
+	0: "DISPUTE_VOTE_OPTION_UNSPECIFIED",
+	1: "DISPUTE_VOTE_OPTION_UPHOLD",
+	2: "DISPUTE_VOTE_OPTION_OVERTURN",
+}
+
+var DisputeVoteOption_value = map[string]int32{
+	"DISPUTE_VOTE_OPTION_UNSPECIFIED": 0,
+	"DISPUTE_VOTE_OPTION_UPHOLD":      1,
+	"DISPUTE_VOTE_OPTION_OVERTURN":    2,
+}
+
+func (x DisputeVoteOption) String() string {
+	return proto.EnumName(DisputeVoteOption_name, int32(x))
+}
+
+func (DisputeVoteOption) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{7}
 }
 
 type TheoremStatus int32
@@ -163,7 +328,7 @@ func (x TheoremStatus) String() string {
 }
 
 func (TheoremStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{3}
+	return fileDescriptor_36e6d679af1b94c6, []int{8}
 }
 
 type ProofStatus int32
@@ -206,7 +371,7 @@ func (x ProofStatus) String() string {
 }
 
 func (ProofStatus) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{4}
+	return fileDescriptor_36e6d679af1b94c6, []int{9}
 }
 
 type TheoremType int32
@@ -237,7 +402,81 @@ func (x TheoremType) String() string {
 }
 
 func (TheoremType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{5}
+	return fileDescriptor_36e6d679af1b94c6, []int{10}
+}
+
+type GrantSource int32
+
+const (
+	// granted by the grantor account.
+	GrantSource_GRANT_SOURCE_ACCOUNT GrantSource = 0
+	// granted by governance from the community pool.
+	GrantSource_GRANT_SOURCE_COMMUNITY_POOL GrantSource = 1
+)
+
+var GrantSource_name = map[int32]string{
+	0: "GRANT_SOURCE_ACCOUNT",
+	1: "GRANT_SOURCE_COMMUNITY_POOL",
+}
+
+var GrantSource_value = map[string]int32{
+	"GRANT_SOURCE_ACCOUNT":        0,
+	"GRANT_SOURCE_COMMUNITY_POOL": 1,
+}
+
+func (x GrantSource) String() string {
+	return proto.EnumName(GrantSource_name, int32(x))
+}
+
+func (GrantSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{11}
+}
+
+// LeaderboardMetric defines the metric an OpenMath leaderboard is sorted by.
+type LeaderboardMetric int32
+
+const (
+	LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED LeaderboardMetric = 0
+	// theorems proven.
+	LeaderboardMetric_LEADERBOARD_METRIC_THEOREMS_PROVEN LeaderboardMetric = 1
+	// proofs checked.
+	LeaderboardMetric_LEADERBOARD_METRIC_PROOFS_CHECKED LeaderboardMetric = 2
+	// prover rewards in a denom.
+	LeaderboardMetric_LEADERBOARD_METRIC_PROVER_REWARDS LeaderboardMetric = 3
+	// checker rewards in a denom.
+	LeaderboardMetric_LEADERBOARD_METRIC_CHECKER_REWARDS LeaderboardMetric = 4
+	// imported rewards in a denom.
+	LeaderboardMetric_LEADERBOARD_METRIC_IMPORTED_REWARDS LeaderboardMetric = 5
+	// prover, checker and imported rewards in a denom.
+	LeaderboardMetric_LEADERBOARD_METRIC_TOTAL_REWARDS LeaderboardMetric = 6
+)
+
+var LeaderboardMetric_name = map[int32]string{
+	0: "LEADERBOARD_METRIC_UNSPECIFIED",
+	1: "LEADERBOARD_METRIC_THEOREMS_PROVEN",
+	2: "LEADERBOARD_METRIC_PROOFS_CHECKED",
+	3: "LEADERBOARD_METRIC_PROVER_REWARDS",
+	4: "LEADERBOARD_METRIC_CHECKER_REWARDS",
+	5: "LEADERBOARD_METRIC_IMPORTED_REWARDS",
+	6: "LEADERBOARD_METRIC_TOTAL_REWARDS",
+}
+
+var LeaderboardMetric_value = map[string]int32{
+	"LEADERBOARD_METRIC_UNSPECIFIED":      0,
+	"LEADERBOARD_METRIC_THEOREMS_PROVEN":  1,
+	"LEADERBOARD_METRIC_PROOFS_CHECKED":   2,
+	"LEADERBOARD_METRIC_PROVER_REWARDS":   3,
+	"LEADERBOARD_METRIC_CHECKER_REWARDS":  4,
+	"LEADERBOARD_METRIC_IMPORTED_REWARDS": 5,
+	"LEADERBOARD_METRIC_TOTAL_REWARDS":    6,
+}
+
+func (x LeaderboardMetric) String() string {
+	return proto.EnumName(LeaderboardMetric_name, int32(x))
+}
+
+func (LeaderboardMetric) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{12}
 }
 
 type Program struct {
@@ -248,6 +487,26 @@ type Program struct {
 	AdminAddress string        `protobuf:"bytes,4,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty" yaml:"admin_address"`
 	Status       ProgramStatus `protobuf:"varint,5,opt,name=status,proto3,enum=shentu.bounty.v1.ProgramStatus" json:"status,omitempty" yaml:"status"`
 	CreateTime   time.Time     `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time" yaml:"create_time"`
+	// reward_pool is the escrow locked in the bounty module account to pay confirmed findings.
+	RewardPool []types1.Coin `protobuf:"bytes,7,rep,name=reward_pool,json=rewardPool,proto3" json:"reward_pool" yaml:"reward_pool"`
+	// reward_schedule defines the payout for each severity level.
+	RewardSchedule []SeverityReward `protobuf:"bytes,8,rep,name=reward_schedule,json=rewardSchedule,proto3" json:"reward_schedule" yaml:"reward_schedule"`
+	// critical_approvals is the number of distinct program admins that must confirm
+	// a critical finding. Zero or one means a single admin confirmation is enough.
+	CriticalApprovals uint32 `protobuf:"varint,9,opt,name=critical_approvals,json=criticalApprovals,proto3" json:"critical_approvals,omitempty" yaml:"critical_approvals"`
+	// duplicate_policy defines how the reward of a paid finding is shared with its duplicates.
+	DuplicatePolicy DuplicatePolicy `protobuf:"varint,10,opt,name=duplicate_policy,json=duplicatePolicy,proto3,enum=shentu.bounty.v1.DuplicatePolicy" json:"duplicate_policy,omitempty" yaml:"duplicate_policy"`
+	// activation_sla is the time the program team has to activate or close a submitted finding.
+	ActivationSla *time.Duration `protobuf:"bytes,11,opt,name=activation_sla,json=activationSla,proto3,stdduration" json:"activation_sla,omitempty" yaml:"activation_sla"`
+	// confirmation_sla is the time the program team has to confirm or close an active finding.
+	ConfirmationSla *time.Duration `protobuf:"bytes,12,opt,name=confirmation_sla,json=confirmationSla,proto3,stdduration" json:"confirmation_sla,omitempty" yaml:"confirmation_sla"`
+	// scope lists the assets of the program. Findings of a program with a scope must name an in-scope target.
+	Scope []ScopeTarget `protobuf:"bytes,13,rep,name=scope,proto3" json:"scope" yaml:"scope"`
+	// submission_requirements restricts who can submit findings to the program.
+	SubmissionRequirements SubmissionRequirements `protobuf:"bytes,14,opt,name=submission_requirements,json=submissionRequirements,proto3" json:"submission_requirements" yaml:"submission_requirements"`
+	// disclosure_embargo is how long a paid or closed finding stays confidential before either the
+	// submitter or the program admin can publish it. No embargo when unset.
+	DisclosureEmbargo *time.Duration `protobuf:"bytes,15,opt,name=disclosure_embargo,json=disclosureEmbargo,proto3,stdduration" json:"disclosure_embargo,omitempty" yaml:"disclosure_embargo"`
 }
 
 func (m *Program) Reset()         { *m = Program{} }
@@ -283,35 +542,26 @@ func (m *Program) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Program proto.InternalMessageInfo
 
-type Finding struct {
-	ProgramId      string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
-	FindingId      string `protobuf:"bytes,2,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty" yaml:"finding_id"`
-	Title          string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	Description    string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
-	ProofOfConcept string `protobuf:"bytes,5,opt,name=proof_of_concept,json=proofOfConcept,proto3" json:"proof_of_concept,omitempty" yaml:"proof_of_concept"`
-	// hash(description + proof_of_concept + submitter)
-	FindingHash      string        `protobuf:"bytes,6,opt,name=finding_hash,json=findingHash,proto3" json:"finding_hash,omitempty" yaml:"finding_hash"`
-	SubmitterAddress string        `protobuf:"bytes,7,opt,name=submitter_address,json=submitterAddress,proto3" json:"submitter_address,omitempty" yaml:"submitter_address"`
-	SeverityLevel    SeverityLevel `protobuf:"varint,8,opt,name=severity_level,json=severityLevel,proto3,enum=shentu.bounty.v1.SeverityLevel" json:"severity_level,omitempty" yaml:"severity_level"`
-	Status           FindingStatus `protobuf:"varint,9,opt,name=status,proto3,enum=shentu.bounty.v1.FindingStatus" json:"status,omitempty" yaml:"status"`
-	// JSON by FindingDetail
-	Detail      string    `protobuf:"bytes,10,opt,name=detail,proto3" json:"detail,omitempty" yaml:"detail"`
-	PaymentHash string    `protobuf:"bytes,11,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty" yaml:"payment_hash"`
-	CreateTime  time.Time `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time" yaml:"create_time"`
+// SubmissionRequirements defines what a hacker needs to submit findings to a program.
+type SubmissionRequirements struct {
+	// min_confirmed_findings is the number of findings of the hacker that must have been confirmed.
+	MinConfirmedFindings uint64 `protobuf:"varint,1,opt,name=min_confirmed_findings,json=minConfirmedFindings,proto3" json:"min_confirmed_findings,omitempty" yaml:"min_confirmed_findings"`
+	// require_identity_cert requires the hacker to hold an identity certificate.
+	RequireIdentityCert bool `protobuf:"varint,2,opt,name=require_identity_cert,json=requireIdentityCert,proto3" json:"require_identity_cert,omitempty" yaml:"require_identity_cert"`
 }
 
-func (m *Finding) Reset()         { *m = Finding{} }
-func (m *Finding) String() string { return proto.CompactTextString(m) }
-func (*Finding) ProtoMessage()    {}
-func (*Finding) Descriptor() ([]byte, []int) {
+func (m *SubmissionRequirements) Reset()         { *m = SubmissionRequirements{} }
+func (m *SubmissionRequirements) String() string { return proto.CompactTextString(m) }
+func (*SubmissionRequirements) ProtoMessage()    {}
+func (*SubmissionRequirements) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36e6d679af1b94c6, []int{1}
 }
-func (m *Finding) XXX_Unmarshal(b []byte) error {
+func (m *SubmissionRequirements) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Finding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SubmissionRequirements) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Finding.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SubmissionRequirements.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -321,39 +571,46 @@ func (m *Finding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Finding) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Finding.Merge(m, src)
+func (m *SubmissionRequirements) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmissionRequirements.Merge(m, src)
 }
-func (m *Finding) XXX_Size() int {
+func (m *SubmissionRequirements) XXX_Size() int {
 	return m.Size()
 }
-func (m *Finding) XXX_DiscardUnknown() {
-	xxx_messageInfo_Finding.DiscardUnknown(m)
+func (m *SubmissionRequirements) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmissionRequirements.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Finding proto.InternalMessageInfo
+var xxx_messageInfo_SubmissionRequirements proto.InternalMessageInfo
 
-type ProgramFingerprint struct {
-	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"id" yaml:"id"`
-	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
-	// JSON by ProgramDetail
-	Detail       string        `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty" yaml:"detail"`
-	AdminAddress string        `protobuf:"bytes,4,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty" yaml:"admin_address"`
-	Status       ProgramStatus `protobuf:"varint,5,opt,name=status,proto3,enum=shentu.bounty.v1.ProgramStatus" json:"status,omitempty" yaml:"status"`
+// ScopeTarget defines an asset listed in the scope of a program.
+type ScopeTarget struct {
+	// target_id identifies the target within the program.
+	TargetId  string    `protobuf:"bytes,1,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty" yaml:"target_id"`
+	AssetType AssetType `protobuf:"varint,2,opt,name=asset_type,json=assetType,proto3,enum=shentu.bounty.v1.AssetType" json:"asset_type,omitempty" yaml:"asset_type"`
+	// location is the contract address, the git repository url or the domain name of the asset.
+	Location string `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty" yaml:"location"`
+	// chain_id is the chain of a contract.
+	ChainId string `protobuf:"bytes,4,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty" yaml:"chain_id"`
+	// commit is the audited commit of a git repository.
+	Commit  string `protobuf:"bytes,5,opt,name=commit,proto3" json:"commit,omitempty" yaml:"commit"`
+	InScope bool   `protobuf:"varint,6,opt,name=in_scope,json=inScope,proto3" json:"in_scope,omitempty" yaml:"in_scope"`
+	// max_severity is the highest severity a finding on this target can be reported with, unlimited when unspecified.
+	MaxSeverity SeverityLevel `protobuf:"varint,7,opt,name=max_severity,json=maxSeverity,proto3,enum=shentu.bounty.v1.SeverityLevel" json:"max_severity,omitempty" yaml:"max_severity"`
 }
 
-func (m *ProgramFingerprint) Reset()         { *m = ProgramFingerprint{} }
-func (m *ProgramFingerprint) String() string { return proto.CompactTextString(m) }
-func (*ProgramFingerprint) ProtoMessage()    {}
-func (*ProgramFingerprint) Descriptor() ([]byte, []int) {
+func (m *ScopeTarget) Reset()         { *m = ScopeTarget{} }
+func (m *ScopeTarget) String() string { return proto.CompactTextString(m) }
+func (*ScopeTarget) ProtoMessage()    {}
+func (*ScopeTarget) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36e6d679af1b94c6, []int{2}
 }
-func (m *ProgramFingerprint) XXX_Unmarshal(b []byte) error {
+func (m *ScopeTarget) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProgramFingerprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ScopeTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProgramFingerprint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ScopeTarget.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -363,43 +620,37 @@ func (m *ProgramFingerprint) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *ProgramFingerprint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProgramFingerprint.Merge(m, src)
+func (m *ScopeTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScopeTarget.Merge(m, src)
 }
-func (m *ProgramFingerprint) XXX_Size() int {
+func (m *ScopeTarget) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProgramFingerprint) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProgramFingerprint.DiscardUnknown(m)
+func (m *ScopeTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScopeTarget.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProgramFingerprint proto.InternalMessageInfo
+var xxx_messageInfo_ScopeTarget proto.InternalMessageInfo
 
-type FindingFingerprint struct {
-	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
-	FindingId string `protobuf:"bytes,2,opt,name=finding_id,json=findingId,proto3" json:"id" yaml:"id"`
-	Title     string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
-	// hash(description + proof_of_concept + submitter)
-	FindingHash   string        `protobuf:"bytes,4,opt,name=finding_hash,json=findingHash,proto3" json:"finding_hash,omitempty" yaml:"finding_hash"`
-	SeverityLevel SeverityLevel `protobuf:"varint,5,opt,name=severity_level,json=severityLevel,proto3,enum=shentu.bounty.v1.SeverityLevel" json:"severity_level,omitempty" yaml:"severity_level"`
-	Status        FindingStatus `protobuf:"varint,6,opt,name=status,proto3,enum=shentu.bounty.v1.FindingStatus" json:"status,omitempty" yaml:"status"`
-	// JSON by FindingDetail
-	Detail      string `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty" yaml:"detail"`
-	PaymentHash string `protobuf:"bytes,8,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty" yaml:"payment_hash"`
+// ProgramMember defines a member of a program team and its role.
+type ProgramMember struct {
+	ProgramId string      `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
+	Address   string      `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	Role      ProgramRole `protobuf:"varint,3,opt,name=role,proto3,enum=shentu.bounty.v1.ProgramRole" json:"role,omitempty" yaml:"role"`
 }
 
-func (m *FindingFingerprint) Reset()         { *m = FindingFingerprint{} }
-func (m *FindingFingerprint) String() string { return proto.CompactTextString(m) }
-func (*FindingFingerprint) ProtoMessage()    {}
-func (*FindingFingerprint) Descriptor() ([]byte, []int) {
+func (m *ProgramMember) Reset()         { *m = ProgramMember{} }
+func (m *ProgramMember) String() string { return proto.CompactTextString(m) }
+func (*ProgramMember) ProtoMessage()    {}
+func (*ProgramMember) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36e6d679af1b94c6, []int{3}
 }
-func (m *FindingFingerprint) XXX_Unmarshal(b []byte) error {
+func (m *ProgramMember) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *FindingFingerprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ProgramMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_FindingFingerprint.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ProgramMember.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -409,59 +660,38 @@ func (m *FindingFingerprint) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *FindingFingerprint) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FindingFingerprint.Merge(m, src)
+func (m *ProgramMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProgramMember.Merge(m, src)
 }
-func (m *FindingFingerprint) XXX_Size() int {
+func (m *ProgramMember) XXX_Size() int {
 	return m.Size()
 }
-func (m *FindingFingerprint) XXX_DiscardUnknown() {
-	xxx_messageInfo_FindingFingerprint.DiscardUnknown(m)
+func (m *ProgramMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProgramMember.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FindingFingerprint proto.InternalMessageInfo
+var xxx_messageInfo_ProgramMember proto.InternalMessageInfo
 
-// Theorem defines the core field members of an openmath theorem.
-type Theorem struct {
-	// id defines the unique id of the theorem.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	// title is the title of the theorem
-	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	// description is the description of the theorem
-	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	// code is a summary of the theorem
-	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
-	// status defines the theorem status.
-	Status TheoremStatus `protobuf:"varint,5,opt,name=status,proto3,enum=shentu.bounty.v1.TheoremStatus" json:"status,omitempty"`
-	// submit_time is the time of theorem submission.
-	SubmitTime *time.Time `protobuf:"bytes,6,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
-	EndTime    *time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
-	// total_grant is the total grant on the theorem.
-	TotalGrant []types1.Coin `protobuf:"bytes,8,rep,name=total_grant,json=totalGrant,proto3" json:"total_grant"`
-	// proposer is the address of the theorem submitter
-	Proposer string `protobuf:"bytes,9,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	// proof term complexity.
-	Complexity int64 `protobuf:"varint,10,opt,name=complexity,proto3" json:"complexity,omitempty"`
-	// the total number of times the theorem has been imported.
-	ImportedCount int64 `protobuf:"varint,11,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
-	// a list of import external theorems.
-	Imports []uint64 `protobuf:"varint,12,rep,packed,name=imports,proto3" json:"imports,omitempty"`
-	// require_openmath_cert gates proof submission to provers with an OpenMath certificate.
-	RequireOpenmathCert bool `protobuf:"varint,13,opt,name=require_openmath_cert,json=requireOpenmathCert,proto3" json:"require_openmath_cert,omitempty"`
+// SeverityReward defines the payout range of a program for findings of one severity level.
+// A fixed payout sets min_amount equal to max_amount.
+type SeverityReward struct {
+	SeverityLevel SeverityLevel `protobuf:"varint,1,opt,name=severity_level,json=severityLevel,proto3,enum=shentu.bounty.v1.SeverityLevel" json:"severity_level,omitempty" yaml:"severity_level"`
+	MinAmount     []types1.Coin `protobuf:"bytes,2,rep,name=min_amount,json=minAmount,proto3" json:"min_amount" yaml:"min_amount"`
+	MaxAmount     []types1.Coin `protobuf:"bytes,3,rep,name=max_amount,json=maxAmount,proto3" json:"max_amount" yaml:"max_amount"`
 }
 
-func (m *Theorem) Reset()         { *m = Theorem{} }
-func (m *Theorem) String() string { return proto.CompactTextString(m) }
-func (*Theorem) ProtoMessage()    {}
-func (*Theorem) Descriptor() ([]byte, []int) {
+func (m *SeverityReward) Reset()         { *m = SeverityReward{} }
+func (m *SeverityReward) String() string { return proto.CompactTextString(m) }
+func (*SeverityReward) ProtoMessage()    {}
+func (*SeverityReward) Descriptor() ([]byte, []int) {
 	return fileDescriptor_36e6d679af1b94c6, []int{4}
 }
-func (m *Theorem) XXX_Unmarshal(b []byte) error {
+func (m *SeverityReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Theorem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *SeverityReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Theorem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_SeverityReward.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -471,139 +701,174 @@ func (m *Theorem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Theorem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Theorem.Merge(m, src)
+func (m *SeverityReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeverityReward.Merge(m, src)
 }
-func (m *Theorem) XXX_Size() int {
+func (m *SeverityReward) XXX_Size() int {
 	return m.Size()
 }
-func (m *Theorem) XXX_DiscardUnknown() {
-	xxx_messageInfo_Theorem.DiscardUnknown(m)
+func (m *SeverityReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeverityReward.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Theorem proto.InternalMessageInfo
+var xxx_messageInfo_SeverityReward proto.InternalMessageInfo
 
-func (m *Theorem) GetId() uint64 {
-	if m != nil {
-		return m.Id
-	}
-	return 0
+// EncryptedFindingPayload defines the confidential report of a finding encrypted
+// with a random content key (AES-256-GCM), and the content key wrapped to the
+// secp256k1 public key (ECIES) of every recipient.
+type EncryptedFindingPayload struct {
+	// keys are the content key wrapped for each recipient.
+	Keys []FindingPayloadKey `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys"`
+	// ciphertext is the nonce followed by the sealed report.
+	Ciphertext []byte `protobuf:"bytes,2,opt,name=ciphertext,proto3" json:"ciphertext,omitempty"`
 }
 
-func (m *Theorem) GetTitle() string {
-	if m != nil {
-		return m.Title
-	}
-	return ""
+func (m *EncryptedFindingPayload) Reset()         { *m = EncryptedFindingPayload{} }
+func (m *EncryptedFindingPayload) String() string { return proto.CompactTextString(m) }
+func (*EncryptedFindingPayload) ProtoMessage()    {}
+func (*EncryptedFindingPayload) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{5}
 }
-
-func (m *Theorem) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
+func (m *EncryptedFindingPayload) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Theorem) GetCode() string {
-	if m != nil {
-		return m.Code
+func (m *EncryptedFindingPayload) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EncryptedFindingPayload.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return ""
 }
-
-func (m *Theorem) GetStatus() TheoremStatus {
-	if m != nil {
-		return m.Status
-	}
-	return TheoremStatus_THEOREM_STATUS_UNSPECIFIED
+func (m *EncryptedFindingPayload) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EncryptedFindingPayload.Merge(m, src)
+}
+func (m *EncryptedFindingPayload) XXX_Size() int {
+	return m.Size()
+}
+func (m *EncryptedFindingPayload) XXX_DiscardUnknown() {
+	xxx_messageInfo_EncryptedFindingPayload.DiscardUnknown(m)
 }
 
-func (m *Theorem) GetSubmitTime() *time.Time {
+var xxx_messageInfo_EncryptedFindingPayload proto.InternalMessageInfo
+
+func (m *EncryptedFindingPayload) GetKeys() []FindingPayloadKey {
 	if m != nil {
-		return m.SubmitTime
+		return m.Keys
 	}
 	return nil
 }
 
-func (m *Theorem) GetEndTime() *time.Time {
+func (m *EncryptedFindingPayload) GetCiphertext() []byte {
 	if m != nil {
-		return m.EndTime
+		return m.Ciphertext
 	}
 	return nil
 }
 
-func (m *Theorem) GetTotalGrant() []types1.Coin {
-	if m != nil {
-		return m.TotalGrant
-	}
-	return nil
+// FindingPayloadKey defines the content key of an encrypted finding payload
+// wrapped to the public key of a recipient.
+type FindingPayloadKey struct {
+	Recipient  string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	WrappedKey []byte `protobuf:"bytes,2,opt,name=wrapped_key,json=wrappedKey,proto3" json:"wrapped_key,omitempty"`
 }
 
-func (m *Theorem) GetProposer() string {
-	if m != nil {
-		return m.Proposer
-	}
-	return ""
+func (m *FindingPayloadKey) Reset()         { *m = FindingPayloadKey{} }
+func (m *FindingPayloadKey) String() string { return proto.CompactTextString(m) }
+func (*FindingPayloadKey) ProtoMessage()    {}
+func (*FindingPayloadKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{6}
 }
-
-func (m *Theorem) GetComplexity() int64 {
-	if m != nil {
-		return m.Complexity
-	}
-	return 0
+func (m *FindingPayloadKey) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Theorem) GetImportedCount() int64 {
-	if m != nil {
-		return m.ImportedCount
+func (m *FindingPayloadKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindingPayloadKey.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return 0
+}
+func (m *FindingPayloadKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindingPayloadKey.Merge(m, src)
+}
+func (m *FindingPayloadKey) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindingPayloadKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindingPayloadKey.DiscardUnknown(m)
 }
 
-func (m *Theorem) GetImports() []uint64 {
+var xxx_messageInfo_FindingPayloadKey proto.InternalMessageInfo
+
+func (m *FindingPayloadKey) GetRecipient() string {
 	if m != nil {
-		return m.Imports
+		return m.Recipient
 	}
-	return nil
+	return ""
 }
 
-func (m *Theorem) GetRequireOpenmathCert() bool {
+func (m *FindingPayloadKey) GetWrappedKey() []byte {
 	if m != nil {
-		return m.RequireOpenmathCert
+		return m.WrappedKey
 	}
-	return false
+	return nil
 }
 
-type Proof struct {
-	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
-	// id defines the unique id of the proof.
-	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
-	// detail is the proof data attached to the theorem stored in ipfs
-	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
-	// status defines the theorem status.
-	Status ProofStatus `protobuf:"varint,4,opt,name=status,proto3,enum=shentu.bounty.v1.ProofStatus" json:"status,omitempty"`
-	// submit_time is the time of proof submission.
-	SubmitTime *time.Time `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
-	// end_time is the time of proof timeout.
-	EndTime *time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
-	// prover is the address of the proof submitter
-	Prover string `protobuf:"bytes,7,opt,name=prover,proto3" json:"prover,omitempty"`
-	// deposit is the amount deposited by the prover
-	Deposit []types1.Coin `protobuf:"bytes,8,rep,name=Deposit,proto3" json:"Deposit"`
+type Finding struct {
+	ProgramId      string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
+	FindingId      string `protobuf:"bytes,2,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty" yaml:"finding_id"`
+	Title          string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	Description    string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	ProofOfConcept string `protobuf:"bytes,5,opt,name=proof_of_concept,json=proofOfConcept,proto3" json:"proof_of_concept,omitempty" yaml:"proof_of_concept"`
+	// hash(description + proof_of_concept + submitter)
+	FindingHash      string        `protobuf:"bytes,6,opt,name=finding_hash,json=findingHash,proto3" json:"finding_hash,omitempty" yaml:"finding_hash"`
+	SubmitterAddress string        `protobuf:"bytes,7,opt,name=submitter_address,json=submitterAddress,proto3" json:"submitter_address,omitempty" yaml:"submitter_address"`
+	SeverityLevel    SeverityLevel `protobuf:"varint,8,opt,name=severity_level,json=severityLevel,proto3,enum=shentu.bounty.v1.SeverityLevel" json:"severity_level,omitempty" yaml:"severity_level"`
+	Status           FindingStatus `protobuf:"varint,9,opt,name=status,proto3,enum=shentu.bounty.v1.FindingStatus" json:"status,omitempty" yaml:"status"`
+	// JSON by FindingDetail
+	Detail      string    `protobuf:"bytes,10,opt,name=detail,proto3" json:"detail,omitempty" yaml:"detail"`
+	PaymentHash string    `protobuf:"bytes,11,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty" yaml:"payment_hash"`
+	CreateTime  time.Time `protobuf:"bytes,12,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time" yaml:"create_time"`
+	// reward is the amount paid to the submitter from the program reward pool.
+	Reward []types1.Coin `protobuf:"bytes,13,rep,name=reward,proto3" json:"reward" yaml:"reward"`
+	// encrypted_payload is the confidential report encrypted to the program
+	// admin and team members.
+	EncryptedPayload *EncryptedFindingPayload `protobuf:"bytes,14,opt,name=encrypted_payload,json=encryptedPayload,proto3" json:"encrypted_payload,omitempty" yaml:"encrypted_payload"`
+	// duplicate_of is the id of the original finding when this finding is a duplicate, kept when the
+	// duplicate is paid a share of the reward of the original finding.
+	DuplicateOf string `protobuf:"bytes,15,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty" yaml:"duplicate_of"`
+	// sla_deadline is when the finding is escalated to bounty admins unless the program team handles it.
+	SlaDeadline *time.Time `protobuf:"bytes,16,opt,name=sla_deadline,json=slaDeadline,proto3,stdtime" json:"sla_deadline,omitempty" yaml:"sla_deadline"`
+	// target_id is the in-scope target of the program the finding was reported on.
+	TargetId string `protobuf:"bytes,17,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty" yaml:"target_id"`
+	// disclosure_deadline is when the disclosure embargo of a paid or closed finding ends.
+	DisclosureDeadline *time.Time `protobuf:"bytes,18,opt,name=disclosure_deadline,json=disclosureDeadline,proto3,stdtime" json:"disclosure_deadline,omitempty" yaml:"disclosure_deadline"`
+	// disclosure_overdue is set once the embargo ended without the finding being published.
+	DisclosureOverdue bool `protobuf:"varint,19,opt,name=disclosure_overdue,json=disclosureOverdue,proto3" json:"disclosure_overdue,omitempty" yaml:"disclosure_overdue"`
 }
 
-func (m *Proof) Reset()         { *m = Proof{} }
-func (m *Proof) String() string { return proto.CompactTextString(m) }
-func (*Proof) ProtoMessage()    {}
-func (*Proof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{5}
+func (m *Finding) Reset()         { *m = Finding{} }
+func (m *Finding) String() string { return proto.CompactTextString(m) }
+func (*Finding) ProtoMessage()    {}
+func (*Finding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{7}
 }
-func (m *Proof) XXX_Unmarshal(b []byte) error {
+func (m *Finding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Proof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Finding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Proof.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Finding.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -613,92 +878,87 @@ func (m *Proof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Proof) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Proof.Merge(m, src)
+func (m *Finding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Finding.Merge(m, src)
 }
-func (m *Proof) XXX_Size() int {
+func (m *Finding) XXX_Size() int {
 	return m.Size()
 }
-func (m *Proof) XXX_DiscardUnknown() {
-	xxx_messageInfo_Proof.DiscardUnknown(m)
+func (m *Finding) XXX_DiscardUnknown() {
+	xxx_messageInfo_Finding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Proof proto.InternalMessageInfo
-
-func (m *Proof) GetTheoremId() uint64 {
-	if m != nil {
-		return m.TheoremId
-	}
-	return 0
-}
+var xxx_messageInfo_Finding proto.InternalMessageInfo
 
-func (m *Proof) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+type ProgramFingerprint struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"id" yaml:"id"`
+	Name      string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty" yaml:"name"`
+	// JSON by ProgramDetail
+	Detail         string           `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty" yaml:"detail"`
+	AdminAddress   string           `protobuf:"bytes,4,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty" yaml:"admin_address"`
+	Status         ProgramStatus    `protobuf:"varint,5,opt,name=status,proto3,enum=shentu.bounty.v1.ProgramStatus" json:"status,omitempty" yaml:"status"`
+	RewardSchedule []SeverityReward `protobuf:"bytes,6,rep,name=reward_schedule,json=rewardSchedule,proto3" json:"reward_schedule" yaml:"reward_schedule"`
 }
 
-func (m *Proof) GetDetail() string {
-	if m != nil {
-		return m.Detail
-	}
-	return ""
+func (m *ProgramFingerprint) Reset()         { *m = ProgramFingerprint{} }
+func (m *ProgramFingerprint) String() string { return proto.CompactTextString(m) }
+func (*ProgramFingerprint) ProtoMessage()    {}
+func (*ProgramFingerprint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{8}
 }
-
-func (m *Proof) GetStatus() ProofStatus {
-	if m != nil {
-		return m.Status
-	}
-	return ProofStatus_PROOF_STATUS_UNSPECIFIED
+func (m *ProgramFingerprint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Proof) GetSubmitTime() *time.Time {
-	if m != nil {
-		return m.SubmitTime
+func (m *ProgramFingerprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProgramFingerprint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *Proof) GetEndTime() *time.Time {
-	if m != nil {
-		return m.EndTime
-	}
-	return nil
+func (m *ProgramFingerprint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProgramFingerprint.Merge(m, src)
 }
-
-func (m *Proof) GetProver() string {
-	if m != nil {
-		return m.Prover
-	}
-	return ""
+func (m *ProgramFingerprint) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *Proof) GetDeposit() []types1.Coin {
-	if m != nil {
-		return m.Deposit
-	}
-	return nil
+func (m *ProgramFingerprint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProgramFingerprint.DiscardUnknown(m)
 }
 
-type ProofHash struct {
-	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
-	Detail    string `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
-	Prover    string `protobuf:"bytes,3,opt,name=prover,proto3" json:"prover,omitempty"`
-}
+var xxx_messageInfo_ProgramFingerprint proto.InternalMessageInfo
 
-func (m *ProofHash) Reset()         { *m = ProofHash{} }
-func (m *ProofHash) String() string { return proto.CompactTextString(m) }
-func (*ProofHash) ProtoMessage()    {}
-func (*ProofHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{6}
+// Dispute defines the arbitration of a closed finding by bounty admins.
+type Dispute struct {
+	FindingId       string        `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty" yaml:"finding_id"`
+	ProgramId       string        `protobuf:"bytes,2,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
+	DisputerAddress string        `protobuf:"bytes,3,opt,name=disputer_address,json=disputerAddress,proto3" json:"disputer_address,omitempty" yaml:"disputer_address"`
+	Reason          string        `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	Status          DisputeStatus `protobuf:"varint,5,opt,name=status,proto3,enum=shentu.bounty.v1.DisputeStatus" json:"status,omitempty" yaml:"status"`
+	CreateTime      time.Time     `protobuf:"bytes,6,opt,name=create_time,json=createTime,proto3,stdtime" json:"create_time" yaml:"create_time"`
+	// end_time is when the votes are tallied and the outcome applied.
+	EndTime       time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time" yaml:"end_time"`
+	UpholdVotes   uint64    `protobuf:"varint,8,opt,name=uphold_votes,json=upholdVotes,proto3" json:"uphold_votes,omitempty" yaml:"uphold_votes"`
+	OverturnVotes uint64    `protobuf:"varint,9,opt,name=overturn_votes,json=overturnVotes,proto3" json:"overturn_votes,omitempty" yaml:"overturn_votes"`
+}
+
+func (m *Dispute) Reset()         { *m = Dispute{} }
+func (m *Dispute) String() string { return proto.CompactTextString(m) }
+func (*Dispute) ProtoMessage()    {}
+func (*Dispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{9}
 }
-func (m *ProofHash) XXX_Unmarshal(b []byte) error {
+func (m *Dispute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProofHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Dispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_ProofHash.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Dispute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -708,61 +968,37 @@ func (m *ProofHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *ProofHash) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProofHash.Merge(m, src)
+func (m *Dispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Dispute.Merge(m, src)
 }
-func (m *ProofHash) XXX_Size() int {
+func (m *Dispute) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProofHash) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProofHash.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_ProofHash proto.InternalMessageInfo
-
-func (m *ProofHash) GetTheoremId() uint64 {
-	if m != nil {
-		return m.TheoremId
-	}
-	return 0
-}
-
-func (m *ProofHash) GetDetail() string {
-	if m != nil {
-		return m.Detail
-	}
-	return ""
+func (m *Dispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_Dispute.DiscardUnknown(m)
 }
 
-func (m *ProofHash) GetProver() string {
-	if m != nil {
-		return m.Prover
-	}
-	return ""
-}
+var xxx_messageInfo_Dispute proto.InternalMessageInfo
 
-// Grant defines an amount granted by a grantor to an active theorem.
-type Grant struct {
-	// theorem_id defines the unique id of the theorem.
-	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
-	// grantor defines the grant addresses.
-	Grantor string `protobuf:"bytes,2,opt,name=grantor,proto3" json:"grantor,omitempty"`
-	// amount to be deposited by the grantor.
-	Amount []types1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+// DisputeVote defines the vote of a bounty admin on a dispute.
+type DisputeVote struct {
+	FindingId string            `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty" yaml:"finding_id"`
+	Voter     string            `protobuf:"bytes,2,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
+	Option    DisputeVoteOption `protobuf:"varint,3,opt,name=option,proto3,enum=shentu.bounty.v1.DisputeVoteOption" json:"option,omitempty" yaml:"option"`
 }
 
-func (m *Grant) Reset()         { *m = Grant{} }
-func (m *Grant) String() string { return proto.CompactTextString(m) }
-func (*Grant) ProtoMessage()    {}
-func (*Grant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{7}
+func (m *DisputeVote) Reset()         { *m = DisputeVote{} }
+func (m *DisputeVote) String() string { return proto.CompactTextString(m) }
+func (*DisputeVote) ProtoMessage()    {}
+func (*DisputeVote) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{10}
 }
-func (m *Grant) XXX_Unmarshal(b []byte) error {
+func (m *DisputeVote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Grant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *DisputeVote) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Grant.Marshal(b, m, deterministic)
+		return xxx_messageInfo_DisputeVote.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -772,61 +1008,86 @@ func (m *Grant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Grant) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Grant.Merge(m, src)
+func (m *DisputeVote) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisputeVote.Merge(m, src)
 }
-func (m *Grant) XXX_Size() int {
+func (m *DisputeVote) XXX_Size() int {
 	return m.Size()
 }
-func (m *Grant) XXX_DiscardUnknown() {
-	xxx_messageInfo_Grant.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_Grant proto.InternalMessageInfo
-
-func (m *Grant) GetTheoremId() uint64 {
-	if m != nil {
-		return m.TheoremId
-	}
-	return 0
+func (m *DisputeVote) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisputeVote.DiscardUnknown(m)
 }
 
-func (m *Grant) GetGrantor() string {
-	if m != nil {
-		return m.Grantor
-	}
-	return ""
-}
+var xxx_messageInfo_DisputeVote proto.InternalMessageInfo
 
-func (m *Grant) GetAmount() []types1.Coin {
-	if m != nil {
-		return m.Amount
+type FindingFingerprint struct {
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
+	FindingId string `protobuf:"bytes,2,opt,name=finding_id,json=findingId,proto3" json:"id" yaml:"id"`
+	Title     string `protobuf:"bytes,3,opt,name=title,proto3" json:"title,omitempty"`
+	// hash(description + proof_of_concept + submitter)
+	FindingHash   string        `protobuf:"bytes,4,opt,name=finding_hash,json=findingHash,proto3" json:"finding_hash,omitempty" yaml:"finding_hash"`
+	SeverityLevel SeverityLevel `protobuf:"varint,5,opt,name=severity_level,json=severityLevel,proto3,enum=shentu.bounty.v1.SeverityLevel" json:"severity_level,omitempty" yaml:"severity_level"`
+	Status        FindingStatus `protobuf:"varint,6,opt,name=status,proto3,enum=shentu.bounty.v1.FindingStatus" json:"status,omitempty" yaml:"status"`
+	// JSON by FindingDetail
+	Detail      string `protobuf:"bytes,7,opt,name=detail,proto3" json:"detail,omitempty" yaml:"detail"`
+	PaymentHash string `protobuf:"bytes,8,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty" yaml:"payment_hash"`
+}
+
+func (m *FindingFingerprint) Reset()         { *m = FindingFingerprint{} }
+func (m *FindingFingerprint) String() string { return proto.CompactTextString(m) }
+func (*FindingFingerprint) ProtoMessage()    {}
+func (*FindingFingerprint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{11}
+}
+func (m *FindingFingerprint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FindingFingerprint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FindingFingerprint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
+}
+func (m *FindingFingerprint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FindingFingerprint.Merge(m, src)
+}
+func (m *FindingFingerprint) XXX_Size() int {
+	return m.Size()
+}
+func (m *FindingFingerprint) XXX_DiscardUnknown() {
+	xxx_messageInfo_FindingFingerprint.DiscardUnknown(m)
 }
 
-// Deposit defines an amount deposited by a depositor for a proof.
-type Deposit struct {
-	// proof_id defines the unique id of the proof.
-	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
-	// depositor defines the deposit addresses.
-	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
-	// amount to be deposited by the depositor.
-	Amount []types1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+var xxx_messageInfo_FindingFingerprint proto.InternalMessageInfo
+
+// Sponsorship is the total amount a sponsor deposited into the reward pool of a program.
+type Sponsorship struct {
+	// program_id defines the unique id of the program.
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
+	// sponsor defines the address of the sponsor.
+	Sponsor string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// amount deposited by the sponsor.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *Deposit) Reset()         { *m = Deposit{} }
-func (m *Deposit) String() string { return proto.CompactTextString(m) }
-func (*Deposit) ProtoMessage()    {}
-func (*Deposit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{8}
+func (m *Sponsorship) Reset()         { *m = Sponsorship{} }
+func (m *Sponsorship) String() string { return proto.CompactTextString(m) }
+func (*Sponsorship) ProtoMessage()    {}
+func (*Sponsorship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{12}
 }
-func (m *Deposit) XXX_Unmarshal(b []byte) error {
+func (m *Sponsorship) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Sponsorship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Sponsorship.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -836,70 +1097,64 @@ func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Deposit) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Deposit.Merge(m, src)
+func (m *Sponsorship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsorship.Merge(m, src)
 }
-func (m *Deposit) XXX_Size() int {
+func (m *Sponsorship) XXX_Size() int {
 	return m.Size()
 }
-func (m *Deposit) XXX_DiscardUnknown() {
-	xxx_messageInfo_Deposit.DiscardUnknown(m)
+func (m *Sponsorship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsorship.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Deposit proto.InternalMessageInfo
+var xxx_messageInfo_Sponsorship proto.InternalMessageInfo
 
-func (m *Deposit) GetProofId() string {
+func (m *Sponsorship) GetProgramId() string {
 	if m != nil {
-		return m.ProofId
+		return m.ProgramId
 	}
 	return ""
 }
 
-func (m *Deposit) GetDepositor() string {
+func (m *Sponsorship) GetSponsor() string {
 	if m != nil {
-		return m.Depositor
+		return m.Sponsor
 	}
 	return ""
 }
 
-func (m *Deposit) GetAmount() []types1.Coin {
+func (m *Sponsorship) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
 	if m != nil {
 		return m.Amount
 	}
 	return nil
 }
 
-type Params struct {
-	// Minimum grant for a theorem to enter the proof period.
-	MinGrant []types1.Coin `protobuf:"bytes,1,rep,name=min_grant,json=minGrant,proto3" json:"min_grant"`
-	// Minimum deposit for a proof to enter the proof_hash_lock period.
-	MinDeposit []types1.Coin `protobuf:"bytes,2,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
-	// Duration of the theorem proof period. Initial value: 2 weeks.
-	TheoremMaxProofPeriod *time.Duration `protobuf:"bytes,3,opt,name=theorem_max_proof_period,json=theoremMaxProofPeriod,proto3,stdduration" json:"theorem_max_proof_period,omitempty"`
-	// Duration of the proof max lock period. 10min
-	ProofMaxLockPeriod *time.Duration `protobuf:"bytes,4,opt,name=proof_max_lock_period,json=proofMaxLockPeriod,proto3,stdduration" json:"proof_max_lock_period,omitempty"`
-	// Deprecated: use complexity_fee_rocq / complexity_fee_lean instead.
-	ComplexityFee types1.Coin `protobuf:"bytes,5,opt,name=complexity_fee,json=complexityFee,proto3" json:"complexity_fee"`
-	// Maximum allowed complexity to prevent overflow and ensure reasonable reward calculations.
-	MaxComplexity int64 `protobuf:"varint,6,opt,name=max_complexity,json=maxComplexity,proto3" json:"max_complexity,omitempty"`
-	// Complexity fee for Rocq theorems.
-	ComplexityFeeRocq types1.Coin `protobuf:"bytes,7,opt,name=complexity_fee_rocq,json=complexityFeeRocq,proto3" json:"complexity_fee_rocq"`
-	// Complexity fee for Lean theorems.
-	ComplexityFeeLean types1.Coin `protobuf:"bytes,8,opt,name=complexity_fee_lean,json=complexityFeeLean,proto3" json:"complexity_fee_lean"`
+// HackerReputation is the track record of a finding submitter across all programs.
+type HackerReputation struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty" yaml:"address"`
+	// confirmed counts the confirmed findings of the hacker by severity level.
+	Confirmed []SeverityCount `protobuf:"bytes,2,rep,name=confirmed,proto3" json:"confirmed" yaml:"confirmed"`
+	// paid_findings is the number of findings of the hacker marked as paid.
+	PaidFindings uint64 `protobuf:"varint,3,opt,name=paid_findings,json=paidFindings,proto3" json:"paid_findings,omitempty" yaml:"paid_findings"`
+	// closed_findings is the number of findings of the hacker closed without being confirmed.
+	ClosedFindings uint64 `protobuf:"varint,4,opt,name=closed_findings,json=closedFindings,proto3" json:"closed_findings,omitempty" yaml:"closed_findings"`
+	// total_paid is the sum of the rewards paid to the hacker from program escrows.
+	TotalPaid github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=total_paid,json=totalPaid,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_paid" yaml:"total_paid"`
 }
 
-func (m *Params) Reset()         { *m = Params{} }
-func (m *Params) String() string { return proto.CompactTextString(m) }
-func (*Params) ProtoMessage()    {}
-func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{9}
+func (m *HackerReputation) Reset()         { *m = HackerReputation{} }
+func (m *HackerReputation) String() string { return proto.CompactTextString(m) }
+func (*HackerReputation) ProtoMessage()    {}
+func (*HackerReputation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{13}
 }
-func (m *Params) XXX_Unmarshal(b []byte) error {
+func (m *HackerReputation) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *HackerReputation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+		return xxx_messageInfo_HackerReputation.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -909,91 +1164,119 @@ func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Params) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Params.Merge(m, src)
+func (m *HackerReputation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HackerReputation.Merge(m, src)
 }
-func (m *Params) XXX_Size() int {
+func (m *HackerReputation) XXX_Size() int {
 	return m.Size()
 }
-func (m *Params) XXX_DiscardUnknown() {
-	xxx_messageInfo_Params.DiscardUnknown(m)
+func (m *HackerReputation) XXX_DiscardUnknown() {
+	xxx_messageInfo_HackerReputation.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Params proto.InternalMessageInfo
+var xxx_messageInfo_HackerReputation proto.InternalMessageInfo
 
-func (m *Params) GetMinGrant() []types1.Coin {
-	if m != nil {
-		return m.MinGrant
-	}
-	return nil
+// SeverityCount is the number of findings of a severity level.
+type SeverityCount struct {
+	SeverityLevel SeverityLevel `protobuf:"varint,1,opt,name=severity_level,json=severityLevel,proto3,enum=shentu.bounty.v1.SeverityLevel" json:"severity_level,omitempty" yaml:"severity_level"`
+	Count         uint64        `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *Params) GetMinDeposit() []types1.Coin {
-	if m != nil {
-		return m.MinDeposit
-	}
-	return nil
+func (m *SeverityCount) Reset()         { *m = SeverityCount{} }
+func (m *SeverityCount) String() string { return proto.CompactTextString(m) }
+func (*SeverityCount) ProtoMessage()    {}
+func (*SeverityCount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{14}
 }
-
-func (m *Params) GetTheoremMaxProofPeriod() *time.Duration {
-	if m != nil {
-		return m.TheoremMaxProofPeriod
-	}
-	return nil
+func (m *SeverityCount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *Params) GetProofMaxLockPeriod() *time.Duration {
-	if m != nil {
-		return m.ProofMaxLockPeriod
+func (m *SeverityCount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SeverityCount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return nil
 }
-
-func (m *Params) GetComplexityFee() types1.Coin {
-	if m != nil {
-		return m.ComplexityFee
-	}
-	return types1.Coin{}
+func (m *SeverityCount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SeverityCount.Merge(m, src)
 }
-
-func (m *Params) GetMaxComplexity() int64 {
-	if m != nil {
-		return m.MaxComplexity
-	}
-	return 0
+func (m *SeverityCount) XXX_Size() int {
+	return m.Size()
+}
+func (m *SeverityCount) XXX_DiscardUnknown() {
+	xxx_messageInfo_SeverityCount.DiscardUnknown(m)
 }
 
-func (m *Params) GetComplexityFeeRocq() types1.Coin {
+var xxx_messageInfo_SeverityCount proto.InternalMessageInfo
+
+func (m *SeverityCount) GetSeverityLevel() SeverityLevel {
 	if m != nil {
-		return m.ComplexityFeeRocq
+		return m.SeverityLevel
 	}
-	return types1.Coin{}
+	return Unspecified
 }
 
-func (m *Params) GetComplexityFeeLean() types1.Coin {
+func (m *SeverityCount) GetCount() uint64 {
 	if m != nil {
-		return m.ComplexityFeeLean
+		return m.Count
 	}
-	return types1.Coin{}
+	return 0
 }
 
-type Reward struct {
-	Address string                                      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Reward  github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward"`
+// Theorem defines the core field members of an openmath theorem.
+type Theorem struct {
+	// id defines the unique id of the theorem.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// title is the title of the theorem
+	Title string `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	// description is the description of the theorem
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// code is a summary of the theorem
+	Code string `protobuf:"bytes,4,opt,name=code,proto3" json:"code,omitempty"`
+	// status defines the theorem status.
+	Status TheoremStatus `protobuf:"varint,5,opt,name=status,proto3,enum=shentu.bounty.v1.TheoremStatus" json:"status,omitempty"`
+	// submit_time is the time of theorem submission.
+	SubmitTime *time.Time `protobuf:"bytes,6,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	EndTime    *time.Time `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// total_grant is the total grant on the theorem.
+	TotalGrant []types1.Coin `protobuf:"bytes,8,rep,name=total_grant,json=totalGrant,proto3" json:"total_grant"`
+	// proposer is the address of the theorem submitter
+	Proposer string `protobuf:"bytes,9,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// proof term complexity.
+	Complexity int64 `protobuf:"varint,10,opt,name=complexity,proto3" json:"complexity,omitempty"`
+	// the total number of times the theorem has been imported.
+	ImportedCount int64 `protobuf:"varint,11,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+	// a list of import external theorems.
+	Imports []uint64 `protobuf:"varint,12,rep,packed,name=imports,proto3" json:"imports,omitempty"`
+	// require_openmath_cert gates proof submission to provers with an OpenMath certificate.
+	RequireOpenmathCert bool `protobuf:"varint,13,opt,name=require_openmath_cert,json=requireOpenmathCert,proto3" json:"require_openmath_cert,omitempty"`
+	// forfeited_deposits is the part of total_grant coming from the forfeited deposits of proofs.
+	ForfeitedDeposits []types1.Coin `protobuf:"bytes,14,rep,name=forfeited_deposits,json=forfeitedDeposits,proto3" json:"forfeited_deposits"`
+	// theorem_type is the proof language of the theorem, which every verification must match. Theorems
+	// created before it was declared have the type inferred at the upgrade, or none if it was inconclusive.
+	TheoremType TheoremType `protobuf:"varint,15,opt,name=theorem_type,json=theoremType,proto3,enum=shentu.bounty.v1.TheoremType" json:"theorem_type,omitempty"`
+	// proof_sequence is the number of proofs submitted for the theorem. It numbers its proofs in order of submission.
+	ProofSequence uint64 `protobuf:"varint,16,opt,name=proof_sequence,json=proofSequence,proto3" json:"proof_sequence,omitempty"`
 }
 
-func (m *Reward) Reset()         { *m = Reward{} }
-func (m *Reward) String() string { return proto.CompactTextString(m) }
-func (*Reward) ProtoMessage()    {}
-func (*Reward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_36e6d679af1b94c6, []int{10}
+func (m *Theorem) Reset()         { *m = Theorem{} }
+func (m *Theorem) String() string { return proto.CompactTextString(m) }
+func (*Theorem) ProtoMessage()    {}
+func (*Theorem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{15}
 }
-func (m *Reward) XXX_Unmarshal(b []byte) error {
+func (m *Theorem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Reward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *Theorem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_Reward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_Theorem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1003,1373 +1286,7820 @@ func (m *Reward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *Reward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Reward.Merge(m, src)
+func (m *Theorem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Theorem.Merge(m, src)
 }
-func (m *Reward) XXX_Size() int {
+func (m *Theorem) XXX_Size() int {
 	return m.Size()
 }
-func (m *Reward) XXX_DiscardUnknown() {
-	xxx_messageInfo_Reward.DiscardUnknown(m)
+func (m *Theorem) XXX_DiscardUnknown() {
+	xxx_messageInfo_Theorem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Reward proto.InternalMessageInfo
+var xxx_messageInfo_Theorem proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("shentu.bounty.v1.ProgramStatus", ProgramStatus_name, ProgramStatus_value)
-	proto.RegisterEnum("shentu.bounty.v1.SeverityLevel", SeverityLevel_name, SeverityLevel_value)
-	proto.RegisterEnum("shentu.bounty.v1.FindingStatus", FindingStatus_name, FindingStatus_value)
-	proto.RegisterEnum("shentu.bounty.v1.TheoremStatus", TheoremStatus_name, TheoremStatus_value)
-	proto.RegisterEnum("shentu.bounty.v1.ProofStatus", ProofStatus_name, ProofStatus_value)
-	proto.RegisterEnum("shentu.bounty.v1.TheoremType", TheoremType_name, TheoremType_value)
-	proto.RegisterType((*Program)(nil), "shentu.bounty.v1.Program")
-	proto.RegisterType((*Finding)(nil), "shentu.bounty.v1.Finding")
-	proto.RegisterType((*ProgramFingerprint)(nil), "shentu.bounty.v1.ProgramFingerprint")
-	proto.RegisterType((*FindingFingerprint)(nil), "shentu.bounty.v1.FindingFingerprint")
-	proto.RegisterType((*Theorem)(nil), "shentu.bounty.v1.Theorem")
-	proto.RegisterType((*Proof)(nil), "shentu.bounty.v1.Proof")
-	proto.RegisterType((*ProofHash)(nil), "shentu.bounty.v1.ProofHash")
-	proto.RegisterType((*Grant)(nil), "shentu.bounty.v1.Grant")
-	proto.RegisterType((*Deposit)(nil), "shentu.bounty.v1.Deposit")
-	proto.RegisterType((*Params)(nil), "shentu.bounty.v1.Params")
-	proto.RegisterType((*Reward)(nil), "shentu.bounty.v1.Reward")
+func (m *Theorem) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
 }
 
-func init() { proto.RegisterFile("shentu/bounty/v1/bounty.proto", fileDescriptor_36e6d679af1b94c6) }
-
-var fileDescriptor_36e6d679af1b94c6 = []byte{
-	// 1987 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcd, 0x6f, 0x1b, 0xc7,
-	0x15, 0xd7, 0x92, 0x14, 0x29, 0x0e, 0x45, 0x85, 0x1a, 0x99, 0x11, 0xc5, 0xd8, 0x5c, 0x66, 0x83,
-	0x00, 0x8a, 0x8b, 0x90, 0xb5, 0xe2, 0xa6, 0x86, 0xfb, 0x01, 0xf0, 0x63, 0x65, 0x6d, 0x4d, 0x8a,
-	0xec, 0x92, 0x72, 0xeb, 0xf6, 0xb0, 0x58, 0x71, 0x87, 0xd4, 0xc2, 0xdc, 0x1d, 0x7a, 0x77, 0xa9,
-	0x48, 0xc7, 0x5e, 0x8a, 0x80, 0x27, 0xf7, 0x16, 0x14, 0x20, 0x90, 0xa2, 0x97, 0xa2, 0x40, 0x81,
-	0xa0, 0x68, 0xff, 0x87, 0xf4, 0x16, 0xf4, 0xd4, 0x13, 0x13, 0xd8, 0x87, 0x16, 0x3d, 0x15, 0xbc,
-	0xf4, 0x5a, 0xec, 0xcc, 0x2c, 0xc9, 0x5d, 0x51, 0x95, 0xe4, 0x34, 0xa7, 0x5e, 0xec, 0x9d, 0xf7,
-	0xde, 0xef, 0xcd, 0x9b, 0xf7, 0x7e, 0xef, 0xcd, 0x88, 0xe0, 0x8e, 0x7d, 0x82, 0x4c, 0x67, 0x58,
-	0x3c, 0xc6, 0x43, 0xd3, 0x39, 0x2f, 0x9e, 0xde, 0x63, 0x5f, 0x85, 0x81, 0x85, 0x1d, 0x0c, 0x53,
-	0x54, 0x5d, 0x60, 0xc2, 0xd3, 0x7b, 0xd9, 0x5b, 0x3d, 0xdc, 0xc3, 0x44, 0x59, 0x74, 0xbf, 0xa8,
-	0x5d, 0x96, 0xef, 0x61, 0xdc, 0xeb, 0xa3, 0x22, 0x59, 0x1d, 0x0f, 0xbb, 0x45, 0x47, 0x37, 0x90,
-	0xed, 0xa8, 0xc6, 0x80, 0x19, 0xe4, 0x3a, 0xd8, 0x36, 0xb0, 0x5d, 0x3c, 0x56, 0x6d, 0x54, 0x3c,
-	0xbd, 0x77, 0x8c, 0x1c, 0xf5, 0x5e, 0xb1, 0x83, 0x75, 0x93, 0xe9, 0x77, 0xa8, 0x5e, 0xa1, 0x9e,
-	0xe9, 0xc2, 0x53, 0x05, 0x7d, 0xab, 0xe6, 0xb9, 0xe7, 0x35, 0xa8, 0xd2, 0x86, 0x96, 0xea, 0xe8,
-	0xd8, 0xf3, 0xba, 0xa9, 0x1a, 0xba, 0x89, 0x8b, 0xe4, 0x5f, 0x2a, 0x12, 0x7e, 0x11, 0x06, 0xb1,
-	0xa6, 0x85, 0x7b, 0x96, 0x6a, 0xc0, 0xfb, 0x00, 0x0c, 0xe8, 0xa7, 0xa2, 0x6b, 0x19, 0x2e, 0xcf,
-	0xed, 0xc6, 0xcb, 0xe9, 0xe9, 0x84, 0xdf, 0x3c, 0x57, 0x8d, 0xfe, 0x43, 0x61, 0xae, 0x13, 0xe4,
-	0x38, 0x5b, 0x48, 0x1a, 0x7c, 0x07, 0x44, 0x4c, 0xd5, 0x40, 0x99, 0x10, 0xb1, 0x7f, 0x63, 0x3a,
-	0xe1, 0x13, 0xd4, 0xde, 0x95, 0x0a, 0x32, 0x51, 0xc2, 0xf7, 0x40, 0x54, 0x43, 0x8e, 0xaa, 0xf7,
-	0x33, 0x61, 0x62, 0xb6, 0x39, 0x9d, 0xf0, 0x49, 0x6a, 0x46, 0xe5, 0x82, 0xcc, 0x0c, 0xe0, 0x0f,
-	0x40, 0x52, 0xd5, 0x0c, 0xdd, 0x54, 0x54, 0x4d, 0xb3, 0x90, 0x6d, 0x67, 0x22, 0x04, 0x91, 0x99,
-	0x4e, 0xf8, 0x5b, 0x14, 0xe1, 0x53, 0x0b, 0xf2, 0x3a, 0x59, 0x97, 0xe8, 0x12, 0xfe, 0x08, 0x44,
-	0x6d, 0x47, 0x75, 0x86, 0x76, 0x66, 0x35, 0xcf, 0xed, 0x6e, 0xec, 0xf1, 0x85, 0x60, 0xcd, 0x0a,
-	0xec, 0xbc, 0x2d, 0x62, 0xb6, 0x18, 0x0a, 0x05, 0x0a, 0x32, 0xf3, 0x00, 0x7f, 0x0e, 0x12, 0x1d,
-	0x0b, 0xa9, 0x0e, 0x52, 0xdc, 0xfa, 0x65, 0xa2, 0x79, 0x6e, 0x37, 0xb1, 0x97, 0x2d, 0xd0, 0x2c,
-	0x17, 0xbc, 0x2c, 0x17, 0xda, 0x5e, 0x71, 0xcb, 0xb9, 0xcf, 0x27, 0xfc, 0xca, 0x74, 0xc2, 0x43,
-	0xea, 0x6f, 0x01, 0x2c, 0xbc, 0xf8, 0x92, 0xe7, 0x64, 0x40, 0x25, 0x2e, 0xe0, 0xe1, 0xda, 0xc7,
-	0x9f, 0xf2, 0x2b, 0xff, 0xf8, 0x94, 0x5f, 0x11, 0x5e, 0x44, 0x41, 0x6c, 0x5f, 0x37, 0x35, 0xdd,
-	0xec, 0xbd, 0x66, 0x0d, 0xee, 0x03, 0xd0, 0xa5, 0x0e, 0x5c, 0x54, 0x28, 0x88, 0x9a, 0xeb, 0x04,
-	0x39, 0xce, 0x16, 0x92, 0x06, 0x6f, 0x81, 0x55, 0x47, 0x77, 0xfa, 0x88, 0xd6, 0x44, 0xa6, 0x0b,
-	0xf8, 0x00, 0x24, 0x34, 0x64, 0x77, 0x2c, 0x7d, 0xe0, 0x32, 0x87, 0x65, 0xff, 0xcd, 0xf9, 0xa1,
-	0x16, 0x94, 0x82, 0xbc, 0x68, 0x0a, 0x45, 0x90, 0x1a, 0x58, 0x18, 0x77, 0x15, 0xdc, 0x55, 0x3a,
-	0xd8, 0xec, 0xa0, 0x81, 0x43, 0x8a, 0x10, 0x2f, 0xbf, 0x35, 0x9d, 0xf0, 0xdb, 0xb3, 0x13, 0xf8,
-	0x2c, 0x04, 0x79, 0x83, 0x88, 0x1a, 0xdd, 0x0a, 0x15, 0xc0, 0x87, 0x60, 0xdd, 0x0b, 0xf8, 0x44,
-	0xb5, 0x4f, 0x48, 0xda, 0xe3, 0xe5, 0xed, 0xe9, 0x84, 0xdf, 0xf2, 0x1f, 0xc7, 0xd5, 0x0a, 0x72,
-	0x82, 0x2d, 0x0f, 0x54, 0xfb, 0x04, 0x4a, 0x60, 0xd3, 0x1e, 0x1e, 0x1b, 0xba, 0xe3, 0x20, 0x6b,
-	0x46, 0xa0, 0x18, 0x71, 0x70, 0x7b, 0x3a, 0xe1, 0x33, 0xac, 0xce, 0x41, 0x13, 0x41, 0x4e, 0xcd,
-	0x64, 0x1e, 0x91, 0x54, 0xb0, 0x61, 0xa3, 0x53, 0x64, 0xe9, 0xce, 0xb9, 0xd2, 0x47, 0xa7, 0xa8,
-	0x9f, 0x59, 0xbb, 0x8c, 0x50, 0x2d, 0x66, 0x57, 0x73, 0xcd, 0xca, 0x3b, 0xd3, 0x09, 0x9f, 0x66,
-	0x1b, 0xf9, 0x1c, 0x08, 0x72, 0xd2, 0x5e, 0xb4, 0x5c, 0xe0, 0x6a, 0xfc, 0x32, 0xd7, 0x8c, 0x17,
-	0x57, 0x73, 0x75, 0xde, 0x61, 0xe0, 0xaa, 0x0e, 0x7b, 0x08, 0xd6, 0x07, 0xea, 0xb9, 0x81, 0x4c,
-	0x87, 0x26, 0x38, 0x11, 0x4c, 0xf0, 0xa2, 0x56, 0x90, 0x13, 0x6c, 0x49, 0x12, 0x1c, 0x68, 0x89,
-	0xf5, 0x6f, 0xa8, 0x25, 0xfe, 0x10, 0x02, 0x90, 0xb5, 0xe9, 0xbe, 0x6e, 0xf6, 0x90, 0x35, 0xb0,
-	0x74, 0xd3, 0x81, 0x7b, 0x4b, 0xba, 0x63, 0xeb, 0x9f, 0x13, 0x3e, 0xa4, 0x6b, 0xd3, 0x09, 0x1f,
-	0xa7, 0x5b, 0xfc, 0xdf, 0xcc, 0xa7, 0x85, 0x7c, 0xfd, 0x2b, 0x0c, 0x20, 0xa3, 0xca, 0x62, 0xbe,
-	0x5e, 0x6f, 0x9a, 0xec, 0x2d, 0x99, 0x26, 0xcb, 0xb3, 0x7c, 0xd5, 0x2c, 0x09, 0xb6, 0x72, 0xe4,
-	0x06, 0xad, 0x7c, 0xb1, 0xff, 0x56, 0xbf, 0xb9, 0xfe, 0x8b, 0xfe, 0x0f, 0xfb, 0x2f, 0x76, 0xd3,
-	0xfe, 0x5b, 0xbb, 0x7e, 0xff, 0x2d, 0x94, 0xfc, 0xb3, 0x08, 0x88, 0xb5, 0x4f, 0x10, 0xb6, 0x90,
-	0x01, 0x37, 0x40, 0x88, 0xd5, 0x37, 0x22, 0x87, 0xf4, 0x85, 0x6a, 0x84, 0x16, 0xab, 0x91, 0xf7,
-	0x4f, 0x76, 0x5a, 0x29, 0xdf, 0x04, 0x87, 0x20, 0xd2, 0xc1, 0x1a, 0xa2, 0x75, 0x92, 0xc9, 0x37,
-	0xfc, 0xee, 0xd5, 0x84, 0x65, 0x61, 0xd0, 0x24, 0xcd, 0x32, 0x52, 0x02, 0x09, 0x3a, 0x54, 0xaf,
-	0x7b, 0x7b, 0x46, 0xe8, 0x40, 0xa0, 0x20, 0x57, 0x0c, 0xbf, 0x07, 0xd6, 0x90, 0xa9, 0x51, 0x7c,
-	0xec, 0x9a, 0xf8, 0x18, 0x32, 0x35, 0x02, 0x16, 0x41, 0xc2, 0xc1, 0x8e, 0xda, 0x57, 0x7a, 0x96,
-	0x6a, 0x3a, 0x99, 0xb5, 0x7c, 0x78, 0x37, 0xb1, 0xb7, 0x53, 0x60, 0x8f, 0x29, 0xf7, 0xe5, 0x55,
-	0x60, 0x2f, 0xaf, 0x42, 0x05, 0xeb, 0x66, 0x39, 0xee, 0x4e, 0xaa, 0xdf, 0xfd, 0xfd, 0xb3, 0xbb,
-	0x9c, 0x0c, 0x08, 0xf0, 0x91, 0x8b, 0x83, 0xf7, 0xc1, 0xda, 0xc0, 0xc2, 0x03, 0x6c, 0x23, 0x8b,
-	0x8c, 0xe9, 0x78, 0x39, 0xf3, 0xd7, 0x3f, 0xbd, 0x7f, 0x8b, 0xb9, 0x61, 0x6d, 0xdd, 0x72, 0x2c,
-	0xdd, 0xec, 0xc9, 0x33, 0x4b, 0x98, 0x03, 0xa0, 0x83, 0x8d, 0x41, 0x1f, 0x9d, 0xe9, 0xce, 0x39,
-	0x19, 0xc9, 0x61, 0x79, 0x41, 0x02, 0xdf, 0x05, 0x1b, 0xba, 0x31, 0xc0, 0x96, 0x83, 0x34, 0xa5,
-	0xe3, 0x26, 0x92, 0x4c, 0xe1, 0xb0, 0x9c, 0xf4, 0xa4, 0x15, 0x57, 0x08, 0x33, 0x20, 0x46, 0x05,
-	0x76, 0x66, 0x3d, 0x1f, 0xde, 0x8d, 0xc8, 0xde, 0x12, 0xee, 0x81, 0xb4, 0x85, 0x9e, 0x0f, 0x75,
-	0x0b, 0x29, 0x78, 0x80, 0x4c, 0x43, 0x75, 0x4e, 0x94, 0x0e, 0xb2, 0x9c, 0x4c, 0x32, 0xcf, 0xed,
-	0xae, 0xc9, 0x5b, 0x4c, 0xd9, 0x60, 0xba, 0x0a, 0xb2, 0x1c, 0xe1, 0xdf, 0x21, 0xb0, 0xda, 0x74,
-	0x2f, 0x5b, 0x78, 0x07, 0x00, 0x87, 0x16, 0x4d, 0x99, 0x11, 0x27, 0xce, 0x24, 0x92, 0xc6, 0xf8,
-	0x44, 0xc9, 0xe3, 0xf2, 0xe9, 0x4d, 0xff, 0x78, 0x9c, 0x31, 0xf9, 0x3b, 0x33, 0x6e, 0x44, 0x08,
-	0x37, 0xee, 0x2c, 0x1d, 0x66, 0xb8, 0xfb, 0xdf, 0x99, 0xb1, 0xfa, 0x35, 0x99, 0x11, 0xbd, 0x29,
-	0x33, 0xbe, 0x0d, 0xa2, 0x03, 0x0b, 0x9f, 0x22, 0x8b, 0xf5, 0xea, 0xe5, 0x05, 0x65, 0x76, 0xf0,
-	0x87, 0x20, 0x56, 0x45, 0x03, 0x6c, 0xeb, 0x37, 0xe3, 0x91, 0x07, 0x12, 0x1c, 0x10, 0x27, 0x89,
-	0x20, 0x93, 0xed, 0x8a, 0xe4, 0xcf, 0x93, 0x1d, 0xf2, 0x25, 0x7b, 0x1e, 0x75, 0xf8, 0x7a, 0x51,
-	0x0b, 0x9f, 0x70, 0x60, 0x95, 0x92, 0xf8, 0x8a, 0x2d, 0xf7, 0x40, 0x8c, 0x34, 0x09, 0xb6, 0xd8,
-	0xb8, 0xbf, 0xdc, 0xb7, 0x67, 0x08, 0xbf, 0x0f, 0xa2, 0xaa, 0x41, 0x98, 0x1b, 0xbe, 0x41, 0x46,
-	0x18, 0x46, 0xf8, 0x35, 0x37, 0xcb, 0x28, 0xdc, 0x21, 0x1d, 0x86, 0xbb, 0xb3, 0x3b, 0x4a, 0x8e,
-	0x91, 0xb5, 0xa4, 0xc1, 0x0f, 0x41, 0x5c, 0xa3, 0x56, 0xd7, 0x08, 0x6d, 0x6e, 0xfa, 0x35, 0x83,
-	0xfb, 0x2a, 0x02, 0xa2, 0x4d, 0xd5, 0x52, 0x0d, 0x97, 0xaa, 0x71, 0xf7, 0x32, 0xa7, 0x23, 0x84,
-	0xbb, 0x81, 0xaf, 0x35, 0x43, 0x37, 0x69, 0xee, 0x45, 0x90, 0x70, 0x5d, 0xb0, 0xe0, 0x32, 0xa1,
-	0x9b, 0xcc, 0x21, 0x43, 0x37, 0xbd, 0x2c, 0xfd, 0x14, 0x64, 0xbc, 0x12, 0x1a, 0xea, 0x99, 0x42,
-	0x33, 0x36, 0x40, 0x96, 0x8e, 0x35, 0x42, 0x08, 0xd7, 0x67, 0xb0, 0x03, 0xaa, 0xec, 0xef, 0xbf,
-	0x72, 0xe4, 0x13, 0xb7, 0x01, 0xd2, 0xcc, 0x41, 0x5d, 0x3d, 0x23, 0x6c, 0x6c, 0x12, 0x34, 0x94,
-	0x41, 0x9a, 0x7a, 0x73, 0xfd, 0xf6, 0x71, 0xe7, 0x99, 0xe7, 0x36, 0x72, 0x3d, 0xb7, 0x90, 0xa0,
-	0xeb, 0xea, 0x59, 0x0d, 0x77, 0x9e, 0x31, 0x9f, 0x8f, 0xc1, 0xc6, 0x7c, 0xda, 0x29, 0x5d, 0xe4,
-	0x75, 0xf9, 0xf5, 0xce, 0x9d, 0x9c, 0x63, 0xf7, 0x11, 0x72, 0x87, 0xa5, 0x1b, 0xda, 0xc2, 0x40,
-	0x8d, 0xd2, 0x61, 0x69, 0xa8, 0x67, 0x95, 0xf9, 0x4c, 0x6d, 0x83, 0x2d, 0xff, 0x9e, 0x8a, 0x85,
-	0x3b, 0xcf, 0xd9, 0xc5, 0x71, 0xbd, 0x8d, 0x37, 0x7d, 0x1b, 0xcb, 0xb8, 0xf3, 0x7c, 0x89, 0xd7,
-	0x3e, 0x52, 0x4d, 0x72, 0x69, 0xbf, 0x9e, 0xd7, 0x1a, 0x52, 0x4d, 0xe1, 0x8f, 0x1c, 0x88, 0xca,
-	0xe8, 0x23, 0xd5, 0x22, 0xcd, 0xe7, 0x3d, 0x25, 0xb9, 0xab, 0x9a, 0x8f, 0x19, 0x42, 0x13, 0x44,
-	0x2d, 0x82, 0x66, 0x74, 0xba, 0xbd, 0x34, 0x8e, 0x2a, 0xea, 0x90, 0x50, 0x1e, 0xb8, 0xa1, 0xfc,
-	0xfe, 0x4b, 0xfe, 0x5b, 0x3d, 0xdd, 0x39, 0x19, 0x1e, 0x17, 0x3a, 0xd8, 0x60, 0xbf, 0x29, 0xb0,
-	0xff, 0xde, 0xb7, 0xb5, 0x67, 0x45, 0xe7, 0x7c, 0x80, 0x6c, 0x0f, 0x63, 0xb3, 0x8e, 0xa0, 0xbb,
-	0x3c, 0x8c, 0xb8, 0xcf, 0x8e, 0xbb, 0x7f, 0xe6, 0x40, 0xd2, 0xf7, 0x38, 0x85, 0x1f, 0x82, 0xed,
-	0xa6, 0xdc, 0x78, 0x24, 0x97, 0xea, 0x4a, 0xab, 0x5d, 0x6a, 0x1f, 0xb5, 0x14, 0xe9, 0xb0, 0x54,
-	0x69, 0x4b, 0x4f, 0xc4, 0xd4, 0x4a, 0x76, 0x67, 0x34, 0xce, 0xa7, 0x7d, 0xf6, 0x92, 0xa9, 0x76,
-	0x1c, 0xfd, 0x14, 0xb9, 0xb7, 0x57, 0x00, 0xc7, 0x50, 0x5c, 0x76, 0x7b, 0x34, 0xce, 0x6f, 0xf9,
-	0x50, 0xa5, 0xcb, 0x30, 0x95, 0x5a, 0xa3, 0x25, 0x56, 0x53, 0xa1, 0x25, 0x98, 0x4a, 0x1f, 0xdb,
-	0x48, 0xcb, 0x46, 0x3e, 0xfe, 0x6d, 0x6e, 0xe5, 0xee, 0xaf, 0x42, 0x20, 0xe9, 0x7b, 0x23, 0xc2,
-	0x22, 0xc8, 0xb6, 0xc4, 0x27, 0xa2, 0x2c, 0xb5, 0x9f, 0x2a, 0x35, 0xf1, 0x89, 0x58, 0x53, 0x8e,
-	0x0e, 0x5b, 0x4d, 0xb1, 0x22, 0xed, 0x4b, 0x62, 0x35, 0xb5, 0x92, 0x7d, 0x63, 0x34, 0xce, 0x27,
-	0x8e, 0x4c, 0x7b, 0x80, 0x3a, 0x7a, 0x57, 0x47, 0x1a, 0x7c, 0x0f, 0x6c, 0x07, 0x00, 0x15, 0x59,
-	0x6a, 0x4b, 0x95, 0x52, 0x2d, 0xc5, 0x65, 0xd7, 0x47, 0xe3, 0xfc, 0x5a, 0xc5, 0xd2, 0x1d, 0xbd,
-	0xa3, 0xf6, 0xe1, 0xdb, 0x60, 0x2b, 0x60, 0x7a, 0x20, 0x3d, 0x3a, 0x48, 0x85, 0xb2, 0x6b, 0xa3,
-	0x71, 0x3e, 0x72, 0xa0, 0xf7, 0x4e, 0xe0, 0xbb, 0x20, 0x1d, 0x30, 0xa9, 0x8b, 0x55, 0xe9, 0xa8,
-	0x9e, 0x0a, 0x67, 0xc1, 0x68, 0x9c, 0x8f, 0xd6, 0x91, 0xa6, 0x0f, 0x0d, 0xc8, 0x03, 0x18, 0x30,
-	0xab, 0x35, 0x7e, 0x92, 0x8a, 0x64, 0x63, 0xa3, 0x71, 0x3e, 0x5c, 0xc3, 0x1f, 0xc1, 0x0f, 0xc0,
-	0xed, 0x80, 0x81, 0x74, 0xb8, 0xdf, 0x90, 0xeb, 0xa5, 0xb6, 0xd4, 0x38, 0x2c, 0xd5, 0x52, 0xab,
-	0xd9, 0xcd, 0xd1, 0x38, 0x9f, 0x94, 0xcc, 0x2e, 0xb6, 0x0c, 0xd2, 0xb2, 0x6a, 0x9f, 0xe5, 0xe4,
-	0x37, 0x21, 0x90, 0xf4, 0x3d, 0x6e, 0xe1, 0x03, 0x90, 0xd9, 0x97, 0x0e, 0xab, 0xd2, 0xe1, 0x23,
-	0x2f, 0xbf, 0xad, 0xa3, 0x72, 0x5d, 0x6a, 0xb7, 0x49, 0x46, 0xb2, 0xa3, 0x71, 0xfe, 0x4d, 0x1f,
-	0xa0, 0xc5, 0xfe, 0x62, 0x76, 0x19, 0x9c, 0x0e, 0x20, 0xfd, 0xd5, 0xf4, 0xc1, 0x58, 0x35, 0x2f,
-	0xee, 0x56, 0x69, 0x1c, 0xee, 0x4b, 0x72, 0x9d, 0x14, 0xf4, 0xe2, 0x6e, 0x15, 0x6c, 0x76, 0x75,
-	0xcb, 0x40, 0x1a, 0x2c, 0x80, 0xad, 0x00, 0xb2, 0x59, 0x92, 0xaa, 0xa9, 0x70, 0x36, 0x3d, 0x1a,
-	0xe7, 0x37, 0x7d, 0xa0, 0xa6, 0xaa, 0x2f, 0x8b, 0x8e, 0xf1, 0x26, 0xb2, 0x24, 0x3a, 0x1f, 0x6f,
-	0x7e, 0xc9, 0x81, 0xa4, 0xef, 0x6d, 0x0b, 0x73, 0x20, 0xdb, 0x3e, 0x10, 0x1b, 0xb2, 0x38, 0xe3,
-	0xa0, 0x8f, 0x37, 0x90, 0x07, 0x6f, 0x05, 0xf4, 0x4d, 0xb9, 0xd1, 0xd8, 0x57, 0x9a, 0xa2, 0x2c,
-	0x35, 0xaa, 0x29, 0x0e, 0xee, 0x80, 0x74, 0xd0, 0xa0, 0xd4, 0x22, 0x24, 0x5e, 0xa2, 0x62, 0x71,
-	0x86, 0xef, 0xfe, 0x85, 0x03, 0x89, 0x85, 0x87, 0x14, 0xbc, 0x0d, 0x32, 0xd4, 0xef, 0xd2, 0x20,
-	0xde, 0x06, 0x77, 0x7c, 0xda, 0x83, 0x52, 0xeb, 0x40, 0xa9, 0x35, 0x2a, 0x8f, 0xe7, 0x61, 0x08,
-	0x20, 0x77, 0x89, 0x49, 0x5b, 0xaa, 0x8b, 0x8d, 0xa3, 0x76, 0x2a, 0x04, 0xdf, 0x01, 0xfc, 0x45,
-	0x9b, 0xaa, 0xd8, 0x2e, 0x49, 0x35, 0xcf, 0x51, 0x18, 0x6e, 0x83, 0x2d, 0x9f, 0x11, 0x3b, 0x4d,
-	0xe4, 0x82, 0x62, 0xbf, 0x24, 0xd5, 0xc4, 0x6a, 0x6a, 0xf5, 0xee, 0x53, 0x90, 0x60, 0x39, 0x6d,
-	0x9f, 0x0f, 0x90, 0x7b, 0x14, 0xef, 0xd4, 0xed, 0xa7, 0x4d, 0x31, 0x70, 0x94, 0x34, 0xd8, 0xf4,
-	0x69, 0xe5, 0x46, 0xe5, 0xc7, 0x29, 0xee, 0x82, 0xb8, 0x26, 0x96, 0x0e, 0x53, 0xa1, 0xf2, 0xe3,
-	0xcf, 0x5f, 0xe6, 0xb8, 0x2f, 0x5e, 0xe6, 0xb8, 0xaf, 0x5e, 0xe6, 0xb8, 0x17, 0xaf, 0x72, 0x2b,
-	0x5f, 0xbc, 0xca, 0xad, 0xfc, 0xed, 0x55, 0x6e, 0xe5, 0x67, 0xf7, 0x16, 0x26, 0x1f, 0x7d, 0xa2,
-	0x76, 0xf1, 0xd0, 0xd4, 0x48, 0x4b, 0x30, 0x41, 0xf1, 0xcc, 0xfb, 0xd5, 0x97, 0x0c, 0xc2, 0xe3,
-	0x28, 0xb9, 0xee, 0x3e, 0xf8, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0xec, 0x7b, 0xbc, 0x13,
-	0x16, 0x00, 0x00,
+func (m *Theorem) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-func (m *Program) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Theorem) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Program) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Theorem) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
 }
 
-func (m *Program) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime):])
-	if err1 != nil {
-		return 0, err1
-	}
-	i -= n1
-	i = encodeVarintBounty(dAtA, i, uint64(n1))
-	i--
-	dAtA[i] = 0x32
-	if m.Status != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.AdminAddress) > 0 {
-		i -= len(m.AdminAddress)
-		copy(dAtA[i:], m.AdminAddress)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.AdminAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
+func (m *Theorem) GetStatus() TheoremStatus {
+	if m != nil {
+		return m.Status
 	}
-	return len(dAtA) - i, nil
+	return TheoremStatus_THEOREM_STATUS_UNSPECIFIED
 }
 
-func (m *Finding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Theorem) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *Finding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Theorem) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
 }
 
-func (m *Finding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime):])
-	if err2 != nil {
-		return 0, err2
-	}
-	i -= n2
-	i = encodeVarintBounty(dAtA, i, uint64(n2))
-	i--
-	dAtA[i] = 0x62
-	if len(m.PaymentHash) > 0 {
-		i -= len(m.PaymentHash)
-		copy(dAtA[i:], m.PaymentHash)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.PaymentHash)))
-		i--
-		dAtA[i] = 0x5a
-	}
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x52
-	}
-	if m.Status != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x48
-	}
-	if m.SeverityLevel != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.SeverityLevel))
-		i--
-		dAtA[i] = 0x40
-	}
-	if len(m.SubmitterAddress) > 0 {
-		i -= len(m.SubmitterAddress)
-		copy(dAtA[i:], m.SubmitterAddress)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.SubmitterAddress)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.FindingHash) > 0 {
-		i -= len(m.FindingHash)
-		copy(dAtA[i:], m.FindingHash)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.FindingHash)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ProofOfConcept) > 0 {
-		i -= len(m.ProofOfConcept)
-		copy(dAtA[i:], m.ProofOfConcept)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProofOfConcept)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
+func (m *Theorem) GetTotalGrant() []types1.Coin {
+	if m != nil {
+		return m.TotalGrant
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *ProgramFingerprint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Theorem) GetProposer() string {
+	if m != nil {
+		return m.Proposer
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *ProgramFingerprint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Theorem) GetComplexity() int64 {
+	if m != nil {
+		return m.Complexity
+	}
+	return 0
 }
 
-func (m *ProgramFingerprint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Status != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.AdminAddress) > 0 {
-		i -= len(m.AdminAddress)
-		copy(dAtA[i:], m.AdminAddress)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.AdminAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x1a
+func (m *Theorem) GetImportedCount() int64 {
+	if m != nil {
+		return m.ImportedCount
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
+	return 0
+}
+
+func (m *Theorem) GetImports() []uint64 {
+	if m != nil {
+		return m.Imports
 	}
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *Theorem) GetRequireOpenmathCert() bool {
+	if m != nil {
+		return m.RequireOpenmathCert
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *FindingFingerprint) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Theorem) GetForfeitedDeposits() []types1.Coin {
+	if m != nil {
+		return m.ForfeitedDeposits
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *FindingFingerprint) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Theorem) GetTheoremType() TheoremType {
+	if m != nil {
+		return m.TheoremType
+	}
+	return TheoremType_THEOREM_TYPE_UNSPECIFIED
 }
 
-func (m *FindingFingerprint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PaymentHash) > 0 {
-		i -= len(m.PaymentHash)
-		copy(dAtA[i:], m.PaymentHash)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.PaymentHash)))
-		i--
-		dAtA[i] = 0x42
+func (m *Theorem) GetProofSequence() uint64 {
+	if m != nil {
+		return m.ProofSequence
 	}
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.Status != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x30
-	}
-	if m.SeverityLevel != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.SeverityLevel))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.FindingHash) > 0 {
-		i -= len(m.FindingHash)
-		copy(dAtA[i:], m.FindingHash)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.FindingHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Theorem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type Proof struct {
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
+	// id defines the unique id of the proof.
+	Id string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	// detail is the proof data attached to the theorem stored in ipfs
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	// status defines the theorem status.
+	Status ProofStatus `protobuf:"varint,4,opt,name=status,proto3,enum=shentu.bounty.v1.ProofStatus" json:"status,omitempty"`
+	// submit_time is the time of proof submission.
+	SubmitTime *time.Time `protobuf:"bytes,5,opt,name=submit_time,json=submitTime,proto3,stdtime" json:"submit_time,omitempty"`
+	// end_time is the time of proof timeout.
+	EndTime *time.Time `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty"`
+	// prover is the address of the proof submitter
+	Prover string `protobuf:"bytes,7,opt,name=prover,proto3" json:"prover,omitempty"`
+	// deposit is the amount deposited by the prover
+	Deposit []types1.Coin `protobuf:"bytes,8,rep,name=Deposit,proto3" json:"Deposit"`
+	// detail_chunks are the content hashes of the chunks of a detail submitted in chunks, in order.
+	// The detail field is empty for such proofs.
+	DetailChunks []string `protobuf:"bytes,9,rep,name=detail_chunks,json=detailChunks,proto3" json:"detail_chunks,omitempty"`
+	// detail_compressed tells whether every detail chunk is a zstd frame.
+	DetailCompressed bool `protobuf:"varint,10,opt,name=detail_compressed,json=detailCompressed,proto3" json:"detail_compressed,omitempty"`
+	// sequence is the submission order of the proof among the proofs of its theorem, starting at 1.
+	Sequence uint64 `protobuf:"varint,11,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *Theorem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Proof) Reset()         { *m = Proof{} }
+func (m *Proof) String() string { return proto.CompactTextString(m) }
+func (*Proof) ProtoMessage()    {}
+func (*Proof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{16}
 }
-
-func (m *Theorem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequireOpenmathCert {
-		i--
-		if m.RequireOpenmathCert {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x68
-	}
-	if len(m.Imports) > 0 {
-		dAtA4 := make([]byte, len(m.Imports)*10)
-		var j3 int
-		for _, num := range m.Imports {
-			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA4[j3] = uint8(num)
-			j3++
+func (m *Proof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Proof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Proof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintBounty(dAtA, i, uint64(j3))
-		i--
-		dAtA[i] = 0x62
-	}
-	if m.ImportedCount != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.ImportedCount))
-		i--
-		dAtA[i] = 0x58
-	}
-	if m.Complexity != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.Complexity))
-		i--
-		dAtA[i] = 0x50
+		return b[:n], nil
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x4a
+}
+func (m *Proof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Proof.Merge(m, src)
+}
+func (m *Proof) XXX_Size() int {
+	return m.Size()
+}
+func (m *Proof) XXX_DiscardUnknown() {
+	xxx_messageInfo_Proof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Proof proto.InternalMessageInfo
+
+func (m *Proof) GetTheoremId() uint64 {
+	if m != nil {
+		return m.TheoremId
 	}
-	if len(m.TotalGrant) > 0 {
-		for iNdEx := len(m.TotalGrant) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TotalGrant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBounty(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
+	return 0
+}
+
+func (m *Proof) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	if m.EndTime != nil {
-		n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
-		if err5 != nil {
-			return 0, err5
-		}
-		i -= n5
-		i = encodeVarintBounty(dAtA, i, uint64(n5))
-		i--
-		dAtA[i] = 0x3a
+	return ""
+}
+
+func (m *Proof) GetDetail() string {
+	if m != nil {
+		return m.Detail
 	}
-	if m.SubmitTime != nil {
-		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err6 != nil {
-			return 0, err6
-		}
-		i -= n6
-		i = encodeVarintBounty(dAtA, i, uint64(n6))
-		i--
-		dAtA[i] = 0x32
+	return ""
+}
+
+func (m *Proof) GetStatus() ProofStatus {
+	if m != nil {
+		return m.Status
 	}
-	if m.Status != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x28
+	return ProofStatus_PROOF_STATUS_UNSPECIFIED
+}
+
+func (m *Proof) GetSubmitTime() *time.Time {
+	if m != nil {
+		return m.SubmitTime
 	}
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Code)))
-		i--
-		dAtA[i] = 0x22
+	return nil
+}
+
+func (m *Proof) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x1a
+	return nil
+}
+
+func (m *Proof) GetProver() string {
+	if m != nil {
+		return m.Prover
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *Proof) GetDeposit() []types1.Coin {
+	if m != nil {
+		return m.Deposit
 	}
-	if m.Id != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.Id))
-		i--
-		dAtA[i] = 0x8
+	return nil
+}
+
+func (m *Proof) GetDetailChunks() []string {
+	if m != nil {
+		return m.DetailChunks
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Proof) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Proof) GetDetailCompressed() bool {
+	if m != nil {
+		return m.DetailCompressed
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Proof) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Proof) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Deposit) > 0 {
-		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBounty(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if len(m.Prover) > 0 {
-		i -= len(m.Prover)
-		copy(dAtA[i:], m.Prover)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Prover)))
-		i--
-		dAtA[i] = 0x3a
-	}
-	if m.EndTime != nil {
-		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
-		if err7 != nil {
-			return 0, err7
-		}
-		i -= n7
-		i = encodeVarintBounty(dAtA, i, uint64(n7))
-		i--
-		dAtA[i] = 0x32
-	}
-	if m.SubmitTime != nil {
-		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
-		if err8 != nil {
-			return 0, err8
-		}
-		i -= n8
-		i = encodeVarintBounty(dAtA, i, uint64(n8))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Status != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Id) > 0 {
-		i -= len(m.Id)
-		copy(dAtA[i:], m.Id)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Id)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TheoremId != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+// ProofChunk defines a chunk of a proof detail, addressed by the sha256 hash of its data.
+type ProofChunk struct {
+	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
+	// hash is the hex encoded sha256 hash of the data.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// data is the chunk as uploaded, zstd compressed for the proofs with a compressed detail.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (m *ProofHash) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProofChunk) Reset()         { *m = ProofChunk{} }
+func (m *ProofChunk) String() string { return proto.CompactTextString(m) }
+func (*ProofChunk) ProtoMessage()    {}
+func (*ProofChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{17}
+}
+func (m *ProofChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ProofHash) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProofChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofChunk.Merge(m, src)
+}
+func (m *ProofChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofChunk.DiscardUnknown(m)
 }
 
-func (m *ProofHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prover) > 0 {
-		i -= len(m.Prover)
-		copy(dAtA[i:], m.Prover)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Prover)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TheoremId != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremId))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_ProofChunk proto.InternalMessageInfo
+
+func (m *ProofChunk) GetProofId() string {
+	if m != nil {
+		return m.ProofId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *Grant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProofChunk) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProofChunk) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBounty(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
+// ProofVerdict defines the verdict of a checker on a proof pending verification.
+type ProofVerdict struct {
+	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
+	Checker string `protobuf:"bytes,2,opt,name=checker,proto3" json:"checker,omitempty"`
+	// status is the passed or failed verdict of the checker.
+	Status ProofStatus `protobuf:"varint,3,opt,name=status,proto3,enum=shentu.bounty.v1.ProofStatus" json:"status,omitempty"`
+	// complexity is the complexity of the theorem assessed by the checker.
+	Complexity int64 `protobuf:"varint,4,opt,name=complexity,proto3" json:"complexity,omitempty"`
+	// imports are the theorems the checker found referenced by the proof.
+	Imports     []uint64    `protobuf:"varint,5,rep,packed,name=imports,proto3" json:"imports,omitempty"`
+	TheoremType TheoremType `protobuf:"varint,6,opt,name=theorem_type,json=theoremType,proto3,enum=shentu.bounty.v1.TheoremType" json:"theorem_type,omitempty"`
+}
+
+func (m *ProofVerdict) Reset()         { *m = ProofVerdict{} }
+func (m *ProofVerdict) String() string { return proto.CompactTextString(m) }
+func (*ProofVerdict) ProtoMessage()    {}
+func (*ProofVerdict) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{18}
+}
+func (m *ProofVerdict) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofVerdict) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofVerdict.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Grantor) > 0 {
-		i -= len(m.Grantor)
-		copy(dAtA[i:], m.Grantor)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Grantor)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TheoremId != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremId))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+}
+func (m *ProofVerdict) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofVerdict.Merge(m, src)
+}
+func (m *ProofVerdict) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofVerdict) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofVerdict.DiscardUnknown(m)
 }
 
-func (m *Deposit) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ProofVerdict proto.InternalMessageInfo
+
+func (m *ProofVerdict) GetProofId() string {
+	if m != nil {
+		return m.ProofId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ProofVerdict) GetChecker() string {
+	if m != nil {
+		return m.Checker
+	}
+	return ""
 }
 
-func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBounty(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+func (m *ProofVerdict) GetStatus() ProofStatus {
+	if m != nil {
+		return m.Status
 	}
-	if len(m.Depositor) > 0 {
-		i -= len(m.Depositor)
-		copy(dAtA[i:], m.Depositor)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Depositor)))
-		i--
-		dAtA[i] = 0x12
+	return ProofStatus_PROOF_STATUS_UNSPECIFIED
+}
+
+func (m *ProofVerdict) GetComplexity() int64 {
+	if m != nil {
+		return m.Complexity
 	}
-	if len(m.ProofId) > 0 {
-		i -= len(m.ProofId)
-		copy(dAtA[i:], m.ProofId)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProofId)))
-		i--
-		dAtA[i] = 0xa
+	return 0
+}
+
+func (m *ProofVerdict) GetImports() []uint64 {
+	if m != nil {
+		return m.Imports
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ProofVerdict) GetTheoremType() TheoremType {
+	if m != nil {
+		return m.TheoremType
 	}
-	return dAtA[:n], nil
+	return TheoremType_THEOREM_TYPE_UNSPECIFIED
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type ProofHash struct {
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
+	Detail    string `protobuf:"bytes,2,opt,name=detail,proto3" json:"detail,omitempty"`
+	Prover    string `protobuf:"bytes,3,opt,name=prover,proto3" json:"prover,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ComplexityFeeLean.MarshalToSizedBuffer(dAtA[:i])
+func (m *ProofHash) Reset()         { *m = ProofHash{} }
+func (m *ProofHash) String() string { return proto.CompactTextString(m) }
+func (*ProofHash) ProtoMessage()    {}
+func (*ProofHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{19}
+}
+func (m *ProofHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProofHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProofHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintBounty(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x42
-	{
-		size, err := m.ComplexityFeeRocq.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintBounty(dAtA, i, uint64(size))
+}
+func (m *ProofHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProofHash.Merge(m, src)
+}
+func (m *ProofHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProofHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProofHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProofHash proto.InternalMessageInfo
+
+func (m *ProofHash) GetTheoremId() uint64 {
+	if m != nil {
+		return m.TheoremId
 	}
-	i--
-	dAtA[i] = 0x3a
-	if m.MaxComplexity != 0 {
-		i = encodeVarintBounty(dAtA, i, uint64(m.MaxComplexity))
-		i--
-		dAtA[i] = 0x30
+	return 0
+}
+
+func (m *ProofHash) GetDetail() string {
+	if m != nil {
+		return m.Detail
 	}
-	{
-		size, err := m.ComplexityFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintBounty(dAtA, i, uint64(size))
+	return ""
+}
+
+func (m *ProofHash) GetProver() string {
+	if m != nil {
+		return m.Prover
 	}
-	i--
-	dAtA[i] = 0x2a
-	if m.ProofMaxLockPeriod != nil {
-		n12, err12 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ProofMaxLockPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ProofMaxLockPeriod):])
-		if err12 != nil {
-			return 0, err12
+	return ""
+}
+
+// Grant defines an amount granted by a grantor to an active theorem.
+type Grant struct {
+	// theorem_id defines the unique id of the theorem.
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
+	// grantor defines the grant addresses.
+	Grantor string `protobuf:"bytes,2,opt,name=grantor,proto3" json:"grantor,omitempty"`
+	// amount to be deposited by the grantor.
+	Amount []types1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+	// source defines where the granted funds come from, and so where they are refunded to.
+	Source GrantSource `protobuf:"varint,4,opt,name=source,proto3,enum=shentu.bounty.v1.GrantSource" json:"source,omitempty"`
+}
+
+func (m *Grant) Reset()         { *m = Grant{} }
+func (m *Grant) String() string { return proto.CompactTextString(m) }
+func (*Grant) ProtoMessage()    {}
+func (*Grant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{20}
+}
+func (m *Grant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Grant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Grant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= n12
-		i = encodeVarintBounty(dAtA, i, uint64(n12))
-		i--
-		dAtA[i] = 0x22
+		return b[:n], nil
 	}
-	if m.TheoremMaxProofPeriod != nil {
-		n13, err13 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.TheoremMaxProofPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TheoremMaxProofPeriod):])
-		if err13 != nil {
-			return 0, err13
-		}
-		i -= n13
-		i = encodeVarintBounty(dAtA, i, uint64(n13))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *Grant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Grant.Merge(m, src)
+}
+func (m *Grant) XXX_Size() int {
+	return m.Size()
+}
+func (m *Grant) XXX_DiscardUnknown() {
+	xxx_messageInfo_Grant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Grant proto.InternalMessageInfo
+
+func (m *Grant) GetTheoremId() uint64 {
+	if m != nil {
+		return m.TheoremId
 	}
-	if len(m.MinDeposit) > 0 {
-		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBounty(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	return 0
+}
+
+func (m *Grant) GetGrantor() string {
+	if m != nil {
+		return m.Grantor
 	}
-	if len(m.MinGrant) > 0 {
-		for iNdEx := len(m.MinGrant) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.MinGrant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBounty(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	return ""
+}
+
+func (m *Grant) GetAmount() []types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *Reward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Grant) GetSource() GrantSource {
+	if m != nil {
+		return m.Source
 	}
-	return dAtA[:n], nil
+	return GrantSource_GRANT_SOURCE_ACCOUNT
 }
 
-func (m *Reward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// Deposit defines an amount deposited by a depositor for a proof.
+type Deposit struct {
+	// proof_id defines the unique id of the proof.
+	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
+	// depositor defines the deposit addresses.
+	Depositor string `protobuf:"bytes,2,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	// amount to be deposited by the depositor.
+	Amount []types1.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
 }
 
-func (m *Reward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Reward) > 0 {
-		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintBounty(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
+func (m *Deposit) Reset()         { *m = Deposit{} }
+func (m *Deposit) String() string { return proto.CompactTextString(m) }
+func (*Deposit) ProtoMessage()    {}
+func (*Deposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{21}
+}
+func (m *Deposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Deposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Deposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintBounty(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+}
+func (m *Deposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Deposit.Merge(m, src)
+}
+func (m *Deposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *Deposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_Deposit.DiscardUnknown(m)
 }
 
-func encodeVarintBounty(dAtA []byte, offset int, v uint64) int {
-	offset -= sovBounty(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_Deposit proto.InternalMessageInfo
+
+func (m *Deposit) GetProofId() string {
+	if m != nil {
+		return m.ProofId
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return ""
 }
-func (m *Program) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.AdminAddress)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovBounty(uint64(m.Status))
+
+func (m *Deposit) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime)
-	n += 1 + l + sovBounty(uint64(l))
-	return n
+	return ""
 }
 
-func (m *Finding) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.ProofOfConcept)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.FindingHash)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.SubmitterAddress)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if m.SeverityLevel != 0 {
-		n += 1 + sovBounty(uint64(m.SeverityLevel))
-	}
-	if m.Status != 0 {
-		n += 1 + sovBounty(uint64(m.Status))
+func (m *Deposit) GetAmount() []types1.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return nil
+}
+
+type Params struct {
+	// Minimum grant for a theorem to enter the proof period.
+	MinGrant []types1.Coin `protobuf:"bytes,1,rep,name=min_grant,json=minGrant,proto3" json:"min_grant"`
+	// Minimum deposit for a proof to enter the proof_hash_lock period.
+	MinDeposit []types1.Coin `protobuf:"bytes,2,rep,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// Duration of the theorem proof period. Initial value: 2 weeks.
+	TheoremMaxProofPeriod *time.Duration `protobuf:"bytes,3,opt,name=theorem_max_proof_period,json=theoremMaxProofPeriod,proto3,stdduration" json:"theorem_max_proof_period,omitempty"`
+	// Duration of the proof max lock period. 10min
+	ProofMaxLockPeriod *time.Duration `protobuf:"bytes,4,opt,name=proof_max_lock_period,json=proofMaxLockPeriod,proto3,stdduration" json:"proof_max_lock_period,omitempty"`
+	// Deprecated: use complexity_fee_rocq / complexity_fee_lean instead.
+	ComplexityFee types1.Coin `protobuf:"bytes,5,opt,name=complexity_fee,json=complexityFee,proto3" json:"complexity_fee"`
+	// Maximum allowed complexity to prevent overflow and ensure reasonable reward calculations.
+	MaxComplexity int64 `protobuf:"varint,6,opt,name=max_complexity,json=maxComplexity,proto3" json:"max_complexity,omitempty"`
+	// Complexity fee for Rocq theorems.
+	ComplexityFeeRocq types1.Coin `protobuf:"bytes,7,opt,name=complexity_fee_rocq,json=complexityFeeRocq,proto3" json:"complexity_fee_rocq"`
+	// Complexity fee for Lean theorems.
+	ComplexityFeeLean types1.Coin `protobuf:"bytes,8,opt,name=complexity_fee_lean,json=complexityFeeLean,proto3" json:"complexity_fee_lean"`
+	// Duration bounty admins have to vote on a finding dispute. Initial value: 7 days.
+	DisputeWindow *time.Duration `protobuf:"bytes,9,opt,name=dispute_window,json=disputeWindow,proto3,stdduration" json:"dispute_window,omitempty"`
+	// Number of matching checker verdicts deciding a proof. Initial value: 1.
+	ProofVerificationQuorum uint32 `protobuf:"varint,10,opt,name=proof_verification_quorum,json=proofVerificationQuorum,proto3" json:"proof_verification_quorum,omitempty"`
+	// Fraction of the deposit forfeited by a failed or expired proof, the rest is refunded to the prover.
+	ProofDepositSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=proof_deposit_slash_fraction,json=proofDepositSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"proof_deposit_slash_fraction"`
+	// Share of a forfeited deposit rewarded to the checkers who failed the proof.
+	ForfeitedDepositCheckerShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=forfeited_deposit_checker_share,json=forfeitedDepositCheckerShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"forfeited_deposit_checker_share"`
+	// Share of a forfeited deposit added to the grant pool of the theorem. The remaining
+	// share goes to the community pool.
+	ForfeitedDepositGrantShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=forfeited_deposit_grant_share,json=forfeitedDepositGrantShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"forfeited_deposit_grant_share"`
+	// Fraction of a grant kept as a penalty when the grantor withdraws it, sent to the community pool.
+	GrantWithdrawalPenalty cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=grant_withdrawal_penalty,json=grantWithdrawalPenalty,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"grant_withdrawal_penalty"`
+	// Maximum duration from the submission of a theorem to the end of its proof period, including
+	// the extensions by grants. Initial value: 360 days.
+	TheoremMaxTotalPeriod *time.Duration `protobuf:"bytes,15,opt,name=theorem_max_total_period,json=theoremMaxTotalPeriod,proto3,stdduration" json:"theorem_max_total_period,omitempty"`
+	// Minimum single grant extending the proof period of a theorem to a full theorem_max_proof_period
+	// from the grant time. Empty disables the extensions.
+	TheoremExtensionMinGrant []types1.Coin `protobuf:"bytes,16,rep,name=theorem_extension_min_grant,json=theoremExtensionMinGrant,proto3" json:"theorem_extension_min_grant"`
+	// Amount of a single OpenMath or bounty reward payout paid liquid, per denom. The excess vests linearly over
+	// reward_vesting_period. Empty disables the vesting of rewards.
+	RewardVestingThreshold []types1.Coin `protobuf:"bytes,17,rep,name=reward_vesting_threshold,json=rewardVestingThreshold,proto3" json:"reward_vesting_threshold"`
+	// Duration of the vesting schedule of rewards above the threshold. Initial value: 180 days.
+	RewardVestingPeriod *time.Duration `protobuf:"bytes,18,opt,name=reward_vesting_period,json=rewardVestingPeriod,proto3,stdduration" json:"reward_vesting_period,omitempty"`
+	// Duration checkers have to decide a proof once its detail is revealed. A proof still undecided
+	// at the end of it is refunded and deleted. Initial value: 7 days.
+	ProofVerificationPeriod *time.Duration `protobuf:"bytes,19,opt,name=proof_verification_period,json=proofVerificationPeriod,proto3,stdduration" json:"proof_verification_period,omitempty"`
+	// Deposit added by the prover for every proof detail chunk stored, on top of min_deposit, so the
+	// deposit of a proof scales with its stored size. Initial value: 100000uctk.
+	ProofChunkDeposit []types1.Coin `protobuf:"bytes,20,rep,name=proof_chunk_deposit,json=proofChunkDeposit,proto3" json:"proof_chunk_deposit"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{22}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.PaymentHash)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMinGrant() []types1.Coin {
+	if m != nil {
+		return m.MinGrant
 	}
-	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime)
-	n += 1 + l + sovBounty(uint64(l))
-	return n
+	return nil
 }
 
-func (m *ProgramFingerprint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetMinDeposit() []types1.Coin {
+	if m != nil {
+		return m.MinDeposit
 	}
-	var l int
-	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return nil
+}
+
+func (m *Params) GetTheoremMaxProofPeriod() *time.Duration {
+	if m != nil {
+		return m.TheoremMaxProofPeriod
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return nil
+}
+
+func (m *Params) GetProofMaxLockPeriod() *time.Duration {
+	if m != nil {
+		return m.ProofMaxLockPeriod
 	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return nil
+}
+
+func (m *Params) GetComplexityFee() types1.Coin {
+	if m != nil {
+		return m.ComplexityFee
 	}
-	l = len(m.AdminAddress)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return types1.Coin{}
+}
+
+func (m *Params) GetMaxComplexity() int64 {
+	if m != nil {
+		return m.MaxComplexity
 	}
-	if m.Status != 0 {
-		n += 1 + sovBounty(uint64(m.Status))
+	return 0
+}
+
+func (m *Params) GetComplexityFeeRocq() types1.Coin {
+	if m != nil {
+		return m.ComplexityFeeRocq
 	}
-	return n
+	return types1.Coin{}
 }
 
-func (m *FindingFingerprint) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Params) GetComplexityFeeLean() types1.Coin {
+	if m != nil {
+		return m.ComplexityFeeLean
 	}
-	var l int
-	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return types1.Coin{}
+}
+
+func (m *Params) GetDisputeWindow() *time.Duration {
+	if m != nil {
+		return m.DisputeWindow
 	}
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return nil
+}
+
+func (m *Params) GetProofVerificationQuorum() uint32 {
+	if m != nil {
+		return m.ProofVerificationQuorum
 	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return 0
+}
+
+func (m *Params) GetTheoremMaxTotalPeriod() *time.Duration {
+	if m != nil {
+		return m.TheoremMaxTotalPeriod
 	}
-	l = len(m.FindingHash)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return nil
+}
+
+func (m *Params) GetTheoremExtensionMinGrant() []types1.Coin {
+	if m != nil {
+		return m.TheoremExtensionMinGrant
 	}
-	if m.SeverityLevel != 0 {
-		n += 1 + sovBounty(uint64(m.SeverityLevel))
+	return nil
+}
+
+func (m *Params) GetRewardVestingThreshold() []types1.Coin {
+	if m != nil {
+		return m.RewardVestingThreshold
 	}
-	if m.Status != 0 {
-		n += 1 + sovBounty(uint64(m.Status))
+	return nil
+}
+
+func (m *Params) GetRewardVestingPeriod() *time.Duration {
+	if m != nil {
+		return m.RewardVestingPeriod
 	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return nil
+}
+
+func (m *Params) GetProofVerificationPeriod() *time.Duration {
+	if m != nil {
+		return m.ProofVerificationPeriod
 	}
-	l = len(m.PaymentHash)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
+	return nil
+}
+
+func (m *Params) GetProofChunkDeposit() []types1.Coin {
+	if m != nil {
+		return m.ProofChunkDeposit
 	}
-	return n
+	return nil
 }
 
-func (m *Theorem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Id != 0 {
-		n += 1 + sovBounty(uint64(m.Id))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovBounty(uint64(m.Status))
-	}
-	if m.SubmitTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if m.EndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if len(m.TotalGrant) > 0 {
-		for _, e := range m.TotalGrant {
-			l = e.Size()
-			n += 1 + l + sovBounty(uint64(l))
-		}
-	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if m.Complexity != 0 {
-		n += 1 + sovBounty(uint64(m.Complexity))
-	}
-	if m.ImportedCount != 0 {
-		n += 1 + sovBounty(uint64(m.ImportedCount))
-	}
-	if len(m.Imports) > 0 {
-		l = 0
-		for _, e := range m.Imports {
-			l += sovBounty(uint64(e))
+// OpenMathStats defines the OpenMath statistics of a prover, checker or theorem proposer.
+type OpenMathStats struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// theorems_proven is the number of theorems proven by the address.
+	TheoremsProven uint64 `protobuf:"varint,2,opt,name=theorems_proven,json=theoremsProven,proto3" json:"theorems_proven,omitempty"`
+	// proofs_failed is the number of proofs of the address failed by the checkers.
+	ProofsFailed uint64 `protobuf:"varint,3,opt,name=proofs_failed,json=proofsFailed,proto3" json:"proofs_failed,omitempty"`
+	// proofs_checked is the number of decided proofs the address verified as a checker.
+	ProofsChecked uint64 `protobuf:"varint,4,opt,name=proofs_checked,json=proofsChecked,proto3" json:"proofs_checked,omitempty"`
+	// prover_rewards is the sum of the rewards earned as a prover.
+	ProverRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=prover_rewards,json=proverRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"prover_rewards"`
+	// checker_rewards is the sum of the rewards earned as a checker.
+	CheckerRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=checker_rewards,json=checkerRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"checker_rewards"`
+	// imported_rewards is the sum of the rewards earned from the imports of the theorems proposed by the address.
+	ImportedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=imported_rewards,json=importedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"imported_rewards"`
+}
+
+func (m *OpenMathStats) Reset()         { *m = OpenMathStats{} }
+func (m *OpenMathStats) String() string { return proto.CompactTextString(m) }
+func (*OpenMathStats) ProtoMessage()    {}
+func (*OpenMathStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{23}
+}
+func (m *OpenMathStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OpenMathStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OpenMathStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		n += 1 + sovBounty(uint64(l)) + l
-	}
-	if m.RequireOpenmathCert {
-		n += 2
+		return b[:n], nil
 	}
-	return n
+}
+func (m *OpenMathStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenMathStats.Merge(m, src)
+}
+func (m *OpenMathStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *OpenMathStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenMathStats.DiscardUnknown(m)
 }
 
-func (m *Proof) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TheoremId != 0 {
-		n += 1 + sovBounty(uint64(m.TheoremId))
-	}
-	l = len(m.Id)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sovBounty(uint64(m.Status))
-	}
-	if m.SubmitTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if m.EndTime != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.Prover)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if len(m.Deposit) > 0 {
-		for _, e := range m.Deposit {
-			l = e.Size()
-			n += 1 + l + sovBounty(uint64(l))
+var xxx_messageInfo_OpenMathStats proto.InternalMessageInfo
+
+// TheoremTypeStats defines the OpenMath statistics of a theorem type.
+type TheoremTypeStats struct {
+	TheoremType     TheoremType                                 `protobuf:"varint,1,opt,name=theorem_type,json=theoremType,proto3,enum=shentu.bounty.v1.TheoremType" json:"theorem_type,omitempty"`
+	TheoremsProven  uint64                                      `protobuf:"varint,2,opt,name=theorems_proven,json=theoremsProven,proto3" json:"theorems_proven,omitempty"`
+	ProofsFailed    uint64                                      `protobuf:"varint,3,opt,name=proofs_failed,json=proofsFailed,proto3" json:"proofs_failed,omitempty"`
+	ProverRewards   github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=prover_rewards,json=proverRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"prover_rewards"`
+	CheckerRewards  github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,5,rep,name=checker_rewards,json=checkerRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"checker_rewards"`
+	ImportedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,6,rep,name=imported_rewards,json=importedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"imported_rewards"`
+}
+
+func (m *TheoremTypeStats) Reset()         { *m = TheoremTypeStats{} }
+func (m *TheoremTypeStats) String() string { return proto.CompactTextString(m) }
+func (*TheoremTypeStats) ProtoMessage()    {}
+func (*TheoremTypeStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{24}
+}
+func (m *TheoremTypeStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TheoremTypeStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TheoremTypeStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *TheoremTypeStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TheoremTypeStats.Merge(m, src)
+}
+func (m *TheoremTypeStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TheoremTypeStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TheoremTypeStats.DiscardUnknown(m)
 }
 
-func (m *ProofHash) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TheoremId != 0 {
-		n += 1 + sovBounty(uint64(m.TheoremId))
-	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.Prover)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	return n
+var xxx_messageInfo_TheoremTypeStats proto.InternalMessageInfo
+
+type Reward struct {
+	Address string                                      `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Reward  github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=reward,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"reward"`
 }
 
-func (m *Grant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TheoremId != 0 {
-		n += 1 + sovBounty(uint64(m.TheoremId))
-	}
-	l = len(m.Grantor)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovBounty(uint64(l))
+func (m *Reward) Reset()         { *m = Reward{} }
+func (m *Reward) String() string { return proto.CompactTextString(m) }
+func (*Reward) ProtoMessage()    {}
+func (*Reward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{25}
+}
+func (m *Reward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Reward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Reward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *Reward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Reward.Merge(m, src)
+}
+func (m *Reward) XXX_Size() int {
+	return m.Size()
+}
+func (m *Reward) XXX_DiscardUnknown() {
+	xxx_messageInfo_Reward.DiscardUnknown(m)
 }
 
-func (m *Deposit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProofId)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = len(m.Depositor)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovBounty(uint64(l))
+var xxx_messageInfo_Reward proto.InternalMessageInfo
+
+// RewardVesting defines the vesting schedules of the rewards of an address held by the module.
+// Vested coins are released to the address on reward withdrawal.
+type RewardVesting struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// schedules are the vesting schedules of the address, one for each vesting payout.
+	Schedules []VestingSchedule `protobuf:"bytes,2,rep,name=schedules,proto3" json:"schedules"`
+}
+
+func (m *RewardVesting) Reset()         { *m = RewardVesting{} }
+func (m *RewardVesting) String() string { return proto.CompactTextString(m) }
+func (*RewardVesting) ProtoMessage()    {}
+func (*RewardVesting) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{26}
+}
+func (m *RewardVesting) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardVesting) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardVesting.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *RewardVesting) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardVesting.Merge(m, src)
+}
+func (m *RewardVesting) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardVesting) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardVesting.DiscardUnknown(m)
 }
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.MinGrant) > 0 {
-		for _, e := range m.MinGrant {
-			l = e.Size()
-			n += 1 + l + sovBounty(uint64(l))
+var xxx_messageInfo_RewardVesting proto.InternalMessageInfo
+
+// VestingSchedule defines the linear vesting of a reward payout.
+type VestingSchedule struct {
+	// total is the amount vesting over the schedule.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+	// released is the amount of the total already released to the address.
+	Released  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=released,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"released"`
+	StartTime time.Time                                `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time"`
+	EndTime   time.Time                                `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time"`
+}
+
+func (m *VestingSchedule) Reset()         { *m = VestingSchedule{} }
+func (m *VestingSchedule) String() string { return proto.CompactTextString(m) }
+func (*VestingSchedule) ProtoMessage()    {}
+func (*VestingSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_36e6d679af1b94c6, []int{27}
+}
+func (m *VestingSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VestingSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VestingSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.MinDeposit) > 0 {
-		for _, e := range m.MinDeposit {
-			l = e.Size()
-			n += 1 + l + sovBounty(uint64(l))
+}
+func (m *VestingSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VestingSchedule.Merge(m, src)
+}
+func (m *VestingSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *VestingSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_VestingSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VestingSchedule proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("shentu.bounty.v1.ProgramStatus", ProgramStatus_name, ProgramStatus_value)
+	proto.RegisterEnum("shentu.bounty.v1.AssetType", AssetType_name, AssetType_value)
+	proto.RegisterEnum("shentu.bounty.v1.ProgramRole", ProgramRole_name, ProgramRole_value)
+	proto.RegisterEnum("shentu.bounty.v1.SeverityLevel", SeverityLevel_name, SeverityLevel_value)
+	proto.RegisterEnum("shentu.bounty.v1.FindingStatus", FindingStatus_name, FindingStatus_value)
+	proto.RegisterEnum("shentu.bounty.v1.DuplicatePolicy", DuplicatePolicy_name, DuplicatePolicy_value)
+	proto.RegisterEnum("shentu.bounty.v1.DisputeStatus", DisputeStatus_name, DisputeStatus_value)
+	proto.RegisterEnum("shentu.bounty.v1.DisputeVoteOption", DisputeVoteOption_name, DisputeVoteOption_value)
+	proto.RegisterEnum("shentu.bounty.v1.TheoremStatus", TheoremStatus_name, TheoremStatus_value)
+	proto.RegisterEnum("shentu.bounty.v1.ProofStatus", ProofStatus_name, ProofStatus_value)
+	proto.RegisterEnum("shentu.bounty.v1.TheoremType", TheoremType_name, TheoremType_value)
+	proto.RegisterEnum("shentu.bounty.v1.GrantSource", GrantSource_name, GrantSource_value)
+	proto.RegisterEnum("shentu.bounty.v1.LeaderboardMetric", LeaderboardMetric_name, LeaderboardMetric_value)
+	proto.RegisterType((*Program)(nil), "shentu.bounty.v1.Program")
+	proto.RegisterType((*SubmissionRequirements)(nil), "shentu.bounty.v1.SubmissionRequirements")
+	proto.RegisterType((*ScopeTarget)(nil), "shentu.bounty.v1.ScopeTarget")
+	proto.RegisterType((*ProgramMember)(nil), "shentu.bounty.v1.ProgramMember")
+	proto.RegisterType((*SeverityReward)(nil), "shentu.bounty.v1.SeverityReward")
+	proto.RegisterType((*EncryptedFindingPayload)(nil), "shentu.bounty.v1.EncryptedFindingPayload")
+	proto.RegisterType((*FindingPayloadKey)(nil), "shentu.bounty.v1.FindingPayloadKey")
+	proto.RegisterType((*Finding)(nil), "shentu.bounty.v1.Finding")
+	proto.RegisterType((*ProgramFingerprint)(nil), "shentu.bounty.v1.ProgramFingerprint")
+	proto.RegisterType((*Dispute)(nil), "shentu.bounty.v1.Dispute")
+	proto.RegisterType((*DisputeVote)(nil), "shentu.bounty.v1.DisputeVote")
+	proto.RegisterType((*FindingFingerprint)(nil), "shentu.bounty.v1.FindingFingerprint")
+	proto.RegisterType((*Sponsorship)(nil), "shentu.bounty.v1.Sponsorship")
+	proto.RegisterType((*HackerReputation)(nil), "shentu.bounty.v1.HackerReputation")
+	proto.RegisterType((*SeverityCount)(nil), "shentu.bounty.v1.SeverityCount")
+	proto.RegisterType((*Theorem)(nil), "shentu.bounty.v1.Theorem")
+	proto.RegisterType((*Proof)(nil), "shentu.bounty.v1.Proof")
+	proto.RegisterType((*ProofChunk)(nil), "shentu.bounty.v1.ProofChunk")
+	proto.RegisterType((*ProofVerdict)(nil), "shentu.bounty.v1.ProofVerdict")
+	proto.RegisterType((*ProofHash)(nil), "shentu.bounty.v1.ProofHash")
+	proto.RegisterType((*Grant)(nil), "shentu.bounty.v1.Grant")
+	proto.RegisterType((*Deposit)(nil), "shentu.bounty.v1.Deposit")
+	proto.RegisterType((*Params)(nil), "shentu.bounty.v1.Params")
+	proto.RegisterType((*OpenMathStats)(nil), "shentu.bounty.v1.OpenMathStats")
+	proto.RegisterType((*TheoremTypeStats)(nil), "shentu.bounty.v1.TheoremTypeStats")
+	proto.RegisterType((*Reward)(nil), "shentu.bounty.v1.Reward")
+	proto.RegisterType((*RewardVesting)(nil), "shentu.bounty.v1.RewardVesting")
+	proto.RegisterType((*VestingSchedule)(nil), "shentu.bounty.v1.VestingSchedule")
+}
+
+func init() { proto.RegisterFile("shentu/bounty/v1/bounty.proto", fileDescriptor_36e6d679af1b94c6) }
+
+var fileDescriptor_36e6d679af1b94c6 = []byte{
+	// 4681 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x7b, 0xdb, 0x6f, 0x23, 0x59,
+	0x5a, 0x78, 0xfb, 0x92, 0x38, 0xfe, 0x1c, 0x27, 0xce, 0x49, 0xd2, 0xed, 0xb8, 0xbb, 0x63, 0x4f,
+	0xcd, 0x6f, 0x76, 0x33, 0xbd, 0xbf, 0x49, 0xb6, 0x7b, 0x67, 0x87, 0x51, 0x2f, 0xec, 0x8e, 0x63,
+	0x3b, 0x1d, 0x4f, 0x3b, 0xb1, 0xe7, 0xd8, 0x49, 0xef, 0xec, 0x48, 0x94, 0xaa, 0xab, 0x4e, 0xe2,
+	0x52, 0xdb, 0x55, 0xd5, 0x55, 0xe5, 0x74, 0xf2, 0xb0, 0x42, 0x48, 0x08, 0x0d, 0x79, 0x40, 0xc3,
+	0x03, 0xd2, 0x0a, 0x29, 0xd2, 0x48, 0xf0, 0x80, 0x10, 0x48, 0x80, 0x16, 0x24, 0x5e, 0x79, 0x40,
+	0xcb, 0x03, 0x62, 0xd9, 0x17, 0x2e, 0x82, 0x0c, 0x3b, 0x23, 0x04, 0x42, 0x02, 0xa1, 0xf0, 0x0f,
+	0xa0, 0x73, 0xa9, 0x72, 0x55, 0xd9, 0xe9, 0x5c, 0x76, 0x86, 0x79, 0xe0, 0xa5, 0xdb, 0xf5, 0x9d,
+	0xef, 0x76, 0xbe, 0xfb, 0x39, 0x55, 0x81, 0xbb, 0x4e, 0x97, 0x18, 0xee, 0x60, 0xed, 0xa9, 0x39,
+	0x30, 0xdc, 0xa3, 0xb5, 0x83, 0xfb, 0xe2, 0xd7, 0xaa, 0x65, 0x9b, 0xae, 0x89, 0x72, 0x7c, 0x79,
+	0x55, 0x00, 0x0f, 0xee, 0x17, 0x16, 0xf6, 0xcd, 0x7d, 0x93, 0x2d, 0xae, 0xd1, 0x5f, 0x1c, 0xaf,
+	0x50, 0xdc, 0x37, 0xcd, 0xfd, 0x1e, 0x59, 0x63, 0x4f, 0x4f, 0x07, 0x7b, 0x6b, 0xae, 0xde, 0x27,
+	0x8e, 0xab, 0xf4, 0x2d, 0x81, 0xb0, 0xac, 0x9a, 0x4e, 0xdf, 0x74, 0xd6, 0x9e, 0x2a, 0x0e, 0x59,
+	0x3b, 0xb8, 0xff, 0x94, 0xb8, 0xca, 0xfd, 0x35, 0xd5, 0xd4, 0x0d, 0xb1, 0xbe, 0xc4, 0xd7, 0x65,
+	0xce, 0x99, 0x3f, 0x78, 0x4b, 0x51, 0xde, 0x8a, 0x71, 0xe4, 0x71, 0x8d, 0x2e, 0x69, 0x03, 0x5b,
+	0x71, 0x75, 0xd3, 0xe3, 0x3a, 0xa7, 0xf4, 0x75, 0xc3, 0x5c, 0x63, 0xff, 0x72, 0x90, 0xf4, 0xeb,
+	0x00, 0xa9, 0x96, 0x6d, 0xee, 0xdb, 0x4a, 0x1f, 0xbd, 0x09, 0x60, 0xf1, 0x9f, 0xb2, 0xae, 0xe5,
+	0x63, 0xa5, 0xd8, 0x4a, 0x7a, 0x7d, 0xf1, 0xec, 0xb4, 0x38, 0x77, 0xa4, 0xf4, 0x7b, 0x0f, 0xa5,
+	0xe1, 0x9a, 0x84, 0xd3, 0xe2, 0xa1, 0xae, 0xa1, 0x57, 0x21, 0x69, 0x28, 0x7d, 0x92, 0x8f, 0x33,
+	0xfc, 0xd9, 0xb3, 0xd3, 0x62, 0x86, 0xe3, 0x53, 0xa8, 0x84, 0xd9, 0x22, 0x7a, 0x1d, 0x26, 0x35,
+	0xe2, 0x2a, 0x7a, 0x2f, 0x9f, 0x60, 0x68, 0x73, 0x67, 0xa7, 0xc5, 0x2c, 0x47, 0xe3, 0x70, 0x09,
+	0x0b, 0x04, 0xf4, 0x0b, 0x90, 0x55, 0xb4, 0xbe, 0x6e, 0xc8, 0x8a, 0xa6, 0xd9, 0xc4, 0x71, 0xf2,
+	0x49, 0x46, 0x91, 0x3f, 0x3b, 0x2d, 0x2e, 0x70, 0x8a, 0xd0, 0xb2, 0x84, 0xa7, 0xd9, 0x73, 0x99,
+	0x3f, 0xa2, 0x77, 0x61, 0xd2, 0x71, 0x15, 0x77, 0xe0, 0xe4, 0x27, 0x4a, 0xb1, 0x95, 0x99, 0x07,
+	0xc5, 0xd5, 0xa8, 0xcf, 0x56, 0xc5, 0x7e, 0xdb, 0x0c, 0x2d, 0xa8, 0x0a, 0x27, 0x94, 0xb0, 0xe0,
+	0x80, 0x3e, 0x80, 0x8c, 0x6a, 0x13, 0xc5, 0x25, 0x32, 0xf5, 0x5f, 0x7e, 0xb2, 0x14, 0x5b, 0xc9,
+	0x3c, 0x28, 0xac, 0x72, 0x2b, 0xaf, 0x7a, 0x56, 0x5e, 0xed, 0x78, 0xce, 0x5d, 0x5f, 0xfe, 0xd1,
+	0x69, 0xf1, 0xc6, 0xd9, 0x69, 0x11, 0x71, 0x7e, 0x01, 0x62, 0xe9, 0xa3, 0x4f, 0x8a, 0x31, 0x0c,
+	0x1c, 0x42, 0x09, 0x28, 0x73, 0x9b, 0xbc, 0x50, 0x6c, 0x4d, 0xb6, 0x4c, 0xb3, 0x97, 0x4f, 0x95,
+	0x12, 0x2b, 0x99, 0x07, 0x4b, 0xab, 0xc2, 0xd7, 0x34, 0x30, 0x56, 0x45, 0x60, 0xac, 0x56, 0x4c,
+	0xdd, 0x58, 0x2f, 0x86, 0x79, 0x07, 0x68, 0xa5, 0xdf, 0xfd, 0xd7, 0x3f, 0xbc, 0x17, 0xc3, 0xc0,
+	0x41, 0x2d, 0xd3, 0xec, 0x21, 0x1d, 0x66, 0x05, 0x82, 0xa3, 0x76, 0x89, 0x36, 0xe8, 0x91, 0xfc,
+	0x14, 0x13, 0x50, 0x1a, 0x35, 0x47, 0x9b, 0x1c, 0x10, 0x5b, 0x77, 0x8f, 0x30, 0x23, 0xf0, 0xf7,
+	0x70, 0x33, 0x24, 0xc7, 0x63, 0x23, 0xe1, 0x19, 0x0e, 0x69, 0x0b, 0x00, 0x6a, 0x00, 0x52, 0x6d,
+	0xdd, 0xd5, 0x55, 0xa5, 0x27, 0x2b, 0x96, 0x65, 0x9b, 0x07, 0x4a, 0xcf, 0xc9, 0xa7, 0x4b, 0xb1,
+	0x95, 0xec, 0xfa, 0xdd, 0xb3, 0xd3, 0xe2, 0x92, 0x67, 0x8b, 0x28, 0x8e, 0x84, 0xe7, 0x3c, 0x60,
+	0xd9, 0x83, 0x21, 0x1d, 0x72, 0xda, 0xc0, 0xea, 0xe9, 0x2a, 0x35, 0x9c, 0x65, 0xf6, 0x74, 0xf5,
+	0x28, 0x0f, 0xcc, 0x91, 0xaf, 0x8c, 0x6a, 0x5e, 0xf5, 0x30, 0x5b, 0x0c, 0x71, 0xfd, 0xf6, 0xd9,
+	0x69, 0xf1, 0x96, 0x88, 0xaa, 0x08, 0x13, 0x09, 0xcf, 0x6a, 0x61, 0x6c, 0x24, 0xc3, 0x8c, 0xa2,
+	0xba, 0xfa, 0x01, 0xcb, 0x10, 0xd9, 0xe9, 0x29, 0xf9, 0x0c, 0x73, 0xf0, 0xd2, 0x88, 0x83, 0xab,
+	0x22, 0x8d, 0xd8, 0x7e, 0x16, 0x45, 0x10, 0x86, 0x48, 0xa5, 0x1f, 0x50, 0xf7, 0x66, 0x87, 0xc0,
+	0x76, 0x4f, 0x41, 0x04, 0x72, 0xaa, 0x69, 0xec, 0xe9, 0x76, 0x7f, 0x28, 0x62, 0xfa, 0x22, 0x11,
+	0xc5, 0xe1, 0x1e, 0xa2, 0xc4, 0x5c, 0xc8, 0x6c, 0x10, 0x4c, 0xc5, 0xd4, 0x61, 0xc2, 0x51, 0x4d,
+	0x8b, 0xe4, 0xb3, 0xcc, 0xc3, 0x77, 0xc7, 0x78, 0x98, 0x2e, 0x77, 0x14, 0x7b, 0x9f, 0xb8, 0xeb,
+	0x0b, 0xc2, 0xbd, 0xd3, 0x22, 0xe4, 0xe9, 0x92, 0x84, 0x39, 0x07, 0xf4, 0x6b, 0x31, 0xb8, 0xe5,
+	0x0c, 0x9e, 0xf6, 0x75, 0xc7, 0xa1, 0x32, 0x6d, 0xf2, 0x7c, 0xa0, 0xdb, 0xa4, 0x4f, 0x0c, 0xd7,
+	0xc9, 0xcf, 0x30, 0xcd, 0x57, 0xc6, 0x70, 0xf7, 0x09, 0x70, 0x00, 0x7f, 0xfd, 0x2b, 0x42, 0xd0,
+	0xb2, 0x10, 0x34, 0x9e, 0xad, 0x84, 0x6f, 0x3a, 0x63, 0xe9, 0xd1, 0x33, 0x40, 0x9a, 0xee, 0xa8,
+	0x3d, 0xd3, 0x19, 0xd8, 0x44, 0x26, 0xfd, 0xa7, 0x8a, 0xbd, 0x6f, 0xe6, 0x67, 0x2f, 0xb2, 0xdf,
+	0x2b, 0xc3, 0x90, 0x1b, 0x25, 0xe7, 0x16, 0x9c, 0x1b, 0x2e, 0xd4, 0x38, 0xfc, 0xe1, 0xd4, 0x87,
+	0x1f, 0x17, 0x6f, 0xfc, 0xdb, 0xc7, 0xc5, 0x1b, 0xd2, 0xdf, 0xc4, 0xe0, 0xe6, 0xf8, 0x1d, 0xa1,
+	0x27, 0x70, 0x93, 0x16, 0x1e, 0x61, 0x7f, 0xa2, 0xc9, 0x7b, 0xba, 0xa1, 0xe9, 0xc6, 0xbe, 0xc3,
+	0x6a, 0x65, 0x92, 0x89, 0xbe, 0xcb, 0x45, 0x8f, 0xc7, 0x93, 0xf0, 0x42, 0x5f, 0x37, 0x2a, 0x1e,
+	0x7c, 0x43, 0x80, 0x51, 0x07, 0x16, 0x85, 0x4d, 0x64, 0x5d, 0x23, 0x86, 0xab, 0xbb, 0x47, 0xb2,
+	0x4a, 0x6c, 0x97, 0xd5, 0xd4, 0xa9, 0xf5, 0xd2, 0xd9, 0x69, 0xf1, 0x8e, 0x97, 0x8d, 0x63, 0xd0,
+	0x24, 0x3c, 0x2f, 0xe0, 0x75, 0x01, 0xae, 0x10, 0xdb, 0x0d, 0xec, 0xe9, 0x4f, 0x13, 0x90, 0x09,
+	0xc4, 0x00, 0xba, 0x0f, 0x69, 0x97, 0xfd, 0x1a, 0xd6, 0xf9, 0x85, 0xb3, 0xd3, 0x62, 0x8e, 0xcb,
+	0xf0, 0x97, 0x24, 0x3c, 0xc5, 0x7f, 0xd7, 0x35, 0xf4, 0x1e, 0x80, 0xe2, 0x38, 0xc4, 0x95, 0xdd,
+	0x23, 0x8b, 0xd7, 0xfa, 0x99, 0x07, 0xb7, 0x47, 0x63, 0xa1, 0x4c, 0x71, 0x3a, 0x47, 0x16, 0x09,
+	0x36, 0x8e, 0x21, 0xa1, 0x84, 0xd3, 0x8a, 0x87, 0x81, 0xd6, 0x60, 0xaa, 0x67, 0xaa, 0xcc, 0x6b,
+	0xa2, 0x2b, 0xcc, 0x9f, 0x9d, 0x16, 0x67, 0x39, 0x8d, 0xb7, 0x22, 0x61, 0x1f, 0x09, 0xad, 0xc2,
+	0x94, 0xda, 0x55, 0x74, 0x83, 0x6a, 0x9d, 0x8c, 0x12, 0x78, 0x2b, 0x12, 0x4e, 0xb1, 0x9f, 0x75,
+	0x8d, 0x36, 0x1d, 0xd5, 0xec, 0xf7, 0x75, 0x97, 0xb5, 0x82, 0x50, 0xd3, 0xe1, 0x70, 0x09, 0x0b,
+	0x04, 0xca, 0x5a, 0x37, 0x64, 0x9e, 0x46, 0x93, 0xcc, 0xe8, 0x01, 0xd6, 0xde, 0x8a, 0x84, 0x53,
+	0xba, 0xc1, 0xec, 0x88, 0x3e, 0x80, 0xe9, 0xbe, 0x72, 0x28, 0x3b, 0xa2, 0x74, 0xe6, 0x53, 0xe7,
+	0xf5, 0x1a, 0xaf, 0xb8, 0x36, 0xc8, 0x01, 0xe9, 0xad, 0xdf, 0x3a, 0x3b, 0x2d, 0xce, 0x8b, 0x08,
+	0x09, 0x90, 0x4b, 0x38, 0xd3, 0x57, 0x0e, 0x3d, 0xd4, 0x80, 0xe3, 0xfe, 0x3e, 0x06, 0x59, 0xd1,
+	0xad, 0xb6, 0x48, 0xff, 0x29, 0xb1, 0xaf, 0xd9, 0xa3, 0xab, 0x90, 0xf2, 0xba, 0x29, 0x6f, 0xd3,
+	0xf7, 0xce, 0x4e, 0x8b, 0x33, 0x5e, 0x37, 0xe5, 0x7d, 0xf4, 0x27, 0x3f, 0x7c, 0x63, 0x41, 0x34,
+	0x1f, 0xd1, 0x4b, 0xdb, 0xae, 0xad, 0x1b, 0xfb, 0xd8, 0x23, 0x45, 0xeb, 0x90, 0xb4, 0xcd, 0x1e,
+	0x61, 0xce, 0x9a, 0x19, 0x57, 0x67, 0x84, 0xaa, 0xd8, 0xec, 0x91, 0xe0, 0x20, 0x40, 0x89, 0x24,
+	0xcc, 0x68, 0x03, 0x7b, 0xfb, 0xa3, 0x38, 0xcc, 0x84, 0x5b, 0x0f, 0x52, 0x60, 0xc6, 0x33, 0x89,
+	0xdc, 0xa3, 0x06, 0x63, 0x1b, 0xbc, 0x84, 0x5d, 0x97, 0x86, 0x75, 0x39, 0xcc, 0x40, 0xc2, 0x59,
+	0x27, 0x88, 0x89, 0xbe, 0x0b, 0xc0, 0x86, 0x87, 0x3e, 0xe5, 0x94, 0x8f, 0x5f, 0xd4, 0x74, 0xbd,
+	0x66, 0x38, 0x37, 0x4c, 0x6b, 0x4e, 0x2a, 0x7a, 0x6e, 0x9a, 0x4e, 0x1e, 0x0c, 0xc0, 0x38, 0x2b,
+	0x87, 0x1e, 0xe7, 0xc4, 0x55, 0x39, 0xfb, 0xa4, 0x3e, 0x67, 0xe5, 0x90, 0x73, 0x0e, 0xd8, 0xec,
+	0xfb, 0x70, 0xab, 0x66, 0xa8, 0xf6, 0x91, 0xe5, 0xfa, 0xd5, 0xa3, 0xa5, 0x1c, 0xf5, 0x4c, 0x45,
+	0xa3, 0xce, 0x79, 0x46, 0x8e, 0x68, 0x29, 0xa2, 0x82, 0x5f, 0x1d, 0xb5, 0x58, 0x18, 0xff, 0x31,
+	0x39, 0x5a, 0x4f, 0x53, 0x15, 0xb8, 0x34, 0x46, 0x8b, 0x96, 0x01, 0x54, 0xdd, 0xea, 0x12, 0xdb,
+	0x25, 0x87, 0xbc, 0xf8, 0x4c, 0xe3, 0x00, 0x44, 0xea, 0xc1, 0xdc, 0x08, 0x17, 0xf4, 0x16, 0xa4,
+	0x6d, 0xa2, 0xea, 0x96, 0x4e, 0x0c, 0x57, 0x04, 0x64, 0xfe, 0xdc, 0x58, 0x1a, 0xa2, 0xa2, 0x22,
+	0x64, 0x5e, 0xd8, 0x8a, 0x65, 0x11, 0x4d, 0x7e, 0x46, 0x8e, 0x3c, 0x69, 0x02, 0xf4, 0x98, 0x1c,
+	0x49, 0x9f, 0x00, 0xa4, 0x84, 0xb8, 0x6b, 0x86, 0xfd, 0x9b, 0x00, 0xa2, 0xf4, 0x52, 0xaa, 0x78,
+	0x94, 0x6a, 0xb8, 0x26, 0xe1, 0xb4, 0x78, 0xa8, 0x6b, 0x68, 0x01, 0x26, 0x5c, 0xdd, 0x15, 0x71,
+	0x9e, 0xc6, 0xfc, 0x01, 0xbd, 0x0d, 0x19, 0x8d, 0x38, 0xaa, 0xad, 0x5b, 0xac, 0x60, 0xf1, 0xfa,
+	0x73, 0x73, 0x38, 0x8f, 0x05, 0x16, 0x25, 0x1c, 0x44, 0x45, 0x35, 0xc8, 0x59, 0xb6, 0x69, 0xee,
+	0xc9, 0xe6, 0x1e, 0xed, 0x09, 0x2a, 0xb1, 0xbc, 0x82, 0x14, 0x98, 0x57, 0xa2, 0x18, 0x12, 0x9e,
+	0x61, 0xa0, 0xe6, 0x5e, 0x85, 0x03, 0xd0, 0x43, 0x98, 0xf6, 0x14, 0xee, 0x2a, 0x4e, 0x97, 0x95,
+	0xa9, 0x74, 0xb0, 0xa2, 0x04, 0x57, 0x25, 0x9c, 0x11, 0x8f, 0x9b, 0x8a, 0xd3, 0x45, 0x75, 0x98,
+	0x63, 0x5d, 0xd6, 0x75, 0x89, 0xed, 0xcf, 0xd5, 0x29, 0xc6, 0xe0, 0xce, 0xd9, 0x69, 0x31, 0x1f,
+	0x68, 0xd1, 0x41, 0x14, 0x09, 0xe7, 0x7c, 0x98, 0x37, 0x5f, 0x8f, 0xe6, 0xe8, 0xd4, 0xe7, 0x9d,
+	0xa3, 0xc3, 0x11, 0x3e, 0x7d, 0x1e, 0x6b, 0x11, 0x17, 0x17, 0x8f, 0xf0, 0xc3, 0x83, 0x07, 0x5c,
+	0x74, 0xf0, 0x78, 0x08, 0xd3, 0x96, 0x72, 0x44, 0x5b, 0x3d, 0x37, 0x70, 0x26, 0x6a, 0xe0, 0xe0,
+	0xaa, 0x84, 0x33, 0xe2, 0x91, 0x19, 0x38, 0x72, 0x52, 0x98, 0xfe, 0x5c, 0x4f, 0x0a, 0x5b, 0x30,
+	0xc9, 0x67, 0x6e, 0x31, 0xe1, 0xbd, 0xa4, 0xaa, 0x14, 0x04, 0xdb, 0x6c, 0x70, 0x78, 0x17, 0x15,
+	0x45, 0x30, 0x41, 0x2e, 0xcc, 0x11, 0xaf, 0x88, 0xc8, 0x16, 0x4f, 0x64, 0x31, 0xdd, 0xbd, 0x3e,
+	0x6a, 0xe9, 0x73, 0xea, 0x4d, 0x30, 0x6e, 0x46, 0xb8, 0x49, 0x38, 0xe7, 0xc3, 0xbc, 0xfa, 0xf4,
+	0x10, 0xa6, 0x87, 0x33, 0xb9, 0xb9, 0xc7, 0x06, 0xb9, 0x90, 0x75, 0x83, 0xab, 0x34, 0x83, 0xbc,
+	0xc7, 0xe6, 0x1e, 0xfa, 0x1e, 0x4c, 0x3b, 0x3d, 0x45, 0xd6, 0x88, 0xa2, 0xf5, 0x74, 0x83, 0xe4,
+	0x73, 0x17, 0x9a, 0xf7, 0xf6, 0x90, 0x6f, 0x90, 0x92, 0xdb, 0x36, 0xe3, 0xf4, 0x94, 0xaa, 0x80,
+	0x84, 0x67, 0xa1, 0xb9, 0x4b, 0xcd, 0x42, 0x26, 0xcc, 0x07, 0x46, 0x4b, 0x5f, 0x2b, 0x74, 0xa1,
+	0x56, 0xd2, 0xd9, 0x69, 0xb1, 0x30, 0x32, 0x9b, 0x86, 0x95, 0x0b, 0x0c, 0xbd, 0xbe, 0x8e, 0x8d,
+	0xd0, 0x28, 0x6c, 0x1e, 0x10, 0x5b, 0x1b, 0x90, 0xfc, 0x3c, 0x9b, 0x53, 0xee, 0x8e, 0x9d, 0x77,
+	0x05, 0x8e, 0x14, 0x9c, 0x75, 0x9b, 0x1c, 0x16, 0x68, 0x27, 0x3f, 0x48, 0x00, 0x12, 0x3d, 0x7b,
+	0x43, 0x37, 0xf6, 0x89, 0x6d, 0xd9, 0xba, 0xe1, 0xa2, 0x07, 0x63, 0x8a, 0xed, 0xfc, 0xbf, 0x9f,
+	0x16, 0xe3, 0xba, 0x76, 0x76, 0x5a, 0x4c, 0x8b, 0xa1, 0xe8, 0xff, 0xcc, 0x2d, 0xc0, 0x98, 0xb3,
+	0xf4, 0xe4, 0x17, 0x73, 0x96, 0x0e, 0xb8, 0xe6, 0x3f, 0x92, 0x90, 0xaa, 0xea, 0x8e, 0x35, 0x70,
+	0x49, 0xa4, 0x8d, 0xc5, 0x2e, 0xd9, 0xc6, 0xc2, 0x2d, 0x33, 0x7e, 0xc9, 0x96, 0xb9, 0x01, 0x39,
+	0x8d, 0x8b, 0x1d, 0x36, 0x8a, 0x44, 0xb4, 0x59, 0x45, 0x31, 0xe8, 0xe1, 0x5a, 0x80, 0x3c, 0x07,
+	0xbc, 0x4e, 0x6b, 0x96, 0xe2, 0xf8, 0x9d, 0x72, 0x2e, 0x58, 0x94, 0x28, 0x5c, 0xc2, 0x02, 0xe1,
+	0x32, 0xbe, 0x12, 0x96, 0xf8, 0x92, 0x6f, 0x6c, 0x30, 0x4c, 0x11, 0x43, 0xe3, 0x9c, 0x53, 0x17,
+	0x97, 0x20, 0xc1, 0x79, 0xd6, 0x2b, 0x92, 0x5a, 0x80, 0x6d, 0x8a, 0x18, 0x1a, 0xe3, 0xf9, 0x10,
+	0xa6, 0x07, 0x56, 0xd7, 0xec, 0x69, 0xf2, 0x81, 0xe9, 0x12, 0x87, 0x35, 0xd3, 0x64, 0xb0, 0x2c,
+	0x06, 0x57, 0x25, 0x9c, 0xe1, 0x8f, 0xbb, 0xf4, 0x09, 0xbd, 0x03, 0x33, 0x34, 0xcf, 0xdd, 0x81,
+	0x6d, 0x08, 0xea, 0x34, 0xa3, 0x0e, 0x74, 0xda, 0xf0, 0xba, 0x84, 0xb3, 0x1e, 0x80, 0x71, 0x08,
+	0xc4, 0xdb, 0x3f, 0xc5, 0x20, 0x23, 0xac, 0x4c, 0x97, 0xae, 0x19, 0x73, 0xdf, 0x86, 0x09, 0x2a,
+	0xc8, 0x16, 0xe1, 0xb6, 0x32, 0xbc, 0x67, 0x60, 0xe0, 0xf3, 0xcf, 0x18, 0x9c, 0x0c, 0x6d, 0xc3,
+	0xa4, 0x69, 0xf9, 0x07, 0xc2, 0x99, 0x71, 0x63, 0x6c, 0x40, 0xc9, 0x26, 0x43, 0x0d, 0x86, 0x83,
+	0x29, 0xe6, 0x2f, 0xc1, 0x25, 0xb0, 0xbf, 0xff, 0x4a, 0x00, 0x12, 0x1d, 0x2c, 0x58, 0xea, 0xae,
+	0x37, 0x57, 0x3e, 0x18, 0x33, 0x57, 0x8e, 0x2f, 0x90, 0x17, 0x4d, 0x95, 0xd1, 0xa1, 0x2e, 0x79,
+	0x85, 0xa1, 0x6e, 0x74, 0x12, 0x9b, 0xf8, 0xe2, 0x26, 0xb1, 0xc9, 0xcf, 0x71, 0x12, 0x4b, 0x5d,
+	0x75, 0x12, 0x9b, 0xba, 0xfc, 0x24, 0x16, 0x70, 0xf9, 0x4f, 0x63, 0x90, 0x69, 0x5b, 0xa6, 0xe1,
+	0x98, 0xb6, 0xd3, 0xd5, 0xad, 0x6b, 0xfb, 0x3a, 0xe5, 0x70, 0x26, 0xc2, 0xd1, 0xe7, 0x1f, 0x6e,
+	0x3c, 0x44, 0xd4, 0x85, 0xc9, 0xcb, 0x1e, 0x03, 0xbf, 0x49, 0xab, 0xc4, 0xef, 0x7d, 0x52, 0x5c,
+	0xd9, 0xd7, 0xdd, 0xee, 0xe0, 0xe9, 0xaa, 0x6a, 0xf6, 0xc5, 0x75, 0xbf, 0xf8, 0xef, 0x0d, 0x47,
+	0x7b, 0xb6, 0xe6, 0x1e, 0x59, 0xc4, 0x61, 0x04, 0x8e, 0x98, 0xe5, 0xc4, 0x59, 0xf1, 0x2f, 0x12,
+	0x90, 0xdb, 0x54, 0xd4, 0x67, 0xc4, 0xc6, 0xc4, 0x1a, 0xb8, 0xfc, 0x9e, 0x24, 0x70, 0xda, 0x8f,
+	0x5d, 0xff, 0xb4, 0xff, 0x04, 0xd2, 0xfe, 0x0d, 0x96, 0x38, 0x28, 0xbf, 0x24, 0xb2, 0x2a, 0x14,
+	0xb2, 0x9e, 0x17, 0x35, 0x2f, 0x17, 0xba, 0xc0, 0x24, 0xd4, 0xa2, 0xfe, 0x6f, 0xda, 0xda, 0x2d,
+	0x45, 0x0f, 0xdc, 0x9e, 0x25, 0x58, 0xd5, 0x0a, 0xb4, 0xf6, 0xd0, 0xb2, 0x84, 0xa7, 0xe9, 0xb3,
+	0x7f, 0x59, 0x56, 0x81, 0x59, 0x3a, 0xd0, 0x04, 0xaf, 0xdf, 0x92, 0x8c, 0x41, 0x61, 0xd8, 0x68,
+	0x23, 0x08, 0x12, 0x9e, 0xe1, 0x10, 0x9f, 0xc9, 0xaf, 0xc4, 0x00, 0x5c, 0xd3, 0x55, 0x7a, 0x32,
+	0xe5, 0x9d, 0x9f, 0xb8, 0xc8, 0x4d, 0xef, 0x86, 0x4f, 0xeb, 0x43, 0x52, 0xe9, 0xea, 0xbe, 0x4b,
+	0x33, 0xea, 0x96, 0xa2, 0x6b, 0x81, 0x60, 0xfd, 0x30, 0x06, 0xd9, 0x90, 0x2d, 0xff, 0x37, 0x2e,
+	0x43, 0x16, 0x60, 0x42, 0x15, 0xf7, 0x20, 0xb1, 0x95, 0x24, 0xe6, 0x0f, 0xd2, 0x7f, 0x4e, 0x40,
+	0xaa, 0xd3, 0x25, 0xa6, 0x4d, 0xfa, 0x68, 0x06, 0xe2, 0x22, 0x57, 0x92, 0x38, 0xae, 0x07, 0xaa,
+	0x58, 0x3c, 0x58, 0xc5, 0x4a, 0xe1, 0xb3, 0x31, 0xaf, 0x70, 0xa1, 0x33, 0x30, 0x82, 0xa4, 0x6a,
+	0x6a, 0x84, 0xd7, 0x37, 0xcc, 0x7e, 0xa3, 0x9f, 0xbb, 0xb8, 0xef, 0x0b, 0x35, 0x78, 0x71, 0xf1,
+	0x2b, 0x49, 0x19, 0x32, 0xfc, 0x58, 0x7a, 0xd9, 0x26, 0x9f, 0xe4, 0xad, 0x9c, 0x13, 0xb1, 0xb6,
+	0xfb, 0xad, 0x2b, 0xb5, 0xf2, 0x64, 0xb8, 0x67, 0xd7, 0x20, 0xc3, 0x03, 0x60, 0xdf, 0x56, 0x0c,
+	0x57, 0xbc, 0x58, 0x79, 0x49, 0xf0, 0x04, 0xee, 0x59, 0x78, 0xd0, 0x3d, 0xa2, 0x74, 0xe8, 0x4d,
+	0x98, 0xb2, 0x6c, 0xd3, 0x32, 0x1d, 0x62, 0xb3, 0xc6, 0xfd, 0xb2, 0xd2, 0xe2, 0x63, 0xb2, 0x3b,
+	0x1a, 0xb3, 0x6f, 0xf5, 0xc8, 0xa1, 0xee, 0xf2, 0x57, 0x23, 0x09, 0x1c, 0x80, 0xa0, 0xd7, 0x60,
+	0x46, 0xef, 0x5b, 0xa6, 0x4d, 0x8f, 0x63, 0xdc, 0xb9, 0x19, 0x86, 0x93, 0xf5, 0xa0, 0x3c, 0xba,
+	0xf2, 0x90, 0xe2, 0x00, 0x27, 0x3f, 0x5d, 0x4a, 0xac, 0x24, 0xb1, 0xf7, 0x88, 0x1e, 0x0c, 0x2f,
+	0xa3, 0x4d, 0x8b, 0x18, 0x7d, 0xc5, 0xed, 0xf2, 0xcb, 0xe8, 0x2c, 0x3d, 0x6f, 0xf8, 0x57, 0xcd,
+	0x4d, 0xb1, 0x56, 0x21, 0xb6, 0x8b, 0xda, 0x80, 0xf6, 0x4c, 0x7b, 0x8f, 0xe8, 0x54, 0xaa, 0x46,
+	0x2c, 0xd3, 0xd1, 0xd9, 0x1b, 0x83, 0xcb, 0x1b, 0x66, 0xce, 0xa7, 0xaf, 0x0a, 0x72, 0xf4, 0x0e,
+	0x4c, 0xbb, 0xdc, 0xff, 0xfc, 0xd2, 0x79, 0xf6, 0xbc, 0x6b, 0x47, 0x11, 0x25, 0x9d, 0x23, 0x8b,
+	0xe0, 0x8c, 0x3b, 0x7c, 0xa0, 0xb6, 0xe0, 0xf7, 0x2a, 0x0e, 0x79, 0x3e, 0x20, 0x86, 0xca, 0x4f,
+	0x8e, 0x49, 0x9c, 0x65, 0xd0, 0xb6, 0x00, 0x4a, 0xff, 0x92, 0x80, 0x89, 0x16, 0x85, 0xa0, 0xbb,
+	0x00, 0x9e, 0x48, 0x3f, 0xec, 0xd3, 0x02, 0x52, 0xd7, 0x44, 0x36, 0xf0, 0xd0, 0xa7, 0xd9, 0x70,
+	0x33, 0x7c, 0x9e, 0xf1, 0xfb, 0xd7, 0x37, 0xfd, 0xc8, 0x4e, 0xbe, 0xe4, 0xaa, 0xd4, 0xdc, 0x7b,
+	0x79, 0x5c, 0x4f, 0xfc, 0x8c, 0x71, 0x3d, 0x79, 0xd5, 0xb8, 0xfe, 0x3a, 0x4c, 0x5a, 0x36, 0x1d,
+	0x10, 0x45, 0x87, 0x3e, 0x3f, 0x1c, 0x05, 0x1e, 0xfa, 0x36, 0xa4, 0x84, 0xbb, 0xae, 0x94, 0x05,
+	0x1e, 0x11, 0x7a, 0x15, 0xb2, 0xdc, 0x64, 0xb2, 0xda, 0x1d, 0x18, 0xcf, 0xe8, 0x00, 0x9b, 0x58,
+	0x49, 0xe3, 0x69, 0x0e, 0xac, 0x30, 0x18, 0xfa, 0x1a, 0xcc, 0x79, 0x48, 0x66, 0xdf, 0xa2, 0x5a,
+	0x10, 0x8d, 0x05, 0xfe, 0x14, 0xce, 0x09, 0x44, 0x1f, 0x8e, 0x0a, 0x30, 0xe5, 0x3b, 0x3b, 0xc3,
+	0xfc, 0xe7, 0x3f, 0x4b, 0x4d, 0x00, 0x66, 0x76, 0xc6, 0x17, 0x2d, 0xb1, 0xf4, 0x33, 0xf7, 0xfc,
+	0x61, 0x00, 0xa7, 0xd8, 0x73, 0x5d, 0xa3, 0xd5, 0x8a, 0xcd, 0x1d, 0xdc, 0xd3, 0xec, 0x37, 0x85,
+	0x69, 0x8a, 0xab, 0x30, 0x4f, 0x4f, 0x63, 0xf6, 0x5b, 0xfa, 0x28, 0x0e, 0xd3, 0x8c, 0xe3, 0x2e,
+	0xb1, 0x35, 0x5d, 0x75, 0x5f, 0xc6, 0xf3, 0x01, 0xa4, 0xd4, 0x2e, 0xa1, 0x9d, 0xfa, 0xe2, 0x39,
+	0x42, 0x20, 0x06, 0xe2, 0x28, 0x71, 0x95, 0x38, 0x0a, 0x97, 0x88, 0xe4, 0x48, 0x89, 0x08, 0xe4,
+	0xfe, 0x44, 0x38, 0xf7, 0xa3, 0x29, 0x37, 0x79, 0xd5, 0x94, 0x93, 0x5c, 0x48, 0x33, 0x95, 0xd8,
+	0x84, 0x7a, 0x41, 0x3a, 0x0d, 0xd3, 0x27, 0x1e, 0x4a, 0x9f, 0x61, 0x1c, 0x26, 0x2e, 0x17, 0x87,
+	0xd2, 0x5f, 0xc7, 0x60, 0x82, 0x17, 0xd5, 0x0b, 0x44, 0x3e, 0x80, 0x14, 0x2b, 0xda, 0x97, 0x99,
+	0xe6, 0x04, 0x22, 0xfa, 0xf9, 0xcb, 0x4f, 0x73, 0x81, 0x18, 0x17, 0x34, 0xcc, 0x87, 0xe6, 0xc0,
+	0x56, 0xc9, 0xf9, 0xb5, 0x80, 0x69, 0xde, 0x66, 0x48, 0x58, 0x20, 0x4b, 0xbf, 0x15, 0xf3, 0x53,
+	0xeb, 0x65, 0x51, 0xf5, 0x16, 0xa4, 0x45, 0xb9, 0xbd, 0xc4, 0x8e, 0x86, 0xa8, 0x3f, 0xdb, 0x9e,
+	0xa4, 0x7f, 0xcc, 0xc2, 0x64, 0x4b, 0xb1, 0x95, 0x3e, 0xad, 0x59, 0xe9, 0xbe, 0x6e, 0x88, 0x4e,
+	0x18, 0xbb, 0x02, 0xaf, 0xa9, 0xbe, 0x6e, 0x70, 0x97, 0xd5, 0x20, 0x43, 0x59, 0x08, 0xe5, 0x2e,
+	0x7e, 0x27, 0x13, 0x6c, 0xa7, 0x7d, 0xdd, 0xf0, 0xac, 0xf4, 0x5d, 0xc8, 0x7b, 0x9e, 0xef, 0x2b,
+	0x87, 0x32, 0xb7, 0x98, 0x45, 0x6c, 0xdd, 0xd4, 0x58, 0x1c, 0xbd, 0xf4, 0xad, 0x71, 0x92, 0xbd,
+	0x18, 0x5e, 0x14, 0x0c, 0xb6, 0x94, 0x43, 0x16, 0xc4, 0x2d, 0x46, 0x8d, 0x30, 0x2c, 0x72, 0x6e,
+	0x94, 0x6f, 0xcf, 0x54, 0x9f, 0x79, 0x6c, 0x93, 0x97, 0x63, 0x8b, 0x18, 0xf5, 0x96, 0x72, 0xd8,
+	0x30, 0xd5, 0x67, 0x82, 0xe7, 0x63, 0x98, 0x19, 0x66, 0xa4, 0xbc, 0x47, 0xbc, 0x72, 0x7f, 0xb9,
+	0x7d, 0x67, 0x87, 0xb4, 0x1b, 0x84, 0xf5, 0x39, 0xaa, 0x5a, 0x20, 0xe9, 0x27, 0x79, 0xcf, 0xef,
+	0x2b, 0x87, 0x95, 0x61, 0xde, 0x77, 0x60, 0x3e, 0x2c, 0x53, 0xb6, 0x4d, 0xf5, 0xb9, 0x98, 0x7f,
+	0x2e, 0xd9, 0xa6, 0x43, 0x82, 0xb1, 0xa9, 0x3e, 0x1f, 0xc3, 0xb5, 0x47, 0x14, 0x83, 0x9d, 0xd9,
+	0xae, 0xc7, 0xb5, 0x41, 0x14, 0x03, 0x6d, 0xc0, 0x8c, 0xb8, 0x52, 0x92, 0x5f, 0xe8, 0x86, 0x66,
+	0xbe, 0x60, 0x23, 0xd2, 0x25, 0x8c, 0x9d, 0x15, 0x64, 0x4f, 0x18, 0x15, 0x7a, 0x08, 0x4b, 0xdc,
+	0x77, 0x74, 0xee, 0xdd, 0xd3, 0xf9, 0x9b, 0x64, 0xf9, 0xf9, 0xc0, 0xb4, 0x07, 0x7d, 0xd6, 0x44,
+	0xb2, 0xf8, 0x96, 0x25, 0x4a, 0xb8, 0xbf, 0xfe, 0x1e, 0x5b, 0x46, 0x36, 0xdc, 0xe1, 0xb4, 0x22,
+	0x34, 0x65, 0xa7, 0xa7, 0x38, 0x5d, 0x79, 0xcf, 0x56, 0x54, 0x36, 0xe7, 0xf2, 0x17, 0x04, 0xf7,
+	0xe9, 0x3e, 0xfe, 0xe1, 0xb4, 0x78, 0x9b, 0xef, 0xd4, 0xd1, 0x9e, 0xad, 0xea, 0xe6, 0x1a, 0x1d,
+	0x8d, 0x56, 0x1b, 0x64, 0x5f, 0x51, 0x8f, 0xaa, 0x44, 0xfd, 0xc9, 0x0f, 0xdf, 0x00, 0x61, 0x88,
+	0x2a, 0x51, 0x31, 0x57, 0x49, 0x04, 0x6e, 0x9b, 0x32, 0xdd, 0x10, 0x3c, 0xd1, 0x21, 0x14, 0x47,
+	0x26, 0x29, 0x59, 0xf4, 0x03, 0xd9, 0xe9, 0x2a, 0x36, 0x7f, 0xb9, 0x70, 0x2d, 0xb1, 0x77, 0xa2,
+	0x33, 0x56, 0x85, 0xf3, 0x6d, 0x53, 0xb6, 0xc8, 0x85, 0xbb, 0xa3, 0x92, 0x59, 0x5e, 0x0b, 0xb9,
+	0xd9, 0xeb, 0xca, 0x2d, 0x44, 0xe5, 0xf2, 0x82, 0xc7, 0xa4, 0x3e, 0x83, 0x3c, 0x97, 0xf1, 0x42,
+	0x77, 0xbb, 0x9a, 0xad, 0xbc, 0xa0, 0xe7, 0x2a, 0x62, 0x28, 0x3d, 0xf7, 0x88, 0xbd, 0x93, 0xb8,
+	0x96, 0xc0, 0x9b, 0x8c, 0xe5, 0x13, 0x9f, 0x63, 0x8b, 0x33, 0x8c, 0x96, 0x08, 0x71, 0x8a, 0xe3,
+	0xb9, 0x3c, 0x7b, 0xe5, 0x12, 0xd1, 0x61, 0xc7, 0x38, 0x9e, 0xce, 0x2a, 0xdc, 0xf6, 0x38, 0x93,
+	0x43, 0x97, 0x18, 0xec, 0x3b, 0x97, 0x61, 0x61, 0xcc, 0x5d, 0xa1, 0xa6, 0x79, 0x2a, 0xd6, 0x3c,
+	0x3e, 0x5b, 0x5e, 0xa1, 0xfc, 0x45, 0xc8, 0x8b, 0x1b, 0xe4, 0x03, 0xe2, 0xb8, 0xba, 0xb1, 0x2f,
+	0xbb, 0x5d, 0x9b, 0x38, 0x5d, 0xb3, 0xa7, 0xe5, 0xe7, 0xae, 0x20, 0xe1, 0x26, 0xe7, 0xb2, 0xcb,
+	0x99, 0x74, 0x3c, 0x1e, 0xa8, 0x4d, 0x27, 0xff, 0x10, 0x7f, 0x61, 0x1b, 0x74, 0x39, 0xdb, 0xcc,
+	0x87, 0xf8, 0x0a, 0xcb, 0x7c, 0x30, 0x36, 0x01, 0x05, 0xe3, 0xf9, 0xcb, 0x31, 0x1e, 0xcd, 0x50,
+	0xc1, 0xbc, 0x03, 0xf3, 0x9c, 0x39, 0x1b, 0x1f, 0xfd, 0x16, 0xb2, 0x70, 0x95, 0x83, 0x87, 0xe5,
+	0x8f, 0x84, 0x22, 0x3a, 0xa5, 0x3f, 0x4f, 0x42, 0x96, 0x1e, 0x6f, 0xb6, 0x14, 0xb7, 0x4b, 0x47,
+	0x2b, 0x7a, 0x26, 0x8a, 0xdc, 0xa8, 0xe4, 0x2f, 0xbe, 0x3f, 0xf9, 0x2a, 0xcc, 0x0a, 0x4f, 0xb2,
+	0xcf, 0x38, 0x0f, 0x88, 0x21, 0x8e, 0xd9, 0x33, 0x1e, 0xb8, 0xc5, 0xa0, 0x74, 0x08, 0x66, 0x3a,
+	0x38, 0xf2, 0x9e, 0xa2, 0xf7, 0x08, 0xef, 0x56, 0x49, 0x3c, 0xcd, 0x81, 0x1b, 0x0c, 0xe6, 0x1f,
+	0x65, 0x1c, 0x51, 0x0c, 0x78, 0xf3, 0xf1, 0x8e, 0x32, 0x0e, 0xcf, 0x64, 0x0d, 0x7d, 0x9f, 0xa1,
+	0x1d, 0x10, 0x5b, 0xe6, 0xbe, 0x70, 0xc4, 0xd5, 0xc6, 0x9d, 0xb1, 0xb6, 0xa8, 0x12, 0x95, 0x99,
+	0xe3, 0x6d, 0x71, 0x09, 0xf5, 0xb5, 0x4b, 0x5c, 0x64, 0x08, 0x1a, 0x71, 0x97, 0x91, 0xe5, 0xd2,
+	0xf8, 0xeb, 0x0e, 0x07, 0xfd, 0x12, 0xcc, 0x7a, 0xb5, 0xca, 0x93, 0x3f, 0xf9, 0x85, 0xca, 0x9f,
+	0x11, 0xe2, 0x3c, 0x05, 0x7e, 0x39, 0x06, 0x39, 0xff, 0xf8, 0xeb, 0xa9, 0x90, 0xfa, 0x42, 0x55,
+	0x98, 0xf5, 0xe4, 0x09, 0x1d, 0x1e, 0x26, 0x3f, 0xfc, 0xb8, 0x78, 0x43, 0xfa, 0xfd, 0x24, 0xe4,
+	0x02, 0x53, 0x32, 0x8f, 0xa3, 0xe8, 0x7c, 0x1d, 0xbb, 0xf2, 0x91, 0xf6, 0xf3, 0x8d, 0xaa, 0xd1,
+	0x70, 0x49, 0x7e, 0xc9, 0xe1, 0x32, 0xf1, 0xe5, 0x87, 0xcb, 0xe4, 0x97, 0x11, 0x2e, 0x7f, 0x1c,
+	0x83, 0x49, 0xf1, 0x15, 0xd4, 0x75, 0x8a, 0x8d, 0xe1, 0x7f, 0x22, 0x10, 0xff, 0x42, 0xb5, 0x17,
+	0x52, 0x84, 0xd2, 0xbf, 0x19, 0x83, 0x2c, 0x0e, 0xd6, 0xfc, 0x6b, 0xe9, 0xfe, 0x2e, 0xa4, 0xbd,
+	0x37, 0xa2, 0x8e, 0x50, 0x7f, 0xcc, 0xb7, 0xbe, 0x42, 0x82, 0xf7, 0xaa, 0x34, 0x58, 0xc2, 0x87,
+	0xe4, 0x42, 0xaf, 0xff, 0x8e, 0xc3, 0x6c, 0x04, 0x1f, 0xed, 0xc1, 0x04, 0xeb, 0xf7, 0x17, 0x1f,
+	0x52, 0xae, 0x79, 0x25, 0xcf, 0xd9, 0xa3, 0x1e, 0x4c, 0xd9, 0xa4, 0x47, 0x14, 0xc7, 0xbf, 0x35,
+	0xff, 0xfc, 0x45, 0xf9, 0x12, 0x50, 0x05, 0xc0, 0x71, 0x15, 0x5b, 0xdc, 0x18, 0x25, 0x2e, 0xbc,
+	0xf1, 0x99, 0xa2, 0x02, 0xd9, 0xad, 0x4f, 0x9a, 0xd1, 0xb1, 0x7b, 0x9f, 0xef, 0x04, 0x2e, 0x8d,
+	0x92, 0x57, 0x60, 0xe1, 0x5d, 0x1c, 0x71, 0xab, 0xdf, 0xfb, 0x93, 0xe1, 0xc7, 0x8a, 0xfc, 0x42,
+	0x02, 0xbd, 0x05, 0xb7, 0x5a, 0xb8, 0xf9, 0x08, 0x97, 0xb7, 0xe4, 0x76, 0xa7, 0xdc, 0xd9, 0x69,
+	0xcb, 0xf5, 0xed, 0x72, 0xa5, 0x53, 0xdf, 0xad, 0xe5, 0x6e, 0x14, 0x96, 0x8e, 0x4f, 0x4a, 0x8b,
+	0x21, 0xfc, 0xba, 0xc1, 0xbe, 0x9f, 0x26, 0xe8, 0x01, 0x2c, 0x46, 0xe8, 0x04, 0x55, 0xac, 0x70,
+	0xeb, 0xf8, 0xa4, 0x34, 0x1f, 0xa2, 0x2a, 0x9f, 0x47, 0x53, 0x69, 0x34, 0xdb, 0xb5, 0x6a, 0x2e,
+	0x3e, 0x86, 0xa6, 0xc2, 0xde, 0x06, 0x14, 0x92, 0x1f, 0xfe, 0xf6, 0xf2, 0x8d, 0x7b, 0x7f, 0x1b,
+	0x83, 0xb4, 0xff, 0xdd, 0x2a, 0x7a, 0x13, 0x6e, 0x96, 0xdb, 0xed, 0x5a, 0x47, 0xee, 0xbc, 0xdf,
+	0xaa, 0xc9, 0x3b, 0xdb, 0xed, 0x56, 0xad, 0x52, 0xdf, 0xa8, 0xd7, 0xaa, 0xb9, 0x1b, 0x85, 0xfc,
+	0xf1, 0x49, 0x69, 0xc1, 0x47, 0xdd, 0x31, 0x1c, 0x8b, 0xa8, 0xfa, 0x9e, 0x4e, 0x34, 0xb4, 0x0a,
+	0xf3, 0x01, 0xaa, 0x4a, 0x73, 0xbb, 0x83, 0xcb, 0x95, 0x4e, 0x2e, 0x56, 0x58, 0x3c, 0x3e, 0x29,
+	0xcd, 0xf9, 0x24, 0x15, 0xd3, 0x70, 0xe9, 0xa4, 0x4f, 0xb5, 0x0d, 0xe0, 0xe3, 0x5a, 0xab, 0xd9,
+	0xae, 0x77, 0x9a, 0xf8, 0x7d, 0x4f, 0x5b, 0x9f, 0x02, 0x7b, 0x47, 0xf6, 0x23, 0x74, 0x0f, 0xe6,
+	0x02, 0x34, 0xd5, 0xe6, 0x56, 0xb9, 0xbe, 0x9d, 0x4b, 0x14, 0xe6, 0x8f, 0x4f, 0x4a, 0xb3, 0x3e,
+	0x7e, 0xd5, 0xec, 0x2b, 0xba, 0x21, 0x76, 0xf6, 0x07, 0x31, 0xc8, 0x04, 0xbe, 0xc9, 0x44, 0x6f,
+	0x43, 0xde, 0xb3, 0x11, 0x6e, 0x36, 0xa2, 0xbb, 0x2b, 0x1c, 0x9f, 0x94, 0x6e, 0x06, 0xd0, 0x83,
+	0xfb, 0xfb, 0x3a, 0x2c, 0x84, 0x28, 0x3b, 0xb8, 0x5e, 0x7e, 0x54, 0xc3, 0xb9, 0x58, 0xe1, 0xe6,
+	0xf1, 0x49, 0x09, 0x05, 0xa8, 0x3a, 0xb6, 0xae, 0xec, 0x13, 0x1b, 0xfd, 0x7f, 0x40, 0x21, 0x8a,
+	0x72, 0x75, 0xab, 0xbe, 0x9d, 0x8b, 0x17, 0x16, 0x8e, 0x4f, 0x4a, 0xb9, 0x00, 0x7e, 0x59, 0xeb,
+	0xfb, 0xfa, 0xfe, 0x46, 0x7c, 0xf8, 0x12, 0x84, 0xbf, 0xa1, 0x58, 0x83, 0x42, 0xbb, 0xb6, 0x5b,
+	0xc3, 0xf5, 0xce, 0xfb, 0x72, 0xa3, 0xb6, 0x5b, 0x6b, 0x44, 0x74, 0x9e, 0x3d, 0x3e, 0x29, 0x65,
+	0x82, 0x8a, 0xbe, 0x0e, 0xb7, 0x22, 0x04, 0x15, 0x5c, 0xef, 0xd4, 0x2b, 0xe5, 0x46, 0x2e, 0x56,
+	0x98, 0x3e, 0x3e, 0x29, 0x4d, 0x55, 0xc4, 0xdf, 0x1c, 0xa0, 0x57, 0x60, 0x3e, 0x82, 0xba, 0x59,
+	0x7f, 0xb4, 0x99, 0x8b, 0x17, 0xa6, 0x8e, 0x4f, 0x4a, 0xc9, 0x4d, 0x7d, 0xbf, 0x8b, 0x5e, 0x83,
+	0xc5, 0x08, 0xca, 0x56, 0xad, 0x5a, 0xdf, 0xd9, 0xca, 0x25, 0x0a, 0x70, 0x7c, 0x52, 0x9a, 0xdc,
+	0x22, 0x9a, 0x3e, 0xe8, 0xa3, 0x22, 0xa0, 0x08, 0x5a, 0xa3, 0xf9, 0x24, 0x97, 0x2c, 0xa4, 0x8e,
+	0x4f, 0x4a, 0x89, 0x86, 0xf9, 0x02, 0x7d, 0x03, 0xee, 0x44, 0x10, 0xea, 0xdb, 0x1b, 0x4d, 0xbc,
+	0x55, 0xee, 0xd4, 0x9b, 0xdb, 0xe5, 0x46, 0x6e, 0xa2, 0x30, 0x77, 0x7c, 0x52, 0xca, 0xd6, 0x8d,
+	0x3d, 0x53, 0x7c, 0xd8, 0xaf, 0xf4, 0x84, 0x4d, 0xfe, 0x2a, 0x01, 0xd9, 0xd0, 0x3b, 0x56, 0xea,
+	0xc5, 0x8d, 0xfa, 0x76, 0xb5, 0xbe, 0xfd, 0xc8, 0x8b, 0xf4, 0xf6, 0xce, 0xfa, 0x56, 0xbd, 0xd3,
+	0x19, 0x7a, 0x31, 0x44, 0xd0, 0x16, 0x9f, 0xf0, 0xd1, 0xce, 0xb2, 0x18, 0xa1, 0x0c, 0xe7, 0x55,
+	0x88, 0x4c, 0xe4, 0xd5, 0xa8, 0xb4, 0x4a, 0x73, 0x7b, 0xa3, 0x8e, 0xb7, 0x58, 0x6a, 0x8d, 0x4a,
+	0xf3, 0xbf, 0x6e, 0xa7, 0x39, 0x11, 0xa1, 0x6c, 0x95, 0xeb, 0xd5, 0x5c, 0x82, 0xe7, 0x44, 0x88,
+	0xa8, 0xa5, 0xe8, 0xe3, 0xb4, 0x13, 0x19, 0x9c, 0x1c, 0xa3, 0x1d, 0xcf, 0x60, 0x5a, 0x61, 0x22,
+	0x34, 0xd5, 0x7a, 0xbb, 0xb5, 0x43, 0x4d, 0x31, 0xc1, 0x2b, 0x4c, 0x88, 0x4a, 0x7c, 0x3c, 0xa0,
+	0x8d, 0xd9, 0x55, 0x75, 0xa7, 0xd5, 0xa8, 0x57, 0xca, 0x9d, 0x5a, 0x6e, 0x72, 0xcc, 0xae, 0xfc,
+	0xbf, 0x34, 0x19, 0x43, 0x59, 0x6b, 0x57, 0xca, 0x8d, 0x32, 0x15, 0x99, 0x1a, 0x43, 0x59, 0x73,
+	0x54, 0xa5, 0xa7, 0xb8, 0x7e, 0xb5, 0xf9, 0x69, 0x0c, 0x66, 0x23, 0x7f, 0xb7, 0x82, 0xde, 0x81,
+	0x3b, 0xbe, 0x78, 0xb9, 0xd5, 0x6c, 0xd4, 0x2b, 0xef, 0x47, 0xe2, 0x7c, 0xf9, 0xf8, 0xa4, 0x54,
+	0x88, 0x90, 0x05, 0xc3, 0xbe, 0x06, 0xc5, 0x11, 0x0e, 0x1b, 0x75, 0xdc, 0xee, 0xb0, 0xda, 0x82,
+	0x3b, 0x2c, 0x55, 0x4b, 0xc7, 0x27, 0xa5, 0x3b, 0x11, 0x26, 0x1b, 0xba, 0xed, 0xb8, 0xb4, 0xc8,
+	0xd8, 0x2e, 0xb1, 0xd1, 0x77, 0xc6, 0x28, 0x52, 0x7b, 0x6f, 0xa7, 0xdc, 0x90, 0xdb, 0xad, 0x46,
+	0xbd, 0x93, 0x8b, 0x17, 0xee, 0x1e, 0x9f, 0x94, 0x96, 0x22, 0x3c, 0x6a, 0xcf, 0x07, 0x4a, 0xaf,
+	0x6d, 0xf5, 0x74, 0x57, 0xec, 0xf1, 0xcf, 0x62, 0x90, 0x0d, 0x7d, 0xb2, 0x43, 0x7d, 0x2b, 0x1c,
+	0xe3, 0x59, 0x6d, 0xb7, 0xd9, 0xa9, 0x6f, 0x3f, 0xca, 0xdd, 0xe0, 0xbe, 0x0d, 0x61, 0xef, 0x9a,
+	0x62, 0x96, 0x88, 0xd2, 0xec, 0xb4, 0x36, 0x6b, 0x8d, 0xaa, 0x17, 0xad, 0x21, 0x9a, 0x1d, 0xab,
+	0x4b, 0x7a, 0x1a, 0x7a, 0x08, 0x4b, 0x11, 0x9a, 0xe6, 0x6e, 0x0d, 0x77, 0x76, 0xf0, 0x36, 0x0b,
+	0xd7, 0xdb, 0xc7, 0x27, 0xa5, 0x5b, 0x21, 0xba, 0xa6, 0xf8, 0x1e, 0xc6, 0xf7, 0xcf, 0x69, 0x0c,
+	0xe6, 0x46, 0xbe, 0x31, 0x61, 0xf6, 0x15, 0x7c, 0x77, 0x9b, 0x9d, 0x9a, 0xdc, 0x6c, 0xd1, 0xcc,
+	0x8d, 0x38, 0x89, 0xdb, 0x37, 0x4a, 0x1b, 0x74, 0xd3, 0xb7, 0xa0, 0x30, 0x96, 0x4d, 0x6b, 0xb3,
+	0xc9, 0xf6, 0x15, 0xd4, 0x2f, 0xc0, 0x81, 0x7d, 0xf3, 0xc3, 0x9c, 0x33, 0x86, 0xd8, 0xdb, 0xa0,
+	0xef, 0x9c, 0x28, 0xb9, 0xb7, 0x45, 0xb1, 0xc1, 0x5f, 0x8d, 0x41, 0x36, 0xf4, 0x5e, 0x15, 0x2d,
+	0x43, 0xa1, 0xb3, 0x59, 0x6b, 0xe2, 0x9a, 0xdf, 0x3a, 0x43, 0xfb, 0x42, 0x45, 0xb8, 0x1d, 0x59,
+	0x6f, 0xe1, 0x66, 0x73, 0x43, 0x6e, 0xd5, 0x70, 0xbd, 0x59, 0xcd, 0xc5, 0xd0, 0x12, 0x2c, 0x46,
+	0x11, 0x68, 0xa7, 0xaa, 0xe6, 0xe2, 0x63, 0x96, 0x44, 0x52, 0x27, 0xee, 0xfd, 0x25, 0xef, 0x4e,
+	0xde, 0xeb, 0x0b, 0x74, 0x87, 0x75, 0xa7, 0xe6, 0xc6, 0x78, 0x25, 0x5e, 0x81, 0xbb, 0xa1, 0xd5,
+	0xcd, 0x72, 0x7b, 0x53, 0x6e, 0x34, 0x2b, 0x8f, 0x87, 0x6a, 0x48, 0xb0, 0x7c, 0x0e, 0x4a, 0xa7,
+	0xbe, 0x55, 0x6b, 0xee, 0x74, 0x72, 0x71, 0xf4, 0x2a, 0x14, 0x47, 0x71, 0xaa, 0xb5, 0x4e, 0xb9,
+	0xde, 0xf0, 0x18, 0x25, 0xd0, 0x2d, 0x98, 0x0f, 0x21, 0x89, 0xdd, 0x24, 0x47, 0x16, 0x36, 0xca,
+	0xf5, 0x06, 0x2d, 0x35, 0xf7, 0xde, 0x87, 0x4c, 0xe0, 0xc8, 0x46, 0xb7, 0xe2, 0xed, 0x7a, 0x74,
+	0x8c, 0x40, 0x8b, 0x30, 0x17, 0x5a, 0xc5, 0xcd, 0xca, 0x7b, 0xb9, 0xd8, 0x08, 0xb8, 0x51, 0x2b,
+	0x6f, 0xe7, 0xe2, 0xf7, 0x36, 0x21, 0x13, 0x78, 0x41, 0x80, 0xf2, 0xb0, 0xf0, 0x08, 0x97, 0xb7,
+	0x3b, 0x72, 0xbb, 0xb9, 0x83, 0x2b, 0x35, 0xb9, 0x5c, 0xa9, 0x34, 0x77, 0xb6, 0x3b, 0xdc, 0x4d,
+	0xa1, 0x95, 0x4a, 0x73, 0x6b, 0x6b, 0x67, 0x9b, 0xb6, 0x9c, 0x56, 0xb3, 0xd9, 0xc8, 0xc5, 0xee,
+	0xfd, 0x4e, 0x1c, 0xe6, 0x1a, 0x44, 0xd1, 0x88, 0xfd, 0xd4, 0x54, 0x6c, 0x6d, 0x8b, 0xb8, 0xb6,
+	0xae, 0x52, 0xab, 0x35, 0x6a, 0xe5, 0x6a, 0x0d, 0xaf, 0x37, 0xcb, 0xb8, 0x2a, 0x6f, 0xd5, 0x3a,
+	0xb8, 0x5e, 0x89, 0x68, 0xfc, 0x15, 0x90, 0xc6, 0xe0, 0x08, 0x6d, 0x59, 0x38, 0xec, 0xd6, 0xb6,
+	0x73, 0x31, 0xf4, 0x1a, 0xbc, 0x32, 0x06, 0x8f, 0x99, 0xac, 0x2d, 0x57, 0x36, 0x6b, 0x95, 0xc7,
+	0x2c, 0x28, 0xce, 0x45, 0xdb, 0xad, 0x61, 0x19, 0xd7, 0x9e, 0x94, 0x71, 0xb5, 0x9d, 0x4b, 0x9c,
+	0x23, 0x95, 0xb3, 0x19, 0xe2, 0x25, 0xd1, 0x57, 0xe1, 0xd5, 0x31, 0x78, 0xf5, 0x2d, 0x56, 0xf8,
+	0xaa, 0x3e, 0xe2, 0x04, 0xfa, 0x7f, 0x50, 0x1a, 0xb7, 0x8d, 0x66, 0xa7, 0xdc, 0xf0, 0xb1, 0x26,
+	0xd7, 0x1f, 0xff, 0xe8, 0xd3, 0xe5, 0xd8, 0x8f, 0x3f, 0x5d, 0x8e, 0xfd, 0xf3, 0xa7, 0xcb, 0xb1,
+	0x8f, 0x3e, 0x5b, 0xbe, 0xf1, 0xe3, 0xcf, 0x96, 0x6f, 0xfc, 0xdd, 0x67, 0xcb, 0x37, 0xbe, 0x77,
+	0x3f, 0x30, 0xa6, 0xf3, 0x03, 0xca, 0x9e, 0x39, 0x30, 0x34, 0xd6, 0xb0, 0x05, 0x60, 0xed, 0xd0,
+	0xfb, 0xdb, 0x61, 0x36, 0xb5, 0x3f, 0x9d, 0x64, 0x13, 0xf4, 0x37, 0xfe, 0x27, 0x00, 0x00, 0xff,
+	0xff, 0x36, 0xe6, 0x2e, 0x1c, 0x59, 0x3c, 0x00, 0x00,
+}
+
+func (m *Program) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Program) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Program) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisclosureEmbargo != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.DisclosureEmbargo, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DisclosureEmbargo):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintBounty(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size, err := m.SubmissionRequirements.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBounty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if len(m.Scope) > 0 {
+		for iNdEx := len(m.Scope) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scope[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if m.ConfirmationSla != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ConfirmationSla, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConfirmationSla):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintBounty(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ActivationSla != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ActivationSla, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ActivationSla):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintBounty(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.DuplicatePolicy != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.DuplicatePolicy))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.CriticalApprovals != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.CriticalApprovals))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RewardSchedule) > 0 {
+		for iNdEx := len(m.RewardSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintBounty(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x32
+	if m.Status != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AdminAddress) > 0 {
+		i -= len(m.AdminAddress)
+		copy(dAtA[i:], m.AdminAddress)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.AdminAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SubmissionRequirements) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SubmissionRequirements) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SubmissionRequirements) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RequireIdentityCert {
+		i--
+		if m.RequireIdentityCert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MinConfirmedFindings != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.MinConfirmedFindings))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScopeTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScopeTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScopeTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxSeverity != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.MaxSeverity))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.InScope {
+		i--
+		if m.InScope {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Commit) > 0 {
+		i -= len(m.Commit)
+		copy(dAtA[i:], m.Commit)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Commit)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ChainId) > 0 {
+		i -= len(m.ChainId)
+		copy(dAtA[i:], m.ChainId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ChainId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Location) > 0 {
+		i -= len(m.Location)
+		copy(dAtA[i:], m.Location)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Location)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.AssetType != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.AssetType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TargetId) > 0 {
+		i -= len(m.TargetId)
+		copy(dAtA[i:], m.TargetId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.TargetId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProgramMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProgramMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProgramMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Role != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeverityReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeverityReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeverityReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MaxAmount) > 0 {
+		for iNdEx := len(m.MaxAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.MinAmount) > 0 {
+		for iNdEx := len(m.MinAmount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinAmount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SeverityLevel != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.SeverityLevel))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EncryptedFindingPayload) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EncryptedFindingPayload) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EncryptedFindingPayload) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Ciphertext) > 0 {
+		i -= len(m.Ciphertext)
+		copy(dAtA[i:], m.Ciphertext)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Ciphertext)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Keys) > 0 {
+		for iNdEx := len(m.Keys) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Keys[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindingPayloadKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindingPayloadKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindingPayloadKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.WrappedKey) > 0 {
+		i -= len(m.WrappedKey)
+		copy(dAtA[i:], m.WrappedKey)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.WrappedKey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Finding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Finding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Finding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DisclosureOverdue {
+		i--
+		if m.DisclosureOverdue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.DisclosureDeadline != nil {
+		n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.DisclosureDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DisclosureDeadline):])
+		if err6 != nil {
+			return 0, err6
+		}
+		i -= n6
+		i = encodeVarintBounty(dAtA, i, uint64(n6))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.TargetId) > 0 {
+		i -= len(m.TargetId)
+		copy(dAtA[i:], m.TargetId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.TargetId)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x8a
+	}
+	if m.SlaDeadline != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SlaDeadline, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SlaDeadline):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintBounty(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x82
+	}
+	if len(m.DuplicateOf) > 0 {
+		i -= len(m.DuplicateOf)
+		copy(dAtA[i:], m.DuplicateOf)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.DuplicateOf)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.EncryptedPayload != nil {
+		{
+			size, err := m.EncryptedPayload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintBounty(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x72
+	}
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintBounty(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x62
+	if len(m.PaymentHash) > 0 {
+		i -= len(m.PaymentHash)
+		copy(dAtA[i:], m.PaymentHash)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.PaymentHash)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x52
+	}
+	if m.Status != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.SeverityLevel != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.SeverityLevel))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.SubmitterAddress) > 0 {
+		i -= len(m.SubmitterAddress)
+		copy(dAtA[i:], m.SubmitterAddress)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.SubmitterAddress)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.FindingHash) > 0 {
+		i -= len(m.FindingHash)
+		copy(dAtA[i:], m.FindingHash)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.FindingHash)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ProofOfConcept) > 0 {
+		i -= len(m.ProofOfConcept)
+		copy(dAtA[i:], m.ProofOfConcept)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProofOfConcept)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProgramFingerprint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProgramFingerprint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProgramFingerprint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardSchedule) > 0 {
+		for iNdEx := len(m.RewardSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Status != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AdminAddress) > 0 {
+		i -= len(m.AdminAddress)
+		copy(dAtA[i:], m.AdminAddress)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.AdminAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Dispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Dispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Dispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverturnVotes != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.OverturnVotes))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.UpholdVotes != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.UpholdVotes))
+		i--
+		dAtA[i] = 0x40
+	}
+	n10, err10 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintBounty(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x3a
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.CreateTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintBounty(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0x32
+	if m.Status != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.DisputerAddress) > 0 {
+		i -= len(m.DisputerAddress)
+		copy(dAtA[i:], m.DisputerAddress)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.DisputerAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DisputeVote) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DisputeVote) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DisputeVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Option != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FindingFingerprint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FindingFingerprint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FindingFingerprint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PaymentHash) > 0 {
+		i -= len(m.PaymentHash)
+		copy(dAtA[i:], m.PaymentHash)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.PaymentHash)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Status != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SeverityLevel != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.SeverityLevel))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FindingHash) > 0 {
+		i -= len(m.FindingHash)
+		copy(dAtA[i:], m.FindingHash)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.FindingHash)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sponsorship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsorship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsorship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HackerReputation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HackerReputation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HackerReputation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalPaid) > 0 {
+		for iNdEx := len(m.TotalPaid) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalPaid[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ClosedFindings != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ClosedFindings))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.PaidFindings != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.PaidFindings))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Confirmed) > 0 {
+		for iNdEx := len(m.Confirmed) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Confirmed[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SeverityCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SeverityCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SeverityCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SeverityLevel != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.SeverityLevel))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Theorem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Theorem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Theorem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ProofSequence != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ProofSequence))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	if m.TheoremType != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremType))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.ForfeitedDeposits) > 0 {
+		for iNdEx := len(m.ForfeitedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForfeitedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if m.RequireOpenmathCert {
+		i--
+		if m.RequireOpenmathCert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.Imports) > 0 {
+		dAtA13 := make([]byte, len(m.Imports)*10)
+		var j12 int
+		for _, num := range m.Imports {
+			for num >= 1<<7 {
+				dAtA13[j12] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j12++
+			}
+			dAtA13[j12] = uint8(num)
+			j12++
+		}
+		i -= j12
+		copy(dAtA[i:], dAtA13[:j12])
+		i = encodeVarintBounty(dAtA, i, uint64(j12))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.ImportedCount != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ImportedCount))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.Complexity != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Complexity))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.TotalGrant) > 0 {
+		for iNdEx := len(m.TotalGrant) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalGrant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.EndTime != nil {
+		n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err14 != nil {
+			return 0, err14
+		}
+		i -= n14
+		i = encodeVarintBounty(dAtA, i, uint64(n14))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.SubmitTime != nil {
+		n15, err15 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err15 != nil {
+			return 0, err15
+		}
+		i -= n15
+		i = encodeVarintBounty(dAtA, i, uint64(n15))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Status != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Proof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Proof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Proof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.DetailCompressed {
+		i--
+		if m.DetailCompressed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.DetailChunks) > 0 {
+		for iNdEx := len(m.DetailChunks) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DetailChunks[iNdEx])
+			copy(dAtA[i:], m.DetailChunks[iNdEx])
+			i = encodeVarintBounty(dAtA, i, uint64(len(m.DetailChunks[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Prover) > 0 {
+		i -= len(m.Prover)
+		copy(dAtA[i:], m.Prover)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Prover)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.EndTime != nil {
+		n16, err16 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime):])
+		if err16 != nil {
+			return 0, err16
+		}
+		i -= n16
+		i = encodeVarintBounty(dAtA, i, uint64(n16))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.SubmitTime != nil {
+		n17, err17 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.SubmitTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime):])
+		if err17 != nil {
+			return 0, err17
+		}
+		i -= n17
+		i = encodeVarintBounty(dAtA, i, uint64(n17))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProofId) > 0 {
+		i -= len(m.ProofId)
+		copy(dAtA[i:], m.ProofId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProofId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofVerdict) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofVerdict) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofVerdict) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TheoremType != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Imports) > 0 {
+		dAtA19 := make([]byte, len(m.Imports)*10)
+		var j18 int
+		for _, num := range m.Imports {
+			for num >= 1<<7 {
+				dAtA19[j18] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j18++
+			}
+			dAtA19[j18] = uint8(num)
+			j18++
+		}
+		i -= j18
+		copy(dAtA[i:], dAtA19[:j18])
+		i = encodeVarintBounty(dAtA, i, uint64(j18))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Complexity != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Complexity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Checker) > 0 {
+		i -= len(m.Checker)
+		copy(dAtA[i:], m.Checker)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Checker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProofId) > 0 {
+		i -= len(m.ProofId)
+		copy(dAtA[i:], m.ProofId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProofId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProofHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProofHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProofHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prover) > 0 {
+		i -= len(m.Prover)
+		copy(dAtA[i:], m.Prover)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Prover)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Grant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Grant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Grant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Source != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.Source))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantor) > 0 {
+		i -= len(m.Grantor)
+		copy(dAtA[i:], m.Grantor)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Grantor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Deposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Deposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Deposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProofId) > 0 {
+		i -= len(m.ProofId)
+		copy(dAtA[i:], m.ProofId)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.ProofId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofChunkDeposit) > 0 {
+		for iNdEx := len(m.ProofChunkDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofChunkDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.ProofVerificationPeriod != nil {
+		n20, err20 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ProofVerificationPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ProofVerificationPeriod):])
+		if err20 != nil {
+			return 0, err20
+		}
+		i -= n20
+		i = encodeVarintBounty(dAtA, i, uint64(n20))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x9a
+	}
+	if m.RewardVestingPeriod != nil {
+		n21, err21 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.RewardVestingPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.RewardVestingPeriod):])
+		if err21 != nil {
+			return 0, err21
+		}
+		i -= n21
+		i = encodeVarintBounty(dAtA, i, uint64(n21))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x92
+	}
+	if len(m.RewardVestingThreshold) > 0 {
+		for iNdEx := len(m.RewardVestingThreshold) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardVestingThreshold[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.TheoremExtensionMinGrant) > 0 {
+		for iNdEx := len(m.TheoremExtensionMinGrant) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TheoremExtensionMinGrant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.TheoremMaxTotalPeriod != nil {
+		n22, err22 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.TheoremMaxTotalPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TheoremMaxTotalPeriod):])
+		if err22 != nil {
+			return 0, err22
+		}
+		i -= n22
+		i = encodeVarintBounty(dAtA, i, uint64(n22))
+		i--
+		dAtA[i] = 0x7a
+	}
+	{
+		size := m.GrantWithdrawalPenalty.Size()
+		i -= size
+		if _, err := m.GrantWithdrawalPenalty.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBounty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.ForfeitedDepositGrantShare.Size()
+		i -= size
+		if _, err := m.ForfeitedDepositGrantShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBounty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	{
+		size := m.ForfeitedDepositCheckerShare.Size()
+		i -= size
+		if _, err := m.ForfeitedDepositCheckerShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBounty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size := m.ProofDepositSlashFraction.Size()
+		i -= size
+		if _, err := m.ProofDepositSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBounty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.ProofVerificationQuorum != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ProofVerificationQuorum))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.DisputeWindow != nil {
+		n23, err23 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.DisputeWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DisputeWindow):])
+		if err23 != nil {
+			return 0, err23
+		}
+		i -= n23
+		i = encodeVarintBounty(dAtA, i, uint64(n23))
+		i--
+		dAtA[i] = 0x4a
+	}
+	{
+		size, err := m.ComplexityFeeLean.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBounty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size, err := m.ComplexityFeeRocq.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBounty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.MaxComplexity != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.MaxComplexity))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.ComplexityFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintBounty(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.ProofMaxLockPeriod != nil {
+		n27, err27 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ProofMaxLockPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ProofMaxLockPeriod):])
+		if err27 != nil {
+			return 0, err27
+		}
+		i -= n27
+		i = encodeVarintBounty(dAtA, i, uint64(n27))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.TheoremMaxProofPeriod != nil {
+		n28, err28 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.TheoremMaxProofPeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TheoremMaxProofPeriod):])
+		if err28 != nil {
+			return 0, err28
+		}
+		i -= n28
+		i = encodeVarintBounty(dAtA, i, uint64(n28))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MinDeposit) > 0 {
+		for iNdEx := len(m.MinDeposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinDeposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MinGrant) > 0 {
+		for iNdEx := len(m.MinGrant) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGrant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OpenMathStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OpenMathStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OpenMathStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ImportedRewards) > 0 {
+		for iNdEx := len(m.ImportedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImportedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.CheckerRewards) > 0 {
+		for iNdEx := len(m.CheckerRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CheckerRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ProverRewards) > 0 {
+		for iNdEx := len(m.ProverRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProverRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.ProofsChecked != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ProofsChecked))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ProofsFailed != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ProofsFailed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TheoremsProven != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremsProven))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TheoremTypeStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TheoremTypeStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TheoremTypeStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ImportedRewards) > 0 {
+		for iNdEx := len(m.ImportedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImportedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.CheckerRewards) > 0 {
+		for iNdEx := len(m.CheckerRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CheckerRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ProverRewards) > 0 {
+		for iNdEx := len(m.ProverRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProverRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.ProofsFailed != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.ProofsFailed))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.TheoremsProven != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremsProven))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.TheoremType != 0 {
+		i = encodeVarintBounty(dAtA, i, uint64(m.TheoremType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Reward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Reward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Reward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardVesting) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardVesting) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardVesting) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Schedules) > 0 {
+		for iNdEx := len(m.Schedules) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Schedules[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBounty(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VestingSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VestingSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VestingSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n29, err29 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EndTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime):])
+	if err29 != nil {
+		return 0, err29
+	}
+	i -= n29
+	i = encodeVarintBounty(dAtA, i, uint64(n29))
+	i--
+	dAtA[i] = 0x22
+	n30, err30 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime):])
+	if err30 != nil {
+		return 0, err30
+	}
+	i -= n30
+	i = encodeVarintBounty(dAtA, i, uint64(n30))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Released) > 0 {
+		for iNdEx := len(m.Released) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Released[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintBounty(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBounty(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBounty(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Program) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBounty(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovBounty(uint64(l))
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.RewardSchedule) > 0 {
+		for _, e := range m.RewardSchedule {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.CriticalApprovals != 0 {
+		n += 1 + sovBounty(uint64(m.CriticalApprovals))
+	}
+	if m.DuplicatePolicy != 0 {
+		n += 1 + sovBounty(uint64(m.DuplicatePolicy))
+	}
+	if m.ActivationSla != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ActivationSla)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.ConfirmationSla != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConfirmationSla)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Scope) > 0 {
+		for _, e := range m.Scope {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	l = m.SubmissionRequirements.Size()
+	n += 1 + l + sovBounty(uint64(l))
+	if m.DisclosureEmbargo != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DisclosureEmbargo)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	return n
+}
+
+func (m *SubmissionRequirements) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MinConfirmedFindings != 0 {
+		n += 1 + sovBounty(uint64(m.MinConfirmedFindings))
+	}
+	if m.RequireIdentityCert {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScopeTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.AssetType != 0 {
+		n += 1 + sovBounty(uint64(m.AssetType))
+	}
+	l = len(m.Location)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.ChainId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Commit)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.InScope {
+		n += 2
+	}
+	if m.MaxSeverity != 0 {
+		n += 1 + sovBounty(uint64(m.MaxSeverity))
+	}
+	return n
+}
+
+func (m *ProgramMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovBounty(uint64(m.Role))
+	}
+	return n
+}
+
+func (m *SeverityReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeverityLevel != 0 {
+		n += 1 + sovBounty(uint64(m.SeverityLevel))
+	}
+	if len(m.MinAmount) > 0 {
+		for _, e := range m.MinAmount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.MaxAmount) > 0 {
+		for _, e := range m.MaxAmount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EncryptedFindingPayload) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Keys) > 0 {
+		for _, e := range m.Keys {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	l = len(m.Ciphertext)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	return n
+}
+
+func (m *FindingPayloadKey) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.WrappedKey)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	return n
+}
+
+func (m *Finding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.ProofOfConcept)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.FindingHash)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.SubmitterAddress)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.SeverityLevel != 0 {
+		n += 1 + sovBounty(uint64(m.SeverityLevel))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBounty(uint64(m.Status))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.PaymentHash)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovBounty(uint64(l))
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.EncryptedPayload != nil {
+		l = m.EncryptedPayload.Size()
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.DuplicateOf)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.SlaDeadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SlaDeadline)
+		n += 2 + l + sovBounty(uint64(l))
+	}
+	l = len(m.TargetId)
+	if l > 0 {
+		n += 2 + l + sovBounty(uint64(l))
+	}
+	if m.DisclosureDeadline != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.DisclosureDeadline)
+		n += 2 + l + sovBounty(uint64(l))
+	}
+	if m.DisclosureOverdue {
+		n += 3
+	}
+	return n
+}
+
+func (m *ProgramFingerprint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBounty(uint64(m.Status))
+	}
+	if len(m.RewardSchedule) > 0 {
+		for _, e := range m.RewardSchedule {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Dispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.DisputerAddress)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBounty(uint64(m.Status))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.CreateTime)
+	n += 1 + l + sovBounty(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovBounty(uint64(l))
+	if m.UpholdVotes != 0 {
+		n += 1 + sovBounty(uint64(m.UpholdVotes))
+	}
+	if m.OverturnVotes != 0 {
+		n += 1 + sovBounty(uint64(m.OverturnVotes))
+	}
+	return n
+}
+
+func (m *DisputeVote) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovBounty(uint64(m.Option))
+	}
+	return n
+}
+
+func (m *FindingFingerprint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.FindingHash)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.SeverityLevel != 0 {
+		n += 1 + sovBounty(uint64(m.SeverityLevel))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBounty(uint64(m.Status))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.PaymentHash)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	return n
+}
+
+func (m *Sponsorship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HackerReputation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Confirmed) > 0 {
+		for _, e := range m.Confirmed {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.PaidFindings != 0 {
+		n += 1 + sovBounty(uint64(m.PaidFindings))
+	}
+	if m.ClosedFindings != 0 {
+		n += 1 + sovBounty(uint64(m.ClosedFindings))
+	}
+	if len(m.TotalPaid) > 0 {
+		for _, e := range m.TotalPaid {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SeverityCount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SeverityLevel != 0 {
+		n += 1 + sovBounty(uint64(m.SeverityLevel))
+	}
+	if m.Count != 0 {
+		n += 1 + sovBounty(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *Theorem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovBounty(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBounty(uint64(m.Status))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.TotalGrant) > 0 {
+		for _, e := range m.TotalGrant {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Complexity != 0 {
+		n += 1 + sovBounty(uint64(m.Complexity))
+	}
+	if m.ImportedCount != 0 {
+		n += 1 + sovBounty(uint64(m.ImportedCount))
+	}
+	if len(m.Imports) > 0 {
+		l = 0
+		for _, e := range m.Imports {
+			l += sovBounty(uint64(e))
+		}
+		n += 1 + sovBounty(uint64(l)) + l
+	}
+	if m.RequireOpenmathCert {
+		n += 2
+	}
+	if len(m.ForfeitedDeposits) > 0 {
+		for _, e := range m.ForfeitedDeposits {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.TheoremType != 0 {
+		n += 1 + sovBounty(uint64(m.TheoremType))
+	}
+	if m.ProofSequence != 0 {
+		n += 2 + sovBounty(uint64(m.ProofSequence))
+	}
+	return n
+}
+
+func (m *Proof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovBounty(uint64(m.TheoremId))
+	}
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBounty(uint64(m.Status))
+	}
+	if m.SubmitTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.SubmitTime)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Prover)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.DetailChunks) > 0 {
+		for _, s := range m.DetailChunks {
+			l = len(s)
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.DetailCompressed {
+		n += 2
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovBounty(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *ProofChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProofId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	return n
+}
+
+func (m *ProofVerdict) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProofId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Checker)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovBounty(uint64(m.Status))
+	}
+	if m.Complexity != 0 {
+		n += 1 + sovBounty(uint64(m.Complexity))
+	}
+	if len(m.Imports) > 0 {
+		l = 0
+		for _, e := range m.Imports {
+			l += sovBounty(uint64(e))
+		}
+		n += 1 + sovBounty(uint64(l)) + l
+	}
+	if m.TheoremType != 0 {
+		n += 1 + sovBounty(uint64(m.TheoremType))
+	}
+	return n
+}
+
+func (m *ProofHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovBounty(uint64(m.TheoremId))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Prover)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	return n
+}
+
+func (m *Grant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovBounty(uint64(m.TheoremId))
+	}
+	l = len(m.Grantor)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.Source != 0 {
+		n += 1 + sovBounty(uint64(m.Source))
+	}
+	return n
+}
+
+func (m *Deposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProofId)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.MinGrant) > 0 {
+		for _, e := range m.MinGrant {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.MinDeposit) > 0 {
+		for _, e := range m.MinDeposit {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.TheoremMaxProofPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TheoremMaxProofPeriod)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.ProofMaxLockPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ProofMaxLockPeriod)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	l = m.ComplexityFee.Size()
+	n += 1 + l + sovBounty(uint64(l))
+	if m.MaxComplexity != 0 {
+		n += 1 + sovBounty(uint64(m.MaxComplexity))
+	}
+	l = m.ComplexityFeeRocq.Size()
+	n += 1 + l + sovBounty(uint64(l))
+	l = m.ComplexityFeeLean.Size()
+	n += 1 + l + sovBounty(uint64(l))
+	if m.DisputeWindow != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DisputeWindow)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.ProofVerificationQuorum != 0 {
+		n += 1 + sovBounty(uint64(m.ProofVerificationQuorum))
+	}
+	l = m.ProofDepositSlashFraction.Size()
+	n += 1 + l + sovBounty(uint64(l))
+	l = m.ForfeitedDepositCheckerShare.Size()
+	n += 1 + l + sovBounty(uint64(l))
+	l = m.ForfeitedDepositGrantShare.Size()
+	n += 1 + l + sovBounty(uint64(l))
+	l = m.GrantWithdrawalPenalty.Size()
+	n += 1 + l + sovBounty(uint64(l))
+	if m.TheoremMaxTotalPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TheoremMaxTotalPeriod)
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.TheoremExtensionMinGrant) > 0 {
+		for _, e := range m.TheoremExtensionMinGrant {
+			l = e.Size()
+			n += 2 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.RewardVestingThreshold) > 0 {
+		for _, e := range m.RewardVestingThreshold {
+			l = e.Size()
+			n += 2 + l + sovBounty(uint64(l))
+		}
+	}
+	if m.RewardVestingPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.RewardVestingPeriod)
+		n += 2 + l + sovBounty(uint64(l))
+	}
+	if m.ProofVerificationPeriod != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ProofVerificationPeriod)
+		n += 2 + l + sovBounty(uint64(l))
+	}
+	if len(m.ProofChunkDeposit) > 0 {
+		for _, e := range m.ProofChunkDeposit {
+			l = e.Size()
+			n += 2 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OpenMathStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if m.TheoremsProven != 0 {
+		n += 1 + sovBounty(uint64(m.TheoremsProven))
+	}
+	if m.ProofsFailed != 0 {
+		n += 1 + sovBounty(uint64(m.ProofsFailed))
+	}
+	if m.ProofsChecked != 0 {
+		n += 1 + sovBounty(uint64(m.ProofsChecked))
+	}
+	if len(m.ProverRewards) > 0 {
+		for _, e := range m.ProverRewards {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.CheckerRewards) > 0 {
+		for _, e := range m.CheckerRewards {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.ImportedRewards) > 0 {
+		for _, e := range m.ImportedRewards {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TheoremTypeStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremType != 0 {
+		n += 1 + sovBounty(uint64(m.TheoremType))
+	}
+	if m.TheoremsProven != 0 {
+		n += 1 + sovBounty(uint64(m.TheoremsProven))
+	}
+	if m.ProofsFailed != 0 {
+		n += 1 + sovBounty(uint64(m.ProofsFailed))
+	}
+	if len(m.ProverRewards) > 0 {
+		for _, e := range m.ProverRewards {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.CheckerRewards) > 0 {
+		for _, e := range m.CheckerRewards {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.ImportedRewards) > 0 {
+		for _, e := range m.ImportedRewards {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Reward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RewardVesting) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBounty(uint64(l))
+	}
+	if len(m.Schedules) > 0 {
+		for _, e := range m.Schedules {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *VestingSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	if len(m.Released) > 0 {
+		for _, e := range m.Released {
+			l = e.Size()
+			n += 1 + l + sovBounty(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovBounty(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EndTime)
+	n += 1 + l + sovBounty(uint64(l))
+	return n
+}
+
+func sovBounty(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBounty(x uint64) (n int) {
+	return sovBounty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Program) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Program: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Program: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProgramStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types1.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardSchedule = append(m.RewardSchedule, SeverityReward{})
+			if err := m.RewardSchedule[len(m.RewardSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalApprovals", wireType)
+			}
+			m.CriticalApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CriticalApprovals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicatePolicy", wireType)
+			}
+			m.DuplicatePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DuplicatePolicy |= DuplicatePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationSla", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivationSla == nil {
+				m.ActivationSla = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ActivationSla, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationSla", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfirmationSla == nil {
+				m.ConfirmationSla = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ConfirmationSla, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = append(m.Scope, ScopeTarget{})
+			if err := m.Scope[len(m.Scope)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubmissionRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisclosureEmbargo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisclosureEmbargo == nil {
+				m.DisclosureEmbargo = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.DisclosureEmbargo, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SubmissionRequirements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SubmissionRequirements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SubmissionRequirements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinConfirmedFindings", wireType)
+			}
+			m.MinConfirmedFindings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinConfirmedFindings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireIdentityCert", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireIdentityCert = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScopeTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScopeTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScopeTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetType", wireType)
+			}
+			m.AssetType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AssetType |= AssetType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Location", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Location = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChainId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChainId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InScope", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InScope = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSeverity", wireType)
+			}
+			m.MaxSeverity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSeverity |= SeverityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProgramMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProgramMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProgramMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ProgramRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeverityReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeverityReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeverityReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
+			}
+			m.SeverityLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeverityLevel |= SeverityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinAmount = append(m.MinAmount, types1.Coin{})
+			if err := m.MinAmount[len(m.MinAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxAmount = append(m.MaxAmount, types1.Coin{})
+			if err := m.MaxAmount[len(m.MaxAmount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EncryptedFindingPayload) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EncryptedFindingPayload: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EncryptedFindingPayload: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keys", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Keys = append(m.Keys, FindingPayloadKey{})
+			if err := m.Keys[len(m.Keys)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ciphertext", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ciphertext = append(m.Ciphertext[:0], dAtA[iNdEx:postIndex]...)
+			if m.Ciphertext == nil {
+				m.Ciphertext = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FindingPayloadKey) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindingPayloadKey: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindingPayloadKey: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WrappedKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.WrappedKey = append(m.WrappedKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.WrappedKey == nil {
+				m.WrappedKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Finding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Finding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Finding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfConcept", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofOfConcept = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmitterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
+			}
+			m.SeverityLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeverityLevel |= SeverityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= FindingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types1.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EncryptedPayload == nil {
+				m.EncryptedPayload = &EncryptedFindingPayload{}
+			}
+			if err := m.EncryptedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateOf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DuplicateOf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlaDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SlaDeadline == nil {
+				m.SlaDeadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SlaDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisclosureDeadline", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisclosureDeadline == nil {
+				m.DisclosureDeadline = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.DisclosureDeadline, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisclosureOverdue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DisclosureOverdue = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProgramFingerprint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProgramFingerprint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProgramFingerprint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ProgramStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardSchedule = append(m.RewardSchedule, SeverityReward{})
+			if err := m.RewardSchedule[len(m.RewardSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Dispute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Dispute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Dispute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputerAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputerAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= DisputeStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpholdVotes", wireType)
+			}
+			m.UpholdVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpholdVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverturnVotes", wireType)
+			}
+			m.OverturnVotes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverturnVotes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DisputeVote) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DisputeVote: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DisputeVote: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
+			}
+			m.Option = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Option |= DisputeVoteOption(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FindingFingerprint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FindingFingerprint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FindingFingerprint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
+			}
+			m.SeverityLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeverityLevel |= SeverityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= FindingStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Sponsorship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Sponsorship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Sponsorship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HackerReputation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HackerReputation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HackerReputation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Confirmed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Confirmed = append(m.Confirmed, SeverityCount{})
+			if err := m.Confirmed[len(m.Confirmed)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaidFindings", wireType)
+			}
+			m.PaidFindings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PaidFindings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedFindings", wireType)
+			}
+			m.ClosedFindings = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedFindings |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalPaid", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalPaid = append(m.TotalPaid, types1.Coin{})
+			if err := m.TotalPaid[len(m.TotalPaid)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SeverityCount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SeverityCount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SeverityCount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
+			}
+			m.SeverityLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeverityLevel |= SeverityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.TheoremMaxProofPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.TheoremMaxProofPeriod)
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if m.ProofMaxLockPeriod != nil {
-		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ProofMaxLockPeriod)
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	l = m.ComplexityFee.Size()
-	n += 1 + l + sovBounty(uint64(l))
-	if m.MaxComplexity != 0 {
-		n += 1 + sovBounty(uint64(m.MaxComplexity))
-	}
-	l = m.ComplexityFeeRocq.Size()
-	n += 1 + l + sovBounty(uint64(l))
-	l = m.ComplexityFeeLean.Size()
-	n += 1 + l + sovBounty(uint64(l))
-	return n
-}
 
-func (m *Reward) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovBounty(uint64(l))
-	}
-	if len(m.Reward) > 0 {
-		for _, e := range m.Reward {
-			l = e.Size()
-			n += 1 + l + sovBounty(uint64(l))
-		}
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovBounty(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozBounty(x uint64) (n int) {
-	return sovBounty(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Program) Unmarshal(dAtA []byte) error {
+func (m *Theorem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2392,17 +9122,187 @@ func (m *Program) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Program: wiretype end group for non-group")
+			return fmt.Errorf("proto: Theorem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Program: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Theorem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TheoremStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2412,29 +9312,33 @@ func (m *Program) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalGrant", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2444,27 +9348,29 @@ func (m *Program) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.TotalGrant = append(m.TotalGrant, types1.Coin{})
+			if err := m.TotalGrant[len(m.TotalGrant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2492,13 +9398,13 @@ func (m *Program) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complexity", wireType)
 			}
-			var stringLen uint64
+			m.Complexity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2508,29 +9414,111 @@ func (m *Program) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Complexity |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBounty
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportedCount", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBounty
+			m.ImportedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ImportedCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 12:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBounty
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Imports = append(m.Imports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBounty
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBounty
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBounty
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Imports) == 0 {
+					m.Imports = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBounty
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Imports = append(m.Imports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imports", wireType)
 			}
-			m.AdminAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireOpenmathCert", wireType)
 			}
-			m.Status = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2540,14 +9528,15 @@ func (m *Program) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ProgramStatus(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			m.RequireOpenmathCert = bool(v != 0)
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedDeposits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2574,10 +9563,49 @@ func (m *Program) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.ForfeitedDeposits = append(m.ForfeitedDeposits, types1.Coin{})
+			if err := m.ForfeitedDeposits[len(m.ForfeitedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremType", wireType)
+			}
+			m.TheoremType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremType |= TheoremType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofSequence", wireType)
+			}
+			m.ProofSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBounty(dAtA[iNdEx:])
@@ -2599,7 +9627,7 @@ func (m *Program) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Finding) Unmarshal(dAtA []byte) error {
+func (m *Proof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2622,15 +9650,66 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Finding: wiretype end group for non-group")
+			return fmt.Errorf("proto: Proof: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Finding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Proof: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+			}
+			m.TheoremId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2658,13 +9737,13 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			m.Detail = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2674,29 +9753,16 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= ProofStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBounty
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBounty
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FindingId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2706,29 +9772,33 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			if m.SubmitTime == nil {
+				m.SubmitTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2738,27 +9808,31 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfConcept", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prover", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2786,13 +9860,13 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofOfConcept = string(dAtA[iNdEx:postIndex])
+			m.Prover = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2802,27 +9876,29 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FindingHash = string(dAtA[iNdEx:postIndex])
+			m.Deposit = append(m.Deposit, types1.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DetailChunks", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2850,13 +9926,13 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubmitterAddress = string(dAtA[iNdEx:postIndex])
+			m.DetailChunks = append(m.DetailChunks, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DetailCompressed", wireType)
 			}
-			m.SeverityLevel = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2866,16 +9942,17 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SeverityLevel |= SeverityLevel(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 9:
+			m.DetailCompressed = bool(v != 0)
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
 			}
-			m.Status = 0
+			m.Sequence = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2885,14 +9962,64 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= FindingStatus(b&0x7F) << shift
+				m.Sequence |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 10:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProofChunk) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProofChunk: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProofChunk: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2920,11 +10047,11 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = string(dAtA[iNdEx:postIndex])
+			m.ProofId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2952,13 +10079,13 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentHash = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 12:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -2968,23 +10095,24 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.CreateTime, dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -3008,7 +10136,7 @@ func (m *Finding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProgramFingerprint) Unmarshal(dAtA []byte) error {
+func (m *ProofVerdict) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3031,15 +10159,15 @@ func (m *ProgramFingerprint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProgramFingerprint: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProofVerdict: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProgramFingerprint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProofVerdict: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3067,11 +10195,11 @@ func (m *ProgramFingerprint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			m.ProofId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Checker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3099,13 +10227,13 @@ func (m *ProgramFingerprint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Checker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3115,61 +10243,111 @@ func (m *ProgramFingerprint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Status |= ProofStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBounty
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBounty
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Detail = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complexity", wireType)
 			}
-			var stringLen uint64
+			m.Complexity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
 				}
-				if iNdEx >= l {
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Complexity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBounty
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Imports = append(m.Imports, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowBounty
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthBounty
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthBounty
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.Imports) == 0 {
+					m.Imports = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowBounty
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Imports = append(m.Imports, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imports", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBounty
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBounty
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AdminAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremType", wireType)
 			}
-			m.Status = 0
+			m.TheoremType = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3179,7 +10357,7 @@ func (m *ProgramFingerprint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ProgramStatus(b&0x7F) << shift
+				m.TheoremType |= TheoremType(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -3205,7 +10383,7 @@ func (m *ProgramFingerprint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FindingFingerprint) Unmarshal(dAtA []byte) error {
+func (m *ProofHash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3228,17 +10406,17 @@ func (m *FindingFingerprint) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FindingFingerprint: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProofHash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FindingFingerprint: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProofHash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
 			}
-			var stringLen uint64
+			m.TheoremId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3248,27 +10426,14 @@ func (m *FindingFingerprint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TheoremId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBounty
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthBounty
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3296,11 +10461,11 @@ func (m *FindingFingerprint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FindingId = string(dAtA[iNdEx:postIndex])
+			m.Detail = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prover", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3328,64 +10493,63 @@ func (m *FindingFingerprint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.Prover = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBounty
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthBounty
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthBounty
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FindingHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Grant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
 			}
-			m.SeverityLevel = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBounty
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SeverityLevel |= SeverityLevel(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
 			}
-			m.Status = 0
+			m.TheoremId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3395,14 +10559,14 @@ func (m *FindingFingerprint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= FindingStatus(b&0x7F) << shift
+				m.TheoremId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3430,13 +10594,13 @@ func (m *FindingFingerprint) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = string(dAtA[iNdEx:postIndex])
+			m.Grantor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3446,24 +10610,45 @@ func (m *FindingFingerprint) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentHash = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Source", wireType)
+			}
+			m.Source = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Source |= GrantSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBounty(dAtA[iNdEx:])
@@ -3485,7 +10670,7 @@ func (m *FindingFingerprint) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Theorem) Unmarshal(dAtA []byte) error {
+func (m *Deposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3508,34 +10693,15 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Theorem: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Theorem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
-			}
-			m.Id = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBounty
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Id |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3563,11 +10729,11 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ProofId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3595,13 +10761,13 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Depositor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3611,46 +10777,79 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types1.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.Status = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBounty
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Status |= TheoremStatus(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
 			}
-		case 6:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGrant", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3677,16 +10876,14 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitTime == nil {
-				m.SubmitTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.MinGrant = append(m.MinGrant, types1.Coin{})
+			if err := m.MinGrant[len(m.MinGrant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3713,16 +10910,14 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTime == nil {
-				m.EndTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
+			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TotalGrant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremMaxProofPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3749,16 +10944,18 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TotalGrant = append(m.TotalGrant, types1.Coin{})
-			if err := m.TotalGrant[len(m.TotalGrant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.TheoremMaxProofPeriod == nil {
+				m.TheoremMaxProofPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.TheoremMaxProofPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofMaxLockPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3768,29 +10965,33 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
+			if m.ProofMaxLockPeriod == nil {
+				m.ProofMaxLockPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ProofMaxLockPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Complexity", wireType)
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplexityFee", wireType)
 			}
-			m.Complexity = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3800,16 +11001,30 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Complexity |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 11:
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ComplexityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImportedCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxComplexity", wireType)
 			}
-			m.ImportedCount = 0
+			m.MaxComplexity = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3819,92 +11034,16 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ImportedCount |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 12:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBounty
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.Imports = append(m.Imports, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowBounty
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthBounty
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthBounty
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.Imports) == 0 {
-					m.Imports = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowBounty
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Imports = append(m.Imports, v)
+				m.MaxComplexity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Imports", wireType)
 			}
-		case 13:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequireOpenmathCert", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplexityFeeRocq", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3914,67 +11053,99 @@ func (m *Theorem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.RequireOpenmathCert = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBounty(dAtA[iNdEx:])
-			if err != nil {
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ComplexityFeeRocq.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ComplexityFeeLean", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Proof) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBounty
+			if err := m.ComplexityFeeLean.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.DisputeWindow == nil {
+				m.DisputeWindow = new(time.Duration)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Proof: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Proof: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.DisputeWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofVerificationQuorum", wireType)
 			}
-			m.TheoremId = 0
+			m.ProofVerificationQuorum = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -3984,14 +11155,14 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TheoremId |= uint64(b&0x7F) << shift
+				m.ProofVerificationQuorum |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofDepositSlashFraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4019,11 +11190,13 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Id = string(dAtA[iNdEx:postIndex])
+			if err := m.ProofDepositSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedDepositCheckerShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4051,13 +11224,15 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = string(dAtA[iNdEx:postIndex])
+			if err := m.ForfeitedDepositCheckerShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForfeitedDepositGrantShare", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4067,16 +11242,31 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= ProofStatus(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForfeitedDepositGrantShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantWithdrawalPenalty", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4086,31 +11276,29 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SubmitTime == nil {
-				m.SubmitTime = new(time.Time)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.SubmitTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.GrantWithdrawalPenalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 15:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremMaxTotalPeriod", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4137,18 +11325,18 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EndTime == nil {
-				m.EndTime = new(time.Time)
+			if m.TheoremMaxTotalPeriod == nil {
+				m.TheoremMaxTotalPeriod = new(time.Duration)
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.TheoremMaxTotalPeriod, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 16:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prover", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremExtensionMinGrant", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4158,27 +11346,29 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prover = string(dAtA[iNdEx:postIndex])
+			m.TheoremExtensionMinGrant = append(m.TheoremExtensionMinGrant, types1.Coin{})
+			if err := m.TheoremExtensionMinGrant[len(m.TheoremExtensionMinGrant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
+		case 17:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingThreshold", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4205,66 +11395,16 @@ func (m *Proof) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposit = append(m.Deposit, types1.Coin{})
-			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RewardVestingThreshold = append(m.RewardVestingThreshold, types1.Coin{})
+			if err := m.RewardVestingThreshold[len(m.RewardVestingThreshold)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBounty(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBounty
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ProofHash) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBounty
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ProofHash: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProofHash: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestingPeriod", wireType)
 			}
-			m.TheoremId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4274,16 +11414,33 @@ func (m *ProofHash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TheoremId |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.RewardVestingPeriod == nil {
+				m.RewardVestingPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.RewardVestingPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofVerificationPeriod", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4293,29 +11450,33 @@ func (m *ProofHash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = string(dAtA[iNdEx:postIndex])
+			if m.ProofVerificationPeriod == nil {
+				m.ProofVerificationPeriod = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ProofVerificationPeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 20:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prover", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofChunkDeposit", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4325,23 +11486,25 @@ func (m *ProofHash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prover = string(dAtA[iNdEx:postIndex])
+			m.ProofChunkDeposit = append(m.ProofChunkDeposit, types1.Coin{})
+			if err := m.ProofChunkDeposit[len(m.ProofChunkDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4364,7 +11527,7 @@ func (m *ProofHash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Grant) Unmarshal(dAtA []byte) error {
+func (m *OpenMathStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4387,34 +11550,15 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Grant: wiretype end group for non-group")
+			return fmt.Errorf("proto: OpenMathStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Grant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OpenMathStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
-			}
-			m.TheoremId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBounty
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TheoremId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4442,13 +11586,13 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantor = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremsProven", wireType)
 			}
-			var msglen int
+			m.TheoremsProven = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4458,81 +11602,54 @@ func (m *Grant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TheoremsProven |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthBounty
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthBounty
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipBounty(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthBounty
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsFailed", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Deposit) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowBounty
+			m.ProofsFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofsFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsChecked", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.ProofsChecked = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofsChecked |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Deposit: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Deposit: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProverRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4542,29 +11659,31 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofId = string(dAtA[iNdEx:postIndex])
+			m.ProverRewards = append(m.ProverRewards, types1.DecCoin{})
+			if err := m.ProverRewards[len(m.ProverRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckerRewards", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4574,27 +11693,29 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Depositor = string(dAtA[iNdEx:postIndex])
+			m.CheckerRewards = append(m.CheckerRewards, types1.DecCoin{})
+			if err := m.CheckerRewards[len(m.CheckerRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4621,8 +11742,8 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types1.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ImportedRewards = append(m.ImportedRewards, types1.DecCoin{})
+			if err := m.ImportedRewards[len(m.ImportedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4647,7 +11768,7 @@ func (m *Deposit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *TheoremTypeStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4670,15 +11791,72 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: TheoremTypeStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TheoremTypeStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremType", wireType)
+			}
+			m.TheoremType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremType |= TheoremType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremsProven", wireType)
+			}
+			m.TheoremsProven = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremsProven |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofsFailed", wireType)
+			}
+			m.ProofsFailed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProofsFailed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinGrant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProverRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4705,14 +11883,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinGrant = append(m.MinGrant, types1.Coin{})
-			if err := m.MinGrant[len(m.MinGrant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ProverRewards = append(m.ProverRewards, types1.DecCoin{})
+			if err := m.ProverRewards[len(m.ProverRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckerRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4739,14 +11917,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MinDeposit = append(m.MinDeposit, types1.Coin{})
-			if err := m.MinDeposit[len(m.MinDeposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.CheckerRewards = append(m.CheckerRewards, types1.DecCoin{})
+			if err := m.CheckerRewards[len(m.CheckerRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TheoremMaxProofPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportedRewards", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4773,18 +11951,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.TheoremMaxProofPeriod == nil {
-				m.TheoremMaxProofPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.TheoremMaxProofPeriod, dAtA[iNdEx:postIndex]); err != nil {
+			m.ImportedRewards = append(m.ImportedRewards, types1.DecCoin{})
+			if err := m.ImportedRewards[len(m.ImportedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Reward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Reward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Reward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofMaxLockPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4794,31 +12020,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ProofMaxLockPeriod == nil {
-				m.ProofMaxLockPeriod = new(time.Duration)
-			}
-			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ProofMaxLockPeriod, dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComplexityFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4845,34 +12067,66 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ComplexityFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reward = append(m.Reward, types1.DecCoin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxComplexity", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBounty(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.MaxComplexity = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowBounty
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxComplexity |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBounty
 			}
-		case 7:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardVesting) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBounty
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardVesting: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardVesting: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComplexityFeeRocq", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4882,28 +12136,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ComplexityFeeRocq.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ComplexityFeeLean", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedules", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4930,7 +12183,8 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ComplexityFeeLean.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Schedules = append(m.Schedules, VestingSchedule{})
+			if err := m.Schedules[len(m.Schedules)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4955,7 +12209,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Reward) Unmarshal(dAtA []byte) error {
+func (m *VestingSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4978,17 +12232,17 @@ func (m *Reward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Reward: wiretype end group for non-group")
+			return fmt.Errorf("proto: VestingSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Reward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VestingSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowBounty
@@ -4998,27 +12252,29 @@ func (m *Reward) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthBounty
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthBounty
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Total = append(m.Total, types1.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Released", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5045,8 +12301,74 @@ func (m *Reward) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reward = append(m.Reward, types1.DecCoin{})
-			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Released = append(m.Released, types1.Coin{})
+			if err := m.Released[len(m.Released)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBounty
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthBounty
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthBounty
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14,6 +14,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgEditProgram{}, "bounty/EditProgram", nil)
 	cdc.RegisterConcrete(MsgActivateProgram{}, "bounty/ActivateProgram", nil)
 	cdc.RegisterConcrete(MsgCloseProgram{}, "bounty/CloseProgram", nil)
+	cdc.RegisterConcrete(MsgFundProgram{}, "bounty/FundProgram", nil)
+	cdc.RegisterConcrete(MsgAddProgramMember{}, "bounty/AddProgramMember", nil)
+	cdc.RegisterConcrete(MsgRemoveProgramMember{}, "bounty/RemoveProgramMember", nil)
 	cdc.RegisterConcrete(MsgSubmitFinding{}, "bounty/SubmitFinding", nil)
 	cdc.RegisterConcrete(MsgEditFinding{}, "bounty/EditFinding", nil)
 	cdc.RegisterConcrete(MsgConfirmFinding{}, "bounty/ConfirmFinding", nil)
@@ -21,11 +24,19 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(MsgActivateFinding{}, "bounty/ActivateFinding", nil)
 	cdc.RegisterConcrete(MsgCloseFinding{}, "bounty/CloseFinding", nil)
 	cdc.RegisterConcrete(MsgPublishFinding{}, "bounty/PublishFinding", nil)
+	cdc.RegisterConcrete(MsgMarkDuplicateFinding{}, "bounty/MarkDuplicateFinding", nil)
+	cdc.RegisterConcrete(MsgDisputeFinding{}, "bounty/DisputeFinding", nil)
+	cdc.RegisterConcrete(MsgVoteDispute{}, "bounty/VoteDispute", nil)
 	cdc.RegisterConcrete(MsgCreateTheorem{}, "bounty/CreateTheorem", nil)
 	cdc.RegisterConcrete(MsgSubmitProofHash{}, "bounty/SubmitProofHash", nil)
 	cdc.RegisterConcrete(MsgSubmitProofDetail{}, "bounty/SubmitProofDetail", nil)
+	cdc.RegisterConcrete(MsgUploadProofChunk{}, "bounty/UploadProofChunk", nil)
+	cdc.RegisterConcrete(MsgSubmitProofDetailChunks{}, "bounty/SubmitProofDetailChunks", nil)
 	cdc.RegisterConcrete(MsgSubmitProofVerification{}, "bounty/SubmitProofVerification", nil)
 	cdc.RegisterConcrete(MsgGrant{}, "bounty/Grant", nil)
+	cdc.RegisterConcrete(MsgGrantFromCommunityPool{}, "bounty/MsgGrantFromCommunityPool", nil)
+	cdc.RegisterConcrete(MsgWithdrawGrant{}, "bounty/WithdrawGrant", nil)
+	cdc.RegisterConcrete(MsgCloseTheorem{}, "bounty/CloseTheorem", nil)
 	cdc.RegisterConcrete(MsgWithdrawReward{}, "bounty/WithdrawReward", nil)
 }
 
@@ -35,6 +46,9 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgEditProgram{},
 		&MsgActivateProgram{},
 		&MsgCloseProgram{},
+		&MsgFundProgram{},
+		&MsgAddProgramMember{},
+		&MsgRemoveProgramMember{},
 		&MsgSubmitFinding{},
 		&MsgEditFinding{},
 		&MsgConfirmFinding{},
@@ -42,11 +56,19 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgActivateFinding{},
 		&MsgCloseFinding{},
 		&MsgPublishFinding{},
+		&MsgMarkDuplicateFinding{},
+		&MsgDisputeFinding{},
+		&MsgVoteDispute{},
 		&MsgCreateTheorem{},
 		&MsgSubmitProofHash{},
 		&MsgSubmitProofDetail{},
+		&MsgUploadProofChunk{},
+		&MsgSubmitProofDetailChunks{},
 		&MsgSubmitProofVerification{},
 		&MsgGrant{},
+		&MsgGrantFromCommunityPool{},
+		&MsgWithdrawGrant{},
+		&MsgCloseTheorem{},
 		&MsgWithdrawReward{},
 	)
 
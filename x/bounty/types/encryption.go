@@ -0,0 +1,239 @@
+package types
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+)
+
+const (
+	// MaxEncryptedPayloadSize is the maximum size in bytes of a finding's encrypted payload.
+	MaxEncryptedPayloadSize = 64 * 1024
+
+	// payloadKeySize is the size of the AES-256 content key of an encrypted payload.
+	payloadKeySize = 32
+
+	// eciesOverhead is the size of the ephemeral public key, the nonce and the
+	// authentication tag prepended/appended to an ECIES ciphertext.
+	eciesOverhead = secp256k1.PubKeyBytesLenCompressed + 12 + 16
+
+	// wrappedKeySize is the size of a content key wrapped to a recipient.
+	wrappedKeySize = eciesOverhead + payloadKeySize
+)
+
+// FindingPayload is the confidential report of a finding that is encrypted to the program team.
+type FindingPayload struct {
+	Title          string `json:"title,omitempty"`
+	Description    string `json:"description"`
+	ProofOfConcept string `json:"proof_of_concept"`
+	Detail         string `json:"detail,omitempty"`
+}
+
+// Hash returns the finding hash committed on chain for the payload, i.e. hash(description + proof_of_concept + submitter).
+func (p FindingPayload) Hash(submitter string) string {
+	hash := sha256.Sum256([]byte(p.Description + p.ProofOfConcept + submitter))
+	return hex.EncodeToString(hash[:])
+}
+
+// PayloadRecipient is the address and compressed secp256k1 public key of a recipient of an encrypted payload.
+type PayloadRecipient struct {
+	Address string
+	PubKey  []byte
+}
+
+// Recipients returns the addresses the content key of the payload is wrapped for.
+func (p *EncryptedFindingPayload) Recipients() []string {
+	recipients := make([]string, 0, len(p.Keys))
+	for _, key := range p.Keys {
+		recipients = append(recipients, key.Recipient)
+	}
+	return recipients
+}
+
+// ValidateEncryptedPayload performs a stateless sanity check of an encrypted payload. The recipient
+// addresses are checked against the program team by the keeper.
+func ValidateEncryptedPayload(payload *EncryptedFindingPayload) error {
+	if payload == nil {
+		return nil
+	}
+	if payload.Size() > MaxEncryptedPayloadSize {
+		return errorsmod.Wrapf(ErrFindingPayloadInvalid, "payload too large: %d > %d bytes", payload.Size(), MaxEncryptedPayloadSize)
+	}
+	if len(payload.Ciphertext) <= 12+16 {
+		return errorsmod.Wrapf(ErrFindingPayloadInvalid, "ciphertext too short: %d bytes", len(payload.Ciphertext))
+	}
+	if len(payload.Keys) == 0 {
+		return errorsmod.Wrap(ErrFindingPayloadInvalid, "payload has no recipient")
+	}
+
+	recipients := make(map[string]bool, len(payload.Keys))
+	for _, key := range payload.Keys {
+		if len(key.Recipient) == 0 {
+			return errorsmod.Wrap(ErrFindingPayloadInvalid, "recipient must be set")
+		}
+		if recipients[key.Recipient] {
+			return errorsmod.Wrapf(ErrFindingPayloadInvalid, "duplicate recipient %s", key.Recipient)
+		}
+		recipients[key.Recipient] = true
+
+		if len(key.WrappedKey) != wrappedKeySize {
+			return errorsmod.Wrapf(ErrFindingPayloadInvalid, "wrapped key of %s must be %d bytes, got %d", key.Recipient, wrappedKeySize, len(key.WrappedKey))
+		}
+		if _, err := secp256k1.ParsePubKey(key.WrappedKey[:secp256k1.PubKeyBytesLenCompressed]); err != nil {
+			return errorsmod.Wrapf(ErrFindingPayloadInvalid, "ephemeral public key of %s: %s", key.Recipient, err)
+		}
+	}
+	return nil
+}
+
+// EncryptFindingPayload encrypts the payload so that every recipient can decrypt it.
+//
+// The payload is sealed with a random AES-256-GCM content key. The content key is
+// wrapped to each recipient with ECIES over secp256k1: an ephemeral key pair is
+// generated, the shared ECDH secret is hashed with sha256 to derive an AES-256-GCM
+// key, and the wrapped key is ephemeral pubkey (33 bytes) || nonce (12 bytes) ||
+// ciphertext || tag.
+func EncryptFindingPayload(recipients []PayloadRecipient, payload FindingPayload) (*EncryptedFindingPayload, error) {
+	if len(recipients) == 0 {
+		return nil, errorsmod.Wrap(ErrFindingPayloadInvalid, "payload has no recipient")
+	}
+	plaintext, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	contentKey := make([]byte, payloadKeySize)
+	if _, err = rand.Read(contentKey); err != nil {
+		return nil, err
+	}
+	ciphertext, err := sealAESGCM(contentKey, plaintext, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	encrypted := &EncryptedFindingPayload{Ciphertext: ciphertext}
+	for _, recipient := range recipients {
+		wrappedKey, err := encryptECIES(recipient.PubKey, contentKey)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "recipient %s", recipient.Address)
+		}
+		encrypted.Keys = append(encrypted.Keys, FindingPayloadKey{Recipient: recipient.Address, WrappedKey: wrappedKey})
+	}
+	return encrypted, nil
+}
+
+// DecryptFindingPayload decrypts an encrypted payload with the raw secp256k1 private key of the given recipient.
+func DecryptFindingPayload(recipient string, privKey []byte, encrypted *EncryptedFindingPayload) (FindingPayload, error) {
+	var payload FindingPayload
+	if encrypted == nil {
+		return payload, errorsmod.Wrap(ErrFindingPayloadInvalid, "empty payload")
+	}
+	if err := ValidateEncryptedPayload(encrypted); err != nil {
+		return payload, err
+	}
+
+	var wrappedKey []byte
+	for _, key := range encrypted.Keys {
+		if key.Recipient == recipient {
+			wrappedKey = key.WrappedKey
+		}
+	}
+	if wrappedKey == nil {
+		return payload, errorsmod.Wrapf(ErrFindingPayloadInvalid, "%s is not a recipient of the payload", recipient)
+	}
+
+	contentKey, err := decryptECIES(privKey, wrappedKey)
+	if err != nil {
+		return payload, err
+	}
+	plaintext, err := openAESGCM(contentKey, encrypted.Ciphertext, nil)
+	if err != nil {
+		return payload, err
+	}
+	if err = json.Unmarshal(plaintext, &payload); err != nil {
+		return payload, errorsmod.Wrap(ErrFindingPayloadInvalid, err.Error())
+	}
+	return payload, nil
+}
+
+func encryptECIES(pubKey, plaintext []byte) ([]byte, error) {
+	recipient, err := secp256k1.ParsePubKey(pubKey)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrFindingPayloadInvalid, "recipient public key: %s", err)
+	}
+	ephemeral, err := secp256k1.GeneratePrivateKey()
+	if err != nil {
+		return nil, err
+	}
+	ephemeralPub := ephemeral.PubKey().SerializeCompressed()
+
+	sealed, err := sealAESGCM(deriveKey(secp256k1.GenerateSharedSecret(ephemeral, recipient), ephemeralPub), plaintext, ephemeralPub)
+	if err != nil {
+		return nil, err
+	}
+	return append(ephemeralPub, sealed...), nil
+}
+
+func decryptECIES(privKey, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) <= eciesOverhead {
+		return nil, errorsmod.Wrapf(ErrFindingPayloadInvalid, "ciphertext too short: %d bytes", len(ciphertext))
+	}
+	ephemeralPub := ciphertext[:secp256k1.PubKeyBytesLenCompressed]
+	ephemeral, err := secp256k1.ParsePubKey(ephemeralPub)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrFindingPayloadInvalid, "ephemeral public key: %s", err)
+	}
+
+	key := deriveKey(secp256k1.GenerateSharedSecret(secp256k1.PrivKeyFromBytes(privKey), ephemeral), ephemeralPub)
+	return openAESGCM(key, ciphertext[len(ephemeralPub):], ephemeralPub)
+}
+
+// deriveKey derives the symmetric key of an ECIES ciphertext from the ECDH shared secret.
+func deriveKey(sharedSecret, ephemeralPub []byte) []byte {
+	key := sha256.Sum256(append(sharedSecret, ephemeralPub...))
+	return key[:]
+}
+
+// sealAESGCM encrypts the plaintext with AES-256-GCM under a random nonce, and returns nonce || ciphertext || tag.
+func sealAESGCM(key, plaintext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, additionalData), nil
+}
+
+// openAESGCM decrypts the output of sealAESGCM.
+func openAESGCM(key, ciphertext, additionalData []byte) ([]byte, error) {
+	aead, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errorsmod.Wrap(ErrFindingPayloadInvalid, "ciphertext too short")
+	}
+	nonce, sealed := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, sealed, additionalData)
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrFindingPayloadInvalid, "decryption failed")
+	}
+	return plaintext, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -15,6 +15,15 @@ const (
 	errProgramOperatorNotAllowed
 	errProgramCloseNotAllowed
 	errProgramID
+	errProgramRewardPoolInsufficient
+	errProgramMemberInvalid
+	errProgramMemberNotExists
+	errProgramApprovalsInvalid
+	errProgramDuplicatePolicyInvalid
+	errProgramSLAInvalid
+	errProgramScopeInvalid
+	errProgramEmbargoInvalid
+	errProgramSponsorshipInvalid
 )
 
 // Finding
@@ -26,19 +35,37 @@ const (
 	errFindingSeverityLevelInvalid
 	errFindingOperatorNotAllowed
 	errFindingID
+	errFindingRewardInvalid
+	errFindingPayloadInvalid
+	errFindingDisputeNotAllowed
+	errDisputeNotExists
+	errDisputeVoteInvalid
+	errFindingDuplicateInvalid
+	errFindingTargetInvalid
+	errFindingSubmitterNotEligible
+	errHackerReputationInvalid
 )
 
 // [1xx] Program
 var (
-	ErrProgramAlreadyExists      = errors.Register(ModuleName, errProgramAlreadyExists, "program already exists")
-	ErrProgramNotExists          = errors.Register(ModuleName, errProgramNotExists, "program does not exists")
-	ErrProgramAlreadyActive      = errors.Register(ModuleName, errProgramAlreadyActive, "program already active")
-	ErrProgramAlreadyClosed      = errors.Register(ModuleName, errProgramAlreadyClosed, "program already closed")
-	ErrProgramNotActive          = errors.Register(ModuleName, errProgramInactive, "program status is not active")
-	ErrProgramStatusInvalid      = errors.Register(ModuleName, errProgramStatusInvalid, "program status invalid")
-	ErrProgramOperatorNotAllowed = errors.Register(ModuleName, errProgramOperatorNotAllowed, "program access denied")
-	ErrProgramCloseNotAllowed    = errors.Register(ModuleName, errProgramCloseNotAllowed, "cannot close the program")
-	ErrProgramID                 = errors.Register(ModuleName, errProgramID, "invalid program id")
+	ErrProgramAlreadyExists          = errors.Register(ModuleName, errProgramAlreadyExists, "program already exists")
+	ErrProgramNotExists              = errors.Register(ModuleName, errProgramNotExists, "program does not exists")
+	ErrProgramAlreadyActive          = errors.Register(ModuleName, errProgramAlreadyActive, "program already active")
+	ErrProgramAlreadyClosed          = errors.Register(ModuleName, errProgramAlreadyClosed, "program already closed")
+	ErrProgramNotActive              = errors.Register(ModuleName, errProgramInactive, "program status is not active")
+	ErrProgramStatusInvalid          = errors.Register(ModuleName, errProgramStatusInvalid, "program status invalid")
+	ErrProgramOperatorNotAllowed     = errors.Register(ModuleName, errProgramOperatorNotAllowed, "program access denied")
+	ErrProgramCloseNotAllowed        = errors.Register(ModuleName, errProgramCloseNotAllowed, "cannot close the program")
+	ErrProgramID                     = errors.Register(ModuleName, errProgramID, "invalid program id")
+	ErrProgramRewardPoolInsufficient = errors.Register(ModuleName, errProgramRewardPoolInsufficient, "insufficient program reward pool")
+	ErrProgramMemberInvalid          = errors.Register(ModuleName, errProgramMemberInvalid, "invalid program member")
+	ErrProgramMemberNotExists        = errors.Register(ModuleName, errProgramMemberNotExists, "program member does not exist")
+	ErrProgramApprovalsInvalid       = errors.Register(ModuleName, errProgramApprovalsInvalid, "invalid number of critical finding approvals")
+	ErrProgramDuplicatePolicyInvalid = errors.Register(ModuleName, errProgramDuplicatePolicyInvalid, "invalid program duplicate policy")
+	ErrProgramSLAInvalid             = errors.Register(ModuleName, errProgramSLAInvalid, "invalid program finding SLA")
+	ErrProgramScopeInvalid           = errors.Register(ModuleName, errProgramScopeInvalid, "invalid program scope")
+	ErrProgramEmbargoInvalid         = errors.Register(ModuleName, errProgramEmbargoInvalid, "invalid program disclosure embargo")
+	ErrProgramSponsorshipInvalid     = errors.Register(ModuleName, errProgramSponsorshipInvalid, "invalid program sponsorship")
 )
 
 // [2xx] Finding
@@ -50,6 +77,15 @@ var (
 	ErrFindingSeverityLevelInvalid = errors.Register(ModuleName, errFindingSeverityLevelInvalid, "invalid finding severity level")
 	ErrFindingOperatorNotAllowed   = errors.Register(ModuleName, errFindingOperatorNotAllowed, "finding access denied")
 	ErrFindingID                   = errors.Register(ModuleName, errFindingID, "invalid finding id")
+	ErrFindingRewardInvalid        = errors.Register(ModuleName, errFindingRewardInvalid, "invalid finding reward")
+	ErrFindingPayloadInvalid       = errors.Register(ModuleName, errFindingPayloadInvalid, "invalid finding encrypted payload")
+	ErrFindingDisputeNotAllowed    = errors.Register(ModuleName, errFindingDisputeNotAllowed, "finding cannot be disputed")
+	ErrDisputeNotExists            = errors.Register(ModuleName, errDisputeNotExists, "dispute does not exist")
+	ErrDisputeVoteInvalid          = errors.Register(ModuleName, errDisputeVoteInvalid, "invalid dispute vote")
+	ErrFindingDuplicateInvalid     = errors.Register(ModuleName, errFindingDuplicateInvalid, "invalid duplicate finding")
+	ErrFindingTargetInvalid        = errors.Register(ModuleName, errFindingTargetInvalid, "finding target is not in scope")
+	ErrFindingSubmitterNotEligible = errors.Register(ModuleName, errFindingSubmitterNotEligible, "submitter does not meet the program submission requirements")
+	ErrHackerReputationInvalid     = errors.Register(ModuleName, errHackerReputationInvalid, "invalid hacker reputation")
 )
 
 // [3xx] Theorem
@@ -57,6 +93,11 @@ var (
 	ErrInvalidContent            = errors.Register(ModuleName, 301, "invalid content")
 	ErrTheoremProposal           = errors.Register(ModuleName, 302, "inactive theorem")
 	ErrTheoremProofStatusInvalid = errors.Register(ModuleName, 303, "theorem is not in proof period")
+	ErrTheoremProofInProgress    = errors.Register(ModuleName, 304, "theorem has a proof in progress")
+	ErrTheoremOperatorNotAllowed = errors.Register(ModuleName, 305, "theorem access denied")
+	ErrTheoremImportCycle        = errors.Register(ModuleName, 306, "theorem import cycle")
+	ErrTheoremTypeMismatch       = errors.Register(ModuleName, 307, "theorem type mismatch")
+	ErrTheoremDuplicate          = errors.Register(ModuleName, 308, "duplicate theorem")
 )
 
 // [4xx] Proof
@@ -67,6 +108,10 @@ var (
 	ErrProofHashInvalid        = errors.Register(ModuleName, 404, "invalid proof hash")
 	ErrProofNotExist           = errors.Register(ModuleName, 405, "proof does not exist.")
 	ErrProofOpenMathCertNeeded = errors.Register(ModuleName, 406, "openmath certificate required")
+	ErrProofOutOfOrder         = errors.Register(ModuleName, 407, "an earlier proof of the theorem is pending")
+	ErrProofVerdictInvalid     = errors.Register(ModuleName, 408, "invalid proof verdict")
+	ErrProofChunkNotExist      = errors.Register(ModuleName, 409, "proof chunk does not exist")
+	ErrProofChunkInvalid       = errors.Register(ModuleName, 410, "invalid proof chunk")
 )
 
 // [5xx] Deposit & Distribution
@@ -77,4 +122,8 @@ var (
 	ErrInsufficientGrantChecker = errors.Register(ModuleName, 504, "insufficient grant for checker rewards")
 	ErrInsufficientGrantTotal   = errors.Register(ModuleName, 505, "insufficient grant for total distribution")
 	ErrInvalidDepositProofID    = errors.Register(ModuleName, 506, "proof_id for deposit is invalid.")
+	ErrGrantNotExist            = errors.Register(ModuleName, 507, "grant does not exist")
+	ErrRewardVestingInvalid     = errors.Register(ModuleName, 508, "invalid reward vesting")
+	ErrOpenMathStatsInvalid     = errors.Register(ModuleName, 509, "invalid openmath statistics")
+	ErrGrantSourceMismatch      = errors.Register(ModuleName, 510, "grant source mismatch")
 )
@@ -10,37 +10,75 @@ const (
 	EventTypeActivateProgram = "activate_program"
 	EventTypeCloseProgram    = "close_program"
 
+	// Program team related events
+	EventTypeAddProgramMember    = "add_program_member"
+	EventTypeRemoveProgramMember = "remove_program_member"
+
 	// Finding related events
 	EventTypeSubmitFinding          = "submit_finding"
 	EventTypeEditFinding            = "edit_finding"
 	EventTypeEditFindingPaymentHash = "edit_finding_payment_hash"
 	EventTypeActivateFinding        = "activate_finding"
 	EventTypeConfirmFinding         = "confirm_finding"
+	EventTypeApproveFinding         = "approve_finding"
 	EventTypeConfirmFindingPaid     = "confirm_finding_paid"
 	EventTypeCloseFinding           = "close_finding"
 	EventTypePublishFinding         = "publish_finding"
+	EventTypeMarkDuplicateFinding   = "mark_duplicate_finding"
+	EventTypeFindingSLABreached     = "finding_sla_breached"
+	EventTypeDisclosureOverdue      = "finding_disclosure_overdue"
+
+	// Finding dispute related events
+	EventTypeDisputeFinding = "dispute_finding"
+	EventTypeVoteDispute    = "vote_dispute"
+	EventTypeResolveDispute = "resolve_dispute"
+
+	// Program escrow related events
+	EventTypeLockProgramRewardPool   = "lock_program_reward_pool"
+	EventTypePayFindingReward        = "pay_finding_reward"
+	EventTypeRefundProgramRewardPool = "refund_program_reward_pool"
+	EventTypeFundProgram             = "fund_program"
 
 	// Program/Finding attributes
 	AttributeKeyProgramID = "program_id"
 	AttributeKeyFindingID = "finding_id"
+	AttributeKeyRecipient = "recipient"
+	AttributeKeyMember    = "member"
+	AttributeKeyRole      = "role"
+	AttributeKeyApprovals = "approvals"
+	AttributeKeyOption    = "option"
+	AttributeKeyOutcome   = "outcome"
+	AttributeKeyEndTime   = "end_time"
+	AttributeKeyDuplicate = "duplicate_of"
+	AttributeKeySLA       = "sla"
+	AttributeKeyDeadline  = "deadline"
 
 	// Theorem related events
 	EventTypeCreateTheorem           = "create_theorem"
 	EventTypeGrantTheorem            = "grant_theorem"
 	EventTypeDeleteTheorem           = "delete_theorem"
+	EventTypeWithdrawGrant           = "withdraw_grant"
+	EventTypeCloseTheorem            = "close_theorem"
 	EventTypeDistributeReward        = "distribute_theorem_reward"
 	EventTypeImportedReward          = "imported_reward"
 	EventTypeUpdateTheoremComplexity = "update_theorem_complexity"
+	EventTypeExtendTheorem           = "extend_theorem"
 
 	// Proof related events
-	EventTypeSubmitProofHash         = "submit_proof_hash"
-	EventTypeSubmitProofDetail       = "submit_proof_detail"
-	EventTypeSubmitProofVerification = "submit_proof_verification"
-	EventTypeDepositProof            = "deposit_proof"
-	EventTypeDeleteProof             = "delete_proof"
-	EventTypeWithdrawReward          = "withdraw_reward"
-	EventTypeProofPassed             = "proof_passed"
-	EventTypeProofFailed             = "proof_failed"
+	EventTypeSubmitProofHash          = "submit_proof_hash"
+	EventTypeSubmitProofDetail        = "submit_proof_detail"
+	EventTypeUploadProofChunk         = "upload_proof_chunk"
+	EventTypeSubmitProofVerification  = "submit_proof_verification"
+	EventTypeDepositProof             = "deposit_proof"
+	EventTypeDeleteProof              = "delete_proof"
+	EventTypeWithdrawReward           = "withdraw_reward"
+	EventTypeVestReward               = "vest_reward"
+	EventTypeReleaseVestedReward      = "release_vested_reward"
+	EventTypeProofPassed              = "proof_passed"
+	EventTypeProofFailed              = "proof_failed"
+	EventTypeProofVerificationExpired = "proof_verification_expired"
+	EventTypeProofUndecided           = "proof_undecided"
+	EventTypeForfeitProofDeposit      = "forfeit_proof_deposit"
 
 	// Theorem/Proof attributes
 	AttributeKeyTheoremID           = "theorem_id"
@@ -48,6 +86,7 @@ const (
 	AttributeKeyProposer            = "proposer"
 	AttributeKeyProver              = "prover"
 	AttributeKeyTheoremGrantor      = "grantor"
+	AttributeKeyGrantSource         = "grant_source"
 	AttributeKeyProofDepositor      = "depositor"
 	AttributeKeyChecker             = "checker"
 	AttributeKeyProofStatus         = "proof_status"
@@ -56,7 +95,17 @@ const (
 	AttributeKeyProverReward        = "prover_reward"
 	AttributeKeyAddress             = "address"
 	AttributeKeyComplexity          = "complexity"
+	AttributeKeyTheoremType         = "theorem_type"
 	AttributeKeyInitialGrant        = "initial_grant"
 	AttributeKeyDeposit             = "deposit"
 	AttributeKeyRequireOpenmathCert = "require_openmath_cert"
+	AttributeKeySlashed             = "slashed"
+	AttributeKeyRefunded            = "refunded"
+	AttributeKeyPenalty             = "penalty"
+	AttributeKeyGrantShare          = "grant_share"
+	AttributeKeyCommunityPoolShare  = "community_pool_share"
+	AttributeKeyChunkHash           = "chunk_hash"
+	AttributeKeyChunks              = "chunks"
+	AttributeKeyVesting             = "vesting"
+	AttributeKeyVerdicts            = "verdicts"
 )
@@ -11,10 +11,13 @@ import (
 type CertKeeper interface {
 	IsBountyAdmin(ctx context.Context, addr sdk.AccAddress) bool
 	IsOpenMathCertified(ctx context.Context, addr sdk.AccAddress) bool
+	IsIdentityCertified(ctx context.Context, addr sdk.AccAddress) bool
 }
 
 type AccountKeeper interface {
 	AddressCodec() address.Codec
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
 }
 
 // BankKeeper defines the expected interface needed to retrieve account balances.
@@ -22,3 +25,9 @@ type BankKeeper interface {
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 }
+
+// DistrKeeper defines the expected interface needed to fund and spend the community pool.
+type DistrKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+	DistributeFromFeePoolToModule(ctx context.Context, amount sdk.Coins, recipientModule string) error
+}
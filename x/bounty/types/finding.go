@@ -2,7 +2,12 @@ package types
 
 import (
 	"fmt"
+	"sort"
 	"strings"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // ValidFindingSeverityLevel returns true if the finding level is valid and false otherwise.
@@ -47,3 +52,73 @@ func NormalizeSeverityLevel(level string) string {
 		return level
 	}
 }
+
+// FindingStatusFromString returns a FindingStatus from a case-insensitive status name, e.g. "confirmed".
+func FindingStatusFromString(str string) (FindingStatus, error) {
+	name := strings.ToUpper(str)
+	if !strings.HasPrefix(name, "FINDING_STATUS_") {
+		name = "FINDING_STATUS_" + name
+	}
+	option, ok := FindingStatus_value[name]
+	if !ok {
+		return FindingStatusSubmitted, fmt.Errorf("'%s' is not a valid FindingStatus option", str)
+	}
+	return FindingStatus(option), nil
+}
+
+// DisputeVoteOptionFromString returns a DisputeVoteOption from a case-insensitive option name, e.g. "overturn".
+func DisputeVoteOptionFromString(str string) (DisputeVoteOption, error) {
+	switch strings.ToLower(str) {
+	case "uphold":
+		return DisputeVoteOptionUphold, nil
+	case "overturn":
+		return DisputeVoteOptionOverturn, nil
+	}
+	option, ok := DisputeVoteOption_value[str]
+	if !ok || !ValidDisputeVoteOption(DisputeVoteOption(option)) {
+		return DisputeVoteOptionUnspecified, fmt.Errorf("'%s' is not a valid DisputeVoteOption option", str)
+	}
+	return DisputeVoteOption(option), nil
+}
+
+// NewHackerReputation returns an empty reputation record for a finding submitter.
+func NewHackerReputation(address string) HackerReputation {
+	return HackerReputation{
+		Address:   address,
+		TotalPaid: sdk.NewCoins(),
+	}
+}
+
+// AddConfirmed counts a confirmed finding of the given severity level.
+func (r *HackerReputation) AddConfirmed(level SeverityLevel) {
+	for i := range r.Confirmed {
+		if r.Confirmed[i].SeverityLevel == level {
+			r.Confirmed[i].Count++
+			return
+		}
+	}
+	r.Confirmed = append(r.Confirmed, SeverityCount{SeverityLevel: level, Count: 1})
+	sort.Slice(r.Confirmed, func(i, j int) bool {
+		return r.Confirmed[i].SeverityLevel < r.Confirmed[j].SeverityLevel
+	})
+}
+
+// ConfirmedFindings returns the number of confirmed findings of all severity levels.
+func (r HackerReputation) ConfirmedFindings() uint64 {
+	var total uint64
+	for _, count := range r.Confirmed {
+		total += count.Count
+	}
+	return total
+}
+
+// AcceptanceRate returns the share of the confirmed findings among the confirmed and closed ones,
+// zero if none of the findings of the hacker was resolved yet.
+func (r HackerReputation) AcceptanceRate() math.LegacyDec {
+	confirmed := r.ConfirmedFindings()
+	resolved := confirmed + r.ClosedFindings
+	if resolved == 0 {
+		return math.LegacyZeroDec()
+	}
+	return math.LegacyNewDec(int64(confirmed)).QuoInt64(int64(resolved))
+}
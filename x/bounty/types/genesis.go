@@ -2,6 +2,8 @@ package types
 
 import (
 	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 // NewGenesisState creates a new GenesisState object
@@ -73,7 +75,93 @@ func ValidateGenesis(data *GenesisState) error {
 		findings[finding.FindingId] = true
 	}
 
+	for _, finding := range data.Findings {
+		if len(finding.DuplicateOf) != 0 && !findings[finding.DuplicateOf] {
+			return errorsmod.Wrapf(ErrFindingDuplicateInvalid, "original finding %s of finding %s does not exist",
+				finding.DuplicateOf, finding.FindingId)
+		}
+	}
+
+	members := make(map[string]bool)
+	for _, member := range data.ProgramMembers {
+		if _, ok := programs[member.ProgramId]; !ok {
+			return errorsmod.Wrapf(ErrProgramID, "program %s for member %s does not exist",
+				member.ProgramId, member.Address)
+		}
+
+		if err := ValidateProgramMember(member); err != nil {
+			return errorsmod.Wrapf(err, "invalid member %s of program %s", member.Address, member.ProgramId)
+		}
+
+		key := member.ProgramId + "/" + member.Address
+		if members[key] {
+			return errorsmod.Wrapf(ErrProgramMemberInvalid, "duplicate member %s of program %s", member.Address, member.ProgramId)
+		}
+		members[key] = true
+	}
+
+	disputes := make(map[string]bool)
+	for _, dispute := range data.Disputes {
+		if !findings[dispute.FindingId] {
+			return errorsmod.Wrapf(ErrFindingID, "finding %s for dispute does not exist", dispute.FindingId)
+		}
+
+		if disputes[dispute.FindingId] {
+			return errorsmod.Wrapf(ErrFindingID, "duplicate dispute for finding %s", dispute.FindingId)
+		}
+
+		if err := ValidateDispute(dispute); err != nil {
+			return errorsmod.Wrapf(err, "invalid dispute for finding %s", dispute.FindingId)
+		}
+
+		disputes[dispute.FindingId] = true
+	}
+
+	for _, vote := range data.DisputeVotes {
+		if !disputes[vote.FindingId] {
+			return errorsmod.Wrapf(ErrDisputeNotExists, "dispute for finding %s does not exist", vote.FindingId)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(vote.Voter); err != nil {
+			return errorsmod.Wrapf(err, "invalid voter address %s", vote.Voter)
+		}
+
+		if !ValidDisputeVoteOption(vote.Option) {
+			return errorsmod.Wrapf(ErrDisputeVoteInvalid, "invalid vote option %s", vote.Option)
+		}
+	}
+
+	hackers := make(map[string]bool)
+	for _, reputation := range data.HackerReputations {
+		if err := ValidateHackerReputation(reputation); err != nil {
+			return err
+		}
+
+		if hackers[reputation.Address] {
+			return errorsmod.Wrapf(ErrHackerReputationInvalid, "duplicate reputation of hacker %s", reputation.Address)
+		}
+		hackers[reputation.Address] = true
+	}
+
+	sponsorships := make(map[string]bool)
+	for _, sponsorship := range data.Sponsorships {
+		if _, ok := programs[sponsorship.ProgramId]; !ok {
+			return errorsmod.Wrapf(ErrProgramID, "program %s for sponsorship does not exist", sponsorship.ProgramId)
+		}
+
+		if err := ValidateSponsorship(sponsorship); err != nil {
+			return errorsmod.Wrapf(err, "invalid sponsorship of program %s", sponsorship.ProgramId)
+		}
+
+		key := sponsorship.ProgramId + "/" + sponsorship.Sponsor
+		if sponsorships[key] {
+			return errorsmod.Wrapf(ErrProgramSponsorshipInvalid, "duplicate sponsor %s of program %s", sponsorship.Sponsor, sponsorship.ProgramId)
+		}
+		sponsorships[key] = true
+	}
+
 	theorems := make(map[uint64]bool)
+	proofSequences := make(map[uint64]uint64)
 	for _, theorem := range data.Theorems {
 		if theorem.Id == 0 {
 			return errorsmod.Wrap(ErrInvalidContent, "theorem id cannot be 0")
@@ -88,6 +176,7 @@ func ValidateGenesis(data *GenesisState) error {
 		}
 
 		theorems[theorem.Id] = true
+		proofSequences[theorem.Id] = theorem.ProofSequence
 	}
 
 	proofs := make(map[string]bool)
@@ -109,10 +198,58 @@ func ValidateGenesis(data *GenesisState) error {
 			return errorsmod.Wrapf(ErrTheoremProposal, "theorem %d for proof %s does not exist",
 				proof.TheoremId, proof.Id)
 		}
+		if proof.Sequence > proofSequences[proof.TheoremId] {
+			return errorsmod.Wrapf(ErrProofStatusInvalid, "sequence %d of proof %s exceeds the proof sequence of theorem %d",
+				proof.Sequence, proof.Id, proof.TheoremId)
+		}
 
 		proofs[proof.Id] = true
 	}
 
+	// Validate proof verdicts
+	verdicts := make(map[string]bool)
+	for _, verdict := range data.ProofVerdicts {
+		if !proofs[verdict.ProofId] {
+			return errorsmod.Wrapf(ErrProofNotExist, "proof %s for verdict does not exist", verdict.ProofId)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(verdict.Checker); err != nil {
+			return errorsmod.Wrapf(err, "invalid checker address %s", verdict.Checker)
+		}
+
+		if verdict.Status != ProofStatus_PROOF_STATUS_PASSED && verdict.Status != ProofStatus_PROOF_STATUS_FAILED {
+			return errorsmod.Wrapf(ErrProofVerdictInvalid, "invalid verdict status %s", verdict.Status)
+		}
+
+		key := verdict.ProofId + "/" + verdict.Checker
+		if verdicts[key] {
+			return errorsmod.Wrapf(ErrProofVerdictInvalid, "duplicate verdict of checker %s on proof %s", verdict.Checker, verdict.ProofId)
+		}
+		verdicts[key] = true
+	}
+
+	// Validate proof chunks
+	chunks := make(map[string]bool)
+	for _, chunk := range data.ProofChunks {
+		if !proofs[chunk.ProofId] {
+			return errorsmod.Wrapf(ErrProofNotExist, "proof %s for chunk does not exist", chunk.ProofId)
+		}
+
+		if len(chunk.Data) == 0 || len(chunk.Data) > MaxProofChunkSize {
+			return errorsmod.Wrapf(ErrProofChunkInvalid, "invalid size %d of chunk %s", len(chunk.Data), chunk.Hash)
+		}
+
+		if ProofChunkHash(chunk.Data) != chunk.Hash {
+			return errorsmod.Wrapf(ErrProofChunkInvalid, "hash mismatch of chunk %s", chunk.Hash)
+		}
+
+		key := chunk.ProofId + "/" + chunk.Hash
+		if chunks[key] {
+			return errorsmod.Wrapf(ErrProofChunkInvalid, "duplicate chunk %s of proof %s", chunk.Hash, chunk.ProofId)
+		}
+		chunks[key] = true
+	}
+
 	// Validate grants
 	for _, grant := range data.Grants {
 		if grant.TheoremId == 0 {
@@ -165,5 +302,43 @@ func ValidateGenesis(data *GenesisState) error {
 		}
 	}
 
+	// Validate reward vestings
+	vestings := make(map[string]bool)
+	for _, vesting := range data.RewardVestings {
+		if err := ValidateRewardVesting(vesting); err != nil {
+			return err
+		}
+
+		if vestings[vesting.Address] {
+			return errorsmod.Wrapf(ErrRewardVestingInvalid, "duplicate reward vesting of address %s", vesting.Address)
+		}
+		vestings[vesting.Address] = true
+	}
+
+	// Validate openmath statistics
+	stats := make(map[string]bool)
+	for _, stat := range data.OpenmathStats {
+		if err := ValidateOpenMathStats(stat); err != nil {
+			return err
+		}
+
+		if stats[stat.Address] {
+			return errorsmod.Wrapf(ErrOpenMathStatsInvalid, "duplicate statistics of address %s", stat.Address)
+		}
+		stats[stat.Address] = true
+	}
+
+	theoremTypes := make(map[TheoremType]bool)
+	for _, stat := range data.TheoremTypeStats {
+		if err := ValidateTheoremTypeStats(stat); err != nil {
+			return err
+		}
+
+		if theoremTypes[stat.TheoremType] {
+			return errorsmod.Wrapf(ErrOpenMathStatsInvalid, "duplicate statistics of theorem type %s", stat.TheoremType)
+		}
+		theoremTypes[stat.TheoremType] = true
+	}
+
 	return nil
 }
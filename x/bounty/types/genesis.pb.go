@@ -34,11 +34,21 @@ type GenesisState struct {
 	// proofs defines all the proofs present at genesis.
 	Proofs []*Proof `protobuf:"bytes,5,rep,name=proofs,proto3" json:"proofs,omitempty"`
 	// grants defines all the grants present at genesis.
-	Grants          []*Grant   `protobuf:"bytes,6,rep,name=grants,proto3" json:"grants,omitempty"`
-	Deposits        []*Deposit `protobuf:"bytes,7,rep,name=deposits,proto3" json:"deposits,omitempty"`
-	Rewards         []*Reward  `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards,omitempty"`
-	Params          *Params    `protobuf:"bytes,9,opt,name=params,proto3" json:"params,omitempty"`
-	ImportedRewards []*Reward  `protobuf:"bytes,10,rep,name=imported_rewards,json=importedRewards,proto3" json:"imported_rewards,omitempty"`
+	Grants            []*Grant            `protobuf:"bytes,6,rep,name=grants,proto3" json:"grants,omitempty"`
+	Deposits          []*Deposit          `protobuf:"bytes,7,rep,name=deposits,proto3" json:"deposits,omitempty"`
+	Rewards           []*Reward           `protobuf:"bytes,8,rep,name=rewards,proto3" json:"rewards,omitempty"`
+	Params            *Params             `protobuf:"bytes,9,opt,name=params,proto3" json:"params,omitempty"`
+	ImportedRewards   []*Reward           `protobuf:"bytes,10,rep,name=imported_rewards,json=importedRewards,proto3" json:"imported_rewards,omitempty"`
+	ProgramMembers    []*ProgramMember    `protobuf:"bytes,11,rep,name=program_members,json=programMembers,proto3" json:"program_members,omitempty"`
+	Disputes          []*Dispute          `protobuf:"bytes,12,rep,name=disputes,proto3" json:"disputes,omitempty"`
+	DisputeVotes      []*DisputeVote      `protobuf:"bytes,13,rep,name=dispute_votes,json=disputeVotes,proto3" json:"dispute_votes,omitempty"`
+	HackerReputations []*HackerReputation `protobuf:"bytes,14,rep,name=hacker_reputations,json=hackerReputations,proto3" json:"hacker_reputations,omitempty"`
+	Sponsorships      []*Sponsorship      `protobuf:"bytes,15,rep,name=sponsorships,proto3" json:"sponsorships,omitempty"`
+	ProofVerdicts     []*ProofVerdict     `protobuf:"bytes,16,rep,name=proof_verdicts,json=proofVerdicts,proto3" json:"proof_verdicts,omitempty"`
+	ProofChunks       []*ProofChunk       `protobuf:"bytes,17,rep,name=proof_chunks,json=proofChunks,proto3" json:"proof_chunks,omitempty"`
+	RewardVestings    []*RewardVesting    `protobuf:"bytes,18,rep,name=reward_vestings,json=rewardVestings,proto3" json:"reward_vestings,omitempty"`
+	OpenmathStats     []*OpenMathStats    `protobuf:"bytes,19,rep,name=openmath_stats,json=openmathStats,proto3" json:"openmath_stats,omitempty"`
+	TheoremTypeStats  []*TheoremTypeStats `protobuf:"bytes,20,rep,name=theorem_type_stats,json=theoremTypeStats,proto3" json:"theorem_type_stats,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -144,6 +154,76 @@ func (m *GenesisState) GetImportedRewards() []*Reward {
 	return nil
 }
 
+func (m *GenesisState) GetProgramMembers() []*ProgramMember {
+	if m != nil {
+		return m.ProgramMembers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisputes() []*Dispute {
+	if m != nil {
+		return m.Disputes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDisputeVotes() []*DisputeVote {
+	if m != nil {
+		return m.DisputeVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHackerReputations() []*HackerReputation {
+	if m != nil {
+		return m.HackerReputations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsorships() []*Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProofVerdicts() []*ProofVerdict {
+	if m != nil {
+		return m.ProofVerdicts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetProofChunks() []*ProofChunk {
+	if m != nil {
+		return m.ProofChunks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardVestings() []*RewardVesting {
+	if m != nil {
+		return m.RewardVestings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOpenmathStats() []*OpenMathStats {
+	if m != nil {
+		return m.OpenmathStats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetTheoremTypeStats() []*TheoremTypeStats {
+	if m != nil {
+		return m.TheoremTypeStats
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "shentu.bounty.v1.GenesisState")
 }
@@ -151,31 +231,46 @@ func init() {
 func init() { proto.RegisterFile("shentu/bounty/v1/genesis.proto", fileDescriptor_186d656250aa7272) }
 
 var fileDescriptor_186d656250aa7272 = []byte{
-	// 381 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xc1, 0x4e, 0xdb, 0x30,
-	0x18, 0xc7, 0x9b, 0xb5, 0x4b, 0x3b, 0x6f, 0xd2, 0xba, 0x6c, 0xd2, 0xbc, 0x4a, 0x8b, 0x2a, 0x4e,
-	0x3d, 0x25, 0xb4, 0x88, 0x17, 0x00, 0x44, 0x85, 0xb8, 0x54, 0x86, 0x13, 0x97, 0x2a, 0x25, 0x8e,
-	0xeb, 0x43, 0xfc, 0x45, 0xb6, 0x53, 0xe8, 0x5b, 0xf0, 0x16, 0xbc, 0x0a, 0xc7, 0x1e, 0x39, 0xa2,
-	0xf6, 0x45, 0x50, 0xec, 0x24, 0x07, 0xda, 0x88, 0x5b, 0x92, 0xff, 0xef, 0xf7, 0xfd, 0x1d, 0xf9,
-	0x43, 0xbe, 0x5a, 0x52, 0xa1, 0xf3, 0x70, 0x01, 0xb9, 0xd0, 0xeb, 0x70, 0x35, 0x0e, 0x19, 0x15,
-	0x54, 0x71, 0x15, 0x64, 0x12, 0x34, 0x78, 0x7d, 0x9b, 0x07, 0x36, 0x0f, 0x56, 0xe3, 0xc1, 0x1f,
-	0x06, 0x0c, 0x4c, 0x18, 0x16, 0x4f, 0x96, 0x1b, 0xfc, 0xdf, 0x9b, 0x53, 0x1a, 0x26, 0x3e, 0x7a,
-	0xee, 0xa0, 0x1f, 0x53, 0x3b, 0xf8, 0x46, 0x47, 0x9a, 0x7a, 0xa7, 0xa8, 0x97, 0x49, 0x60, 0x32,
-	0x4a, 0x15, 0x76, 0x86, 0xed, 0xd1, 0xf7, 0xc9, 0xbf, 0xe0, 0x63, 0x55, 0x30, 0xb3, 0x04, 0xa9,
-	0xd1, 0x42, 0x4b, 0xb8, 0x88, 0xb9, 0x60, 0x0a, 0x7f, 0x69, 0xd2, 0x2e, 0x2d, 0x41, 0x6a, 0xd4,
-	0x0b, 0xd0, 0x6f, 0xa5, 0x23, 0xa9, 0xb9, 0x60, 0x73, 0xbd, 0xa4, 0x20, 0x69, 0x3a, 0xe7, 0x31,
-	0x6e, 0x0f, 0x9d, 0x51, 0x87, 0xfc, 0xaa, 0xa2, 0x5b, 0x9b, 0x5c, 0xc5, 0x45, 0x4d, 0x89, 0x29,
-	0xdc, 0x69, 0xaa, 0x29, 0x71, 0x52, 0xa3, 0x5e, 0x88, 0xdc, 0x4c, 0x02, 0x24, 0x0a, 0x7f, 0x35,
-	0xd2, 0xdf, 0x83, 0xbf, 0x04, 0x09, 0x29, 0xb1, 0x42, 0x60, 0x32, 0x12, 0x5a, 0x61, 0xb7, 0x49,
-	0x98, 0x16, 0x39, 0x29, 0xb1, 0xe2, 0x60, 0x31, 0xcd, 0x40, 0x71, 0xad, 0x70, 0xb7, 0xe9, 0x60,
-	0x17, 0x96, 0x20, 0x35, 0xea, 0x4d, 0x50, 0x57, 0xd2, 0x87, 0x48, 0xc6, 0x0a, 0xf7, 0x8c, 0x85,
-	0xf7, 0x2d, 0x62, 0x00, 0x52, 0x81, 0xde, 0x31, 0x72, 0xb3, 0xc8, 0xdc, 0xcf, 0xb7, 0xa1, 0x73,
-	0x58, 0x99, 0x99, 0x9c, 0x94, 0x9c, 0x77, 0x8e, 0xfa, 0x3c, 0xcd, 0x40, 0x6a, 0x1a, 0xcf, 0xab,
-	0x3a, 0xf4, 0x49, 0xdd, 0xcf, 0xca, 0xb0, 0xef, 0xea, 0xec, 0xfa, 0x65, 0xeb, 0x3b, 0x9b, 0xad,
-	0xef, 0xbc, 0x6d, 0x7d, 0xe7, 0x69, 0xe7, 0xb7, 0x36, 0x3b, 0xbf, 0xf5, 0xba, 0xf3, 0x5b, 0x77,
-	0x63, 0xc6, 0xf5, 0x32, 0x5f, 0x04, 0xf7, 0x90, 0x86, 0x76, 0x5c, 0x02, 0xb9, 0x88, 0x23, 0xcd,
-	0x41, 0x94, 0x1f, 0xc2, 0xc7, 0x6a, 0x01, 0xf5, 0x3a, 0xa3, 0x6a, 0xe1, 0x9a, 0xed, 0x3b, 0x79,
-	0x0f, 0x00, 0x00, 0xff, 0xff, 0xdc, 0x04, 0xc9, 0xc4, 0xe6, 0x02, 0x00, 0x00,
+	// 620 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0x41, 0x4f, 0x14, 0x31,
+	0x14, 0xc7, 0x59, 0xc1, 0x05, 0xca, 0xee, 0xb2, 0x14, 0x12, 0x2b, 0x91, 0x91, 0x70, 0xe2, 0x34,
+	0x23, 0x18, 0xcf, 0x46, 0x50, 0xc0, 0x18, 0x22, 0x16, 0xc2, 0xc1, 0xcb, 0x64, 0x76, 0xa7, 0x3b,
+	0x33, 0x21, 0xd3, 0x36, 0x7d, 0x9d, 0x55, 0xbe, 0x85, 0x1f, 0xcb, 0x23, 0x47, 0x8f, 0x06, 0xee,
+	0x7e, 0x06, 0x33, 0x6d, 0x67, 0x40, 0xa0, 0xf1, 0xd6, 0xd7, 0xf7, 0xfb, 0xff, 0x5f, 0x67, 0xde,
+	0x6b, 0x51, 0x00, 0x39, 0xe3, 0xba, 0x8a, 0x46, 0xa2, 0xe2, 0xfa, 0x32, 0x9a, 0xee, 0x44, 0x19,
+	0xe3, 0x0c, 0x0a, 0x08, 0xa5, 0x12, 0x5a, 0xe0, 0xa1, 0xcd, 0x87, 0x36, 0x1f, 0x4e, 0x77, 0xd6,
+	0xd7, 0x32, 0x91, 0x09, 0x93, 0x8c, 0xea, 0x95, 0xe5, 0xd6, 0x37, 0x1e, 0xf8, 0x38, 0x85, 0x49,
+	0x6f, 0xfd, 0x59, 0x44, 0xbd, 0x43, 0x6b, 0x7c, 0xaa, 0x13, 0xcd, 0xf0, 0x1b, 0xb4, 0x20, 0x95,
+	0xc8, 0x54, 0x52, 0x02, 0xe9, 0x6c, 0xce, 0x6e, 0x2f, 0xed, 0x3e, 0x0f, 0xef, 0x97, 0x0a, 0x4f,
+	0x2c, 0x41, 0x5b, 0xb4, 0x96, 0x4d, 0x0a, 0x9e, 0x16, 0x3c, 0x03, 0xf2, 0xc4, 0x27, 0x3b, 0xb0,
+	0x04, 0x6d, 0x51, 0x1c, 0xa2, 0x55, 0xd0, 0x89, 0xd2, 0x05, 0xcf, 0x62, 0x9d, 0x33, 0xa1, 0x58,
+	0x19, 0x17, 0x29, 0x99, 0xdd, 0xec, 0x6c, 0xcf, 0xd1, 0x95, 0x26, 0x75, 0x66, 0x33, 0x1f, 0xd3,
+	0xba, 0x8c, 0xc3, 0x80, 0xcc, 0xf9, 0xca, 0x38, 0x9c, 0xb6, 0x28, 0x8e, 0x50, 0x57, 0x2a, 0x21,
+	0x26, 0x40, 0x9e, 0x1a, 0xd1, 0xb3, 0x47, 0x3f, 0x49, 0x4c, 0xa8, 0xc3, 0x6a, 0x41, 0xa6, 0x12,
+	0xae, 0x81, 0x74, 0x7d, 0x82, 0xc3, 0x3a, 0x4f, 0x1d, 0x56, 0x1f, 0x2c, 0x65, 0x52, 0x40, 0xa1,
+	0x81, 0xcc, 0xfb, 0x0e, 0xf6, 0xde, 0x12, 0xb4, 0x45, 0xf1, 0x2e, 0x9a, 0x57, 0xec, 0x5b, 0xa2,
+	0x52, 0x20, 0x0b, 0x46, 0x45, 0x1e, 0xaa, 0xa8, 0x01, 0x68, 0x03, 0xe2, 0x57, 0xa8, 0x2b, 0x13,
+	0xd3, 0x9f, 0xc5, 0xcd, 0xce, 0xe3, 0x92, 0x13, 0x93, 0xa7, 0x8e, 0xc3, 0xfb, 0x68, 0x58, 0x94,
+	0x52, 0x28, 0xcd, 0xd2, 0xb8, 0x29, 0x87, 0xfe, 0x53, 0x6e, 0xb9, 0x51, 0x50, 0x57, 0xf6, 0x08,
+	0x2d, 0xbb, 0x6e, 0xc7, 0x25, 0x2b, 0x47, 0x4c, 0x01, 0x59, 0x32, 0x1e, 0x2f, 0xbd, 0xf3, 0x71,
+	0x6c, 0x38, 0x3a, 0x90, 0x77, 0x43, 0xfb, 0xaf, 0x0a, 0x90, 0x95, 0x66, 0x40, 0x7a, 0xde, 0x7f,
+	0x65, 0x09, 0xda, 0xa2, 0x78, 0x0f, 0xf5, 0xdd, 0x3a, 0x9e, 0x8a, 0x5a, 0xdb, 0x37, 0xda, 0x0d,
+	0xaf, 0xf6, 0x5c, 0x68, 0x46, 0x7b, 0xe9, 0x6d, 0x00, 0xf8, 0x0b, 0xc2, 0x79, 0x32, 0xbe, 0x60,
+	0x2a, 0x56, 0x4c, 0x56, 0x3a, 0xd1, 0x85, 0xe0, 0x40, 0x06, 0xc6, 0x68, 0xeb, 0xa1, 0xd1, 0x91,
+	0x61, 0x69, 0x8b, 0xd2, 0x95, 0xfc, 0xde, 0x0e, 0xe0, 0x77, 0xa8, 0x07, 0x52, 0x70, 0x10, 0x0a,
+	0xf2, 0x42, 0x02, 0x59, 0xf6, 0x9d, 0xea, 0xf4, 0x96, 0xa2, 0xff, 0x48, 0xf0, 0x07, 0x34, 0x30,
+	0x73, 0x17, 0x4f, 0x99, 0x4a, 0x8b, 0xb1, 0x06, 0x32, 0x34, 0x26, 0x81, 0x67, 0x4c, 0xcf, 0x2d,
+	0x46, 0xfb, 0xf2, 0x4e, 0x04, 0xf8, 0x2d, 0xea, 0x59, 0x9b, 0x71, 0x5e, 0xf1, 0x0b, 0x20, 0x2b,
+	0xc6, 0xe4, 0x85, 0xc7, 0x64, 0xbf, 0x86, 0xe8, 0x92, 0x6c, 0xd7, 0xa6, 0xc5, 0x76, 0x3c, 0xe2,
+	0x29, 0x03, 0x6d, 0xee, 0x32, 0xf6, 0xb5, 0xd8, 0x8e, 0xc5, 0xb9, 0xe5, 0xe8, 0x40, 0xdd, 0x0d,
+	0x01, 0x1f, 0xa0, 0x81, 0x90, 0x8c, 0x97, 0x89, 0xce, 0x63, 0xd0, 0x89, 0x06, 0xb2, 0xea, 0x33,
+	0xfa, 0x2c, 0x19, 0x3f, 0x4e, 0x74, 0x5e, 0x3f, 0x3f, 0x40, 0xfb, 0x8d, 0xcc, 0x84, 0xf8, 0x04,
+	0xe1, 0xe6, 0x59, 0xd0, 0x97, 0x92, 0x39, 0xaf, 0x35, 0x5f, 0xbf, 0xdc, 0xcd, 0x3f, 0xbb, 0x94,
+	0xcc, 0xda, 0x0d, 0xf5, 0xbd, 0x9d, 0xbd, 0x4f, 0x3f, 0xaf, 0x83, 0xce, 0xd5, 0x75, 0xd0, 0xf9,
+	0x7d, 0x1d, 0x74, 0x7e, 0xdc, 0x04, 0x33, 0x57, 0x37, 0xc1, 0xcc, 0xaf, 0x9b, 0x60, 0xe6, 0xeb,
+	0x4e, 0x56, 0xe8, 0xbc, 0x1a, 0x85, 0x63, 0x51, 0x46, 0xd6, 0x79, 0x22, 0x2a, 0x9e, 0x9a, 0x36,
+	0xbb, 0x8d, 0xe8, 0x7b, 0xf3, 0x8e, 0xd6, 0xa7, 0x81, 0x51, 0xd7, 0x3c, 0xa2, 0xaf, 0xff, 0x06,
+	0x00, 0x00, 0xff, 0xff, 0x72, 0x0b, 0x2d, 0x10, 0xad, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -198,6 +293,156 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.TheoremTypeStats) > 0 {
+		for iNdEx := len(m.TheoremTypeStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TheoremTypeStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.OpenmathStats) > 0 {
+		for iNdEx := len(m.OpenmathStats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OpenmathStats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.RewardVestings) > 0 {
+		for iNdEx := len(m.RewardVestings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardVestings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.ProofChunks) > 0 {
+		for iNdEx := len(m.ProofChunks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofChunks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.ProofVerdicts) > 0 {
+		for iNdEx := len(m.ProofVerdicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofVerdicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.HackerReputations) > 0 {
+		for iNdEx := len(m.HackerReputations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HackerReputations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.DisputeVotes) > 0 {
+		for iNdEx := len(m.DisputeVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DisputeVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.Disputes) > 0 {
+		for iNdEx := len(m.Disputes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Disputes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ProgramMembers) > 0 {
+		for iNdEx := len(m.ProgramMembers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProgramMembers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if len(m.ImportedRewards) > 0 {
 		for iNdEx := len(m.ImportedRewards) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -402,6 +647,66 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.ProgramMembers) > 0 {
+		for _, e := range m.ProgramMembers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Disputes) > 0 {
+		for _, e := range m.Disputes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DisputeVotes) > 0 {
+		for _, e := range m.DisputeVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.HackerReputations) > 0 {
+		for _, e := range m.HackerReputations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProofVerdicts) > 0 {
+		for _, e := range m.ProofVerdicts {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ProofChunks) > 0 {
+		for _, e := range m.ProofChunks {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardVestings) > 0 {
+		for _, e := range m.RewardVestings {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OpenmathStats) > 0 {
+		for _, e := range m.OpenmathStats {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.TheoremTypeStats) > 0 {
+		for _, e := range m.TheoremTypeStats {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -767,6 +1072,346 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramMembers = append(m.ProgramMembers, &ProgramMember{})
+			if err := m.ProgramMembers[len(m.ProgramMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Disputes = append(m.Disputes, &Dispute{})
+			if err := m.Disputes[len(m.Disputes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DisputeVotes = append(m.DisputeVotes, &DisputeVote{})
+			if err := m.DisputeVotes[len(m.DisputeVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HackerReputations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HackerReputations = append(m.HackerReputations, &HackerReputation{})
+			if err := m.HackerReputations[len(m.HackerReputations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, &Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofVerdicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofVerdicts = append(m.ProofVerdicts, &ProofVerdict{})
+			if err := m.ProofVerdicts[len(m.ProofVerdicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofChunks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofChunks = append(m.ProofChunks, &ProofChunk{})
+			if err := m.ProofChunks[len(m.ProofChunks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardVestings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardVestings = append(m.RewardVestings, &RewardVesting{})
+			if err := m.RewardVestings[len(m.RewardVestings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OpenmathStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OpenmathStats = append(m.OpenmathStats, &OpenMathStats{})
+			if err := m.OpenmathStats[len(m.OpenmathStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremTypeStats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TheoremTypeStats = append(m.TheoremTypeStats, &TheoremTypeStats{})
+			if err := m.TheoremTypeStats[len(m.TheoremTypeStats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -23,25 +23,65 @@ const (
 	FundTypeDeposit = "deposit"
 )
 
+// Proof chunk limits
+const (
+	// MaxProofChunkSize is the maximum size of an uploaded proof chunk
+	MaxProofChunkSize = 512 * 1024
+
+	// MaxProofChunks is the maximum number of chunks of a proof detail
+	MaxProofChunks = 256
+
+	// MaxProofDetailSize is the maximum size of a proof detail assembled from chunks, after decompression
+	MaxProofDetailSize = 32 * 1024 * 1024
+)
+
+// Reward vesting limits
+const (
+	// MaxRewardVestingSchedules is the maximum number of vesting schedules of an address
+	MaxRewardVestingSchedules = 32
+)
+
 var (
 	// Program related keys
-	ProgramKeyPrefix      = collections.NewPrefix(1)
-	FindingKeyPrefix      = collections.NewPrefix(2)
-	ProgramFindingListKey = collections.NewPrefix(10)
+	ProgramKeyPrefix         = collections.NewPrefix(1)
+	FindingKeyPrefix         = collections.NewPrefix(2)
+	ProgramStatusIndexKey    = collections.NewPrefix(3)
+	ProgramAdminIndexKey     = collections.NewPrefix(4)
+	FindingStatusIndexKey    = collections.NewPrefix(5)
+	FindingSeverityIndexKey  = collections.NewPrefix(6)
+	FindingTimeIndexKey      = collections.NewPrefix(7)
+	DisclosureQueueKey       = collections.NewPrefix(8)
+	OverdueDisclosureKey     = collections.NewPrefix(9)
+	ProgramFindingListKey    = collections.NewPrefix(10)
+	ProgramMemberKeyPrefix   = collections.NewPrefix(11)
+	FindingApprovalKeyPrefix = collections.NewPrefix(12)
+	DisputeKeyPrefix         = collections.NewPrefix(13)
+	DisputeVoteKeyPrefix     = collections.NewPrefix(14)
+	ActiveDisputeQueueKey    = collections.NewPrefix(15)
+	DuplicateFindingKey      = collections.NewPrefix(16)
+	FindingSLAQueueKey       = collections.NewPrefix(17)
+	FindingTargetKey         = collections.NewPrefix(18)
+	HackerKeyPrefix          = collections.NewPrefix(19)
+	SponsorshipKeyPrefix     = collections.NewPrefix(20)
 
 	// Theorem related keys
 	TheoremIDKey          = collections.NewPrefix(21)
 	TheoremKeyPrefix      = collections.NewPrefix(22)
 	ActiveTheoremQueueKey = collections.NewPrefix(23)
+	TheoremDependentKey   = collections.NewPrefix(24)
+	TheoremCodeHashKey    = collections.NewPrefix(25)
 
 	// Proof related keys
-	ProofKeyPrefix      = collections.NewPrefix(31)
-	ActiveProofQueueKey = collections.NewPrefix(32)
+	ProofKeyPrefix        = collections.NewPrefix(31)
+	ActiveProofQueueKey   = collections.NewPrefix(32)
+	ProofVerdictKeyPrefix = collections.NewPrefix(33)
+	ProofChunkKeyPrefix   = collections.NewPrefix(34)
 
 	// Grant and deposit related keys
 	GrantKeyPrefix          = collections.NewPrefix(41)
 	DepositKeyPrefix        = collections.NewPrefix(42)
 	RewardKeyPrefix         = collections.NewPrefix(43)
+	RewardVestingKeyPrefix  = collections.NewPrefix(44)
 	ImportedRewardKeyPrefix = collections.NewPrefix(45)
 
 	// Relationship keys
@@ -51,4 +91,9 @@ var (
 
 	// Parameter key
 	ParamsKey = collections.NewPrefix(61)
+
+	// Statistics keys
+	OpenMathStatsKeyPrefix    = collections.NewPrefix(71)
+	TheoremTypeStatsKeyPrefix = collections.NewPrefix(72)
+	LeaderboardKeyPrefix      = collections.NewPrefix(73)
 )
@@ -6,52 +6,95 @@ import (
 
 var (
 	_, _, _, _       sdk.Msg = &MsgCreateProgram{}, &MsgEditProgram{}, &MsgActivateProgram{}, &MsgCloseProgram{}
+	_, _, _          sdk.Msg = &MsgFundProgram{}, &MsgAddProgramMember{}, &MsgRemoveProgramMember{}
 	_, _, _, _, _, _ sdk.Msg = &MsgSubmitFinding{}, &MsgEditFinding{}, &MsgActivateFinding{}, &MsgConfirmFinding{}, &MsgCloseFinding{}, &MsgPublishFinding{}
-	_, _             sdk.Msg = &MsgCreateTheorem{}, &MsgGrant{}
+	_, _             sdk.Msg = &MsgDisputeFinding{}, &MsgVoteDispute{}
+	_                sdk.Msg = &MsgMarkDuplicateFinding{}
+	_, _, _, _       sdk.Msg = &MsgCreateTheorem{}, &MsgGrant{}, &MsgWithdrawGrant{}, &MsgCloseTheorem{}
+	_                sdk.Msg = &MsgGrantFromCommunityPool{}
 	_, _, _          sdk.Msg = &MsgSubmitProofHash{}, &MsgSubmitProofDetail{}, &MsgSubmitProofVerification{}
+	_, _             sdk.Msg = &MsgUploadProofChunk{}, &MsgSubmitProofDetailChunks{}
 	_                sdk.Msg = &MsgWithdrawReward{}
 )
 
 // NewMsgCreateProgram creates a new NewMsgCreateProgram instance.
 // Delegator address and validator address are the same.
-func NewMsgCreateProgram(pid, name, detail string, operator sdk.AccAddress) *MsgCreateProgram {
+func NewMsgCreateProgram(pid, name, detail string, operator sdk.AccAddress, rewardPool sdk.Coins, rewardSchedule []SeverityReward, criticalApprovals uint32, duplicatePolicy DuplicatePolicy) *MsgCreateProgram {
 	return &MsgCreateProgram{
-		ProgramId:       pid,
-		Name:            name,
-		Detail:          detail,
-		OperatorAddress: operator.String(),
+		ProgramId:         pid,
+		Name:              name,
+		Detail:            detail,
+		OperatorAddress:   operator.String(),
+		RewardPool:        rewardPool,
+		RewardSchedule:    rewardSchedule,
+		CriticalApprovals: criticalApprovals,
+		DuplicatePolicy:   duplicatePolicy,
 	}
 }
 
 // NewMsgEditProgram edit a program.
-func NewMsgEditProgram(pid, name, detail string, operator sdk.AccAddress) *MsgEditProgram {
+func NewMsgEditProgram(pid, name, detail string, operator sdk.AccAddress, rewardSchedule []SeverityReward, criticalApprovals uint32, duplicatePolicy DuplicatePolicy) *MsgEditProgram {
 	return &MsgEditProgram{
+		ProgramId:         pid,
+		Name:              name,
+		Detail:            detail,
+		OperatorAddress:   operator.String(),
+		RewardSchedule:    rewardSchedule,
+		CriticalApprovals: criticalApprovals,
+		DuplicatePolicy:   duplicatePolicy,
+	}
+}
+
+// NewMsgFundProgram deposits into the reward pool of a program.
+func NewMsgFundProgram(pid string, sponsor sdk.AccAddress, amount sdk.Coins) *MsgFundProgram {
+	return &MsgFundProgram{
+		ProgramId:      pid,
+		SponsorAddress: sponsor.String(),
+		Amount:         amount,
+	}
+}
+
+// NewMsgAddProgramMember adds a member to a program team.
+func NewMsgAddProgramMember(pid string, member sdk.AccAddress, role ProgramRole, operator sdk.AccAddress) *MsgAddProgramMember {
+	return &MsgAddProgramMember{
 		ProgramId:       pid,
-		Name:            name,
-		Detail:          detail,
+		MemberAddress:   member.String(),
+		Role:            role,
 		OperatorAddress: operator.String(),
 	}
 }
 
-// NewMsgSubmitFinding submit a new finding.
-func NewMsgSubmitFinding(pid, fid, hash string, operator sdk.AccAddress, level SeverityLevel) *MsgSubmitFinding {
-	return &MsgSubmitFinding{
+// NewMsgRemoveProgramMember removes a member from a program team.
+func NewMsgRemoveProgramMember(pid string, member, operator sdk.AccAddress) *MsgRemoveProgramMember {
+	return &MsgRemoveProgramMember{
 		ProgramId:       pid,
-		FindingId:       fid,
-		FindingHash:     hash,
+		MemberAddress:   member.String(),
 		OperatorAddress: operator.String(),
-		SeverityLevel:   level,
+	}
+}
+
+// NewMsgSubmitFinding submit a new finding.
+func NewMsgSubmitFinding(pid, fid, targetID, hash string, operator sdk.AccAddress, level SeverityLevel, encryptedPayload *EncryptedFindingPayload) *MsgSubmitFinding {
+	return &MsgSubmitFinding{
+		ProgramId:        pid,
+		FindingId:        fid,
+		TargetId:         targetID,
+		FindingHash:      hash,
+		OperatorAddress:  operator.String(),
+		SeverityLevel:    level,
+		EncryptedPayload: encryptedPayload,
 	}
 }
 
 // NewMsgEditFinding submit a new finding.
-func NewMsgEditFinding(fid, hash, paymentHash string, operator sdk.AccAddress, level SeverityLevel) *MsgEditFinding {
+func NewMsgEditFinding(fid, hash, paymentHash string, operator sdk.AccAddress, level SeverityLevel, encryptedPayload *EncryptedFindingPayload) *MsgEditFinding {
 	return &MsgEditFinding{
-		FindingId:       fid,
-		FindingHash:     hash,
-		OperatorAddress: operator.String(),
-		SeverityLevel:   level,
-		PaymentHash:     paymentHash,
+		FindingId:        fid,
+		FindingHash:      hash,
+		OperatorAddress:  operator.String(),
+		SeverityLevel:    level,
+		PaymentHash:      paymentHash,
+		EncryptedPayload: encryptedPayload,
 	}
 }
 
@@ -76,11 +119,12 @@ func NewMsgActivateFinding(findingID string, hostAddr sdk.AccAddress) *MsgActiva
 	}
 }
 
-func NewMsgConfirmFinding(findingID, fingerprint string, hostAddr sdk.AccAddress) *MsgConfirmFinding {
+func NewMsgConfirmFinding(findingID, fingerprint string, hostAddr sdk.AccAddress, reward sdk.Coins) *MsgConfirmFinding {
 	return &MsgConfirmFinding{
 		FindingId:       findingID,
 		OperatorAddress: hostAddr.String(),
 		Fingerprint:     fingerprint,
+		Reward:          reward,
 	}
 }
 
@@ -108,7 +152,34 @@ func NewMsgPublishFinding(fid, desc, poc string, operator sdk.AccAddress) *MsgPu
 	}
 }
 
-func NewMsgCreateTheorem(title, desc, code, proposer string, initialGrant sdk.Coins, requireOpenMathCert bool) *MsgCreateTheorem {
+// NewMsgMarkDuplicateFinding marks a finding as a duplicate of another finding.
+func NewMsgMarkDuplicateFinding(fid, duplicateOf string, operator sdk.AccAddress) *MsgMarkDuplicateFinding {
+	return &MsgMarkDuplicateFinding{
+		FindingId:       fid,
+		DuplicateOf:     duplicateOf,
+		OperatorAddress: operator.String(),
+	}
+}
+
+// NewMsgDisputeFinding disputes the closure of a finding.
+func NewMsgDisputeFinding(fid, reason string, operator sdk.AccAddress) *MsgDisputeFinding {
+	return &MsgDisputeFinding{
+		FindingId:       fid,
+		Reason:          reason,
+		OperatorAddress: operator.String(),
+	}
+}
+
+// NewMsgVoteDispute votes on a finding dispute.
+func NewMsgVoteDispute(fid string, option DisputeVoteOption, voter sdk.AccAddress) *MsgVoteDispute {
+	return &MsgVoteDispute{
+		FindingId: fid,
+		Option:    option,
+		Voter:     voter.String(),
+	}
+}
+
+func NewMsgCreateTheorem(title, desc, code, proposer string, initialGrant sdk.Coins, theoremType TheoremType, requireOpenMathCert bool) *MsgCreateTheorem {
 	return &MsgCreateTheorem{
 		Title:               title,
 		Description:         desc,
@@ -116,6 +187,7 @@ func NewMsgCreateTheorem(title, desc, code, proposer string, initialGrant sdk.Co
 		InitialGrant:        initialGrant,
 		Proposer:            proposer,
 		RequireOpenmathCert: requireOpenMathCert,
+		TheoremType:         theoremType,
 	}
 }
 
@@ -127,6 +199,28 @@ func NewMsgGrant(theoremID uint64, grantor string, amount sdk.Coins) *MsgGrant {
 	}
 }
 
+func NewMsgGrantFromCommunityPool(authority string, theoremID uint64, amount sdk.Coins) *MsgGrantFromCommunityPool {
+	return &MsgGrantFromCommunityPool{
+		Authority: authority,
+		TheoremId: theoremID,
+		Amount:    amount,
+	}
+}
+
+func NewMsgWithdrawGrant(theoremID uint64, grantor string) *MsgWithdrawGrant {
+	return &MsgWithdrawGrant{
+		TheoremId: theoremID,
+		Grantor:   grantor,
+	}
+}
+
+func NewMsgCloseTheorem(theoremID uint64, proposer string) *MsgCloseTheorem {
+	return &MsgCloseTheorem{
+		TheoremId: theoremID,
+		Proposer:  proposer,
+	}
+}
+
 func NewMsgSubmitProofHash(theoremID uint64, prover, hash string, amount sdk.Coins) *MsgSubmitProofHash {
 	return &MsgSubmitProofHash{
 		TheoremId: theoremID,
@@ -144,6 +238,23 @@ func NewMsgSubmitProofDetail(proofID, prover, detail string) *MsgSubmitProofDeta
 	}
 }
 
+func NewMsgUploadProofChunk(proofID, prover string, data []byte) *MsgUploadProofChunk {
+	return &MsgUploadProofChunk{
+		ProofId: proofID,
+		Prover:  prover,
+		Data:    data,
+	}
+}
+
+func NewMsgSubmitProofDetailChunks(proofID, prover string, chunkHashes []string, compressed bool) *MsgSubmitProofDetailChunks {
+	return &MsgSubmitProofDetailChunks{
+		ProofId:     proofID,
+		Prover:      prover,
+		ChunkHashes: chunkHashes,
+		Compressed:  compressed,
+	}
+}
+
 func NewMsgSubmitProofVerification(proofID string, status ProofStatus, checker string, complexity int64, imports []uint64, theoremType TheoremType) *MsgSubmitProofVerification {
 	return &MsgSubmitProofVerification{
 		ProofId:     proofID,
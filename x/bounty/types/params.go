@@ -12,19 +12,63 @@ import (
 const (
 	// DefaultStartingTheoremID is 1
 	DefaultStartingTheoremID uint64 = 1
+
+	// DefaultDisputeWindow is the default duration of the finding dispute vote: 7 days
+	DefaultDisputeWindow = 7 * 24 * time.Hour
+
+	// DefaultProofVerificationQuorum is the default number of matching checker verdicts deciding a proof
+	DefaultProofVerificationQuorum uint32 = 1
+
+	// DefaultTheoremMaxTotalPeriod is the default maximum duration of a theorem including extensions: 360 days
+	DefaultTheoremMaxTotalPeriod = 360 * 24 * time.Hour
+
+	// DefaultRewardVestingPeriod is the default duration of the vesting of rewards above the threshold: 180 days
+	DefaultRewardVestingPeriod = 180 * 24 * time.Hour
+
+	// DefaultProofVerificationPeriod is the default duration checkers have to decide a revealed proof: 7 days
+	DefaultProofVerificationPeriod = 7 * 24 * time.Hour
+)
+
+var (
+	// DefaultProofDepositSlashFraction is the default fraction of the deposit forfeited by a failed or expired proof
+	DefaultProofDepositSlashFraction = sdkmath.LegacyOneDec()
+	// DefaultForfeitedDepositCheckerShare is the default share of a forfeited deposit rewarded to the checkers
+	DefaultForfeitedDepositCheckerShare = sdkmath.LegacyNewDecWithPrec(2, 1)
+	// DefaultForfeitedDepositGrantShare is the default share of a forfeited deposit added to the theorem grant pool
+	DefaultForfeitedDepositGrantShare = sdkmath.LegacyNewDecWithPrec(5, 1)
+	// DefaultGrantWithdrawalPenalty is the default fraction of a grant kept when the grantor withdraws it
+	DefaultGrantWithdrawalPenalty = sdkmath.LegacyNewDecWithPrec(1, 1)
+	// DefaultTheoremExtensionMinGrant is the default minimum grant extending a theorem: 10000000uctk
+	DefaultTheoremExtensionMinGrant = sdk.NewCoins(sdk.NewCoin("uctk", sdkmath.NewInt(10000000)))
+	// DefaultRewardVestingThreshold is the default reward payout above which rewards vest: none, disabled
+	DefaultRewardVestingThreshold sdk.Coins
+	// DefaultProofChunkDeposit is the default deposit for every stored proof detail chunk: 100000uctk
+	DefaultProofChunkDeposit = sdk.NewCoins(sdk.NewCoin("uctk", sdkmath.NewInt(100000)))
 )
 
 // NewParams creates a new Params instance
-func NewParams(minGrant, minDeposit []sdk.Coin, theoremMaxProofPeriod, proofMaxLockPeriod time.Duration, complexityFee sdk.Coin, maxComplexity int64, complexityFeeRocq, complexityFeeLean sdk.Coin) Params {
+func NewParams(minGrant, minDeposit []sdk.Coin, theoremMaxProofPeriod, proofMaxLockPeriod time.Duration, complexityFee sdk.Coin, maxComplexity int64, complexityFeeRocq, complexityFeeLean sdk.Coin, disputeWindow time.Duration, proofVerificationQuorum uint32, proofDepositSlashFraction, forfeitedDepositCheckerShare, forfeitedDepositGrantShare, grantWithdrawalPenalty sdkmath.LegacyDec, theoremMaxTotalPeriod time.Duration, theoremExtensionMinGrant, rewardVestingThreshold []sdk.Coin, rewardVestingPeriod, proofVerificationPeriod time.Duration, proofChunkDeposit []sdk.Coin) Params {
 	return Params{
-		MinGrant:              minGrant,
-		MinDeposit:            minDeposit,
-		TheoremMaxProofPeriod: &theoremMaxProofPeriod,
-		ProofMaxLockPeriod:    &proofMaxLockPeriod,
-		ComplexityFee:         complexityFee,
-		MaxComplexity:         maxComplexity,
-		ComplexityFeeRocq:     complexityFeeRocq,
-		ComplexityFeeLean:     complexityFeeLean,
+		MinGrant:                     minGrant,
+		MinDeposit:                   minDeposit,
+		TheoremMaxProofPeriod:        &theoremMaxProofPeriod,
+		ProofMaxLockPeriod:           &proofMaxLockPeriod,
+		ComplexityFee:                complexityFee,
+		MaxComplexity:                maxComplexity,
+		ComplexityFeeRocq:            complexityFeeRocq,
+		ComplexityFeeLean:            complexityFeeLean,
+		DisputeWindow:                &disputeWindow,
+		ProofVerificationQuorum:      proofVerificationQuorum,
+		ProofDepositSlashFraction:    proofDepositSlashFraction,
+		ForfeitedDepositCheckerShare: forfeitedDepositCheckerShare,
+		ForfeitedDepositGrantShare:   forfeitedDepositGrantShare,
+		GrantWithdrawalPenalty:       grantWithdrawalPenalty,
+		TheoremMaxTotalPeriod:        &theoremMaxTotalPeriod,
+		TheoremExtensionMinGrant:     theoremExtensionMinGrant,
+		RewardVestingThreshold:       rewardVestingThreshold,
+		RewardVestingPeriod:          &rewardVestingPeriod,
+		ProofVerificationPeriod:      &proofVerificationPeriod,
+		ProofChunkDeposit:            proofChunkDeposit,
 	}
 }
 
@@ -46,7 +90,10 @@ func DefaultParams() Params {
 	complexityFeeRocq := sdk.NewCoin("uctk", sdkmath.NewInt(10000))
 	complexityFeeLean := sdk.NewCoin("uctk", sdkmath.NewInt(10000))
 
-	return NewParams(minGrant, minDeposit, theoremMaxProofPeriod, proofMaxLockPeriod, complexityFee, maxComplexity, complexityFeeRocq, complexityFeeLean)
+	return NewParams(minGrant, minDeposit, theoremMaxProofPeriod, proofMaxLockPeriod, complexityFee, maxComplexity, complexityFeeRocq, complexityFeeLean, DefaultDisputeWindow, DefaultProofVerificationQuorum,
+		DefaultProofDepositSlashFraction, DefaultForfeitedDepositCheckerShare, DefaultForfeitedDepositGrantShare,
+		DefaultGrantWithdrawalPenalty, DefaultTheoremMaxTotalPeriod, DefaultTheoremExtensionMinGrant,
+		DefaultRewardVestingThreshold, DefaultRewardVestingPeriod, DefaultProofVerificationPeriod, DefaultProofChunkDeposit)
 }
 
 // Validate performs validation on params
@@ -83,6 +130,58 @@ func (p Params) Validate() error {
 		return fmt.Errorf("complexity fee lean is invalid: %s", p.ComplexityFeeLean)
 	}
 
+	if p.DisputeWindow == nil || *p.DisputeWindow <= 0 {
+		return fmt.Errorf("dispute window must be positive")
+	}
+
+	if p.ProofVerificationQuorum == 0 {
+		return fmt.Errorf("proof verification quorum must be positive")
+	}
+
+	if err := validateFraction("proof deposit slash fraction", p.ProofDepositSlashFraction); err != nil {
+		return err
+	}
+
+	if err := validateFraction("forfeited deposit checker share", p.ForfeitedDepositCheckerShare); err != nil {
+		return err
+	}
+
+	if err := validateFraction("forfeited deposit grant share", p.ForfeitedDepositGrantShare); err != nil {
+		return err
+	}
+
+	if p.ForfeitedDepositCheckerShare.Add(p.ForfeitedDepositGrantShare).GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("forfeited deposit checker and grant shares cannot exceed 1")
+	}
+
+	if err := validateFraction("grant withdrawal penalty", p.GrantWithdrawalPenalty); err != nil {
+		return err
+	}
+
+	if p.TheoremMaxTotalPeriod == nil || *p.TheoremMaxTotalPeriod < *p.TheoremMaxProofPeriod {
+		return fmt.Errorf("theorem max total period cannot be shorter than the theorem max proof period")
+	}
+
+	if !sdk.Coins(p.TheoremExtensionMinGrant).IsValid() {
+		return fmt.Errorf("invalid theorem extension min grant: %s", sdk.Coins(p.TheoremExtensionMinGrant))
+	}
+
+	if !sdk.Coins(p.RewardVestingThreshold).IsValid() {
+		return fmt.Errorf("invalid reward vesting threshold: %s", sdk.Coins(p.RewardVestingThreshold))
+	}
+
+	if p.RewardVestingPeriod == nil || *p.RewardVestingPeriod <= 0 {
+		return fmt.Errorf("reward vesting period must be positive")
+	}
+
+	if p.ProofVerificationPeriod == nil || *p.ProofVerificationPeriod <= 0 {
+		return fmt.Errorf("proof verification period must be positive")
+	}
+
+	if !sdk.Coins(p.ProofChunkDeposit).IsValid() {
+		return fmt.Errorf("invalid proof chunk deposit: %s", sdk.Coins(p.ProofChunkDeposit))
+	}
+
 	return nil
 }
 
@@ -135,3 +234,13 @@ func validateMinDeposit(i interface{}) error {
 
 	return nil
 }
+
+func validateFraction(name string, v sdkmath.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("%s cannot be nil", name)
+	}
+	if v.IsNegative() || v.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("%s must be between 0 and 1, got %s", name, v)
+	}
+	return nil
+}
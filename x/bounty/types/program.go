@@ -0,0 +1,259 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewSeverityReward creates a new SeverityReward instance.
+func NewSeverityReward(level SeverityLevel, minAmount, maxAmount sdk.Coins) SeverityReward {
+	return SeverityReward{
+		SeverityLevel: level,
+		MinAmount:     minAmount,
+		MaxAmount:     maxAmount,
+	}
+}
+
+// ValidateRewardSchedule validates that every severity level of the schedule is specified once
+// and that each payout range is well formed.
+func ValidateRewardSchedule(schedule []SeverityReward) error {
+	seen := make(map[SeverityLevel]bool)
+	for _, r := range schedule {
+		if r.SeverityLevel == Unspecified || !ValidFindingSeverityLevel(r.SeverityLevel) {
+			return errorsmod.Wrapf(ErrFindingSeverityLevelInvalid, "reward schedule: %s", r.SeverityLevel)
+		}
+		if seen[r.SeverityLevel] {
+			return errorsmod.Wrapf(ErrFindingSeverityLevelInvalid, "duplicate reward schedule for %s", r.SeverityLevel)
+		}
+		seen[r.SeverityLevel] = true
+
+		minAmount, maxAmount := sdk.Coins(r.MinAmount), sdk.Coins(r.MaxAmount)
+		if err := minAmount.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrFindingRewardInvalid, "min amount for %s: %s", r.SeverityLevel, err)
+		}
+		if err := maxAmount.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrFindingRewardInvalid, "max amount for %s: %s", r.SeverityLevel, err)
+		}
+		if maxAmount.Empty() {
+			return errorsmod.Wrapf(ErrFindingRewardInvalid, "max amount for %s cannot be empty", r.SeverityLevel)
+		}
+		if !maxAmount.IsAllGTE(minAmount) {
+			return errorsmod.Wrapf(ErrFindingRewardInvalid, "max amount (%s) is less than min amount (%s) for %s", maxAmount, minAmount, r.SeverityLevel)
+		}
+	}
+	return nil
+}
+
+// GetSeverityReward returns the reward schedule entry of the program for the given severity level.
+func (p Program) GetSeverityReward(level SeverityLevel) (SeverityReward, bool) {
+	for _, r := range p.RewardSchedule {
+		if r.SeverityLevel == level {
+			return r, true
+		}
+	}
+	return SeverityReward{}, false
+}
+
+// ValidateAmount checks that the amount lies within the payout range.
+func (r SeverityReward) ValidateAmount(amount sdk.Coins) error {
+	minAmount, maxAmount := sdk.NewCoins(r.MinAmount...), sdk.NewCoins(r.MaxAmount...)
+	if !amount.IsAllGTE(minAmount) || !maxAmount.IsAllGTE(amount) {
+		return errorsmod.Wrapf(ErrFindingRewardInvalid, "%s reward must be between (%s) and (%s), got (%s)",
+			r.SeverityLevel, minAmount, maxAmount, amount)
+	}
+	return nil
+}
+
+// NewProgramMember creates a new ProgramMember instance.
+func NewProgramMember(pid string, member sdk.AccAddress, role ProgramRole) ProgramMember {
+	return ProgramMember{
+		ProgramId: pid,
+		Address:   member.String(),
+		Role:      role,
+	}
+}
+
+// ValidProgramRole returns true if the role can be assigned to a program member.
+func ValidProgramRole(role ProgramRole) bool {
+	return role == ProgramRoleTriager || role == ProgramRoleAdmin
+}
+
+// Finding SLA names
+const (
+	FindingSLAActivation   = "activation"
+	FindingSLAConfirmation = "confirmation"
+)
+
+// ValidateFindingSLA checks that a finding SLA is not negative. An unset or zero SLA means no limit.
+func ValidateFindingSLA(sla *time.Duration) error {
+	if sla != nil && *sla < 0 {
+		return errorsmod.Wrapf(ErrProgramSLAInvalid, "SLA must not be negative, got %s", sla)
+	}
+	return nil
+}
+
+// ValidateDisclosureEmbargo checks that a disclosure embargo is not negative. An unset or zero
+// embargo means findings are not embargoed.
+func ValidateDisclosureEmbargo(embargo *time.Duration) error {
+	if embargo != nil && *embargo < 0 {
+		return errorsmod.Wrapf(ErrProgramEmbargoInvalid, "embargo must not be negative, got %s", embargo)
+	}
+	return nil
+}
+
+// Embargo returns the disclosure embargo of the paid and closed findings of the program, and
+// false if they are not embargoed.
+func (p Program) Embargo() (time.Duration, bool) {
+	if p.DisclosureEmbargo == nil || *p.DisclosureEmbargo <= 0 {
+		return 0, false
+	}
+	return *p.DisclosureEmbargo, true
+}
+
+// FindingSLA returns the name and duration of the program SLA that applies to findings of the given
+// status, and false if the program team has no time limit for it.
+func (p Program) FindingSLA(status FindingStatus) (string, time.Duration, bool) {
+	switch {
+	case status == FindingStatusSubmitted && p.ActivationSla != nil && *p.ActivationSla > 0:
+		return FindingSLAActivation, *p.ActivationSla, true
+	case status == FindingStatusActive && p.ConfirmationSla != nil && *p.ConfirmationSla > 0:
+		return FindingSLAConfirmation, *p.ConfirmationSla, true
+	default:
+		return "", 0, false
+	}
+}
+
+// ValidateScope validates that every target of a program scope has a unique id and the location
+// fields required by its asset type.
+func ValidateScope(scope []ScopeTarget) error {
+	seen := make(map[string]bool)
+	for _, t := range scope {
+		if len(t.TargetId) == 0 {
+			return errorsmod.Wrap(ErrProgramScopeInvalid, "target id cannot be empty")
+		}
+		if seen[t.TargetId] {
+			return errorsmod.Wrapf(ErrProgramScopeInvalid, "duplicate target %s", t.TargetId)
+		}
+		seen[t.TargetId] = true
+
+		if len(t.Location) == 0 {
+			return errorsmod.Wrapf(ErrProgramScopeInvalid, "target %s location cannot be empty", t.TargetId)
+		}
+		switch t.AssetType {
+		case AssetTypeContract:
+			if len(t.ChainId) == 0 {
+				return errorsmod.Wrapf(ErrProgramScopeInvalid, "contract target %s requires a chain id", t.TargetId)
+			}
+		case AssetTypeRepository:
+			if len(t.Commit) == 0 {
+				return errorsmod.Wrapf(ErrProgramScopeInvalid, "repository target %s requires a commit", t.TargetId)
+			}
+		case AssetTypeDomain:
+		default:
+			return errorsmod.Wrapf(ErrProgramScopeInvalid, "target %s asset type %s", t.TargetId, t.AssetType)
+		}
+
+		if !ValidFindingSeverityLevel(t.MaxSeverity) {
+			return errorsmod.Wrapf(ErrFindingSeverityLevelInvalid, "target %s max severity %s", t.TargetId, t.MaxSeverity)
+		}
+	}
+	return nil
+}
+
+// ValidateFindingTarget checks that a finding of the given severity can be reported on the target
+// of the program. Findings of programs without a scope do not name a target.
+func (p Program) ValidateFindingTarget(targetID string, level SeverityLevel) error {
+	if len(p.Scope) == 0 {
+		if len(targetID) != 0 {
+			return errorsmod.Wrapf(ErrFindingTargetInvalid, "program %s has no scope", p.ProgramId)
+		}
+		return nil
+	}
+
+	t, ok := p.GetScopeTarget(targetID)
+	if !ok {
+		return errorsmod.Wrapf(ErrFindingTargetInvalid, "target %q is not listed in the scope of program %s", targetID, p.ProgramId)
+	}
+	if !t.InScope {
+		return errorsmod.Wrapf(ErrFindingTargetInvalid, "target %s is out of scope", targetID)
+	}
+	if !t.AllowsSeverity(level) {
+		return errorsmod.Wrapf(ErrFindingSeverityLevelInvalid, "target %s accepts findings up to %s, got %s", targetID, t.MaxSeverity, level)
+	}
+	return nil
+}
+
+// GetScopeTarget returns the target of the program scope with the given id.
+func (p Program) GetScopeTarget(targetID string) (ScopeTarget, bool) {
+	for _, t := range p.Scope {
+		if t.TargetId == targetID {
+			return t, true
+		}
+	}
+	return ScopeTarget{}, false
+}
+
+// AllowsSeverity returns true if findings of the severity level can be reported on the target.
+// Lower levels are more severe, Critical being the most severe.
+func (t ScopeTarget) AllowsSeverity(level SeverityLevel) bool {
+	return t.MaxSeverity == Unspecified || level == Unspecified || level >= t.MaxSeverity
+}
+
+// ValidDuplicatePolicy returns true if the policy can be set on a program.
+func ValidDuplicatePolicy(policy DuplicatePolicy) bool {
+	return policy == DuplicatePolicyFirstReporter || policy == DuplicatePolicyEqualSplit
+}
+
+// DuplicatePolicyFromString returns a DuplicatePolicy from a case-insensitive policy name, e.g. "equal-split".
+func DuplicatePolicyFromString(str string) (DuplicatePolicy, error) {
+	switch strings.ToLower(str) {
+	case "first-reporter":
+		return DuplicatePolicyFirstReporter, nil
+	case "equal-split":
+		return DuplicatePolicyEqualSplit, nil
+	}
+	option, ok := DuplicatePolicy_value[str]
+	if !ok || !ValidDuplicatePolicy(DuplicatePolicy(option)) {
+		return DuplicatePolicyUnspecified, fmt.Errorf("'%s' is not a valid DuplicatePolicy option", str)
+	}
+	return DuplicatePolicy(option), nil
+}
+
+// ProgramRoleFromString returns a ProgramRole from a case-insensitive role name, e.g. "triager".
+func ProgramRoleFromString(str string) (ProgramRole, error) {
+	switch strings.ToLower(str) {
+	case "triager":
+		return ProgramRoleTriager, nil
+	case "admin":
+		return ProgramRoleAdmin, nil
+	}
+	option, ok := ProgramRole_value[str]
+	if !ok || !ValidProgramRole(ProgramRole(option)) {
+		return ProgramRoleUnspecified, fmt.Errorf("'%s' is not a valid ProgramRole option", str)
+	}
+	return ProgramRole(option), nil
+}
+
+// HasRole returns true if a member with this role is granted the permissions of the required role.
+// Admins hold every triager permission.
+func (r ProgramRole) HasRole(required ProgramRole) bool {
+	return ValidProgramRole(r) && r >= required
+}
+
+// ProgramStatusFromString returns a ProgramStatus from a case-insensitive status name, e.g. "active".
+func ProgramStatusFromString(str string) (ProgramStatus, error) {
+	name := strings.ToUpper(str)
+	if !strings.HasPrefix(name, "PROGRAM_STATUS_") {
+		name = "PROGRAM_STATUS_" + name
+	}
+	option, ok := ProgramStatus_value[name]
+	if !ok {
+		return ProgramStatusInactive, fmt.Errorf("'%s' is not a valid ProgramStatus option", str)
+	}
+	return ProgramStatus(option), nil
+}
@@ -0,0 +1,80 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ProofChunkHash returns the content hash of a proof chunk, the hex encoded sha256 hash of its data.
+func ProofChunkHash(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+// DecodeProofChunk returns the content of a proof chunk, decompressing it if the proof detail
+// is compressed. The decompressed size is bounded by MaxProofDetailSize.
+func DecodeProofChunk(data []byte, compressed bool) ([]byte, error) {
+	if !compressed {
+		return data, nil
+	}
+
+	decoder, err := zstd.NewReader(nil,
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(MaxProofDetailSize),
+		zstd.WithDecoderLowmem(true),
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer decoder.Close()
+
+	decoded, err := decoder.DecodeAll(data, nil)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrProofChunkInvalid, "failed to decompress chunk: %s", err)
+	}
+	return decoded, nil
+}
+
+// DecompressProofChunk streams the decompressed content of a compressed proof chunk to w block by
+// block, so that w can meter and bound the decompression before the whole chunk is decoded. An
+// error returned by w stops the decompression and is returned as is.
+func DecompressProofChunk(w io.Writer, data []byte) error {
+	decoder, err := zstd.NewReader(bytes.NewReader(data),
+		zstd.WithDecoderConcurrency(1),
+		zstd.WithDecoderMaxMemory(MaxProofDetailSize),
+		zstd.WithDecoderLowmem(true),
+	)
+	if err != nil {
+		return errorsmod.Wrapf(ErrProofChunkInvalid, "failed to decompress chunk: %s", err)
+	}
+	defer decoder.Close()
+
+	sw := &sinkWriter{w: w}
+	if _, err = decoder.WriteTo(sw); err != nil {
+		if sw.err != nil {
+			return sw.err
+		}
+		return errorsmod.Wrapf(ErrProofChunkInvalid, "failed to decompress chunk: %s", err)
+	}
+	return nil
+}
+
+// sinkWriter records the error of the writer it wraps, to tell it apart from decoding errors.
+type sinkWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (s *sinkWriter) Write(p []byte) (int, error) {
+	n, err := s.w.Write(p)
+	if err != nil {
+		s.err = err
+	}
+	return n, err
+}
@@ -5,15 +5,18 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
-	types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -21,12 +24,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -193,8 +198,13 @@ var xxx_messageInfo_QueryHostResponse proto.InternalMessageInfo
 
 // QueryProgramsRequest is the request type for the Query/Programs RPC method.
 type QueryProgramsRequest struct {
-	// pagination defines an optional pagination for the request.
+	// pagination defines an optional pagination for the request, set pagination.reverse
+	// to list the programs in descending order.
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// status returns the programs with the given status when set, e.g. "active".
+	Status string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	// admin_address returns the programs administered by the given address when set.
+	AdminAddress string `protobuf:"bytes,3,opt,name=admin_address,json=adminAddress,proto3" json:"admin_address,omitempty"`
 }
 
 func (m *QueryProgramsRequest) Reset()         { *m = QueryProgramsRequest{} }
@@ -237,6 +247,20 @@ func (m *QueryProgramsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryProgramsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryProgramsRequest) GetAdminAddress() string {
+	if m != nil {
+		return m.AdminAddress
+	}
+	return ""
+}
+
 // QueryProgramsResponse is the response type for the Query/Programs RPC method.
 type QueryProgramsResponse struct {
 	Programs []*Program `protobuf:"bytes,1,rep,name=programs,proto3" json:"programs,omitempty"`
@@ -382,21 +406,252 @@ func (m *QueryProgramResponse) GetProgram() *Program {
 	return nil
 }
 
+// QueryProgramMembersRequest is the request type for the Query/ProgramMembers RPC method.
+type QueryProgramMembersRequest struct {
+	// program_id defines the unique id of the bounty program.
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProgramMembersRequest) Reset()         { *m = QueryProgramMembersRequest{} }
+func (m *QueryProgramMembersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProgramMembersRequest) ProtoMessage()    {}
+func (*QueryProgramMembersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{8}
+}
+func (m *QueryProgramMembersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProgramMembersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProgramMembersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProgramMembersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProgramMembersRequest.Merge(m, src)
+}
+func (m *QueryProgramMembersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProgramMembersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProgramMembersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProgramMembersRequest proto.InternalMessageInfo
+
+func (m *QueryProgramMembersRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *QueryProgramMembersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryProgramMembersResponse is the response type for the Query/ProgramMembers RPC method.
+type QueryProgramMembersResponse struct {
+	Members []ProgramMember `protobuf:"bytes,1,rep,name=members,proto3" json:"members"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProgramMembersResponse) Reset()         { *m = QueryProgramMembersResponse{} }
+func (m *QueryProgramMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProgramMembersResponse) ProtoMessage()    {}
+func (*QueryProgramMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{9}
+}
+func (m *QueryProgramMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProgramMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProgramMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProgramMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProgramMembersResponse.Merge(m, src)
+}
+func (m *QueryProgramMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProgramMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProgramMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProgramMembersResponse proto.InternalMessageInfo
+
+func (m *QueryProgramMembersResponse) GetMembers() []ProgramMember {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *QueryProgramMembersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySponsorshipsRequest is the request type for the Query/Sponsorships RPC method.
+type QuerySponsorshipsRequest struct {
+	// program_id defines the unique id of the program.
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorshipsRequest) Reset()         { *m = QuerySponsorshipsRequest{} }
+func (m *QuerySponsorshipsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipsRequest) ProtoMessage()    {}
+func (*QuerySponsorshipsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{10}
+}
+func (m *QuerySponsorshipsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipsRequest.Merge(m, src)
+}
+func (m *QuerySponsorshipsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipsRequest proto.InternalMessageInfo
+
+func (m *QuerySponsorshipsRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
+}
+
+func (m *QuerySponsorshipsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySponsorshipsResponse is the response type for the Query/Sponsorships RPC method.
+type QuerySponsorshipsResponse struct {
+	Sponsorships []Sponsorship `protobuf:"bytes,1,rep,name=sponsorships,proto3" json:"sponsorships"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySponsorshipsResponse) Reset()         { *m = QuerySponsorshipsResponse{} }
+func (m *QuerySponsorshipsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySponsorshipsResponse) ProtoMessage()    {}
+func (*QuerySponsorshipsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{11}
+}
+func (m *QuerySponsorshipsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySponsorshipsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySponsorshipsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySponsorshipsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySponsorshipsResponse.Merge(m, src)
+}
+func (m *QuerySponsorshipsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySponsorshipsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySponsorshipsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySponsorshipsResponse proto.InternalMessageInfo
+
+func (m *QuerySponsorshipsResponse) GetSponsorships() []Sponsorship {
+	if m != nil {
+		return m.Sponsorships
+	}
+	return nil
+}
+
+func (m *QuerySponsorshipsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryFindingRequests is the request type for the Query/Findings RPC method.
 type QueryFindingsRequest struct {
 	// program_id defines the unique id of the program.
 	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 	// submitter_address defines the find address for the finding.
 	SubmitterAddress string `protobuf:"bytes,2,opt,name=submitter_address,json=submitterAddress,proto3" json:"submitter_address,omitempty"`
-	// pagination defines the pagination in the request.
+	// pagination defines the pagination in the request, set pagination.reverse to list
+	// the findings in descending order, e.g. newest first when filtering by create time.
 	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// duplicate_of returns the duplicates of the given finding when set.
+	DuplicateOf string `protobuf:"bytes,4,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
+	// target_id returns the findings reported on the given scope target of program_id when set.
+	TargetId string `protobuf:"bytes,5,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	// status returns the findings with the given status when set, e.g. "confirmed".
+	Status string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	// severity_level returns the findings with the given severity level when set, e.g. "critical".
+	SeverityLevel string `protobuf:"bytes,7,opt,name=severity_level,json=severityLevel,proto3" json:"severity_level,omitempty"`
+	// created_after returns the findings created at or after the given time when set.
+	CreatedAfter *time.Time `protobuf:"bytes,8,opt,name=created_after,json=createdAfter,proto3,stdtime" json:"created_after,omitempty"`
+	// created_before returns the findings created before the given time when set.
+	CreatedBefore *time.Time `protobuf:"bytes,9,opt,name=created_before,json=createdBefore,proto3,stdtime" json:"created_before,omitempty"`
 }
 
 func (m *QueryFindingsRequest) Reset()         { *m = QueryFindingsRequest{} }
 func (m *QueryFindingsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFindingsRequest) ProtoMessage()    {}
 func (*QueryFindingsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{8}
+	return fileDescriptor_31c92d65cbd97e4b, []int{12}
 }
 func (m *QueryFindingsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -446,6 +701,48 @@ func (m *QueryFindingsRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QueryFindingsRequest) GetDuplicateOf() string {
+	if m != nil {
+		return m.DuplicateOf
+	}
+	return ""
+}
+
+func (m *QueryFindingsRequest) GetTargetId() string {
+	if m != nil {
+		return m.TargetId
+	}
+	return ""
+}
+
+func (m *QueryFindingsRequest) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *QueryFindingsRequest) GetSeverityLevel() string {
+	if m != nil {
+		return m.SeverityLevel
+	}
+	return ""
+}
+
+func (m *QueryFindingsRequest) GetCreatedAfter() *time.Time {
+	if m != nil {
+		return m.CreatedAfter
+	}
+	return nil
+}
+
+func (m *QueryFindingsRequest) GetCreatedBefore() *time.Time {
+	if m != nil {
+		return m.CreatedBefore
+	}
+	return nil
+}
+
 // QueryFindingsResponse is the response type for the Query/Findings RPC method.
 type QueryFindingsResponse struct {
 	Findings []*Finding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings,omitempty"`
@@ -457,7 +754,7 @@ func (m *QueryFindingsResponse) Reset()         { *m = QueryFindingsResponse{} }
 func (m *QueryFindingsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFindingsResponse) ProtoMessage()    {}
 func (*QueryFindingsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{9}
+	return fileDescriptor_31c92d65cbd97e4b, []int{13}
 }
 func (m *QueryFindingsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -510,7 +807,7 @@ func (m *QueryFindingRequest) Reset()         { *m = QueryFindingRequest{} }
 func (m *QueryFindingRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryFindingRequest) ProtoMessage()    {}
 func (*QueryFindingRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{10}
+	return fileDescriptor_31c92d65cbd97e4b, []int{14}
 }
 func (m *QueryFindingRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -555,7 +852,7 @@ func (m *QueryFindingResponse) Reset()         { *m = QueryFindingResponse{} }
 func (m *QueryFindingResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryFindingResponse) ProtoMessage()    {}
 func (*QueryFindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{11}
+	return fileDescriptor_31c92d65cbd97e4b, []int{15}
 }
 func (m *QueryFindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -591,24 +888,24 @@ func (m *QueryFindingResponse) GetFinding() *Finding {
 	return nil
 }
 
-// QueryFindingFingerPrint is the request type for the Query/Finding RPC method.
-type QueryFindingFingerprintRequest struct {
-	// finding_id defines the unique id of the finding.
+// QueryDisputeRequest is the request type for the Query/Dispute RPC method.
+type QueryDisputeRequest struct {
+	// finding_id defines the unique id of the disputed finding.
 	FindingId string `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty"`
 }
 
-func (m *QueryFindingFingerprintRequest) Reset()         { *m = QueryFindingFingerprintRequest{} }
-func (m *QueryFindingFingerprintRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryFindingFingerprintRequest) ProtoMessage()    {}
-func (*QueryFindingFingerprintRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{12}
+func (m *QueryDisputeRequest) Reset()         { *m = QueryDisputeRequest{} }
+func (m *QueryDisputeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisputeRequest) ProtoMessage()    {}
+func (*QueryDisputeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{16}
 }
-func (m *QueryFindingFingerprintRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDisputeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFindingFingerprintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDisputeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFindingFingerprintRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDisputeRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -618,42 +915,43 @@ func (m *QueryFindingFingerprintRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryFindingFingerprintRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFindingFingerprintRequest.Merge(m, src)
+func (m *QueryDisputeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisputeRequest.Merge(m, src)
 }
-func (m *QueryFindingFingerprintRequest) XXX_Size() int {
+func (m *QueryDisputeRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFindingFingerprintRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFindingFingerprintRequest.DiscardUnknown(m)
+func (m *QueryDisputeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisputeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFindingFingerprintRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDisputeRequest proto.InternalMessageInfo
 
-func (m *QueryFindingFingerprintRequest) GetFindingId() string {
+func (m *QueryDisputeRequest) GetFindingId() string {
 	if m != nil {
 		return m.FindingId
 	}
 	return ""
 }
 
-// QueryFindingFingerPrintResponse is the response type for the Query/Finding RPC method.
-type QueryFindingFingerprintResponse struct {
-	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+// QueryDisputeResponse is the response type for the Query/Dispute RPC method.
+type QueryDisputeResponse struct {
+	Dispute *Dispute      `protobuf:"bytes,1,opt,name=dispute,proto3" json:"dispute,omitempty"`
+	Votes   []DisputeVote `protobuf:"bytes,2,rep,name=votes,proto3" json:"votes"`
 }
 
-func (m *QueryFindingFingerprintResponse) Reset()         { *m = QueryFindingFingerprintResponse{} }
-func (m *QueryFindingFingerprintResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryFindingFingerprintResponse) ProtoMessage()    {}
-func (*QueryFindingFingerprintResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{13}
+func (m *QueryDisputeResponse) Reset()         { *m = QueryDisputeResponse{} }
+func (m *QueryDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisputeResponse) ProtoMessage()    {}
+func (*QueryDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{17}
 }
-func (m *QueryFindingFingerprintResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDisputeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryFindingFingerprintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryFindingFingerprintResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDisputeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -663,43 +961,50 @@ func (m *QueryFindingFingerprintResponse) XXX_Marshal(b []byte, deterministic bo
 		return b[:n], nil
 	}
 }
-func (m *QueryFindingFingerprintResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryFindingFingerprintResponse.Merge(m, src)
+func (m *QueryDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisputeResponse.Merge(m, src)
 }
-func (m *QueryFindingFingerprintResponse) XXX_Size() int {
+func (m *QueryDisputeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryFindingFingerprintResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryFindingFingerprintResponse.DiscardUnknown(m)
+func (m *QueryDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisputeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryFindingFingerprintResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDisputeResponse proto.InternalMessageInfo
 
-func (m *QueryFindingFingerprintResponse) GetFingerprint() string {
+func (m *QueryDisputeResponse) GetDispute() *Dispute {
 	if m != nil {
-		return m.Fingerprint
+		return m.Dispute
 	}
-	return ""
+	return nil
 }
 
-// QueryProgramFingerprintRequest is the request type for the Query/Finding RPC method.
-type QueryProgramFingerprintRequest struct {
-	// program_id defines the unique id of the finding.
-	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+func (m *QueryDisputeResponse) GetVotes() []DisputeVote {
+	if m != nil {
+		return m.Votes
+	}
+	return nil
 }
 
-func (m *QueryProgramFingerprintRequest) Reset()         { *m = QueryProgramFingerprintRequest{} }
-func (m *QueryProgramFingerprintRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProgramFingerprintRequest) ProtoMessage()    {}
-func (*QueryProgramFingerprintRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{14}
+// QueryHackersRequest is the request type for the Query/Hackers RPC method.
+type QueryHackersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryProgramFingerprintRequest) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryHackersRequest) Reset()         { *m = QueryHackersRequest{} }
+func (m *QueryHackersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHackersRequest) ProtoMessage()    {}
+func (*QueryHackersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{18}
+}
+func (m *QueryHackersRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProgramFingerprintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHackersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProgramFingerprintRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHackersRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -709,135 +1014,44 @@ func (m *QueryProgramFingerprintRequest) XXX_Marshal(b []byte, deterministic boo
 		return b[:n], nil
 	}
 }
-func (m *QueryProgramFingerprintRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProgramFingerprintRequest.Merge(m, src)
-}
-func (m *QueryProgramFingerprintRequest) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryProgramFingerprintRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProgramFingerprintRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryProgramFingerprintRequest proto.InternalMessageInfo
-
-func (m *QueryProgramFingerprintRequest) GetProgramId() string {
-	if m != nil {
-		return m.ProgramId
-	}
-	return ""
-}
-
-// QueryProgramFingerprintResponse is the response type for the Query/Finding RPC method.
-type QueryProgramFingerprintResponse struct {
-	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
-}
-
-func (m *QueryProgramFingerprintResponse) Reset()         { *m = QueryProgramFingerprintResponse{} }
-func (m *QueryProgramFingerprintResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProgramFingerprintResponse) ProtoMessage()    {}
-func (*QueryProgramFingerprintResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{15}
-}
-func (m *QueryProgramFingerprintResponse) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryProgramFingerprintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryProgramFingerprintResponse.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryProgramFingerprintResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProgramFingerprintResponse.Merge(m, src)
-}
-func (m *QueryProgramFingerprintResponse) XXX_Size() int {
-	return m.Size()
-}
-func (m *QueryProgramFingerprintResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProgramFingerprintResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_QueryProgramFingerprintResponse proto.InternalMessageInfo
-
-func (m *QueryProgramFingerprintResponse) GetFingerprint() string {
-	if m != nil {
-		return m.Fingerprint
-	}
-	return ""
-}
-
-// QueryTheoremsRequest is the request type for the Query/Theorems RPC method.
-type QueryTheoremsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
-}
-
-func (m *QueryTheoremsRequest) Reset()         { *m = QueryTheoremsRequest{} }
-func (m *QueryTheoremsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTheoremsRequest) ProtoMessage()    {}
-func (*QueryTheoremsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{16}
-}
-func (m *QueryTheoremsRequest) XXX_Unmarshal(b []byte) error {
-	return m.Unmarshal(b)
-}
-func (m *QueryTheoremsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	if deterministic {
-		return xxx_messageInfo_QueryTheoremsRequest.Marshal(b, m, deterministic)
-	} else {
-		b = b[:cap(b)]
-		n, err := m.MarshalToSizedBuffer(b)
-		if err != nil {
-			return nil, err
-		}
-		return b[:n], nil
-	}
-}
-func (m *QueryTheoremsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTheoremsRequest.Merge(m, src)
+func (m *QueryHackersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHackersRequest.Merge(m, src)
 }
-func (m *QueryTheoremsRequest) XXX_Size() int {
+func (m *QueryHackersRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTheoremsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTheoremsRequest.DiscardUnknown(m)
+func (m *QueryHackersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHackersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTheoremsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryHackersRequest proto.InternalMessageInfo
 
-func (m *QueryTheoremsRequest) GetPagination() *query.PageRequest {
+func (m *QueryHackersRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryTheoremsResponse is the response type for the Query/Theorems RPC method.
-type QueryTheoremsResponse struct {
-	Theorems []*Theorem `protobuf:"bytes,1,rep,name=theorems,proto3" json:"theorems,omitempty"`
+// QueryHackersResponse is the response type for the Query/Hackers RPC method.
+type QueryHackersResponse struct {
+	Hackers []HackerReputation `protobuf:"bytes,1,rep,name=hackers,proto3" json:"hackers"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTheoremsResponse) Reset()         { *m = QueryTheoremsResponse{} }
-func (m *QueryTheoremsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTheoremsResponse) ProtoMessage()    {}
-func (*QueryTheoremsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{17}
+func (m *QueryHackersResponse) Reset()         { *m = QueryHackersResponse{} }
+func (m *QueryHackersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHackersResponse) ProtoMessage()    {}
+func (*QueryHackersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{19}
 }
-func (m *QueryTheoremsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryHackersResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTheoremsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHackersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTheoremsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHackersResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -847,50 +1061,50 @@ func (m *QueryTheoremsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byt
 		return b[:n], nil
 	}
 }
-func (m *QueryTheoremsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTheoremsResponse.Merge(m, src)
+func (m *QueryHackersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHackersResponse.Merge(m, src)
 }
-func (m *QueryTheoremsResponse) XXX_Size() int {
+func (m *QueryHackersResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTheoremsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTheoremsResponse.DiscardUnknown(m)
+func (m *QueryHackersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHackersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTheoremsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryHackersResponse proto.InternalMessageInfo
 
-func (m *QueryTheoremsResponse) GetTheorems() []*Theorem {
+func (m *QueryHackersResponse) GetHackers() []HackerReputation {
 	if m != nil {
-		return m.Theorems
+		return m.Hackers
 	}
 	return nil
 }
 
-func (m *QueryTheoremsResponse) GetPagination() *query.PageResponse {
+func (m *QueryHackersResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryTheoremRequest is the request type for the Query/Theorem RPC method.
-type QueryTheoremRequest struct {
-	// theorem_id defines the unique id of the theorem.
-	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
+// QueryHackerRequest is the request type for the Query/Hacker RPC method.
+type QueryHackerRequest struct {
+	// address defines the address of the finding submitter.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryTheoremRequest) Reset()         { *m = QueryTheoremRequest{} }
-func (m *QueryTheoremRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryTheoremRequest) ProtoMessage()    {}
-func (*QueryTheoremRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{18}
+func (m *QueryHackerRequest) Reset()         { *m = QueryHackerRequest{} }
+func (m *QueryHackerRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHackerRequest) ProtoMessage()    {}
+func (*QueryHackerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{20}
 }
-func (m *QueryTheoremRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryHackerRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTheoremRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHackerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTheoremRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHackerRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -900,42 +1114,44 @@ func (m *QueryTheoremRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryTheoremRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTheoremRequest.Merge(m, src)
+func (m *QueryHackerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHackerRequest.Merge(m, src)
 }
-func (m *QueryTheoremRequest) XXX_Size() int {
+func (m *QueryHackerRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTheoremRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTheoremRequest.DiscardUnknown(m)
+func (m *QueryHackerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHackerRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTheoremRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryHackerRequest proto.InternalMessageInfo
 
-func (m *QueryTheoremRequest) GetTheoremId() uint64 {
+func (m *QueryHackerRequest) GetAddress() string {
 	if m != nil {
-		return m.TheoremId
+		return m.Address
 	}
-	return 0
+	return ""
 }
 
-// QueryTheoremResponse is the response type for the Query/Theorem RPC method.
-type QueryTheoremResponse struct {
-	Theorem *Theorem `protobuf:"bytes,1,opt,name=theorem,proto3" json:"theorem,omitempty"`
+// QueryHackerResponse is the response type for the Query/Hacker RPC method.
+type QueryHackerResponse struct {
+	Reputation HackerReputation `protobuf:"bytes,1,opt,name=reputation,proto3" json:"reputation"`
+	// acceptance_rate is the share of the resolved findings of the hacker that were confirmed.
+	AcceptanceRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=acceptance_rate,json=acceptanceRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"acceptance_rate"`
 }
 
-func (m *QueryTheoremResponse) Reset()         { *m = QueryTheoremResponse{} }
-func (m *QueryTheoremResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryTheoremResponse) ProtoMessage()    {}
-func (*QueryTheoremResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{19}
+func (m *QueryHackerResponse) Reset()         { *m = QueryHackerResponse{} }
+func (m *QueryHackerResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHackerResponse) ProtoMessage()    {}
+func (*QueryHackerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{21}
 }
-func (m *QueryTheoremResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryHackerResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryTheoremResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryHackerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryTheoremResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryHackerResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -945,45 +1161,46 @@ func (m *QueryTheoremResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryTheoremResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryTheoremResponse.Merge(m, src)
+func (m *QueryHackerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHackerResponse.Merge(m, src)
 }
-func (m *QueryTheoremResponse) XXX_Size() int {
+func (m *QueryHackerResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryTheoremResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryTheoremResponse.DiscardUnknown(m)
+func (m *QueryHackerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHackerResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryTheoremResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryHackerResponse proto.InternalMessageInfo
 
-func (m *QueryTheoremResponse) GetTheorem() *Theorem {
+func (m *QueryHackerResponse) GetReputation() HackerReputation {
 	if m != nil {
-		return m.Theorem
+		return m.Reputation
 	}
-	return nil
+	return HackerReputation{}
 }
 
-// QueryProofsRequest is the request type for the Query/Proofs RPC method.
-type QueryProofsRequest struct {
-	// theorem_id defines the unique id of the theorem.
-	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
+// QueryDisclosuresRequest is the request type for the Query/Disclosures RPC method.
+type QueryDisclosuresRequest struct {
+	// overdue returns the findings whose embargo ended without being published instead of
+	// the findings still under embargo.
+	Overdue bool `protobuf:"varint,1,opt,name=overdue,proto3" json:"overdue,omitempty"`
 	// pagination defines an optional pagination for the request.
 	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProofsRequest) Reset()         { *m = QueryProofsRequest{} }
-func (m *QueryProofsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProofsRequest) ProtoMessage()    {}
-func (*QueryProofsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{20}
+func (m *QueryDisclosuresRequest) Reset()         { *m = QueryDisclosuresRequest{} }
+func (m *QueryDisclosuresRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDisclosuresRequest) ProtoMessage()    {}
+func (*QueryDisclosuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{22}
 }
-func (m *QueryProofsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryDisclosuresRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProofsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDisclosuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProofsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDisclosuresRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -993,51 +1210,51 @@ func (m *QueryProofsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryProofsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProofsRequest.Merge(m, src)
+func (m *QueryDisclosuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisclosuresRequest.Merge(m, src)
 }
-func (m *QueryProofsRequest) XXX_Size() int {
+func (m *QueryDisclosuresRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProofsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProofsRequest.DiscardUnknown(m)
+func (m *QueryDisclosuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisclosuresRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProofsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryDisclosuresRequest proto.InternalMessageInfo
 
-func (m *QueryProofsRequest) GetTheoremId() uint64 {
+func (m *QueryDisclosuresRequest) GetOverdue() bool {
 	if m != nil {
-		return m.TheoremId
+		return m.Overdue
 	}
-	return 0
+	return false
 }
 
-func (m *QueryProofsRequest) GetPagination() *query.PageRequest {
+func (m *QueryDisclosuresRequest) GetPagination() *query.PageRequest {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryProofsResponse is the response type for the Query/Proofs RPC method.
-type QueryProofsResponse struct {
-	Proofs []*Proof `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+// QueryDisclosuresResponse is the response type for the Query/Disclosures RPC method.
+type QueryDisclosuresResponse struct {
+	Findings []Finding `protobuf:"bytes,1,rep,name=findings,proto3" json:"findings"`
 	// pagination defines the pagination in the response.
 	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProofsResponse) Reset()         { *m = QueryProofsResponse{} }
-func (m *QueryProofsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProofsResponse) ProtoMessage()    {}
-func (*QueryProofsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{21}
+func (m *QueryDisclosuresResponse) Reset()         { *m = QueryDisclosuresResponse{} }
+func (m *QueryDisclosuresResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDisclosuresResponse) ProtoMessage()    {}
+func (*QueryDisclosuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{23}
 }
-func (m *QueryProofsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryDisclosuresResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProofsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryDisclosuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProofsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryDisclosuresResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1047,50 +1264,50 @@ func (m *QueryProofsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryProofsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProofsResponse.Merge(m, src)
+func (m *QueryDisclosuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDisclosuresResponse.Merge(m, src)
 }
-func (m *QueryProofsResponse) XXX_Size() int {
+func (m *QueryDisclosuresResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProofsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProofsResponse.DiscardUnknown(m)
+func (m *QueryDisclosuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDisclosuresResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProofsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryDisclosuresResponse proto.InternalMessageInfo
 
-func (m *QueryProofsResponse) GetProofs() []*Proof {
+func (m *QueryDisclosuresResponse) GetFindings() []Finding {
 	if m != nil {
-		return m.Proofs
+		return m.Findings
 	}
 	return nil
 }
 
-func (m *QueryProofsResponse) GetPagination() *query.PageResponse {
+func (m *QueryDisclosuresResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryProofRequest is the request type for the Query/Proof RPC method.
-type QueryProofRequest struct {
-	// proof_id defines the unique id of the proof.
-	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
+// QueryFindingFingerPrint is the request type for the Query/Finding RPC method.
+type QueryFindingFingerprintRequest struct {
+	// finding_id defines the unique id of the finding.
+	FindingId string `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty"`
 }
 
-func (m *QueryProofRequest) Reset()         { *m = QueryProofRequest{} }
-func (m *QueryProofRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryProofRequest) ProtoMessage()    {}
-func (*QueryProofRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{22}
+func (m *QueryFindingFingerprintRequest) Reset()         { *m = QueryFindingFingerprintRequest{} }
+func (m *QueryFindingFingerprintRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFindingFingerprintRequest) ProtoMessage()    {}
+func (*QueryFindingFingerprintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{24}
 }
-func (m *QueryProofRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryFindingFingerprintRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFindingFingerprintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProofRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFindingFingerprintRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1100,42 +1317,42 @@ func (m *QueryProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryProofRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProofRequest.Merge(m, src)
+func (m *QueryFindingFingerprintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFindingFingerprintRequest.Merge(m, src)
 }
-func (m *QueryProofRequest) XXX_Size() int {
+func (m *QueryFindingFingerprintRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProofRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProofRequest.DiscardUnknown(m)
+func (m *QueryFindingFingerprintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFindingFingerprintRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProofRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryFindingFingerprintRequest proto.InternalMessageInfo
 
-func (m *QueryProofRequest) GetProofId() string {
+func (m *QueryFindingFingerprintRequest) GetFindingId() string {
 	if m != nil {
-		return m.ProofId
+		return m.FindingId
 	}
 	return ""
 }
 
-// QueryProofResponse is the response type for the Query/Proof RPC method.
-type QueryProofResponse struct {
-	Proof *Proof `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+// QueryFindingFingerPrintResponse is the response type for the Query/Finding RPC method.
+type QueryFindingFingerprintResponse struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
 }
 
-func (m *QueryProofResponse) Reset()         { *m = QueryProofResponse{} }
-func (m *QueryProofResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryProofResponse) ProtoMessage()    {}
-func (*QueryProofResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{23}
+func (m *QueryFindingFingerprintResponse) Reset()         { *m = QueryFindingFingerprintResponse{} }
+func (m *QueryFindingFingerprintResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFindingFingerprintResponse) ProtoMessage()    {}
+func (*QueryFindingFingerprintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{25}
 }
-func (m *QueryProofResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryFindingFingerprintResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryFindingFingerprintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryProofResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryFindingFingerprintResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1145,43 +1362,43 @@ func (m *QueryProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryProofResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryProofResponse.Merge(m, src)
+func (m *QueryFindingFingerprintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFindingFingerprintResponse.Merge(m, src)
 }
-func (m *QueryProofResponse) XXX_Size() int {
+func (m *QueryFindingFingerprintResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryProofResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryProofResponse.DiscardUnknown(m)
+func (m *QueryFindingFingerprintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFindingFingerprintResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryProofResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryFindingFingerprintResponse proto.InternalMessageInfo
 
-func (m *QueryProofResponse) GetProof() *Proof {
+func (m *QueryFindingFingerprintResponse) GetFingerprint() string {
 	if m != nil {
-		return m.Proof
+		return m.Fingerprint
 	}
-	return nil
+	return ""
 }
 
-// QueryRewardsRequest is the request type for the Query/AllRewards RPC method.
-type QueryRewardsRequest struct {
-	// address defines the address to query for.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+// QueryProgramFingerprintRequest is the request type for the Query/Finding RPC method.
+type QueryProgramFingerprintRequest struct {
+	// program_id defines the unique id of the finding.
+	ProgramId string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
 }
 
-func (m *QueryRewardsRequest) Reset()         { *m = QueryRewardsRequest{} }
-func (m *QueryRewardsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRewardsRequest) ProtoMessage()    {}
-func (*QueryRewardsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{24}
+func (m *QueryProgramFingerprintRequest) Reset()         { *m = QueryProgramFingerprintRequest{} }
+func (m *QueryProgramFingerprintRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProgramFingerprintRequest) ProtoMessage()    {}
+func (*QueryProgramFingerprintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{26}
 }
-func (m *QueryRewardsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryProgramFingerprintRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProgramFingerprintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRewardsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProgramFingerprintRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1191,36 +1408,42 @@ func (m *QueryRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryRewardsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRewardsRequest.Merge(m, src)
+func (m *QueryProgramFingerprintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProgramFingerprintRequest.Merge(m, src)
 }
-func (m *QueryRewardsRequest) XXX_Size() int {
+func (m *QueryProgramFingerprintRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRewardsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRewardsRequest.DiscardUnknown(m)
+func (m *QueryProgramFingerprintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProgramFingerprintRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRewardsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryProgramFingerprintRequest proto.InternalMessageInfo
 
-// QueryRewardsResponse is the response type for the Query/AllRewards RPC method.
-type QueryRewardsResponse struct {
-	ProofRewards    github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=proof_rewards,json=proofRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"proof_rewards"`
-	ImportedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=imported_rewards,json=importedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"imported_rewards"`
+func (m *QueryProgramFingerprintRequest) GetProgramId() string {
+	if m != nil {
+		return m.ProgramId
+	}
+	return ""
 }
 
-func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
-func (m *QueryRewardsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryRewardsResponse) ProtoMessage()    {}
-func (*QueryRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{25}
+// QueryProgramFingerprintResponse is the response type for the Query/Finding RPC method.
+type QueryProgramFingerprintResponse struct {
+	Fingerprint string `protobuf:"bytes,1,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
 }
-func (m *QueryRewardsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *QueryProgramFingerprintResponse) Reset()         { *m = QueryProgramFingerprintResponse{} }
+func (m *QueryProgramFingerprintResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProgramFingerprintResponse) ProtoMessage()    {}
+func (*QueryProgramFingerprintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{27}
+}
+func (m *QueryProgramFingerprintResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryProgramFingerprintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryRewardsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryProgramFingerprintResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1230,48 +1453,45 @@ func (m *QueryRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *QueryRewardsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRewardsResponse.Merge(m, src)
+func (m *QueryProgramFingerprintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProgramFingerprintResponse.Merge(m, src)
 }
-func (m *QueryRewardsResponse) XXX_Size() int {
+func (m *QueryProgramFingerprintResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryRewardsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRewardsResponse.DiscardUnknown(m)
+func (m *QueryProgramFingerprintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProgramFingerprintResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRewardsResponse proto.InternalMessageInfo
-
-func (m *QueryRewardsResponse) GetProofRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
-	if m != nil {
-		return m.ProofRewards
-	}
-	return nil
-}
+var xxx_messageInfo_QueryProgramFingerprintResponse proto.InternalMessageInfo
 
-func (m *QueryRewardsResponse) GetImportedRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+func (m *QueryProgramFingerprintResponse) GetFingerprint() string {
 	if m != nil {
-		return m.ImportedRewards
+		return m.Fingerprint
 	}
-	return nil
+	return ""
 }
 
-// QueryParamsRequest defines the request type for querying x/bounty parameters.
-type QueryParamsRequest struct {
+// QueryTheoremsRequest is the request type for the Query/Theorems RPC method.
+type QueryTheoremsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// theorem_type filters the theorems by proof language, unspecified returns all theorems.
+	TheoremType TheoremType `protobuf:"varint,2,opt,name=theorem_type,json=theoremType,proto3,enum=shentu.bounty.v1.TheoremType" json:"theorem_type,omitempty"`
 }
 
-func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
-func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsRequest) ProtoMessage()    {}
-func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{26}
+func (m *QueryTheoremsRequest) Reset()         { *m = QueryTheoremsRequest{} }
+func (m *QueryTheoremsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremsRequest) ProtoMessage()    {}
+func (*QueryTheoremsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{28}
 }
-func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTheoremsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTheoremsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTheoremsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1281,35 +1501,51 @@ func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+func (m *QueryTheoremsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremsRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) XXX_Size() int {
+func (m *QueryTheoremsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
+func (m *QueryTheoremsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTheoremsRequest proto.InternalMessageInfo
 
-// QueryParamsResponse defines the response type for querying x/bounty parameters.
-type QueryParamsResponse struct {
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (m *QueryTheoremsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
-func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryParamsResponse) ProtoMessage()    {}
-func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{27}
+func (m *QueryTheoremsRequest) GetTheoremType() TheoremType {
+	if m != nil {
+		return m.TheoremType
+	}
+	return TheoremType_THEOREM_TYPE_UNSPECIFIED
 }
-func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryTheoremsResponse is the response type for the Query/Theorems RPC method.
+type QueryTheoremsResponse struct {
+	Theorems []*Theorem `protobuf:"bytes,1,rep,name=theorems,proto3" json:"theorems,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTheoremsResponse) Reset()         { *m = QueryTheoremsResponse{} }
+func (m *QueryTheoremsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremsResponse) ProtoMessage()    {}
+func (*QueryTheoremsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{29}
+}
+func (m *QueryTheoremsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTheoremsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTheoremsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1319,45 +1555,50 @@ func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+func (m *QueryTheoremsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremsResponse.Merge(m, src)
 }
-func (m *QueryParamsResponse) XXX_Size() int {
+func (m *QueryTheoremsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
+func (m *QueryTheoremsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTheoremsResponse proto.InternalMessageInfo
 
-func (m *QueryParamsResponse) GetParams() *Params {
+func (m *QueryTheoremsResponse) GetTheorems() []*Theorem {
 	if m != nil {
-		return m.Params
+		return m.Theorems
 	}
 	return nil
 }
 
-// QueryGrantsRequest is the request type for the Query/Grants RPC method.
-type QueryGrantsRequest struct {
+func (m *QueryTheoremsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTheoremRequest is the request type for the Query/Theorem RPC method.
+type QueryTheoremRequest struct {
 	// theorem_id defines the unique id of the theorem.
 	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGrantsRequest) Reset()         { *m = QueryGrantsRequest{} }
-func (m *QueryGrantsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryGrantsRequest) ProtoMessage()    {}
-func (*QueryGrantsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{28}
+func (m *QueryTheoremRequest) Reset()         { *m = QueryTheoremRequest{} }
+func (m *QueryTheoremRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremRequest) ProtoMessage()    {}
+func (*QueryTheoremRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{30}
 }
-func (m *QueryGrantsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryTheoremRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTheoremRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGrantsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTheoremRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1367,51 +1608,42 @@ func (m *QueryGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryGrantsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGrantsRequest.Merge(m, src)
+func (m *QueryTheoremRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremRequest.Merge(m, src)
 }
-func (m *QueryGrantsRequest) XXX_Size() int {
+func (m *QueryTheoremRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGrantsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGrantsRequest.DiscardUnknown(m)
+func (m *QueryTheoremRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGrantsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryTheoremRequest proto.InternalMessageInfo
 
-func (m *QueryGrantsRequest) GetTheoremId() uint64 {
+func (m *QueryTheoremRequest) GetTheoremId() uint64 {
 	if m != nil {
 		return m.TheoremId
 	}
 	return 0
 }
 
-func (m *QueryGrantsRequest) GetPagination() *query.PageRequest {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
-}
-
-// QueryGrantsResponse defines the response type for querying x/bounty grants.
-type QueryGrantsResponse struct {
-	Grants []*Grant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryTheoremResponse is the response type for the Query/Theorem RPC method.
+type QueryTheoremResponse struct {
+	Theorem *Theorem `protobuf:"bytes,1,opt,name=theorem,proto3" json:"theorem,omitempty"`
 }
 
-func (m *QueryGrantsResponse) Reset()         { *m = QueryGrantsResponse{} }
-func (m *QueryGrantsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryGrantsResponse) ProtoMessage()    {}
-func (*QueryGrantsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_31c92d65cbd97e4b, []int{29}
+func (m *QueryTheoremResponse) Reset()         { *m = QueryTheoremResponse{} }
+func (m *QueryTheoremResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremResponse) ProtoMessage()    {}
+func (*QueryTheoremResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{31}
 }
-func (m *QueryGrantsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryTheoremResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryTheoremResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryGrantsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryTheoremResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1421,2190 +1653,9688 @@ func (m *QueryGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryGrantsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryGrantsResponse.Merge(m, src)
+func (m *QueryTheoremResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremResponse.Merge(m, src)
 }
-func (m *QueryGrantsResponse) XXX_Size() int {
+func (m *QueryTheoremResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryGrantsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryGrantsResponse.DiscardUnknown(m)
+func (m *QueryTheoremResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryGrantsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryTheoremResponse proto.InternalMessageInfo
 
-func (m *QueryGrantsResponse) GetGrants() []*Grant {
+func (m *QueryTheoremResponse) GetTheorem() *Theorem {
 	if m != nil {
-		return m.Grants
+		return m.Theorem
 	}
 	return nil
 }
 
-func (m *QueryGrantsResponse) GetPagination() *query.PageResponse {
-	if m != nil {
-		return m.Pagination
-	}
-	return nil
+// QueryTheoremByCodeHashRequest is the request type for the Query/TheoremByCodeHash RPC method.
+type QueryTheoremByCodeHashRequest struct {
+	// code_hash defines the hex encoded sha256 hash of the normalized theorem code.
+	CodeHash string `protobuf:"bytes,1,opt,name=code_hash,json=codeHash,proto3" json:"code_hash,omitempty"`
 }
 
-func init() {
-	proto.RegisterType((*QueryHostsRequest)(nil), "shentu.bounty.v1.QueryHostsRequest")
-	proto.RegisterType((*QueryHostsResponse)(nil), "shentu.bounty.v1.QueryHostsResponse")
-	proto.RegisterType((*QueryHostRequest)(nil), "shentu.bounty.v1.QueryHostRequest")
-	proto.RegisterType((*QueryHostResponse)(nil), "shentu.bounty.v1.QueryHostResponse")
-	proto.RegisterType((*QueryProgramsRequest)(nil), "shentu.bounty.v1.QueryProgramsRequest")
-	proto.RegisterType((*QueryProgramsResponse)(nil), "shentu.bounty.v1.QueryProgramsResponse")
-	proto.RegisterType((*QueryProgramRequest)(nil), "shentu.bounty.v1.QueryProgramRequest")
-	proto.RegisterType((*QueryProgramResponse)(nil), "shentu.bounty.v1.QueryProgramResponse")
-	proto.RegisterType((*QueryFindingsRequest)(nil), "shentu.bounty.v1.QueryFindingsRequest")
-	proto.RegisterType((*QueryFindingsResponse)(nil), "shentu.bounty.v1.QueryFindingsResponse")
-	proto.RegisterType((*QueryFindingRequest)(nil), "shentu.bounty.v1.QueryFindingRequest")
-	proto.RegisterType((*QueryFindingResponse)(nil), "shentu.bounty.v1.QueryFindingResponse")
-	proto.RegisterType((*QueryFindingFingerprintRequest)(nil), "shentu.bounty.v1.QueryFindingFingerprintRequest")
-	proto.RegisterType((*QueryFindingFingerprintResponse)(nil), "shentu.bounty.v1.QueryFindingFingerprintResponse")
-	proto.RegisterType((*QueryProgramFingerprintRequest)(nil), "shentu.bounty.v1.QueryProgramFingerprintRequest")
-	proto.RegisterType((*QueryProgramFingerprintResponse)(nil), "shentu.bounty.v1.QueryProgramFingerprintResponse")
-	proto.RegisterType((*QueryTheoremsRequest)(nil), "shentu.bounty.v1.QueryTheoremsRequest")
-	proto.RegisterType((*QueryTheoremsResponse)(nil), "shentu.bounty.v1.QueryTheoremsResponse")
-	proto.RegisterType((*QueryTheoremRequest)(nil), "shentu.bounty.v1.QueryTheoremRequest")
-	proto.RegisterType((*QueryTheoremResponse)(nil), "shentu.bounty.v1.QueryTheoremResponse")
-	proto.RegisterType((*QueryProofsRequest)(nil), "shentu.bounty.v1.QueryProofsRequest")
-	proto.RegisterType((*QueryProofsResponse)(nil), "shentu.bounty.v1.QueryProofsResponse")
-	proto.RegisterType((*QueryProofRequest)(nil), "shentu.bounty.v1.QueryProofRequest")
-	proto.RegisterType((*QueryProofResponse)(nil), "shentu.bounty.v1.QueryProofResponse")
-	proto.RegisterType((*QueryRewardsRequest)(nil), "shentu.bounty.v1.QueryRewardsRequest")
-	proto.RegisterType((*QueryRewardsResponse)(nil), "shentu.bounty.v1.QueryRewardsResponse")
-	proto.RegisterType((*QueryParamsRequest)(nil), "shentu.bounty.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "shentu.bounty.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGrantsRequest)(nil), "shentu.bounty.v1.QueryGrantsRequest")
-	proto.RegisterType((*QueryGrantsResponse)(nil), "shentu.bounty.v1.QueryGrantsResponse")
+func (m *QueryTheoremByCodeHashRequest) Reset()         { *m = QueryTheoremByCodeHashRequest{} }
+func (m *QueryTheoremByCodeHashRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremByCodeHashRequest) ProtoMessage()    {}
+func (*QueryTheoremByCodeHashRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{32}
 }
-
-func init() { proto.RegisterFile("shentu/bounty/v1/query.proto", fileDescriptor_31c92d65cbd97e4b) }
-
-var fileDescriptor_31c92d65cbd97e4b = []byte{
-	// 1240 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xa6, 0x24, 0xb1, 0x27, 0xfc, 0x48, 0xa6, 0x41, 0x38, 0x26, 0xb5, 0xa3, 0x6d, 0xe3,
-	0x94, 0x46, 0xd9, 0xad, 0x13, 0x2a, 0x21, 0x38, 0xa0, 0xa4, 0x28, 0x01, 0xf5, 0x12, 0xb6, 0x9c,
-	0x38, 0x10, 0xad, 0xb3, 0xeb, 0xcd, 0x8a, 0x78, 0x67, 0xbb, 0xb3, 0x4e, 0x89, 0xac, 0x28, 0x02,
-	0x71, 0x28, 0x27, 0x90, 0x38, 0xf4, 0xda, 0x0b, 0x12, 0xea, 0x89, 0x03, 0xe2, 0x6f, 0xe8, 0xb1,
-	0x82, 0x0b, 0x27, 0x40, 0x09, 0x12, 0xfc, 0x19, 0xc8, 0x33, 0x6f, 0x66, 0x77, 0x6d, 0xcf, 0xda,
-	0x40, 0xaa, 0x5e, 0xda, 0xfa, 0xcd, 0x7b, 0xef, 0xfb, 0xe6, 0xbd, 0xb7, 0xf3, 0x3e, 0x15, 0x2d,
-	0xd2, 0x03, 0x37, 0x88, 0x3b, 0x66, 0x93, 0x74, 0x82, 0xf8, 0xd8, 0x3c, 0x6a, 0x98, 0xf7, 0x3a,
-	0x6e, 0x74, 0x6c, 0x84, 0x11, 0x89, 0x09, 0x9e, 0xe5, 0xa7, 0x06, 0x3f, 0x35, 0x8e, 0x1a, 0x95,
-	0x79, 0x8f, 0x78, 0x84, 0x1d, 0x9a, 0xbd, 0x7f, 0x71, 0xbf, 0xca, 0xa2, 0x47, 0x88, 0x77, 0xe8,
-	0x9a, 0x76, 0xe8, 0x9b, 0x76, 0x10, 0x90, 0xd8, 0x8e, 0x7d, 0x12, 0x50, 0x38, 0x5d, 0xd8, 0x27,
-	0xb4, 0x4d, 0xe8, 0x1e, 0x0f, 0xe3, 0x3f, 0xe0, 0x68, 0xce, 0x6e, 0xfb, 0x01, 0x31, 0xd9, 0x9f,
-	0x60, 0xaa, 0x72, 0x07, 0xb3, 0x69, 0x53, 0xd7, 0x3c, 0x6a, 0x34, 0xdd, 0xd8, 0x6e, 0x98, 0xfb,
-	0xc4, 0x0f, 0xe0, 0xfc, 0x46, 0xfa, 0x9c, 0x91, 0x95, 0x5e, 0xa1, 0xed, 0xf9, 0x01, 0x83, 0x06,
-	0xdf, 0x2b, 0x03, 0xb7, 0x83, 0x9b, 0xb0, 0x63, 0xfd, 0x32, 0x9a, 0xfb, 0xb0, 0x97, 0xe0, 0x7d,
-	0x42, 0x63, 0x6a, 0xb9, 0xf7, 0x3a, 0x2e, 0x8d, 0xf5, 0x79, 0x84, 0xd3, 0x46, 0x1a, 0x92, 0x80,
-	0xba, 0xba, 0x89, 0x66, 0xa5, 0x15, 0x3c, 0xf1, 0xeb, 0xa8, 0x74, 0x40, 0x68, 0xbc, 0x67, 0x3b,
-	0x4e, 0x54, 0xd6, 0x96, 0xb4, 0xeb, 0x25, 0xab, 0xd8, 0x33, 0x6c, 0x3a, 0x4e, 0x94, 0xc9, 0x2d,
-	0xb3, 0x7c, 0x82, 0xe6, 0x99, 0x71, 0x37, 0x22, 0x5e, 0x64, 0xb7, 0x05, 0x26, 0xde, 0x46, 0x28,
-	0xe1, 0xce, 0x52, 0xcd, 0xac, 0xd7, 0x0d, 0xa8, 0x54, 0xef, 0xa2, 0x06, 0xef, 0x0a, 0x5c, 0xd4,
-	0xd8, 0xb5, 0x3d, 0x17, 0x62, 0xad, 0x54, 0xa4, 0xfe, 0x50, 0x43, 0xaf, 0xf6, 0x01, 0x70, 0x64,
-	0x7c, 0x0b, 0x15, 0x43, 0xb0, 0x95, 0xb5, 0xa5, 0x4b, 0xd7, 0x67, 0xd6, 0x17, 0x8c, 0xfe, 0xe6,
-	0x1a, 0x10, 0x65, 0x49, 0x57, 0xbc, 0x93, 0x21, 0x36, 0xc1, 0x88, 0xad, 0x8c, 0x24, 0xc6, 0x31,
-	0x33, 0xcc, 0xde, 0x44, 0x97, 0xd3, 0xc4, 0xc4, 0xc5, 0xaf, 0x20, 0x04, 0x58, 0x7b, 0xbe, 0x03,
-	0x35, 0x2c, 0x81, 0xe5, 0x03, 0x47, 0xbf, 0x93, 0xad, 0x97, 0xbc, 0xcd, 0x06, 0x9a, 0x06, 0x27,
-	0x28, 0x56, 0xce, 0x65, 0x84, 0xa7, 0xfe, 0x58, 0x83, 0x6c, 0xdb, 0x7e, 0xe0, 0xf8, 0x81, 0x47,
-	0xc7, 0x23, 0x81, 0x57, 0xd1, 0x1c, 0xed, 0x34, 0xdb, 0x7e, 0x1c, 0xbb, 0x11, 0xeb, 0xb5, 0x4b,
-	0x29, 0x2b, 0x45, 0xc9, 0x9a, 0x95, 0x07, 0x9b, 0xdc, 0xde, 0xd7, 0xc9, 0x4b, 0xff, 0xbf, 0x93,
-	0x09, 0xd9, 0xa4, 0x93, 0x2d, 0xb0, 0xa9, 0x3b, 0x09, 0x51, 0x96, 0x74, 0xbd, 0xf8, 0x4e, 0x0a,
-	0x88, 0xa4, 0x88, 0x80, 0x95, 0x2a, 0x22, 0x58, 0x52, 0x9d, 0x94, 0x51, 0x49, 0x27, 0xc1, 0x49,
-	0xdd, 0x49, 0x11, 0x23, 0x3c, 0xf5, 0x77, 0x51, 0x35, 0x9d, 0x6c, 0xdb, 0x0f, 0x3c, 0x37, 0x0a,
-	0x23, 0x3f, 0x88, 0xc7, 0x64, 0x73, 0x1b, 0xd5, 0x94, 0x09, 0x80, 0xd8, 0x12, 0x9a, 0x69, 0x25,
-	0x66, 0x48, 0x91, 0x36, 0x49, 0x16, 0x30, 0x68, 0xc3, 0x59, 0xe4, 0x4d, 0xb7, 0x60, 0x31, 0x2c,
-	0xc1, 0xd8, 0x2c, 0xc4, 0x93, 0xf2, 0xd1, 0x81, 0x4b, 0x22, 0xf7, 0x19, 0x3e, 0x29, 0x09, 0x40,
-	0x32, 0x88, 0x31, 0xd8, 0xd4, 0x83, 0x08, 0x51, 0x96, 0x74, 0xbd, 0xf8, 0x41, 0x14, 0x10, 0x49,
-	0xd1, 0x01, 0x4b, 0x14, 0xfd, 0x05, 0xab, 0x04, 0x96, 0xd4, 0x20, 0xca, 0xa8, 0x64, 0x10, 0xc1,
-	0x49, 0x3d, 0x88, 0x22, 0x46, 0x78, 0xea, 0x5d, 0xd8, 0x15, 0xbb, 0x11, 0x21, 0x2d, 0x3a, 0x1e,
-	0x83, 0xbe, 0xce, 0x4c, 0xfc, 0xe7, 0xce, 0x7c, 0xad, 0x25, 0x6f, 0x2a, 0x43, 0x87, 0x9b, 0x98,
-	0x68, 0x2a, 0x64, 0x16, 0xe8, 0xca, 0x6b, 0x43, 0xdf, 0x46, 0xd2, 0xb2, 0xc0, 0xed, 0xe2, 0x3a,
-	0x62, 0xc0, 0xce, 0xe3, 0xe9, 0xa1, 0x1a, 0x0b, 0x6c, 0xf3, 0x90, 0x56, 0xf2, 0x09, 0x4c, 0xb3,
-	0xdf, 0xec, 0x03, 0xc0, 0x69, 0x7f, 0xe0, 0xbf, 0x86, 0x26, 0x99, 0x03, 0xf4, 0x41, 0x49, 0x9f,
-	0x7b, 0xe9, 0x77, 0xa1, 0x0a, 0x96, 0x7b, 0xdf, 0x8e, 0x1c, 0xd9, 0x84, 0x75, 0x34, 0x2d, 0xde,
-	0x6a, 0x86, 0xba, 0x55, 0xfe, 0xf9, 0xc7, 0xb5, 0x79, 0xb8, 0x14, 0xbc, 0xd6, 0x77, 0xe3, 0x88,
-	0xbd, 0x2b, 0xe0, 0xf8, 0x76, 0xf1, 0xc1, 0xa3, 0x5a, 0xe1, 0xef, 0x47, 0xb5, 0x82, 0xfe, 0x70,
-	0x02, 0xc6, 0x44, 0x66, 0x05, 0x72, 0x5d, 0xf4, 0x12, 0xbf, 0x4d, 0xc4, 0x0f, 0xa0, 0xc6, 0x8b,
-	0x99, 0x72, 0x89, 0x42, 0xbd, 0xe7, 0xee, 0xdf, 0x26, 0x7e, 0xb0, 0xf5, 0xd6, 0x93, 0xdf, 0x6a,
-	0x85, 0xc7, 0xbf, 0xd7, 0x56, 0x3d, 0x3f, 0x3e, 0xe8, 0x34, 0x8d, 0x7d, 0xd2, 0x06, 0x19, 0x04,
-	0x7f, 0xad, 0x51, 0xe7, 0x53, 0x33, 0x3e, 0x0e, 0x5d, 0x2a, 0x62, 0xe8, 0xf7, 0x7f, 0xfd, 0x70,
-	0x43, 0xb3, 0x5e, 0x0c, 0x79, 0x69, 0x18, 0x16, 0xfe, 0x5c, 0x43, 0xb3, 0x7e, 0x3b, 0x24, 0x51,
-	0xec, 0x3a, 0x92, 0xc0, 0xc4, 0x33, 0x25, 0xf0, 0x8a, 0xc0, 0x03, 0x0e, 0x52, 0x1e, 0xed, 0xda,
-	0x29, 0x01, 0xa3, 0xef, 0x88, 0x51, 0xb4, 0x33, 0xaa, 0xe3, 0x26, 0x9a, 0x0a, 0x6d, 0xd0, 0x1c,
-	0xbd, 0x5e, 0x96, 0x87, 0xf4, 0x92, 0x47, 0x80, 0x9f, 0xfc, 0xa2, 0x76, 0x22, 0x3b, 0x88, 0x9f,
-	0xdb, 0x17, 0x25, 0xd0, 0x93, 0x2f, 0xca, 0x63, 0x16, 0xf5, 0x17, 0xc5, 0x22, 0x2c, 0x70, 0xbb,
-	0xb0, 0x2f, 0x6a, 0xfd, 0xbb, 0x97, 0xd1, 0x24, 0x63, 0x84, 0x4f, 0x51, 0x51, 0x88, 0x3a, 0x5c,
-	0x1f, 0xc4, 0x1f, 0x26, 0x2b, 0x2b, 0x2b, 0x23, 0xfd, 0x40, 0x97, 0xea, 0x5f, 0xfc, 0xf2, 0xe7,
-	0xb7, 0x13, 0x8b, 0xb8, 0x62, 0x0e, 0x08, 0x66, 0x29, 0x05, 0xbf, 0xd2, 0xd0, 0x34, 0x04, 0xe2,
-	0xe5, 0xfc, 0xc4, 0x02, 0xbf, 0x3e, 0xca, 0x4d, 0x88, 0x6b, 0x06, 0xff, 0x06, 0x5e, 0x51, 0xc3,
-	0x9b, 0xdd, 0x64, 0x93, 0x9e, 0xf4, 0x8a, 0x21, 0x74, 0x91, 0xb2, 0x18, 0x7d, 0x2a, 0x4f, 0x59,
-	0x8c, 0x7e, 0x81, 0x95, 0x57, 0x0c, 0xa9, 0xa6, 0x7a, 0xc5, 0x80, 0x40, 0x65, 0x31, 0xb2, 0x02,
-	0xa9, 0x52, 0x1f, 0xe5, 0x36, 0xba, 0x18, 0x02, 0xde, 0xec, 0x26, 0xe2, 0xe6, 0x04, 0xff, 0xa4,
-	0x21, 0x3c, 0x28, 0x64, 0xf0, 0xcd, 0x7c, 0xbc, 0x41, 0xb9, 0x52, 0x69, 0xfc, 0x8b, 0x08, 0x20,
-	0xfb, 0x0e, 0x23, 0x7b, 0x0b, 0x6f, 0x8c, 0x49, 0xd6, 0x4c, 0x49, 0x17, 0x46, 0x7c, 0x50, 0xfb,
-	0x28, 0x89, 0x2b, 0x75, 0x96, 0x92, 0xb8, 0x5a, 0x58, 0xe5, 0x11, 0x1f, 0x3a, 0x72, 0x19, 0xe2,
-	0xa7, 0xa8, 0x28, 0xd4, 0x90, 0x72, 0xfc, 0xfa, 0xf4, 0x98, 0x72, 0xfc, 0xfa, 0x65, 0x55, 0xde,
-	0xf8, 0x49, 0x0d, 0xd5, 0x1b, 0x3f, 0x08, 0x54, 0x8e, 0x5f, 0x56, 0x16, 0x55, 0xea, 0xa3, 0xdc,
-	0x46, 0x8f, 0x9f, 0x80, 0x37, 0xbb, 0xc9, 0x63, 0x7c, 0x82, 0xef, 0xa3, 0x29, 0x2e, 0x40, 0xf0,
-	0x35, 0x75, 0x1b, 0x12, 0x75, 0x54, 0x59, 0x1e, 0xe1, 0x05, 0x3c, 0x96, 0x18, 0x8f, 0x0a, 0x2e,
-	0x0f, 0x6d, 0x50, 0x0f, 0xee, 0x14, 0x4d, 0xb2, 0x18, 0x7c, 0x35, 0x2f, 0xa3, 0x80, 0xbd, 0x96,
-	0xef, 0x04, 0xa8, 0xab, 0x0c, 0x75, 0x19, 0x5f, 0x55, 0xa1, 0xb2, 0xa1, 0x60, 0x62, 0xe6, 0x04,
-	0x3f, 0xd0, 0x10, 0xda, 0x3c, 0x3c, 0x14, 0xdb, 0x59, 0x75, 0xb1, 0xac, 0x30, 0x51, 0x36, 0xa2,
-	0x4f, 0x69, 0xe4, 0x51, 0x81, 0xd5, 0x6f, 0x76, 0x41, 0xb8, 0xf0, 0x26, 0xb0, 0x05, 0xaa, 0x6e,
-	0x42, 0x7a, 0x5f, 0xab, 0x9b, 0x90, 0xd9, 0xdf, 0xb9, 0x4d, 0xe0, 0x70, 0x5f, 0x6a, 0x68, 0x8a,
-	0x6f, 0x4b, 0x25, 0x72, 0x66, 0x95, 0x2b, 0x91, 0xb3, 0x2b, 0x57, 0x5f, 0x63, 0xc8, 0x2b, 0x78,
-	0x79, 0x10, 0x99, 0xef, 0xd8, 0xcc, 0x10, 0x6e, 0xdd, 0x79, 0x72, 0x56, 0xd5, 0x9e, 0x9e, 0x55,
-	0xb5, 0x3f, 0xce, 0xaa, 0xda, 0x37, 0xe7, 0xd5, 0xc2, 0xd3, 0xf3, 0x6a, 0xe1, 0xd7, 0xf3, 0x6a,
-	0xe1, 0xe3, 0x46, 0x4a, 0xf2, 0xf0, 0x54, 0x2d, 0xd2, 0x09, 0x1c, 0xb6, 0x5e, 0x45, 0xee, 0xcf,
-	0x44, 0x76, 0xa6, 0x80, 0x9a, 0x53, 0xec, 0x7f, 0x87, 0x36, 0xfe, 0x09, 0x00, 0x00, 0xff, 0xff,
-	0x4a, 0xe4, 0x11, 0x6f, 0x1c, 0x13, 0x00, 0x00,
+func (m *QueryTheoremByCodeHashRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTheoremByCodeHashRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTheoremByCodeHashRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTheoremByCodeHashRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremByCodeHashRequest.Merge(m, src)
+}
+func (m *QueryTheoremByCodeHashRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTheoremByCodeHashRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremByCodeHashRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryTheoremByCodeHashRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Programs queries all programs based on given status.
-	Programs(ctx context.Context, in *QueryProgramsRequest, opts ...grpc.CallOption) (*QueryProgramsResponse, error)
-	// Program queries program details based on ProgramId.
-	Program(ctx context.Context, in *QueryProgramRequest, opts ...grpc.CallOption) (*QueryProgramResponse, error)
-	// Findings queries findings of a given program.
-	Findings(ctx context.Context, in *QueryFindingsRequest, opts ...grpc.CallOption) (*QueryFindingsResponse, error)
-	// Finding queries Finding information based on programID, FindingId.
-	Finding(ctx context.Context, in *QueryFindingRequest, opts ...grpc.CallOption) (*QueryFindingResponse, error)
-	// FindingFingerprint queries finding fingerprint based on findingId.
-	FindingFingerprint(ctx context.Context, in *QueryFindingFingerprintRequest, opts ...grpc.CallOption) (*QueryFindingFingerprintResponse, error)
-	// ProgramFingerprint queries program fingerprint based on programId.
-	ProgramFingerprint(ctx context.Context, in *QueryProgramFingerprintRequest, opts ...grpc.CallOption) (*QueryProgramFingerprintResponse, error)
-	// Theorems queries all theorems based on given status.
-	Theorems(ctx context.Context, in *QueryTheoremsRequest, opts ...grpc.CallOption) (*QueryTheoremsResponse, error)
-	// Theorem queries theorem details based on theoremID.
-	Theorem(ctx context.Context, in *QueryTheoremRequest, opts ...grpc.CallOption) (*QueryTheoremResponse, error)
-	// Proofs queries all proofs based on theorem id.
-	Proofs(ctx context.Context, in *QueryProofsRequest, opts ...grpc.CallOption) (*QueryProofsResponse, error)
-	// Proof queries proof details based on proofID.
-	Proof(ctx context.Context, in *QueryProofRequest, opts ...grpc.CallOption) (*QueryProofResponse, error)
-	// AllRewards queries all reward details (including imported rewards) based on address.
-	AllRewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
-	// Params queries the bounty module parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Grants queries theorem details based on theoremID.
-	Grants(ctx context.Context, in *QueryGrantsRequest, opts ...grpc.CallOption) (*QueryGrantsResponse, error)
+func (m *QueryTheoremByCodeHashRequest) GetCodeHash() string {
+	if m != nil {
+		return m.CodeHash
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryTheoremByCodeHashResponse is the response type for the Query/TheoremByCodeHash RPC method.
+type QueryTheoremByCodeHashResponse struct {
+	Theorem *Theorem `protobuf:"bytes,1,opt,name=theorem,proto3" json:"theorem,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryTheoremByCodeHashResponse) Reset()         { *m = QueryTheoremByCodeHashResponse{} }
+func (m *QueryTheoremByCodeHashResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremByCodeHashResponse) ProtoMessage()    {}
+func (*QueryTheoremByCodeHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{33}
 }
-
-func (c *queryClient) Programs(ctx context.Context, in *QueryProgramsRequest, opts ...grpc.CallOption) (*QueryProgramsResponse, error) {
-	out := new(QueryProgramsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Programs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTheoremByCodeHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTheoremByCodeHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTheoremByCodeHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTheoremByCodeHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremByCodeHashResponse.Merge(m, src)
+}
+func (m *QueryTheoremByCodeHashResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTheoremByCodeHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremByCodeHashResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Program(ctx context.Context, in *QueryProgramRequest, opts ...grpc.CallOption) (*QueryProgramResponse, error) {
-	out := new(QueryProgramResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Program", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTheoremByCodeHashResponse proto.InternalMessageInfo
+
+func (m *QueryTheoremByCodeHashResponse) GetTheorem() *Theorem {
+	if m != nil {
+		return m.Theorem
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Findings(ctx context.Context, in *QueryFindingsRequest, opts ...grpc.CallOption) (*QueryFindingsResponse, error) {
-	out := new(QueryFindingsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Findings", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryTheoremDependentsRequest is the request type for the Query/TheoremDependents RPC method.
+type QueryTheoremDependentsRequest struct {
+	// theorem_id defines the unique id of the imported theorem.
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Finding(ctx context.Context, in *QueryFindingRequest, opts ...grpc.CallOption) (*QueryFindingResponse, error) {
-	out := new(QueryFindingResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Finding", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTheoremDependentsRequest) Reset()         { *m = QueryTheoremDependentsRequest{} }
+func (m *QueryTheoremDependentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremDependentsRequest) ProtoMessage()    {}
+func (*QueryTheoremDependentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{34}
+}
+func (m *QueryTheoremDependentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTheoremDependentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTheoremDependentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTheoremDependentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremDependentsRequest.Merge(m, src)
+}
+func (m *QueryTheoremDependentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTheoremDependentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremDependentsRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) FindingFingerprint(ctx context.Context, in *QueryFindingFingerprintRequest, opts ...grpc.CallOption) (*QueryFindingFingerprintResponse, error) {
-	out := new(QueryFindingFingerprintResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/FindingFingerprint", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTheoremDependentsRequest proto.InternalMessageInfo
+
+func (m *QueryTheoremDependentsRequest) GetTheoremId() uint64 {
+	if m != nil {
+		return m.TheoremId
 	}
-	return out, nil
+	return 0
 }
 
-func (c *queryClient) ProgramFingerprint(ctx context.Context, in *QueryProgramFingerprintRequest, opts ...grpc.CallOption) (*QueryProgramFingerprintResponse, error) {
-	out := new(QueryProgramFingerprintResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/ProgramFingerprint", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTheoremDependentsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Theorems(ctx context.Context, in *QueryTheoremsRequest, opts ...grpc.CallOption) (*QueryTheoremsResponse, error) {
-	out := new(QueryTheoremsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Theorems", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QueryTheoremDependentsResponse is the response type for the Query/TheoremDependents RPC method.
+type QueryTheoremDependentsResponse struct {
+	TheoremIds []uint64 `protobuf:"varint,1,rep,packed,name=theorem_ids,json=theoremIds,proto3" json:"theorem_ids,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTheoremDependentsResponse) Reset()         { *m = QueryTheoremDependentsResponse{} }
+func (m *QueryTheoremDependentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremDependentsResponse) ProtoMessage()    {}
+func (*QueryTheoremDependentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{35}
+}
+func (m *QueryTheoremDependentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTheoremDependentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTheoremDependentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTheoremDependentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremDependentsResponse.Merge(m, src)
+}
+func (m *QueryTheoremDependentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTheoremDependentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremDependentsResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) Theorem(ctx context.Context, in *QueryTheoremRequest, opts ...grpc.CallOption) (*QueryTheoremResponse, error) {
-	out := new(QueryTheoremResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Theorem", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTheoremDependentsResponse proto.InternalMessageInfo
+
+func (m *QueryTheoremDependentsResponse) GetTheoremIds() []uint64 {
+	if m != nil {
+		return m.TheoremIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Proofs(ctx context.Context, in *QueryProofsRequest, opts ...grpc.CallOption) (*QueryProofsResponse, error) {
-	out := new(QueryProofsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Proofs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTheoremDependentsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Proof(ctx context.Context, in *QueryProofRequest, opts ...grpc.CallOption) (*QueryProofResponse, error) {
-	out := new(QueryProofResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Proof", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// QueryTheoremDependenciesRequest is the request type for the Query/TheoremDependencies RPC method.
+type QueryTheoremDependenciesRequest struct {
+	// theorem_id defines the unique id of the theorem.
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
 }
 
-func (c *queryClient) AllRewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error) {
-	out := new(QueryRewardsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/AllRewards", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryTheoremDependenciesRequest) Reset()         { *m = QueryTheoremDependenciesRequest{} }
+func (m *QueryTheoremDependenciesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremDependenciesRequest) ProtoMessage()    {}
+func (*QueryTheoremDependenciesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{36}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryTheoremDependenciesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTheoremDependenciesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTheoremDependenciesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryTheoremDependenciesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremDependenciesRequest.Merge(m, src)
+}
+func (m *QueryTheoremDependenciesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTheoremDependenciesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremDependenciesRequest.DiscardUnknown(m)
 }
 
-func (c *queryClient) Grants(ctx context.Context, in *QueryGrantsRequest, opts ...grpc.CallOption) (*QueryGrantsResponse, error) {
-	out := new(QueryGrantsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Grants", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTheoremDependenciesRequest proto.InternalMessageInfo
+
+func (m *QueryTheoremDependenciesRequest) GetTheoremId() uint64 {
+	if m != nil {
+		return m.TheoremId
 	}
-	return out, nil
+	return 0
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Programs queries all programs based on given status.
-	Programs(context.Context, *QueryProgramsRequest) (*QueryProgramsResponse, error)
-	// Program queries program details based on ProgramId.
-	Program(context.Context, *QueryProgramRequest) (*QueryProgramResponse, error)
-	// Findings queries findings of a given program.
-	Findings(context.Context, *QueryFindingsRequest) (*QueryFindingsResponse, error)
-	// Finding queries Finding information based on programID, FindingId.
-	Finding(context.Context, *QueryFindingRequest) (*QueryFindingResponse, error)
-	// FindingFingerprint queries finding fingerprint based on findingId.
-	FindingFingerprint(context.Context, *QueryFindingFingerprintRequest) (*QueryFindingFingerprintResponse, error)
-	// ProgramFingerprint queries program fingerprint based on programId.
-	ProgramFingerprint(context.Context, *QueryProgramFingerprintRequest) (*QueryProgramFingerprintResponse, error)
-	// Theorems queries all theorems based on given status.
-	Theorems(context.Context, *QueryTheoremsRequest) (*QueryTheoremsResponse, error)
-	// Theorem queries theorem details based on theoremID.
-	Theorem(context.Context, *QueryTheoremRequest) (*QueryTheoremResponse, error)
-	// Proofs queries all proofs based on theorem id.
-	Proofs(context.Context, *QueryProofsRequest) (*QueryProofsResponse, error)
-	// Proof queries proof details based on proofID.
-	Proof(context.Context, *QueryProofRequest) (*QueryProofResponse, error)
-	// AllRewards queries all reward details (including imported rewards) based on address.
-	AllRewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
-	// Params queries the bounty module parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Grants queries theorem details based on theoremID.
-	Grants(context.Context, *QueryGrantsRequest) (*QueryGrantsResponse, error)
+// QueryTheoremDependenciesResponse is the response type for the Query/TheoremDependencies RPC method.
+type QueryTheoremDependenciesResponse struct {
+	// theorem_ids are the theorems imported directly or indirectly, in ascending order.
+	TheoremIds []uint64 `protobuf:"varint,1,rep,packed,name=theorem_ids,json=theoremIds,proto3" json:"theorem_ids,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryTheoremDependenciesResponse) Reset()         { *m = QueryTheoremDependenciesResponse{} }
+func (m *QueryTheoremDependenciesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremDependenciesResponse) ProtoMessage()    {}
+func (*QueryTheoremDependenciesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{37}
 }
-
-func (*UnimplementedQueryServer) Programs(ctx context.Context, req *QueryProgramsRequest) (*QueryProgramsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Programs not implemented")
+func (m *QueryTheoremDependenciesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Program(ctx context.Context, req *QueryProgramRequest) (*QueryProgramResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Program not implemented")
+func (m *QueryTheoremDependenciesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTheoremDependenciesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) Findings(ctx context.Context, req *QueryFindingsRequest) (*QueryFindingsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Findings not implemented")
+func (m *QueryTheoremDependenciesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremDependenciesResponse.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Finding(ctx context.Context, req *QueryFindingRequest) (*QueryFindingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Finding not implemented")
+func (m *QueryTheoremDependenciesResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) FindingFingerprint(ctx context.Context, req *QueryFindingFingerprintRequest) (*QueryFindingFingerprintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindingFingerprint not implemented")
+func (m *QueryTheoremDependenciesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremDependenciesResponse.DiscardUnknown(m)
 }
-func (*UnimplementedQueryServer) ProgramFingerprint(ctx context.Context, req *QueryProgramFingerprintRequest) (*QueryProgramFingerprintResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ProgramFingerprint not implemented")
+
+var xxx_messageInfo_QueryTheoremDependenciesResponse proto.InternalMessageInfo
+
+func (m *QueryTheoremDependenciesResponse) GetTheoremIds() []uint64 {
+	if m != nil {
+		return m.TheoremIds
+	}
+	return nil
 }
-func (*UnimplementedQueryServer) Theorems(ctx context.Context, req *QueryTheoremsRequest) (*QueryTheoremsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Theorems not implemented")
+
+// QueryTheoremGraphRequest is the request type for the Query/TheoremGraph RPC method.
+type QueryTheoremGraphRequest struct {
+	// theorem_id defines the root theorem of the graph, 0 exports the whole library.
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
+	// pagination defines an optional pagination over the edges of the whole library,
+	// it is ignored when a root theorem is given.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (*UnimplementedQueryServer) Theorem(ctx context.Context, req *QueryTheoremRequest) (*QueryTheoremResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Theorem not implemented")
+
+func (m *QueryTheoremGraphRequest) Reset()         { *m = QueryTheoremGraphRequest{} }
+func (m *QueryTheoremGraphRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremGraphRequest) ProtoMessage()    {}
+func (*QueryTheoremGraphRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{38}
 }
-func (*UnimplementedQueryServer) Proofs(ctx context.Context, req *QueryProofsRequest) (*QueryProofsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proofs not implemented")
+func (m *QueryTheoremGraphRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) Proof(ctx context.Context, req *QueryProofRequest) (*QueryProofResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Proof not implemented")
+func (m *QueryTheoremGraphRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTheoremGraphRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AllRewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AllRewards not implemented")
+func (m *QueryTheoremGraphRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremGraphRequest.Merge(m, src)
 }
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryTheoremGraphRequest) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedQueryServer) Grants(ctx context.Context, req *QueryGrantsRequest) (*QueryGrantsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Grants not implemented")
+func (m *QueryTheoremGraphRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremGraphRequest.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
-}
+var xxx_messageInfo_QueryTheoremGraphRequest proto.InternalMessageInfo
 
-func _Query_Programs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProgramsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Programs(ctx, in)
+func (m *QueryTheoremGraphRequest) GetTheoremId() uint64 {
+	if m != nil {
+		return m.TheoremId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/Programs",
+	return 0
+}
+
+func (m *QueryTheoremGraphRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Programs(ctx, req.(*QueryProgramsRequest))
+	return nil
+}
+
+// QueryTheoremGraphResponse is the response type for the Query/TheoremGraph RPC method.
+type QueryTheoremGraphResponse struct {
+	Nodes []TheoremNode `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+	Edges []TheoremEdge `protobuf:"bytes,2,rep,name=edges,proto3" json:"edges"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTheoremGraphResponse) Reset()         { *m = QueryTheoremGraphResponse{} }
+func (m *QueryTheoremGraphResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremGraphResponse) ProtoMessage()    {}
+func (*QueryTheoremGraphResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{39}
+}
+func (m *QueryTheoremGraphResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTheoremGraphResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTheoremGraphResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryTheoremGraphResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremGraphResponse.Merge(m, src)
+}
+func (m *QueryTheoremGraphResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTheoremGraphResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremGraphResponse.DiscardUnknown(m)
 }
 
-func _Query_Program_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProgramRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTheoremGraphResponse proto.InternalMessageInfo
+
+func (m *QueryTheoremGraphResponse) GetNodes() []TheoremNode {
+	if m != nil {
+		return m.Nodes
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Program(ctx, in)
+	return nil
+}
+
+func (m *QueryTheoremGraphResponse) GetEdges() []TheoremEdge {
+	if m != nil {
+		return m.Edges
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/Program",
+	return nil
+}
+
+func (m *QueryTheoremGraphResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Program(ctx, req.(*QueryProgramRequest))
+	return nil
+}
+
+// TheoremNode is a theorem in the theorem import graph.
+type TheoremNode struct {
+	Id            uint64        `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title         string        `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Status        TheoremStatus `protobuf:"varint,3,opt,name=status,proto3,enum=shentu.bounty.v1.TheoremStatus" json:"status,omitempty"`
+	Complexity    int64         `protobuf:"varint,4,opt,name=complexity,proto3" json:"complexity,omitempty"`
+	ImportedCount int64         `protobuf:"varint,5,opt,name=imported_count,json=importedCount,proto3" json:"imported_count,omitempty"`
+}
+
+func (m *TheoremNode) Reset()         { *m = TheoremNode{} }
+func (m *TheoremNode) String() string { return proto.CompactTextString(m) }
+func (*TheoremNode) ProtoMessage()    {}
+func (*TheoremNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{40}
+}
+func (m *TheoremNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TheoremNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TheoremNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *TheoremNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TheoremNode.Merge(m, src)
+}
+func (m *TheoremNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *TheoremNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_TheoremNode.DiscardUnknown(m)
 }
 
-func _Query_Findings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFindingsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_TheoremNode proto.InternalMessageInfo
+
+func (m *TheoremNode) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	if interceptor == nil {
-		return srv.(QueryServer).Findings(ctx, in)
+	return 0
+}
+
+func (m *TheoremNode) GetTitle() string {
+	if m != nil {
+		return m.Title
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/Findings",
+	return ""
+}
+
+func (m *TheoremNode) GetStatus() TheoremStatus {
+	if m != nil {
+		return m.Status
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Findings(ctx, req.(*QueryFindingsRequest))
+	return TheoremStatus_THEOREM_STATUS_UNSPECIFIED
+}
+
+func (m *TheoremNode) GetComplexity() int64 {
+	if m != nil {
+		return m.Complexity
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Finding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFindingRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Finding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/Finding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Finding(ctx, req.(*QueryFindingRequest))
+func (m *TheoremNode) GetImportedCount() int64 {
+	if m != nil {
+		return m.ImportedCount
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_FindingFingerprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryFindingFingerprintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).FindingFingerprint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/FindingFingerprint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).FindingFingerprint(ctx, req.(*QueryFindingFingerprintRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// TheoremEdge is an import of a theorem by another theorem.
+type TheoremEdge struct {
+	// importer is the id of the importing theorem.
+	Importer uint64 `protobuf:"varint,1,opt,name=importer,proto3" json:"importer,omitempty"`
+	// imported is the id of the imported theorem.
+	Imported uint64 `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
 }
 
-func _Query_ProgramFingerprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProgramFingerprintRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ProgramFingerprint(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/ProgramFingerprint",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ProgramFingerprint(ctx, req.(*QueryProgramFingerprintRequest))
+func (m *TheoremEdge) Reset()         { *m = TheoremEdge{} }
+func (m *TheoremEdge) String() string { return proto.CompactTextString(m) }
+func (*TheoremEdge) ProtoMessage()    {}
+func (*TheoremEdge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{41}
+}
+func (m *TheoremEdge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TheoremEdge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TheoremEdge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *TheoremEdge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TheoremEdge.Merge(m, src)
+}
+func (m *TheoremEdge) XXX_Size() int {
+	return m.Size()
+}
+func (m *TheoremEdge) XXX_DiscardUnknown() {
+	xxx_messageInfo_TheoremEdge.DiscardUnknown(m)
 }
 
-func _Query_Theorems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTheoremsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Theorems(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/Theorems",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Theorems(ctx, req.(*QueryTheoremsRequest))
+var xxx_messageInfo_TheoremEdge proto.InternalMessageInfo
+
+func (m *TheoremEdge) GetImporter() uint64 {
+	if m != nil {
+		return m.Importer
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Theorem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryTheoremRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Theorem(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/Theorem",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Theorem(ctx, req.(*QueryTheoremRequest))
+func (m *TheoremEdge) GetImported() uint64 {
+	if m != nil {
+		return m.Imported
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Proofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProofsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Proofs(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/Proofs",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Proofs(ctx, req.(*QueryProofsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryProofsRequest is the request type for the Query/Proofs RPC method.
+type QueryProofsRequest struct {
+	// theorem_id defines the unique id of the theorem.
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_Proof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryProofRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Proof(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/Proof",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Proof(ctx, req.(*QueryProofRequest))
+func (m *QueryProofsRequest) Reset()         { *m = QueryProofsRequest{} }
+func (m *QueryProofsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProofsRequest) ProtoMessage()    {}
+func (*QueryProofsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{42}
+}
+func (m *QueryProofsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProofsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProofsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryProofsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProofsRequest.Merge(m, src)
+}
+func (m *QueryProofsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProofsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProofsRequest.DiscardUnknown(m)
 }
 
-func _Query_AllRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryRewardsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AllRewards(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/AllRewards",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AllRewards(ctx, req.(*QueryRewardsRequest))
+var xxx_messageInfo_QueryProofsRequest proto.InternalMessageInfo
+
+func (m *QueryProofsRequest) GetTheoremId() uint64 {
+	if m != nil {
+		return m.TheoremId
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+func (m *QueryProofsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_Grants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGrantsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Grants(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Query/Grants",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Grants(ctx, req.(*QueryGrantsRequest))
+// QueryProofsResponse is the response type for the Query/Proofs RPC method.
+type QueryProofsResponse struct {
+	Proofs []*Proof `protobuf:"bytes,1,rep,name=proofs,proto3" json:"proofs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryProofsResponse) Reset()         { *m = QueryProofsResponse{} }
+func (m *QueryProofsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProofsResponse) ProtoMessage()    {}
+func (*QueryProofsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{43}
+}
+func (m *QueryProofsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProofsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProofsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryProofsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProofsResponse.Merge(m, src)
+}
+func (m *QueryProofsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProofsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProofsResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "shentu.bounty.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Programs",
-			Handler:    _Query_Programs_Handler,
-		},
-		{
-			MethodName: "Program",
-			Handler:    _Query_Program_Handler,
-		},
-		{
-			MethodName: "Findings",
-			Handler:    _Query_Findings_Handler,
-		},
-		{
-			MethodName: "Finding",
-			Handler:    _Query_Finding_Handler,
-		},
-		{
-			MethodName: "FindingFingerprint",
-			Handler:    _Query_FindingFingerprint_Handler,
-		},
-		{
-			MethodName: "ProgramFingerprint",
-			Handler:    _Query_ProgramFingerprint_Handler,
-		},
-		{
-			MethodName: "Theorems",
-			Handler:    _Query_Theorems_Handler,
-		},
-		{
-			MethodName: "Theorem",
-			Handler:    _Query_Theorem_Handler,
-		},
-		{
-			MethodName: "Proofs",
-			Handler:    _Query_Proofs_Handler,
-		},
-		{
-			MethodName: "Proof",
-			Handler:    _Query_Proof_Handler,
-		},
-		{
-			MethodName: "AllRewards",
-			Handler:    _Query_AllRewards_Handler,
-		},
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Grants",
-			Handler:    _Query_Grants_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "shentu/bounty/v1/query.proto",
-}
+var xxx_messageInfo_QueryProofsResponse proto.InternalMessageInfo
 
-func (m *QueryHostsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProofsResponse) GetProofs() []*Proof {
+	if m != nil {
+		return m.Proofs
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryHostsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProofsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryHostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryProofRequest is the request type for the Query/Proof RPC method.
+type QueryProofRequest struct {
+	// proof_id defines the unique id of the proof.
+	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
 }
 
-func (m *QueryHostsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProofRequest) Reset()         { *m = QueryProofRequest{} }
+func (m *QueryProofRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProofRequest) ProtoMessage()    {}
+func (*QueryProofRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{44}
+}
+func (m *QueryProofRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProofRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProofRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryHostsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProofRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProofRequest.Merge(m, src)
 }
-
-func (m *QueryHostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryProofRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryHostRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryProofRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProofRequest.DiscardUnknown(m)
 }
 
-func (m *QueryHostRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryProofRequest proto.InternalMessageInfo
 
-func (m *QueryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.HostAddr) > 0 {
-		i -= len(m.HostAddr)
-		copy(dAtA[i:], m.HostAddr)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.HostAddr)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProofRequest) GetProofId() string {
+	if m != nil {
+		return m.ProofId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryHostResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryProofResponse is the response type for the Query/Proof RPC method.
+type QueryProofResponse struct {
+	Proof    *Proof         `protobuf:"bytes,1,opt,name=proof,proto3" json:"proof,omitempty"`
+	Verdicts []ProofVerdict `protobuf:"bytes,2,rep,name=verdicts,proto3" json:"verdicts"`
 }
 
-func (m *QueryHostResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProofResponse) Reset()         { *m = QueryProofResponse{} }
+func (m *QueryProofResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProofResponse) ProtoMessage()    {}
+func (*QueryProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{45}
 }
-
-func (m *QueryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *QueryProgramsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryProgramsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProofResponse.Merge(m, src)
+}
+func (m *QueryProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProofResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProgramsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryProofResponse proto.InternalMessageInfo
+
+func (m *QueryProofResponse) GetProof() *Proof {
+	if m != nil {
+		return m.Proof
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryProgramsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProofResponse) GetVerdicts() []ProofVerdict {
+	if m != nil {
+		return m.Verdicts
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProgramsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryProofChunkRequest is the request type for the Query/ProofChunk RPC method.
+type QueryProofChunkRequest struct {
+	// proof_id defines the unique id of the proof.
+	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
+	// index is the position of the chunk in the proof detail.
+	Index uint32 `protobuf:"varint,2,opt,name=index,proto3" json:"index,omitempty"`
 }
 
-func (m *QueryProgramsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Programs) > 0 {
-		for iNdEx := len(m.Programs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Programs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryProofChunkRequest) Reset()         { *m = QueryProofChunkRequest{} }
+func (m *QueryProofChunkRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProofChunkRequest) ProtoMessage()    {}
+func (*QueryProofChunkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{46}
+}
+func (m *QueryProofChunkRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProofChunkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProofChunkRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryProofChunkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProofChunkRequest.Merge(m, src)
+}
+func (m *QueryProofChunkRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProofChunkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProofChunkRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProgramRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryProofChunkRequest proto.InternalMessageInfo
+
+func (m *QueryProofChunkRequest) GetProofId() string {
+	if m != nil {
+		return m.ProofId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryProgramRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryProofChunkRequest) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
 }
 
-func (m *QueryProgramRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// QueryProofChunkResponse is the response type for the Query/ProofChunk RPC method.
+type QueryProofChunkResponse struct {
+	// data is the decompressed chunk.
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	// hash is the content hash of the chunk as stored.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	// total is the number of chunks of the proof detail.
+	Total uint32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
 }
 
-func (m *QueryProgramResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProofChunkResponse) Reset()         { *m = QueryProofChunkResponse{} }
+func (m *QueryProofChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProofChunkResponse) ProtoMessage()    {}
+func (*QueryProofChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{47}
+}
+func (m *QueryProofChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProofChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProofChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryProofChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProofChunkResponse.Merge(m, src)
+}
+func (m *QueryProofChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProofChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProofChunkResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProgramResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryProofChunkResponse proto.InternalMessageInfo
+
+func (m *QueryProofChunkResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
 }
 
-func (m *QueryProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Program != nil {
-		{
-			size, err := m.Program.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
+func (m *QueryProofChunkResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryFindingsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryProofChunkResponse) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryFindingsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOpenMathStatsRequest is the request type for the Query/OpenMathStats RPC method.
+type QueryOpenMathStatsRequest struct {
+	// address defines the address to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *QueryFindingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryOpenMathStatsRequest) Reset()         { *m = QueryOpenMathStatsRequest{} }
+func (m *QueryOpenMathStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenMathStatsRequest) ProtoMessage()    {}
+func (*QueryOpenMathStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{48}
+}
+func (m *QueryOpenMathStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenMathStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenMathStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.SubmitterAddress) > 0 {
-		i -= len(m.SubmitterAddress)
-		copy(dAtA[i:], m.SubmitterAddress)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.SubmitterAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOpenMathStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenMathStatsRequest.Merge(m, src)
+}
+func (m *QueryOpenMathStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenMathStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenMathStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFindingsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOpenMathStatsRequest proto.InternalMessageInfo
+
+func (m *QueryOpenMathStatsRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryFindingsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryOpenMathStatsResponse is the response type for the Query/OpenMathStats RPC method.
+type QueryOpenMathStatsResponse struct {
+	Stats OpenMathStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
 }
 
-func (m *QueryFindingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Findings) > 0 {
-		for iNdEx := len(m.Findings) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Findings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryOpenMathStatsResponse) Reset()         { *m = QueryOpenMathStatsResponse{} }
+func (m *QueryOpenMathStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOpenMathStatsResponse) ProtoMessage()    {}
+func (*QueryOpenMathStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{49}
+}
+func (m *QueryOpenMathStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOpenMathStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOpenMathStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryOpenMathStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOpenMathStatsResponse.Merge(m, src)
+}
+func (m *QueryOpenMathStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOpenMathStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOpenMathStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFindingRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryOpenMathStatsResponse proto.InternalMessageInfo
+
+func (m *QueryOpenMathStatsResponse) GetStats() OpenMathStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return OpenMathStats{}
 }
 
-func (m *QueryFindingRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTheoremTypeStatsRequest is the request type for the Query/TheoremTypeStats RPC method.
+type QueryTheoremTypeStatsRequest struct {
 }
 
-func (m *QueryFindingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryTheoremTypeStatsRequest) Reset()         { *m = QueryTheoremTypeStatsRequest{} }
+func (m *QueryTheoremTypeStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremTypeStatsRequest) ProtoMessage()    {}
+func (*QueryTheoremTypeStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{50}
 }
-
-func (m *QueryFindingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryTheoremTypeStatsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTheoremTypeStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTheoremTypeStatsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryTheoremTypeStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremTypeStatsRequest.Merge(m, src)
+}
+func (m *QueryTheoremTypeStatsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTheoremTypeStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremTypeStatsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFindingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryTheoremTypeStatsRequest proto.InternalMessageInfo
+
+// QueryTheoremTypeStatsResponse is the response type for the Query/TheoremTypeStats RPC method.
+type QueryTheoremTypeStatsResponse struct {
+	Stats []TheoremTypeStats `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats"`
 }
 
-func (m *QueryFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Finding != nil {
-		{
-			size, err := m.Finding.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTheoremTypeStatsResponse) Reset()         { *m = QueryTheoremTypeStatsResponse{} }
+func (m *QueryTheoremTypeStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTheoremTypeStatsResponse) ProtoMessage()    {}
+func (*QueryTheoremTypeStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{51}
+}
+func (m *QueryTheoremTypeStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTheoremTypeStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTheoremTypeStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTheoremTypeStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTheoremTypeStatsResponse.Merge(m, src)
+}
+func (m *QueryTheoremTypeStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTheoremTypeStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTheoremTypeStatsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryFindingFingerprintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTheoremTypeStatsResponse proto.InternalMessageInfo
+
+func (m *QueryTheoremTypeStatsResponse) GetStats() []TheoremTypeStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryFindingFingerprintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLeaderboardRequest is the request type for the Query/Leaderboard RPC method.
+type QueryLeaderboardRequest struct {
+	// metric defines the metric the addresses are sorted by.
+	Metric LeaderboardMetric `protobuf:"varint,1,opt,name=metric,proto3,enum=shentu.bounty.v1.LeaderboardMetric" json:"metric,omitempty"`
+	// denom defines the denom of the rewards for the reward metrics.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// pagination defines an optional pagination for the request. The entries are sorted by
+	// descending score, reverse sorts them by ascending score.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryFindingFingerprintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryLeaderboardRequest) Reset()         { *m = QueryLeaderboardRequest{} }
+func (m *QueryLeaderboardRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaderboardRequest) ProtoMessage()    {}
+func (*QueryLeaderboardRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{52}
 }
-
-func (m *QueryFindingFingerprintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLeaderboardRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaderboardRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaderboardRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryFindingFingerprintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLeaderboardRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaderboardRequest.Merge(m, src)
+}
+func (m *QueryLeaderboardRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaderboardRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaderboardRequest.DiscardUnknown(m)
 }
 
-func (m *QueryFindingFingerprintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryLeaderboardRequest proto.InternalMessageInfo
+
+func (m *QueryLeaderboardRequest) GetMetric() LeaderboardMetric {
+	if m != nil {
+		return m.Metric
 	}
-	return len(dAtA) - i, nil
+	return LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED
 }
 
-func (m *QueryProgramFingerprintRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryLeaderboardRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryProgramFingerprintRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLeaderboardRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryProgramFingerprintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// LeaderboardEntry defines an address of a leaderboard with its score.
+type LeaderboardEntry struct {
+	// score is the value of the metric, truncated to an integer for the reward metrics.
+	Score uint64        `protobuf:"varint,1,opt,name=score,proto3" json:"score,omitempty"`
+	Stats OpenMathStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
 }
 
-func (m *QueryProgramFingerprintResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LeaderboardEntry) Reset()         { *m = LeaderboardEntry{} }
+func (m *LeaderboardEntry) String() string { return proto.CompactTextString(m) }
+func (*LeaderboardEntry) ProtoMessage()    {}
+func (*LeaderboardEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{53}
+}
+func (m *LeaderboardEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LeaderboardEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LeaderboardEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryProgramFingerprintResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *LeaderboardEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LeaderboardEntry.Merge(m, src)
+}
+func (m *LeaderboardEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *LeaderboardEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_LeaderboardEntry.DiscardUnknown(m)
 }
 
-func (m *QueryProgramFingerprintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_LeaderboardEntry proto.InternalMessageInfo
+
+func (m *LeaderboardEntry) GetScore() uint64 {
+	if m != nil {
+		return m.Score
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryTheoremsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *LeaderboardEntry) GetStats() OpenMathStats {
+	if m != nil {
+		return m.Stats
 	}
-	return dAtA[:n], nil
+	return OpenMathStats{}
 }
 
-func (m *QueryTheoremsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryLeaderboardResponse is the response type for the Query/Leaderboard RPC method.
+type QueryLeaderboardResponse struct {
+	Entries []LeaderboardEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryTheoremsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryLeaderboardResponse) Reset()         { *m = QueryLeaderboardResponse{} }
+func (m *QueryLeaderboardResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLeaderboardResponse) ProtoMessage()    {}
+func (*QueryLeaderboardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{54}
+}
+func (m *QueryLeaderboardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLeaderboardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLeaderboardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryLeaderboardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLeaderboardResponse.Merge(m, src)
+}
+func (m *QueryLeaderboardResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLeaderboardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLeaderboardResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTheoremsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryLeaderboardResponse proto.InternalMessageInfo
+
+func (m *QueryLeaderboardResponse) GetEntries() []LeaderboardEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTheoremsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryLeaderboardResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryTheoremsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+// QueryRewardsRequest is the request type for the Query/AllRewards RPC method.
+type QueryRewardsRequest struct {
+	// address defines the address to query for.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryRewardsRequest) Reset()         { *m = QueryRewardsRequest{} }
+func (m *QueryRewardsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsRequest) ProtoMessage()    {}
+func (*QueryRewardsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{55}
+}
+func (m *QueryRewardsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Theorems) > 0 {
-		for iNdEx := len(m.Theorems) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Theorems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+}
+func (m *QueryRewardsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsRequest.Merge(m, src)
+}
+func (m *QueryRewardsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardsRequest proto.InternalMessageInfo
+
+// QueryRewardsResponse is the response type for the Query/AllRewards RPC method.
+type QueryRewardsResponse struct {
+	ProofRewards    github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=proof_rewards,json=proofRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"proof_rewards"`
+	ImportedRewards github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=imported_rewards,json=importedRewards,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"imported_rewards"`
+	// vesting is the vesting schedules of the rewards of the address, if any.
+	Vesting *RewardVesting `protobuf:"bytes,3,opt,name=vesting,proto3" json:"vesting,omitempty"`
+	// releasable is the vested amount of the schedules not released yet.
+	Releasable github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=releasable,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"releasable"`
+}
+
+func (m *QueryRewardsResponse) Reset()         { *m = QueryRewardsResponse{} }
+func (m *QueryRewardsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardsResponse) ProtoMessage()    {}
+func (*QueryRewardsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{56}
+}
+func (m *QueryRewardsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryRewardsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardsResponse.Merge(m, src)
+}
+func (m *QueryRewardsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryTheoremRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryRewardsResponse proto.InternalMessageInfo
+
+func (m *QueryRewardsResponse) GetProofRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ProofRewards
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTheoremRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRewardsResponse) GetImportedRewards() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.ImportedRewards
+	}
+	return nil
 }
 
-func (m *QueryTheoremRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TheoremId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TheoremId))
-		i--
-		dAtA[i] = 0x8
+func (m *QueryRewardsResponse) GetVesting() *RewardVesting {
+	if m != nil {
+		return m.Vesting
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryTheoremResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRewardsResponse) GetReleasable() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Releasable
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryTheoremResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryParamsRequest defines the request type for querying x/bounty parameters.
+type QueryParamsRequest struct {
 }
 
-func (m *QueryTheoremResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Theorem != nil {
-		{
-			size, err := m.Theorem.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{57}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryProofsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProofsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+// QueryParamsResponse defines the response type for querying x/bounty parameters.
+type QueryParamsResponse struct {
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
 }
 
-func (m *QueryProofsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{58}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TheoremId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TheoremId))
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryProofsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+func (m *QueryParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProofsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGrantsRequest is the request type for the Query/Grants RPC method.
+type QueryGrantsRequest struct {
+	// theorem_id defines the unique id of the theorem.
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProofsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Proofs) > 0 {
-		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryGrantsRequest) Reset()         { *m = QueryGrantsRequest{} }
+func (m *QueryGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantsRequest) ProtoMessage()    {}
+func (*QueryGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{59}
+}
+func (m *QueryGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryProofRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantsRequest.Merge(m, src)
 }
-
-func (m *QueryProofRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ProofId) > 0 {
-		i -= len(m.ProofId)
-		copy(dAtA[i:], m.ProofId)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofId)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryGrantsRequest) GetTheoremId() uint64 {
+	if m != nil {
+		return m.TheoremId
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryProofResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryProofResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGrantsResponse defines the response type for querying x/bounty grants.
+type QueryGrantsResponse struct {
+	Grants []*Grant `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Proof != nil {
-		{
-			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGrantsResponse) Reset()         { *m = QueryGrantsResponse{} }
+func (m *QueryGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGrantsResponse) ProtoMessage()    {}
+func (*QueryGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_31c92d65cbd97e4b, []int{60}
 }
-
-func (m *QueryRewardsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGrantsResponse.Merge(m, src)
 }
-
-func (m *QueryRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *QueryGrantsResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryRewardsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGrantsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryGrantsResponse proto.InternalMessageInfo
 
-func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ImportedRewards) > 0 {
-		for iNdEx := len(m.ImportedRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ImportedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if len(m.ProofRewards) > 0 {
-		for iNdEx := len(m.ProofRewards) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ProofRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (m *QueryGrantsResponse) GetGrants() []*Grant {
+	if m != nil {
+		return m.Grants
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
-}
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func init() {
+	proto.RegisterType((*QueryHostsRequest)(nil), "shentu.bounty.v1.QueryHostsRequest")
+	proto.RegisterType((*QueryHostsResponse)(nil), "shentu.bounty.v1.QueryHostsResponse")
+	proto.RegisterType((*QueryHostRequest)(nil), "shentu.bounty.v1.QueryHostRequest")
+	proto.RegisterType((*QueryHostResponse)(nil), "shentu.bounty.v1.QueryHostResponse")
+	proto.RegisterType((*QueryProgramsRequest)(nil), "shentu.bounty.v1.QueryProgramsRequest")
+	proto.RegisterType((*QueryProgramsResponse)(nil), "shentu.bounty.v1.QueryProgramsResponse")
+	proto.RegisterType((*QueryProgramRequest)(nil), "shentu.bounty.v1.QueryProgramRequest")
+	proto.RegisterType((*QueryProgramResponse)(nil), "shentu.bounty.v1.QueryProgramResponse")
+	proto.RegisterType((*QueryProgramMembersRequest)(nil), "shentu.bounty.v1.QueryProgramMembersRequest")
+	proto.RegisterType((*QueryProgramMembersResponse)(nil), "shentu.bounty.v1.QueryProgramMembersResponse")
+	proto.RegisterType((*QuerySponsorshipsRequest)(nil), "shentu.bounty.v1.QuerySponsorshipsRequest")
+	proto.RegisterType((*QuerySponsorshipsResponse)(nil), "shentu.bounty.v1.QuerySponsorshipsResponse")
+	proto.RegisterType((*QueryFindingsRequest)(nil), "shentu.bounty.v1.QueryFindingsRequest")
+	proto.RegisterType((*QueryFindingsResponse)(nil), "shentu.bounty.v1.QueryFindingsResponse")
+	proto.RegisterType((*QueryFindingRequest)(nil), "shentu.bounty.v1.QueryFindingRequest")
+	proto.RegisterType((*QueryFindingResponse)(nil), "shentu.bounty.v1.QueryFindingResponse")
+	proto.RegisterType((*QueryDisputeRequest)(nil), "shentu.bounty.v1.QueryDisputeRequest")
+	proto.RegisterType((*QueryDisputeResponse)(nil), "shentu.bounty.v1.QueryDisputeResponse")
+	proto.RegisterType((*QueryHackersRequest)(nil), "shentu.bounty.v1.QueryHackersRequest")
+	proto.RegisterType((*QueryHackersResponse)(nil), "shentu.bounty.v1.QueryHackersResponse")
+	proto.RegisterType((*QueryHackerRequest)(nil), "shentu.bounty.v1.QueryHackerRequest")
+	proto.RegisterType((*QueryHackerResponse)(nil), "shentu.bounty.v1.QueryHackerResponse")
+	proto.RegisterType((*QueryDisclosuresRequest)(nil), "shentu.bounty.v1.QueryDisclosuresRequest")
+	proto.RegisterType((*QueryDisclosuresResponse)(nil), "shentu.bounty.v1.QueryDisclosuresResponse")
+	proto.RegisterType((*QueryFindingFingerprintRequest)(nil), "shentu.bounty.v1.QueryFindingFingerprintRequest")
+	proto.RegisterType((*QueryFindingFingerprintResponse)(nil), "shentu.bounty.v1.QueryFindingFingerprintResponse")
+	proto.RegisterType((*QueryProgramFingerprintRequest)(nil), "shentu.bounty.v1.QueryProgramFingerprintRequest")
+	proto.RegisterType((*QueryProgramFingerprintResponse)(nil), "shentu.bounty.v1.QueryProgramFingerprintResponse")
+	proto.RegisterType((*QueryTheoremsRequest)(nil), "shentu.bounty.v1.QueryTheoremsRequest")
+	proto.RegisterType((*QueryTheoremsResponse)(nil), "shentu.bounty.v1.QueryTheoremsResponse")
+	proto.RegisterType((*QueryTheoremRequest)(nil), "shentu.bounty.v1.QueryTheoremRequest")
+	proto.RegisterType((*QueryTheoremResponse)(nil), "shentu.bounty.v1.QueryTheoremResponse")
+	proto.RegisterType((*QueryTheoremByCodeHashRequest)(nil), "shentu.bounty.v1.QueryTheoremByCodeHashRequest")
+	proto.RegisterType((*QueryTheoremByCodeHashResponse)(nil), "shentu.bounty.v1.QueryTheoremByCodeHashResponse")
+	proto.RegisterType((*QueryTheoremDependentsRequest)(nil), "shentu.bounty.v1.QueryTheoremDependentsRequest")
+	proto.RegisterType((*QueryTheoremDependentsResponse)(nil), "shentu.bounty.v1.QueryTheoremDependentsResponse")
+	proto.RegisterType((*QueryTheoremDependenciesRequest)(nil), "shentu.bounty.v1.QueryTheoremDependenciesRequest")
+	proto.RegisterType((*QueryTheoremDependenciesResponse)(nil), "shentu.bounty.v1.QueryTheoremDependenciesResponse")
+	proto.RegisterType((*QueryTheoremGraphRequest)(nil), "shentu.bounty.v1.QueryTheoremGraphRequest")
+	proto.RegisterType((*QueryTheoremGraphResponse)(nil), "shentu.bounty.v1.QueryTheoremGraphResponse")
+	proto.RegisterType((*TheoremNode)(nil), "shentu.bounty.v1.TheoremNode")
+	proto.RegisterType((*TheoremEdge)(nil), "shentu.bounty.v1.TheoremEdge")
+	proto.RegisterType((*QueryProofsRequest)(nil), "shentu.bounty.v1.QueryProofsRequest")
+	proto.RegisterType((*QueryProofsResponse)(nil), "shentu.bounty.v1.QueryProofsResponse")
+	proto.RegisterType((*QueryProofRequest)(nil), "shentu.bounty.v1.QueryProofRequest")
+	proto.RegisterType((*QueryProofResponse)(nil), "shentu.bounty.v1.QueryProofResponse")
+	proto.RegisterType((*QueryProofChunkRequest)(nil), "shentu.bounty.v1.QueryProofChunkRequest")
+	proto.RegisterType((*QueryProofChunkResponse)(nil), "shentu.bounty.v1.QueryProofChunkResponse")
+	proto.RegisterType((*QueryOpenMathStatsRequest)(nil), "shentu.bounty.v1.QueryOpenMathStatsRequest")
+	proto.RegisterType((*QueryOpenMathStatsResponse)(nil), "shentu.bounty.v1.QueryOpenMathStatsResponse")
+	proto.RegisterType((*QueryTheoremTypeStatsRequest)(nil), "shentu.bounty.v1.QueryTheoremTypeStatsRequest")
+	proto.RegisterType((*QueryTheoremTypeStatsResponse)(nil), "shentu.bounty.v1.QueryTheoremTypeStatsResponse")
+	proto.RegisterType((*QueryLeaderboardRequest)(nil), "shentu.bounty.v1.QueryLeaderboardRequest")
+	proto.RegisterType((*LeaderboardEntry)(nil), "shentu.bounty.v1.LeaderboardEntry")
+	proto.RegisterType((*QueryLeaderboardResponse)(nil), "shentu.bounty.v1.QueryLeaderboardResponse")
+	proto.RegisterType((*QueryRewardsRequest)(nil), "shentu.bounty.v1.QueryRewardsRequest")
+	proto.RegisterType((*QueryRewardsResponse)(nil), "shentu.bounty.v1.QueryRewardsResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "shentu.bounty.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "shentu.bounty.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGrantsRequest)(nil), "shentu.bounty.v1.QueryGrantsRequest")
+	proto.RegisterType((*QueryGrantsResponse)(nil), "shentu.bounty.v1.QueryGrantsResponse")
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("shentu/bounty/v1/query.proto", fileDescriptor_31c92d65cbd97e4b) }
+
+var fileDescriptor_31c92d65cbd97e4b = []byte{
+	// 2765 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0xdb, 0x8f, 0x14, 0xc7,
+	0xd5, 0xdf, 0xde, 0x3b, 0xb5, 0x17, 0x2f, 0x05, 0x9f, 0x3d, 0x0c, 0xb0, 0x03, 0x8d, 0x97, 0xfb,
+	0x4e, 0xb3, 0x0b, 0x7c, 0x36, 0xc6, 0x89, 0x61, 0x17, 0x58, 0x90, 0x21, 0x90, 0x86, 0x10, 0xc5,
+	0x52, 0x34, 0xea, 0x9d, 0xae, 0x9d, 0x69, 0x31, 0xd3, 0xdd, 0xee, 0xae, 0x59, 0xb3, 0x5a, 0xad,
+	0x90, 0x9d, 0xc4, 0x72, 0x92, 0x87, 0x10, 0xe5, 0x21, 0x4f, 0x89, 0x90, 0xa2, 0xc4, 0x91, 0xa5,
+	0x48, 0x56, 0x42, 0x22, 0x25, 0x79, 0xcd, 0x83, 0x1f, 0x2d, 0xe7, 0x25, 0xf2, 0x83, 0x6d, 0x41,
+	0xa4, 0xe4, 0xcf, 0x88, 0xba, 0xea, 0x54, 0x77, 0xf5, 0xcc, 0x54, 0x4f, 0x03, 0x63, 0x5e, 0x60,
+	0xbb, 0xea, 0x9c, 0x3a, 0xbf, 0x73, 0xa9, 0x73, 0xaa, 0x4e, 0x0d, 0xda, 0x13, 0xd6, 0x89, 0x4b,
+	0x5b, 0xc6, 0xaa, 0xd7, 0x72, 0xe9, 0x86, 0xb1, 0xbe, 0x60, 0xbc, 0xdd, 0x22, 0xc1, 0x46, 0xd9,
+	0x0f, 0x3c, 0xea, 0xe1, 0x19, 0x3e, 0x5b, 0xe6, 0xb3, 0xe5, 0xf5, 0x85, 0xe2, 0xce, 0x9a, 0x57,
+	0xf3, 0xd8, 0xa4, 0x11, 0xfd, 0xc5, 0xe9, 0x8a, 0x7b, 0x6a, 0x9e, 0x57, 0x6b, 0x10, 0xc3, 0xf2,
+	0x1d, 0xc3, 0x72, 0x5d, 0x8f, 0x5a, 0xd4, 0xf1, 0xdc, 0x10, 0x66, 0x4b, 0x30, 0xcb, 0xbe, 0x56,
+	0x5b, 0x6b, 0x06, 0x75, 0x9a, 0x24, 0xa4, 0x56, 0xd3, 0x07, 0x82, 0x5d, 0x55, 0x2f, 0x6c, 0x7a,
+	0x61, 0x85, 0xaf, 0xcb, 0x3f, 0x60, 0x6a, 0xbb, 0xd5, 0x74, 0x5c, 0xcf, 0x60, 0xff, 0xc2, 0xd0,
+	0x2c, 0x27, 0x30, 0x56, 0xad, 0x90, 0x18, 0xeb, 0x0b, 0xab, 0x84, 0x5a, 0x0b, 0x46, 0xd5, 0x73,
+	0x5c, 0x98, 0x3f, 0x2a, 0xcf, 0x33, 0x6d, 0x62, 0x2a, 0xdf, 0xaa, 0x39, 0x2e, 0xc3, 0x06, 0xb4,
+	0x7b, 0x3b, 0xd4, 0x07, 0x55, 0xd9, 0xb4, 0xbe, 0x03, 0x6d, 0xff, 0x76, 0xb4, 0xc0, 0x65, 0x2f,
+	0xa4, 0xa1, 0x49, 0xde, 0x6e, 0x91, 0x90, 0xea, 0x3b, 0x11, 0x96, 0x07, 0x43, 0xdf, 0x73, 0x43,
+	0xa2, 0x1b, 0x68, 0x26, 0x1e, 0x05, 0x4a, 0xbc, 0x1b, 0x6d, 0xab, 0x7b, 0x21, 0xad, 0x58, 0xb6,
+	0x1d, 0x14, 0xb4, 0x7d, 0xda, 0xe1, 0x6d, 0xe6, 0x78, 0x34, 0x70, 0xde, 0xb6, 0x83, 0xd4, 0xda,
+	0xf1, 0x2a, 0x7f, 0xd6, 0xd0, 0x4e, 0x36, 0x7a, 0x23, 0xf0, 0x6a, 0x81, 0xd5, 0x14, 0x42, 0xf1,
+	0x25, 0x84, 0x12, 0xf0, 0x6c, 0xad, 0x89, 0xc5, 0x83, 0x65, 0x30, 0x55, 0xa4, 0x69, 0x99, 0xfb,
+	0x0d, 0x34, 0x2d, 0xdf, 0xb0, 0x6a, 0x04, 0x78, 0x4d, 0x89, 0x13, 0xbf, 0x88, 0x46, 0x43, 0x6a,
+	0xd1, 0x56, 0x58, 0x18, 0x64, 0x78, 0xe0, 0x0b, 0x7f, 0x03, 0x4d, 0x59, 0x76, 0xd3, 0x71, 0x19,
+	0x56, 0x12, 0x86, 0x85, 0xa1, 0x68, 0x7a, 0xa9, 0xf0, 0xd9, 0xc3, 0xf9, 0x9d, 0x20, 0xe5, 0x3c,
+	0x9f, 0xb9, 0x49, 0x03, 0xc7, 0xad, 0x99, 0x93, 0x8c, 0x1c, 0xc6, 0xf4, 0x5f, 0x6a, 0xe8, 0xff,
+	0xda, 0x70, 0x73, 0x8d, 0xf0, 0x69, 0x34, 0xee, 0xc3, 0x58, 0x41, 0xdb, 0x37, 0x74, 0x78, 0x62,
+	0x71, 0x57, 0xb9, 0x3d, 0xaa, 0xca, 0xc0, 0x65, 0xc6, 0xa4, 0x78, 0x25, 0xa5, 0xef, 0x20, 0xd3,
+	0xf7, 0x50, 0x4f, 0x7d, 0xb9, 0x4c, 0x59, 0x61, 0xfd, 0x14, 0xda, 0x21, 0x03, 0x13, 0xf6, 0xdc,
+	0x8b, 0x10, 0xc8, 0xaa, 0x38, 0x36, 0xf8, 0x66, 0x1b, 0x8c, 0x5c, 0xb1, 0xf5, 0x37, 0xd3, 0x6e,
+	0x88, 0xb5, 0x39, 0x89, 0xc6, 0x80, 0x08, 0x7c, 0x90, 0xa1, 0x8c, 0xa0, 0xd4, 0x7f, 0xa0, 0xa1,
+	0xa2, 0xbc, 0xda, 0x35, 0xd2, 0x5c, 0x25, 0x41, 0x98, 0x0f, 0x4a, 0x9b, 0xe7, 0x07, 0x9f, 0xd6,
+	0xf3, 0xfa, 0x87, 0x1a, 0xda, 0xdd, 0x15, 0x05, 0xa8, 0xf6, 0x06, 0x1a, 0x6b, 0xf2, 0x21, 0xf0,
+	0x53, 0x49, 0xa9, 0x1a, 0x67, 0x5d, 0x1a, 0xfe, 0xe4, 0x8b, 0xd2, 0x80, 0x29, 0xb8, 0xfa, 0xe7,
+	0xb2, 0x77, 0x35, 0x54, 0x60, 0x48, 0x6f, 0x46, 0x73, 0x5e, 0x10, 0xd6, 0x1d, 0xff, 0x79, 0x5b,
+	0xeb, 0x0f, 0x1a, 0xda, 0xd5, 0x05, 0x03, 0xd8, 0x6a, 0x05, 0x4d, 0x86, 0xd2, 0x38, 0x18, 0x6c,
+	0x6f, 0xa7, 0xc1, 0x24, 0x6e, 0x30, 0x57, 0x8a, 0xb1, 0x7f, 0x36, 0xfb, 0xeb, 0x10, 0x44, 0xec,
+	0x25, 0xc7, 0xb5, 0x1d, 0xb7, 0x96, 0xd7, 0x5e, 0xc7, 0xd0, 0xf6, 0xb0, 0xb5, 0xda, 0x74, 0x28,
+	0x25, 0x41, 0xbc, 0xf7, 0x79, 0x6a, 0x98, 0x89, 0x27, 0x60, 0x97, 0xb7, 0x19, 0x77, 0xe8, 0xa9,
+	0x93, 0xd0, 0x7e, 0x34, 0x69, 0xb7, 0xfc, 0x86, 0x53, 0xb5, 0x28, 0xa9, 0x78, 0x6b, 0x85, 0x61,
+	0x26, 0x6f, 0x22, 0x1e, 0xbb, 0xbe, 0x16, 0xa5, 0x4e, 0x6a, 0x05, 0x35, 0x42, 0x23, 0xd4, 0x23,
+	0x3c, 0x75, 0xf2, 0x81, 0x2b, 0xb6, 0x94, 0xc4, 0x46, 0x53, 0x49, 0x6c, 0x0e, 0x4d, 0x87, 0x64,
+	0x9d, 0x04, 0x0e, 0xdd, 0xa8, 0x34, 0xc8, 0x3a, 0x69, 0x14, 0xc6, 0xd8, 0xfc, 0x94, 0x18, 0xbd,
+	0x1a, 0x0d, 0xe2, 0x8b, 0x68, 0xaa, 0x1a, 0x10, 0x8b, 0x12, 0xbb, 0x62, 0xad, 0x51, 0x12, 0x14,
+	0xc6, 0x99, 0x26, 0xc5, 0x32, 0xaf, 0x53, 0x65, 0x51, 0xa7, 0xca, 0xb7, 0x44, 0x9d, 0x5a, 0x1a,
+	0xbe, 0xff, 0x65, 0x49, 0x33, 0x27, 0x81, 0xed, 0x7c, 0xc4, 0x85, 0x57, 0xd0, 0xb4, 0x58, 0x66,
+	0x95, 0xac, 0x79, 0x01, 0x29, 0x6c, 0xcb, 0xb9, 0x8e, 0x10, 0xbf, 0xc4, 0xd8, 0x92, 0xe4, 0x99,
+	0xf8, 0x2e, 0x49, 0x9e, 0x6b, 0x30, 0xa6, 0x4e, 0x9e, 0xc0, 0x65, 0xc6, 0xa4, 0xfd, 0x4f, 0x9e,
+	0x42, 0x44, 0x12, 0x53, 0x20, 0x4b, 0x8a, 0x29, 0x18, 0x91, 0x92, 0x67, 0xcc, 0x95, 0x24, 0x4f,
+	0x20, 0x52, 0x27, 0x4f, 0xc1, 0x23, 0x28, 0x63, 0x08, 0x17, 0x9c, 0xd0, 0x6f, 0x51, 0x92, 0x13,
+	0xc2, 0xfb, 0xa2, 0x8e, 0xc6, 0x6c, 0x09, 0x06, 0x9b, 0x0f, 0xa9, 0x31, 0x08, 0x1e, 0x41, 0x89,
+	0xcf, 0xa0, 0x91, 0x75, 0x8f, 0x92, 0x68, 0x63, 0x28, 0xf6, 0x39, 0xb0, 0xdc, 0xf6, 0x28, 0x81,
+	0x7d, 0xce, 0x39, 0xf4, 0xef, 0x03, 0xfc, 0xcb, 0x56, 0xf5, 0x8e, 0x94, 0xf3, 0xfb, 0x54, 0xce,
+	0xf5, 0xdf, 0x08, 0x3d, 0xe3, 0xf5, 0x41, 0xcf, 0x25, 0x34, 0x56, 0xe7, 0x43, 0x10, 0x38, 0x7a,
+	0x27, 0x68, 0xce, 0x63, 0x12, 0xbf, 0xc5, 0xcf, 0x6b, 0x22, 0xa1, 0x03, 0x63, 0xff, 0xc2, 0xe8,
+	0xb2, 0x38, 0x31, 0x81, 0x40, 0x6e, 0x83, 0x45, 0x34, 0x26, 0x12, 0x8e, 0xd6, 0xe3, 0xb0, 0x21,
+	0x08, 0xf5, 0xbf, 0x6b, 0x29, 0x7b, 0xc6, 0xea, 0x5e, 0x46, 0x28, 0x88, 0xf5, 0x00, 0x7b, 0xe6,
+	0xd7, 0x58, 0xe2, 0xc5, 0x6f, 0xa1, 0x17, 0xac, 0x6a, 0x95, 0xf8, 0xd4, 0x72, 0xab, 0xa4, 0x12,
+	0x58, 0x94, 0xf0, 0x74, 0xb8, 0xb4, 0x10, 0x91, 0x7e, 0xfe, 0x45, 0x69, 0x37, 0x47, 0x18, 0xda,
+	0x77, 0xca, 0x8e, 0x67, 0x34, 0x2d, 0x5a, 0x2f, 0x5f, 0x25, 0x35, 0xab, 0xba, 0x71, 0x81, 0x54,
+	0x3f, 0x7b, 0x38, 0x8f, 0x40, 0x81, 0x0b, 0xa4, 0x6a, 0x4e, 0x27, 0x2b, 0x99, 0x16, 0x25, 0xfa,
+	0x26, 0x7a, 0x49, 0x04, 0x65, 0xb5, 0xe1, 0x85, 0xad, 0x80, 0xc4, 0x01, 0x51, 0x40, 0x63, 0xde,
+	0x3a, 0x09, 0xec, 0x16, 0x8f, 0xcb, 0x71, 0x53, 0x7c, 0xf6, 0xad, 0xa2, 0x3d, 0x10, 0x55, 0x35,
+	0x25, 0x1d, 0xec, 0x77, 0xf6, 0x09, 0x12, 0x0d, 0x18, 0xed, 0x6b, 0x48, 0x37, 0x6f, 0xa0, 0x59,
+	0x39, 0x71, 0x5c, 0x72, 0xdc, 0x1a, 0x09, 0xfc, 0xc0, 0x71, 0x69, 0xce, 0x6d, 0xbf, 0x8c, 0x4a,
+	0xca, 0x05, 0x40, 0xd3, 0x7d, 0x68, 0x62, 0x2d, 0x19, 0x86, 0x25, 0xe4, 0xa1, 0x18, 0x05, 0x1c,
+	0x76, 0xba, 0xa3, 0xc8, 0x3a, 0x3c, 0x0a, 0x14, 0xdd, 0x16, 0xc8, 0x8d, 0xe2, 0x81, 0xd8, 0xd9,
+	0xb7, 0xea, 0xc4, 0x0b, 0x48, 0xff, 0x6f, 0x02, 0xe7, 0xd0, 0x24, 0xe5, 0x4b, 0x57, 0xe8, 0x86,
+	0xcf, 0xa3, 0x7c, 0xba, 0x5b, 0x6e, 0x03, 0x00, 0xb7, 0x36, 0x7c, 0x62, 0x4e, 0xd0, 0xe4, 0x23,
+	0xa9, 0x5b, 0x09, 0xc4, 0xa4, 0x6e, 0x01, 0x61, 0x46, 0x38, 0x01, 0x97, 0x19, 0x93, 0xf6, 0xbf,
+	0x6e, 0x09, 0x11, 0x89, 0xdf, 0x84, 0xca, 0xe0, 0xb7, 0x61, 0x73, 0x1b, 0x8c, 0x48, 0x75, 0x2b,
+	0xe6, 0x4a, 0x6a, 0x06, 0x10, 0xa9, 0x6b, 0x86, 0xe0, 0x11, 0x94, 0xfa, 0xeb, 0x68, 0xaf, 0xbc,
+	0xd8, 0xd2, 0xc6, 0xb2, 0x67, 0x93, 0xcb, 0x56, 0x58, 0x97, 0x2e, 0x87, 0x55, 0xcf, 0x26, 0x95,
+	0xba, 0x15, 0xd6, 0xc5, 0xe5, 0xb0, 0x0a, 0x34, 0xfa, 0x77, 0x20, 0x06, 0xbb, 0x70, 0x3f, 0x0b,
+	0xa8, 0xf7, 0xb5, 0x34, 0xaa, 0x0b, 0xc4, 0x27, 0xae, 0x4d, 0x5c, 0x1a, 0xe6, 0x33, 0x51, 0xdf,
+	0x92, 0xd1, 0x4f, 0xb4, 0xb4, 0x82, 0x32, 0x10, 0x50, 0xb0, 0x84, 0x26, 0x12, 0x24, 0x3c, 0x8c,
+	0x86, 0x4d, 0x14, 0x43, 0xe9, 0x63, 0xb4, 0x9c, 0x83, 0xfd, 0xda, 0x86, 0xa5, 0xea, 0x90, 0x9c,
+	0x66, 0xd1, 0x97, 0xd1, 0x3e, 0xf5, 0x0a, 0x39, 0xf5, 0x49, 0xae, 0x3d, 0xb0, 0xca, 0x4a, 0x60,
+	0xf9, 0xf5, 0xe7, 0xec, 0x97, 0xcf, 0xc5, 0xb5, 0x27, 0x8d, 0x01, 0x54, 0x38, 0x83, 0x46, 0x5c,
+	0xcf, 0x26, 0x19, 0xf7, 0x1d, 0x60, 0xfb, 0x96, 0x67, 0xc7, 0xe7, 0x20, 0xc6, 0x11, 0xb1, 0x12,
+	0xbb, 0x96, 0x75, 0x84, 0x02, 0xd6, 0x8b, 0x76, 0x2d, 0x66, 0x65, 0x1c, 0x6d, 0x7e, 0x1e, 0x7a,
+	0x7a, 0x3f, 0xff, 0x49, 0x43, 0x13, 0x12, 0x40, 0x3c, 0x8d, 0x06, 0x63, 0x5b, 0x0e, 0x3a, 0x36,
+	0xde, 0x89, 0x46, 0xa8, 0x43, 0x1b, 0x50, 0xf0, 0x4d, 0xfe, 0x81, 0x5f, 0x89, 0x2f, 0x1b, 0x43,
+	0x2c, 0x43, 0x96, 0x94, 0xd0, 0x6f, 0x32, 0xb2, 0xf8, 0x36, 0x32, 0x8b, 0x50, 0xd5, 0x6b, 0xfa,
+	0x0d, 0x72, 0xd7, 0xa1, 0x1b, 0xec, 0x8e, 0x33, 0x64, 0x4a, 0x23, 0xd1, 0x6d, 0xc5, 0x69, 0xfa,
+	0x5e, 0x10, 0x5d, 0x20, 0xaa, 0xd1, 0x5a, 0xec, 0x9e, 0x33, 0x64, 0x4e, 0x89, 0xd1, 0xe5, 0x68,
+	0x50, 0xbf, 0x18, 0x83, 0x8e, 0x4c, 0x83, 0x8b, 0x68, 0x1c, 0xe6, 0x03, 0x80, 0x1e, 0x7f, 0x4b,
+	0x73, 0x36, 0xd3, 0x21, 0x99, 0xb3, 0xf5, 0x4d, 0x38, 0x83, 0xdd, 0x08, 0x3c, 0x6f, 0xed, 0x79,
+	0x6f, 0xf7, 0x9f, 0x69, 0x49, 0x17, 0x86, 0x49, 0x87, 0x80, 0x32, 0xd0, 0xa8, 0xcf, 0x46, 0x20,
+	0xa2, 0x5e, 0xea, 0xda, 0x72, 0xf0, 0xd6, 0x4c, 0x20, 0xeb, 0xdf, 0x9e, 0x2f, 0x43, 0xf7, 0x8d,
+	0x2f, 0x0f, 0xd6, 0xd8, 0xc5, 0x7a, 0x55, 0xde, 0x5a, 0x52, 0xd5, 0xc7, 0xd8, 0xf7, 0x15, 0x5b,
+	0xff, 0x91, 0x26, 0xdb, 0x2f, 0x56, 0x60, 0x1e, 0x8d, 0x30, 0x0a, 0xc8, 0xc1, 0x4a, 0xfc, 0x9c,
+	0x0a, 0x9f, 0x43, 0xe3, 0xd1, 0xa9, 0xce, 0xa9, 0x52, 0xb1, 0x11, 0x66, 0x15, 0x1c, 0xb7, 0x39,
+	0x99, 0x38, 0x6b, 0x09, 0x2e, 0xfd, 0x0a, 0x7a, 0x31, 0x81, 0xb1, 0x5c, 0x6f, 0xb9, 0x77, 0x7a,
+	0x83, 0x8f, 0x02, 0xdb, 0x71, 0x6d, 0x72, 0x97, 0x19, 0x6c, 0xca, 0xe4, 0x1f, 0xfa, 0x77, 0xe1,
+	0x34, 0x2a, 0x2f, 0x05, 0x6a, 0x61, 0x34, 0x6c, 0x5b, 0xd4, 0x62, 0xeb, 0x4c, 0x9a, 0xec, 0xef,
+	0x68, 0x8c, 0x95, 0x2a, 0xbe, 0x39, 0xd8, 0xdf, 0x6c, 0xc7, 0x78, 0xd4, 0x6a, 0xb0, 0xad, 0x31,
+	0x65, 0xf2, 0x0f, 0xfd, 0x3a, 0xe4, 0x90, 0xeb, 0x3e, 0x71, 0xaf, 0x59, 0xb4, 0x1e, 0xed, 0x8b,
+	0xf0, 0x59, 0x4e, 0xfd, 0xdf, 0x83, 0xfe, 0x59, 0xdb, 0x82, 0xf1, 0xd9, 0x75, 0x24, 0xda, 0x71,
+	0x21, 0xf8, 0xa0, 0xcb, 0xfe, 0x4c, 0xf1, 0x89, 0xe4, 0xc2, 0x78, 0xf4, 0x59, 0xb4, 0x47, 0xce,
+	0x77, 0xd1, 0xb9, 0x46, 0x86, 0xab, 0x57, 0xd2, 0x05, 0x53, 0x9a, 0x07, 0xe9, 0xdf, 0x4c, 0xa4,
+	0x2b, 0xae, 0x59, 0xed, 0xac, 0x69, 0x00, 0x0f, 0x35, 0x70, 0xc3, 0x55, 0x62, 0xd9, 0x24, 0x58,
+	0xf5, 0xac, 0xc0, 0x16, 0xb6, 0x3a, 0x8b, 0x46, 0x9b, 0x84, 0x06, 0x4e, 0x95, 0xa9, 0x36, 0xbd,
+	0x78, 0xa0, 0x73, 0x71, 0x89, 0xeb, 0x1a, 0x23, 0x35, 0x81, 0x25, 0xf2, 0x8d, 0x4d, 0x5c, 0xaf,
+	0x29, 0xb2, 0x19, 0xfb, 0xe8, 0x57, 0x0b, 0x47, 0x27, 0x68, 0x46, 0x12, 0x7d, 0xd1, 0xa5, 0xc1,
+	0x46, 0x24, 0x31, 0xac, 0x7a, 0x01, 0x81, 0x3c, 0xc2, 0x3f, 0x12, 0xf7, 0x0c, 0x3e, 0x85, 0x7b,
+	0x3e, 0x14, 0x35, 0x31, 0x65, 0x9d, 0xe4, 0x8e, 0x4b, 0x5c, 0x1a, 0x38, 0x24, 0xc3, 0xf8, 0xed,
+	0x20, 0xc5, 0x1d, 0x17, 0x18, 0xfb, 0x97, 0x50, 0x6e, 0x42, 0x86, 0x33, 0xc9, 0x3b, 0x56, 0x60,
+	0x3f, 0x4b, 0xb8, 0xbf, 0x36, 0xfe, 0xc1, 0x83, 0xd2, 0xc0, 0x7f, 0x1f, 0x94, 0x06, 0xf4, 0x7f,
+	0x88, 0xae, 0x5e, 0xbc, 0x2a, 0xa8, 0xbe, 0x89, 0xa6, 0xf8, 0x66, 0x0f, 0xf8, 0x04, 0x18, 0x60,
+	0x4f, 0x0a, 0xb9, 0xc0, 0x7c, 0x81, 0x54, 0x97, 0x3d, 0xc7, 0x5d, 0x7a, 0x35, 0x52, 0xfd, 0xa3,
+	0x2f, 0x4b, 0xc7, 0x6a, 0x0e, 0xad, 0xb7, 0x56, 0xcb, 0x55, 0xaf, 0x09, 0x8f, 0x2d, 0xf0, 0xdf,
+	0x7c, 0x68, 0xdf, 0x31, 0xa2, 0xcb, 0x40, 0x28, 0x78, 0xc2, 0xdf, 0xff, 0xe7, 0xe3, 0xa3, 0x9a,
+	0x39, 0xe9, 0xf3, 0xac, 0xc7, 0x64, 0xe1, 0x77, 0x35, 0x34, 0x13, 0x57, 0x2e, 0x01, 0x60, 0xf0,
+	0x6b, 0x05, 0xf0, 0x82, 0x90, 0x27, 0x30, 0x9c, 0x41, 0x63, 0xeb, 0x24, 0xa4, 0x8e, 0x5b, 0x83,
+	0x20, 0xee, 0x12, 0x57, 0x9c, 0xf6, 0x36, 0x27, 0x33, 0x05, 0x3d, 0xf6, 0x11, 0x0a, 0x48, 0x83,
+	0x58, 0xa1, 0xb5, 0xda, 0x20, 0x85, 0x61, 0xb8, 0x9e, 0x74, 0xc3, 0xcd, 0x40, 0x9f, 0x06, 0xd0,
+	0x87, 0x73, 0x80, 0x96, 0x10, 0x4b, 0x32, 0xe2, 0x17, 0xa3, 0x1b, 0x96, 0xf4, 0xa4, 0xa3, 0xaf,
+	0x88, 0x9a, 0x68, 0xa5, 0x1e, 0x4c, 0x4e, 0xa0, 0x51, 0xdf, 0x82, 0xe7, 0x92, 0x48, 0xb1, 0x42,
+	0x97, 0x0a, 0xc1, 0x39, 0x80, 0x2e, 0x2e, 0xed, 0x2b, 0x81, 0xf5, 0xfc, 0x4f, 0xf2, 0x71, 0x69,
+	0x17, 0xd2, 0x93, 0xd2, 0x5e, 0x63, 0x23, 0xea, 0xd2, 0xce, 0x38, 0x4c, 0x20, 0xeb, 0xdb, 0x4e,
+	0x5c, 0xfc, 0xaa, 0x84, 0x46, 0x18, 0x22, 0x7c, 0x0f, 0x8d, 0x8b, 0xf7, 0x28, 0x7c, 0xb0, 0x53,
+	0x7e, 0xb7, 0x87, 0xb6, 0xe2, 0xa1, 0x9e, 0x74, 0xf0, 0x54, 0xa7, 0xbf, 0xf7, 0xcf, 0x7f, 0xff,
+	0x62, 0x70, 0x0f, 0x2e, 0x1a, 0x1d, 0x6f, 0x88, 0xf1, 0x2b, 0xd6, 0x8f, 0x35, 0x34, 0x06, 0x8c,
+	0x78, 0x2e, 0x7b, 0x61, 0x21, 0xff, 0x60, 0x2f, 0x32, 0xf1, 0xde, 0xc8, 0xc4, 0x1f, 0xc1, 0x87,
+	0xd4, 0xe2, 0x8d, 0xcd, 0xa4, 0x4b, 0xb1, 0x85, 0x7f, 0xa7, 0xa1, 0xe9, 0xf4, 0xd3, 0x0f, 0x3e,
+	0x9e, 0x2d, 0x2b, 0xfd, 0x4e, 0x55, 0x9c, 0xcf, 0x49, 0x0d, 0x00, 0x5f, 0x61, 0x00, 0x17, 0xb0,
+	0x91, 0x13, 0xa0, 0x21, 0xde, 0x91, 0x7e, 0xab, 0xa1, 0x49, 0xf9, 0xd5, 0x05, 0x1f, 0x55, 0x08,
+	0xee, 0xf2, 0x3c, 0x54, 0x3c, 0x96, 0x8b, 0x16, 0x20, 0xbe, 0xce, 0x20, 0xfe, 0x3f, 0x3e, 0x95,
+	0x17, 0x62, 0xea, 0xed, 0xe6, 0x1e, 0x1a, 0x17, 0x0d, 0x7b, 0x65, 0x74, 0xb5, 0xbd, 0xc6, 0x28,
+	0xa3, 0xab, 0xbd, 0xf3, 0x9f, 0x15, 0x5d, 0x71, 0xdf, 0x2d, 0x8a, 0x2e, 0x60, 0x54, 0x46, 0x57,
+	0xba, 0x73, 0x5f, 0x3c, 0xd8, 0x8b, 0xac, 0x77, 0x74, 0x09, 0xf1, 0xc6, 0x66, 0xd2, 0x89, 0xdb,
+	0xc2, 0x7f, 0xd1, 0x10, 0xee, 0xec, 0xba, 0xe1, 0x13, 0xd9, 0xf2, 0x3a, 0x7b, 0x6b, 0xc5, 0x85,
+	0x27, 0xe0, 0x00, 0xb0, 0x67, 0x19, 0xd8, 0xd3, 0xf8, 0x64, 0x4e, 0xb0, 0x86, 0xd4, 0x67, 0xc3,
+	0x3f, 0xd5, 0xd0, 0x84, 0xd4, 0x11, 0xc5, 0x47, 0x14, 0xf2, 0x3b, 0x7b, 0xb6, 0xc5, 0xa3, 0x79,
+	0x48, 0x01, 0xe3, 0x1c, 0xc3, 0x58, 0xc2, 0x7b, 0x3b, 0x31, 0xda, 0x92, 0xf4, 0x9f, 0x6b, 0x68,
+	0x0c, 0xde, 0x12, 0x94, 0x2e, 0x4d, 0xbf, 0x84, 0x28, 0x5d, 0xda, 0xf6, 0xf2, 0x91, 0xb5, 0x1f,
+	0xbb, 0x5b, 0x49, 0xbc, 0x7e, 0x44, 0xae, 0xed, 0x6c, 0x65, 0x2a, 0x5d, 0xab, 0x6c, 0x9b, 0x2a,
+	0x5d, 0xab, 0xee, 0x93, 0x66, 0xb9, 0xb6, 0xfb, 0x0e, 0x95, 0x5d, 0x7b, 0x0f, 0x8d, 0x8b, 0xce,
+	0xa4, 0x72, 0x83, 0xb6, 0x75, 0x57, 0x95, 0x1b, 0xb4, 0xbd, 0xc5, 0x99, 0xb5, 0x41, 0xe3, 0x7e,
+	0x66, 0xb4, 0x41, 0x81, 0x51, 0xe9, 0xcd, 0x74, 0x8b, 0xb2, 0x78, 0xb0, 0x17, 0x59, 0xef, 0x0d,
+	0x2a, 0xc4, 0x1b, 0x9b, 0x49, 0xfd, 0xdf, 0xc2, 0x1f, 0x6b, 0x68, 0x7b, 0x47, 0x37, 0x11, 0x1b,
+	0xd9, 0xe2, 0x3a, 0xba, 0x96, 0xc5, 0x13, 0xf9, 0x19, 0x00, 0xe9, 0xab, 0x0c, 0xe9, 0x22, 0x3e,
+	0x91, 0x81, 0x34, 0x6e, 0x84, 0x1a, 0x9b, 0xf1, 0x9f, 0x5b, 0xf8, 0x8f, 0x09, 0xe4, 0xa4, 0x3f,
+	0xd8, 0x0b, 0x72, 0x47, 0x4b, 0xb3, 0x17, 0xe4, 0xce, 0xd6, 0xa3, 0xfe, 0x1a, 0x83, 0x7c, 0x0a,
+	0x2f, 0xe6, 0x34, 0xae, 0x61, 0x27, 0xf0, 0xfe, 0xa6, 0xa1, 0x1d, 0x5d, 0xda, 0x80, 0x78, 0x21,
+	0x1f, 0x0a, 0xa9, 0xe9, 0x58, 0x5c, 0x7c, 0x12, 0x96, 0xde, 0x25, 0x2d, 0x1b, 0x3a, 0x03, 0x79,
+	0x5f, 0x43, 0x93, 0x72, 0xe7, 0x4f, 0x59, 0x7a, 0xbb, 0xb4, 0x28, 0x95, 0xa5, 0xb7, 0x5b, 0x2b,
+	0x51, 0x3f, 0xc4, 0x70, 0xee, 0xc7, 0x25, 0x25, 0xce, 0x4a, 0x8d, 0x21, 0x78, 0x07, 0x8d, 0xf2,
+	0xa6, 0x11, 0x7e, 0x59, 0x9d, 0x3e, 0x92, 0x8e, 0x56, 0x71, 0xae, 0x07, 0x15, 0xc8, 0xdf, 0xc7,
+	0xe4, 0x17, 0x71, 0xa1, 0x6b, 0x62, 0x89, 0xc4, 0xdd, 0x43, 0x23, 0x8c, 0x07, 0x1f, 0xc8, 0x5a,
+	0x51, 0x88, 0x7d, 0x39, 0x9b, 0x08, 0xa4, 0x1e, 0x63, 0x52, 0xe7, 0xf0, 0x01, 0x95, 0x54, 0x96,
+	0xcc, 0x58, 0x0f, 0x67, 0x0b, 0xff, 0x4a, 0x43, 0x28, 0xe9, 0xcd, 0xe0, 0xc3, 0x59, 0x12, 0xe4,
+	0x4e, 0x50, 0xf1, 0x48, 0x0e, 0xca, 0xde, 0x91, 0xde, 0x01, 0xc8, 0xa8, 0x46, 0xac, 0xa1, 0xb1,
+	0xc9, 0xda, 0x47, 0x5b, 0xf8, 0xd7, 0x1a, 0x9a, 0x4a, 0x5d, 0xdd, 0xb1, 0x2a, 0x02, 0xba, 0x35,
+	0x82, 0x8a, 0xc7, 0xf3, 0x11, 0x03, 0xd0, 0x45, 0x06, 0xf4, 0x38, 0x3e, 0xda, 0x09, 0xd4, 0xf3,
+	0x89, 0xdb, 0xb4, 0x68, 0xdd, 0x60, 0x2d, 0x03, 0x63, 0x13, 0xae, 0xd1, 0x5b, 0xf8, 0x23, 0x0d,
+	0xcd, 0xb4, 0x37, 0x5f, 0x70, 0x39, 0x3b, 0x4a, 0xdb, 0x1b, 0x40, 0x45, 0x23, 0x37, 0x3d, 0x20,
+	0x3d, 0xcd, 0x90, 0x1a, 0x78, 0x3e, 0x03, 0xa9, 0xfc, 0xf0, 0x56, 0x61, 0xb0, 0x23, 0x6f, 0x4f,
+	0x48, 0xcd, 0x0a, 0xe5, 0x39, 0xa4, 0xb3, 0x4d, 0xa4, 0x3c, 0x87, 0x74, 0xe9, 0x99, 0x64, 0x9d,
+	0x02, 0x62, 0x74, 0x8d, 0x84, 0xcf, 0xd8, 0xe4, 0xdd, 0xa4, 0x2d, 0xfc, 0x81, 0x86, 0xd0, 0xf9,
+	0x46, 0x43, 0xdc, 0xbf, 0x55, 0xdb, 0x2c, 0xdd, 0xfe, 0x50, 0x96, 0xb3, 0xb6, 0x7e, 0x46, 0xd6,
+	0xc6, 0x80, 0x06, 0x83, 0xe4, 0xd7, 0x28, 0x25, 0xb0, 0x9b, 0xaf, 0x3a, 0x25, 0xc8, 0x17, 0x6d,
+	0x75, 0x4a, 0x48, 0x5d, 0xbc, 0x33, 0x53, 0x02, 0x17, 0xf7, 0x43, 0x0d, 0x8d, 0xf2, 0x6b, 0xae,
+	0x52, 0x72, 0xea, 0x0e, 0xae, 0x94, 0x9c, 0xbe, 0x2b, 0xeb, 0xf3, 0x4c, 0xf2, 0x21, 0x3c, 0xd7,
+	0x29, 0x99, 0x5f, 0x8e, 0xd3, 0xa5, 0x7c, 0x13, 0x8d, 0xc1, 0xcf, 0x3d, 0x94, 0x6e, 0x48, 0xff,
+	0xdc, 0x44, 0xe9, 0x86, 0xb6, 0x5f, 0x8d, 0xe8, 0xfb, 0x19, 0x90, 0xdd, 0x78, 0x57, 0x27, 0x10,
+	0xf1, 0xa3, 0x90, 0xf7, 0x34, 0x34, 0xca, 0xd9, 0x94, 0x36, 0x48, 0xfd, 0xcc, 0xa3, 0x38, 0xd7,
+	0x83, 0xaa, 0x77, 0x04, 0x80, 0xe8, 0x24, 0x02, 0x96, 0xde, 0xfc, 0xe4, 0xd1, 0xac, 0xf6, 0xe9,
+	0xa3, 0x59, 0xed, 0xab, 0x47, 0xb3, 0xda, 0xfd, 0xc7, 0xb3, 0x03, 0x9f, 0x3e, 0x9e, 0x1d, 0xf8,
+	0xd7, 0xe3, 0xd9, 0x81, 0xb7, 0x16, 0xa4, 0x2e, 0x0d, 0x5f, 0x68, 0xcd, 0x6b, 0xb9, 0x36, 0xeb,
+	0x0c, 0x88, 0x95, 0xef, 0x8a, 0xb5, 0x59, 0xd3, 0x66, 0x75, 0x94, 0xfd, 0x4e, 0xeb, 0xe4, 0xff,
+	0x02, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x1b, 0xc6, 0x32, 0x0b, 0x2d, 0x00, 0x00,
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Params != nil {
-		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Programs queries all programs based on given status.
+	Programs(ctx context.Context, in *QueryProgramsRequest, opts ...grpc.CallOption) (*QueryProgramsResponse, error)
+	// Program queries program details based on ProgramId.
+	Program(ctx context.Context, in *QueryProgramRequest, opts ...grpc.CallOption) (*QueryProgramResponse, error)
+	// ProgramMembers queries the team members of a program.
+	ProgramMembers(ctx context.Context, in *QueryProgramMembersRequest, opts ...grpc.CallOption) (*QueryProgramMembersResponse, error)
+	// Sponsorships queries the sponsorships of a program.
+	Sponsorships(ctx context.Context, in *QuerySponsorshipsRequest, opts ...grpc.CallOption) (*QuerySponsorshipsResponse, error)
+	// Findings queries findings of a given program.
+	Findings(ctx context.Context, in *QueryFindingsRequest, opts ...grpc.CallOption) (*QueryFindingsResponse, error)
+	// Finding queries Finding information based on programID, FindingId.
+	Finding(ctx context.Context, in *QueryFindingRequest, opts ...grpc.CallOption) (*QueryFindingResponse, error)
+	// FindingFingerprint queries finding fingerprint based on findingId.
+	FindingFingerprint(ctx context.Context, in *QueryFindingFingerprintRequest, opts ...grpc.CallOption) (*QueryFindingFingerprintResponse, error)
+	// Disclosures queries the paid or closed findings under disclosure embargo, ordered by deadline.
+	Disclosures(ctx context.Context, in *QueryDisclosuresRequest, opts ...grpc.CallOption) (*QueryDisclosuresResponse, error)
+	// Dispute queries the dispute of a finding and its votes.
+	Dispute(ctx context.Context, in *QueryDisputeRequest, opts ...grpc.CallOption) (*QueryDisputeResponse, error)
+	// ProgramFingerprint queries program fingerprint based on programId.
+	ProgramFingerprint(ctx context.Context, in *QueryProgramFingerprintRequest, opts ...grpc.CallOption) (*QueryProgramFingerprintResponse, error)
+	// Theorems queries all theorems based on given status.
+	Theorems(ctx context.Context, in *QueryTheoremsRequest, opts ...grpc.CallOption) (*QueryTheoremsResponse, error)
+	// Theorem queries theorem details based on theoremID.
+	Theorem(ctx context.Context, in *QueryTheoremRequest, opts ...grpc.CallOption) (*QueryTheoremResponse, error)
+	// TheoremByCodeHash queries the theorem indexed by the sha256 hash of its normalized code.
+	TheoremByCodeHash(ctx context.Context, in *QueryTheoremByCodeHashRequest, opts ...grpc.CallOption) (*QueryTheoremByCodeHashResponse, error)
+	// TheoremDependents queries the theorems that directly import a theorem.
+	TheoremDependents(ctx context.Context, in *QueryTheoremDependentsRequest, opts ...grpc.CallOption) (*QueryTheoremDependentsResponse, error)
+	// TheoremDependencies queries the transitive closure of the imports of a theorem.
+	TheoremDependencies(ctx context.Context, in *QueryTheoremDependenciesRequest, opts ...grpc.CallOption) (*QueryTheoremDependenciesResponse, error)
+	// TheoremGraph queries the theorem import graph, either of the whole library or of the
+	// dependencies of a theorem.
+	TheoremGraph(ctx context.Context, in *QueryTheoremGraphRequest, opts ...grpc.CallOption) (*QueryTheoremGraphResponse, error)
+	// Proofs queries all proofs based on theorem id.
+	Proofs(ctx context.Context, in *QueryProofsRequest, opts ...grpc.CallOption) (*QueryProofsResponse, error)
+	// Proof queries proof details based on proofID.
+	Proof(ctx context.Context, in *QueryProofRequest, opts ...grpc.CallOption) (*QueryProofResponse, error)
+	// ProofChunk queries a chunk of a proof detail submitted in chunks, decompressed.
+	ProofChunk(ctx context.Context, in *QueryProofChunkRequest, opts ...grpc.CallOption) (*QueryProofChunkResponse, error)
+	// OpenMathStats queries the OpenMath statistics of an address.
+	OpenMathStats(ctx context.Context, in *QueryOpenMathStatsRequest, opts ...grpc.CallOption) (*QueryOpenMathStatsResponse, error)
+	// TheoremTypeStats queries the OpenMath statistics of every theorem type.
+	TheoremTypeStats(ctx context.Context, in *QueryTheoremTypeStatsRequest, opts ...grpc.CallOption) (*QueryTheoremTypeStatsResponse, error)
+	// Leaderboard queries the addresses sorted by descending OpenMath metric.
+	Leaderboard(ctx context.Context, in *QueryLeaderboardRequest, opts ...grpc.CallOption) (*QueryLeaderboardResponse, error)
+	// AllRewards queries all reward details (including imported rewards) based on address.
+	AllRewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error)
+	// Params queries the bounty module parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Grants queries theorem details based on theoremID.
+	Grants(ctx context.Context, in *QueryGrantsRequest, opts ...grpc.CallOption) (*QueryGrantsResponse, error)
+	// Hackers queries the reputation of all finding submitters.
+	Hackers(ctx context.Context, in *QueryHackersRequest, opts ...grpc.CallOption) (*QueryHackersResponse, error)
+	// Hacker queries the reputation of a finding submitter.
+	Hacker(ctx context.Context, in *QueryHackerRequest, opts ...grpc.CallOption) (*QueryHackerResponse, error)
 }
 
-func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+type queryClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *QueryGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
 }
 
-func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.TheoremId != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.TheoremId))
-		i--
-		dAtA[i] = 0x8
+func (c *queryClient) Programs(ctx context.Context, in *QueryProgramsRequest, opts ...grpc.CallOption) (*QueryProgramsResponse, error) {
+	out := new(QueryProgramsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Programs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) Program(ctx context.Context, in *QueryProgramRequest, opts ...grpc.CallOption) (*QueryProgramResponse, error) {
+	out := new(QueryProgramResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Program", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *QueryGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *queryClient) ProgramMembers(ctx context.Context, in *QueryProgramMembersRequest, opts ...grpc.CallOption) (*QueryProgramMembersResponse, error) {
+	out := new(QueryProgramMembersResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/ProgramMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Grants) > 0 {
-		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+func (c *queryClient) Sponsorships(ctx context.Context, in *QuerySponsorshipsRequest, opts ...grpc.CallOption) (*QuerySponsorshipsResponse, error) {
+	out := new(QuerySponsorshipsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Sponsorships", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (c *queryClient) Findings(ctx context.Context, in *QueryFindingsRequest, opts ...grpc.CallOption) (*QueryFindingsResponse, error) {
+	out := new(QueryFindingsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Findings", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return out, nil
 }
-func (m *QueryHostsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (c *queryClient) Finding(ctx context.Context, in *QueryFindingRequest, opts ...grpc.CallOption) (*QueryFindingResponse, error) {
+	out := new(QueryFindingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Finding", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryHostsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) FindingFingerprint(ctx context.Context, in *QueryFindingFingerprintRequest, opts ...grpc.CallOption) (*QueryFindingFingerprintResponse, error) {
+	out := new(QueryFindingFingerprintResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/FindingFingerprint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryHostRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.HostAddr)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Disclosures(ctx context.Context, in *QueryDisclosuresRequest, opts ...grpc.CallOption) (*QueryDisclosuresResponse, error) {
+	out := new(QueryDisclosuresResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Disclosures", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryHostResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryClient) Dispute(ctx context.Context, in *QueryDisputeRequest, opts ...grpc.CallOption) (*QueryDisputeResponse, error) {
+	out := new(QueryDisputeResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Dispute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return out, nil
 }
 
-func (m *QueryProgramsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ProgramFingerprint(ctx context.Context, in *QueryProgramFingerprintRequest, opts ...grpc.CallOption) (*QueryProgramFingerprintResponse, error) {
+	out := new(QueryProgramFingerprintResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/ProgramFingerprint", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProgramsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Programs) > 0 {
-		for _, e := range m.Programs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Theorems(ctx context.Context, in *QueryTheoremsRequest, opts ...grpc.CallOption) (*QueryTheoremsResponse, error) {
+	out := new(QueryTheoremsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Theorems", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProgramRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Theorem(ctx context.Context, in *QueryTheoremRequest, opts ...grpc.CallOption) (*QueryTheoremResponse, error) {
+	out := new(QueryTheoremResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Theorem", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProgramResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Program != nil {
-		l = m.Program.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) TheoremByCodeHash(ctx context.Context, in *QueryTheoremByCodeHashRequest, opts ...grpc.CallOption) (*QueryTheoremByCodeHashResponse, error) {
+	out := new(QueryTheoremByCodeHashResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/TheoremByCodeHash", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFindingsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.SubmitterAddress)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) TheoremDependents(ctx context.Context, in *QueryTheoremDependentsRequest, opts ...grpc.CallOption) (*QueryTheoremDependentsResponse, error) {
+	out := new(QueryTheoremDependentsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/TheoremDependents", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFindingsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Findings) > 0 {
-		for _, e := range m.Findings {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) TheoremDependencies(ctx context.Context, in *QueryTheoremDependenciesRequest, opts ...grpc.CallOption) (*QueryTheoremDependenciesResponse, error) {
+	out := new(QueryTheoremDependenciesResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/TheoremDependencies", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFindingRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) TheoremGraph(ctx context.Context, in *QueryTheoremGraphRequest, opts ...grpc.CallOption) (*QueryTheoremGraphResponse, error) {
+	out := new(QueryTheoremGraphResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/TheoremGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFindingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Finding != nil {
-		l = m.Finding.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Proofs(ctx context.Context, in *QueryProofsRequest, opts ...grpc.CallOption) (*QueryProofsResponse, error) {
+	out := new(QueryProofsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Proofs", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFindingFingerprintRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Proof(ctx context.Context, in *QueryProofRequest, opts ...grpc.CallOption) (*QueryProofResponse, error) {
+	out := new(QueryProofResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Proof", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryFindingFingerprintResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) ProofChunk(ctx context.Context, in *QueryProofChunkRequest, opts ...grpc.CallOption) (*QueryProofChunkResponse, error) {
+	out := new(QueryProofChunkResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/ProofChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProgramFingerprintRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) OpenMathStats(ctx context.Context, in *QueryOpenMathStatsRequest, opts ...grpc.CallOption) (*QueryOpenMathStatsResponse, error) {
+	out := new(QueryOpenMathStatsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/OpenMathStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProgramFingerprintResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) TheoremTypeStats(ctx context.Context, in *QueryTheoremTypeStatsRequest, opts ...grpc.CallOption) (*QueryTheoremTypeStatsResponse, error) {
+	out := new(QueryTheoremTypeStatsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/TheoremTypeStats", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTheoremsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Leaderboard(ctx context.Context, in *QueryLeaderboardRequest, opts ...grpc.CallOption) (*QueryLeaderboardResponse, error) {
+	out := new(QueryLeaderboardResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Leaderboard", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTheoremsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Theorems) > 0 {
-		for _, e := range m.Theorems {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) AllRewards(ctx context.Context, in *QueryRewardsRequest, opts ...grpc.CallOption) (*QueryRewardsResponse, error) {
+	out := new(QueryRewardsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/AllRewards", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTheoremRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TheoremId != 0 {
-		n += 1 + sovQuery(uint64(m.TheoremId))
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryTheoremResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Theorem != nil {
-		l = m.Theorem.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Grants(ctx context.Context, in *QueryGrantsRequest, opts ...grpc.CallOption) (*QueryGrantsResponse, error) {
+	out := new(QueryGrantsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Grants", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProofsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TheoremId != 0 {
-		n += 1 + sovQuery(uint64(m.TheoremId))
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Hackers(ctx context.Context, in *QueryHackersRequest, opts ...grpc.CallOption) (*QueryHackersResponse, error) {
+	out := new(QueryHackersResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Hackers", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProofsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Proofs) > 0 {
-		for _, e := range m.Proofs {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
-	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+func (c *queryClient) Hacker(ctx context.Context, in *QueryHackerRequest, opts ...grpc.CallOption) (*QueryHackerResponse, error) {
+	out := new(QueryHackerResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Query/Hacker", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryProofRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProofId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Programs queries all programs based on given status.
+	Programs(context.Context, *QueryProgramsRequest) (*QueryProgramsResponse, error)
+	// Program queries program details based on ProgramId.
+	Program(context.Context, *QueryProgramRequest) (*QueryProgramResponse, error)
+	// ProgramMembers queries the team members of a program.
+	ProgramMembers(context.Context, *QueryProgramMembersRequest) (*QueryProgramMembersResponse, error)
+	// Sponsorships queries the sponsorships of a program.
+	Sponsorships(context.Context, *QuerySponsorshipsRequest) (*QuerySponsorshipsResponse, error)
+	// Findings queries findings of a given program.
+	Findings(context.Context, *QueryFindingsRequest) (*QueryFindingsResponse, error)
+	// Finding queries Finding information based on programID, FindingId.
+	Finding(context.Context, *QueryFindingRequest) (*QueryFindingResponse, error)
+	// FindingFingerprint queries finding fingerprint based on findingId.
+	FindingFingerprint(context.Context, *QueryFindingFingerprintRequest) (*QueryFindingFingerprintResponse, error)
+	// Disclosures queries the paid or closed findings under disclosure embargo, ordered by deadline.
+	Disclosures(context.Context, *QueryDisclosuresRequest) (*QueryDisclosuresResponse, error)
+	// Dispute queries the dispute of a finding and its votes.
+	Dispute(context.Context, *QueryDisputeRequest) (*QueryDisputeResponse, error)
+	// ProgramFingerprint queries program fingerprint based on programId.
+	ProgramFingerprint(context.Context, *QueryProgramFingerprintRequest) (*QueryProgramFingerprintResponse, error)
+	// Theorems queries all theorems based on given status.
+	Theorems(context.Context, *QueryTheoremsRequest) (*QueryTheoremsResponse, error)
+	// Theorem queries theorem details based on theoremID.
+	Theorem(context.Context, *QueryTheoremRequest) (*QueryTheoremResponse, error)
+	// TheoremByCodeHash queries the theorem indexed by the sha256 hash of its normalized code.
+	TheoremByCodeHash(context.Context, *QueryTheoremByCodeHashRequest) (*QueryTheoremByCodeHashResponse, error)
+	// TheoremDependents queries the theorems that directly import a theorem.
+	TheoremDependents(context.Context, *QueryTheoremDependentsRequest) (*QueryTheoremDependentsResponse, error)
+	// TheoremDependencies queries the transitive closure of the imports of a theorem.
+	TheoremDependencies(context.Context, *QueryTheoremDependenciesRequest) (*QueryTheoremDependenciesResponse, error)
+	// TheoremGraph queries the theorem import graph, either of the whole library or of the
+	// dependencies of a theorem.
+	TheoremGraph(context.Context, *QueryTheoremGraphRequest) (*QueryTheoremGraphResponse, error)
+	// Proofs queries all proofs based on theorem id.
+	Proofs(context.Context, *QueryProofsRequest) (*QueryProofsResponse, error)
+	// Proof queries proof details based on proofID.
+	Proof(context.Context, *QueryProofRequest) (*QueryProofResponse, error)
+	// ProofChunk queries a chunk of a proof detail submitted in chunks, decompressed.
+	ProofChunk(context.Context, *QueryProofChunkRequest) (*QueryProofChunkResponse, error)
+	// OpenMathStats queries the OpenMath statistics of an address.
+	OpenMathStats(context.Context, *QueryOpenMathStatsRequest) (*QueryOpenMathStatsResponse, error)
+	// TheoremTypeStats queries the OpenMath statistics of every theorem type.
+	TheoremTypeStats(context.Context, *QueryTheoremTypeStatsRequest) (*QueryTheoremTypeStatsResponse, error)
+	// Leaderboard queries the addresses sorted by descending OpenMath metric.
+	Leaderboard(context.Context, *QueryLeaderboardRequest) (*QueryLeaderboardResponse, error)
+	// AllRewards queries all reward details (including imported rewards) based on address.
+	AllRewards(context.Context, *QueryRewardsRequest) (*QueryRewardsResponse, error)
+	// Params queries the bounty module parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Grants queries theorem details based on theoremID.
+	Grants(context.Context, *QueryGrantsRequest) (*QueryGrantsResponse, error)
+	// Hackers queries the reputation of all finding submitters.
+	Hackers(context.Context, *QueryHackersRequest) (*QueryHackersResponse, error)
+	// Hacker queries the reputation of a finding submitter.
+	Hacker(context.Context, *QueryHackerRequest) (*QueryHackerResponse, error)
 }
 
-func (m *QueryProofResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Proof != nil {
-		l = m.Proof.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
 }
 
-func (m *QueryRewardsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (*UnimplementedQueryServer) Programs(ctx context.Context, req *QueryProgramsRequest) (*QueryProgramsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Programs not implemented")
+}
+func (*UnimplementedQueryServer) Program(ctx context.Context, req *QueryProgramRequest) (*QueryProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Program not implemented")
+}
+func (*UnimplementedQueryServer) ProgramMembers(ctx context.Context, req *QueryProgramMembersRequest) (*QueryProgramMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProgramMembers not implemented")
+}
+func (*UnimplementedQueryServer) Sponsorships(ctx context.Context, req *QuerySponsorshipsRequest) (*QuerySponsorshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsorships not implemented")
+}
+func (*UnimplementedQueryServer) Findings(ctx context.Context, req *QueryFindingsRequest) (*QueryFindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Findings not implemented")
+}
+func (*UnimplementedQueryServer) Finding(ctx context.Context, req *QueryFindingRequest) (*QueryFindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Finding not implemented")
+}
+func (*UnimplementedQueryServer) FindingFingerprint(ctx context.Context, req *QueryFindingFingerprintRequest) (*QueryFindingFingerprintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindingFingerprint not implemented")
+}
+func (*UnimplementedQueryServer) Disclosures(ctx context.Context, req *QueryDisclosuresRequest) (*QueryDisclosuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Disclosures not implemented")
+}
+func (*UnimplementedQueryServer) Dispute(ctx context.Context, req *QueryDisputeRequest) (*QueryDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Dispute not implemented")
+}
+func (*UnimplementedQueryServer) ProgramFingerprint(ctx context.Context, req *QueryProgramFingerprintRequest) (*QueryProgramFingerprintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProgramFingerprint not implemented")
+}
+func (*UnimplementedQueryServer) Theorems(ctx context.Context, req *QueryTheoremsRequest) (*QueryTheoremsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Theorems not implemented")
+}
+func (*UnimplementedQueryServer) Theorem(ctx context.Context, req *QueryTheoremRequest) (*QueryTheoremResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Theorem not implemented")
+}
+func (*UnimplementedQueryServer) TheoremByCodeHash(ctx context.Context, req *QueryTheoremByCodeHashRequest) (*QueryTheoremByCodeHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TheoremByCodeHash not implemented")
+}
+func (*UnimplementedQueryServer) TheoremDependents(ctx context.Context, req *QueryTheoremDependentsRequest) (*QueryTheoremDependentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TheoremDependents not implemented")
+}
+func (*UnimplementedQueryServer) TheoremDependencies(ctx context.Context, req *QueryTheoremDependenciesRequest) (*QueryTheoremDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TheoremDependencies not implemented")
+}
+func (*UnimplementedQueryServer) TheoremGraph(ctx context.Context, req *QueryTheoremGraphRequest) (*QueryTheoremGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TheoremGraph not implemented")
+}
+func (*UnimplementedQueryServer) Proofs(ctx context.Context, req *QueryProofsRequest) (*QueryProofsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proofs not implemented")
+}
+func (*UnimplementedQueryServer) Proof(ctx context.Context, req *QueryProofRequest) (*QueryProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Proof not implemented")
+}
+func (*UnimplementedQueryServer) ProofChunk(ctx context.Context, req *QueryProofChunkRequest) (*QueryProofChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProofChunk not implemented")
+}
+func (*UnimplementedQueryServer) OpenMathStats(ctx context.Context, req *QueryOpenMathStatsRequest) (*QueryOpenMathStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OpenMathStats not implemented")
+}
+func (*UnimplementedQueryServer) TheoremTypeStats(ctx context.Context, req *QueryTheoremTypeStatsRequest) (*QueryTheoremTypeStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TheoremTypeStats not implemented")
+}
+func (*UnimplementedQueryServer) Leaderboard(ctx context.Context, req *QueryLeaderboardRequest) (*QueryLeaderboardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Leaderboard not implemented")
+}
+func (*UnimplementedQueryServer) AllRewards(ctx context.Context, req *QueryRewardsRequest) (*QueryRewardsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AllRewards not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Grants(ctx context.Context, req *QueryGrantsRequest) (*QueryGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Grants not implemented")
+}
+func (*UnimplementedQueryServer) Hackers(ctx context.Context, req *QueryHackersRequest) (*QueryHackersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hackers not implemented")
+}
+func (*UnimplementedQueryServer) Hacker(ctx context.Context, req *QueryHackerRequest) (*QueryHackerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Hacker not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Programs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProgramsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Programs(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Programs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Programs(ctx, req.(*QueryProgramsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryRewardsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Program_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProgramRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.ProofRewards) > 0 {
-		for _, e := range m.ProofRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryServer).Program(ctx, in)
 	}
-	if len(m.ImportedRewards) > 0 {
-		for _, e := range m.ImportedRewards {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Program",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Program(ctx, req.(*QueryProgramRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_ProgramMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProgramMembersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(QueryServer).ProgramMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/ProgramMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProgramMembers(ctx, req.(*QueryProgramMembersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Sponsorships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Params != nil {
-		l = m.Params.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsorships(ctx, in)
 	}
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Sponsorships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsorships(ctx, req.(*QuerySponsorshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGrantsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func _Query_Findings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.TheoremId != 0 {
-		n += 1 + sovQuery(uint64(m.TheoremId))
+	if interceptor == nil {
+		return srv.(QueryServer).Findings(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Findings",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Findings(ctx, req.(*QueryFindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryGrantsResponse) Size() (n int) {
+func _Query_Finding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Finding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Finding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Finding(ctx, req.(*QueryFindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FindingFingerprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFindingFingerprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FindingFingerprint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/FindingFingerprint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FindingFingerprint(ctx, req.(*QueryFindingFingerprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Disclosures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisclosuresRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Disclosures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Disclosures",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Disclosures(ctx, req.(*QueryDisclosuresRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Dispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDisputeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Dispute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Dispute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Dispute(ctx, req.(*QueryDisputeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProgramFingerprint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProgramFingerprintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProgramFingerprint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/ProgramFingerprint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProgramFingerprint(ctx, req.(*QueryProgramFingerprintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Theorems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTheoremsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Theorems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Theorems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Theorems(ctx, req.(*QueryTheoremsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Theorem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTheoremRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Theorem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Theorem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Theorem(ctx, req.(*QueryTheoremRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TheoremByCodeHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTheoremByCodeHashRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TheoremByCodeHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/TheoremByCodeHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TheoremByCodeHash(ctx, req.(*QueryTheoremByCodeHashRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TheoremDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTheoremDependentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TheoremDependents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/TheoremDependents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TheoremDependents(ctx, req.(*QueryTheoremDependentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TheoremDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTheoremDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TheoremDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/TheoremDependencies",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TheoremDependencies(ctx, req.(*QueryTheoremDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TheoremGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTheoremGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TheoremGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/TheoremGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TheoremGraph(ctx, req.(*QueryTheoremGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proofs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProofsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proofs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Proofs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proofs(ctx, req.(*QueryProofsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Proof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProofRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Proof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Proof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Proof(ctx, req.(*QueryProofRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProofChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProofChunkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProofChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/ProofChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProofChunk(ctx, req.(*QueryProofChunkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OpenMathStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOpenMathStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OpenMathStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/OpenMathStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OpenMathStats(ctx, req.(*QueryOpenMathStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TheoremTypeStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTheoremTypeStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TheoremTypeStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/TheoremTypeStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TheoremTypeStats(ctx, req.(*QueryTheoremTypeStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Leaderboard_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLeaderboardRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Leaderboard(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Leaderboard",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Leaderboard(ctx, req.(*QueryLeaderboardRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AllRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AllRewards(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/AllRewards",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AllRewards(ctx, req.(*QueryRewardsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Grants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Grants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Grants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Grants(ctx, req.(*QueryGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Hackers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHackersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Hackers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Hackers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Hackers(ctx, req.(*QueryHackersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Hacker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHackerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Hacker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Query/Hacker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Hacker(ctx, req.(*QueryHackerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "shentu.bounty.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Programs",
+			Handler:    _Query_Programs_Handler,
+		},
+		{
+			MethodName: "Program",
+			Handler:    _Query_Program_Handler,
+		},
+		{
+			MethodName: "ProgramMembers",
+			Handler:    _Query_ProgramMembers_Handler,
+		},
+		{
+			MethodName: "Sponsorships",
+			Handler:    _Query_Sponsorships_Handler,
+		},
+		{
+			MethodName: "Findings",
+			Handler:    _Query_Findings_Handler,
+		},
+		{
+			MethodName: "Finding",
+			Handler:    _Query_Finding_Handler,
+		},
+		{
+			MethodName: "FindingFingerprint",
+			Handler:    _Query_FindingFingerprint_Handler,
+		},
+		{
+			MethodName: "Disclosures",
+			Handler:    _Query_Disclosures_Handler,
+		},
+		{
+			MethodName: "Dispute",
+			Handler:    _Query_Dispute_Handler,
+		},
+		{
+			MethodName: "ProgramFingerprint",
+			Handler:    _Query_ProgramFingerprint_Handler,
+		},
+		{
+			MethodName: "Theorems",
+			Handler:    _Query_Theorems_Handler,
+		},
+		{
+			MethodName: "Theorem",
+			Handler:    _Query_Theorem_Handler,
+		},
+		{
+			MethodName: "TheoremByCodeHash",
+			Handler:    _Query_TheoremByCodeHash_Handler,
+		},
+		{
+			MethodName: "TheoremDependents",
+			Handler:    _Query_TheoremDependents_Handler,
+		},
+		{
+			MethodName: "TheoremDependencies",
+			Handler:    _Query_TheoremDependencies_Handler,
+		},
+		{
+			MethodName: "TheoremGraph",
+			Handler:    _Query_TheoremGraph_Handler,
+		},
+		{
+			MethodName: "Proofs",
+			Handler:    _Query_Proofs_Handler,
+		},
+		{
+			MethodName: "Proof",
+			Handler:    _Query_Proof_Handler,
+		},
+		{
+			MethodName: "ProofChunk",
+			Handler:    _Query_ProofChunk_Handler,
+		},
+		{
+			MethodName: "OpenMathStats",
+			Handler:    _Query_OpenMathStats_Handler,
+		},
+		{
+			MethodName: "TheoremTypeStats",
+			Handler:    _Query_TheoremTypeStats_Handler,
+		},
+		{
+			MethodName: "Leaderboard",
+			Handler:    _Query_Leaderboard_Handler,
+		},
+		{
+			MethodName: "AllRewards",
+			Handler:    _Query_AllRewards_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Grants",
+			Handler:    _Query_Grants_Handler,
+		},
+		{
+			MethodName: "Hackers",
+			Handler:    _Query_Hackers_Handler,
+		},
+		{
+			MethodName: "Hacker",
+			Handler:    _Query_Hacker_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shentu/bounty/v1/query.proto",
+}
+
+func (m *QueryHostsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HostAddr) > 0 {
+		i -= len(m.HostAddr)
+		copy(dAtA[i:], m.HostAddr)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.HostAddr)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHostResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHostResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHostResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProgramsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProgramsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProgramsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AdminAddress) > 0 {
+		i -= len(m.AdminAddress)
+		copy(dAtA[i:], m.AdminAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AdminAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProgramsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProgramsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProgramsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Programs) > 0 {
+		for iNdEx := len(m.Programs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Programs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProgramRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProgramRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProgramRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProgramResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProgramResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Program != nil {
+		{
+			size, err := m.Program.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProgramMembersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProgramMembersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProgramMembersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProgramMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProgramMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProgramMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySponsorshipsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySponsorshipsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySponsorshipsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sponsorships) > 0 {
+		for iNdEx := len(m.Sponsorships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsorships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindingsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindingsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindingsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedBefore != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CreatedBefore, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedBefore):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.CreatedAfter != nil {
+		n9, err9 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.CreatedAfter, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedAfter):])
+		if err9 != nil {
+			return 0, err9
+		}
+		i -= n9
+		i = encodeVarintQuery(dAtA, i, uint64(n9))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.SeverityLevel) > 0 {
+		i -= len(m.SeverityLevel)
+		copy(dAtA[i:], m.SeverityLevel)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SeverityLevel)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Status) > 0 {
+		i -= len(m.Status)
+		copy(dAtA[i:], m.Status)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Status)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.TargetId) > 0 {
+		i -= len(m.TargetId)
+		copy(dAtA[i:], m.TargetId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TargetId)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DuplicateOf) > 0 {
+		i -= len(m.DuplicateOf)
+		copy(dAtA[i:], m.DuplicateOf)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DuplicateOf)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.SubmitterAddress) > 0 {
+		i -= len(m.SubmitterAddress)
+		copy(dAtA[i:], m.SubmitterAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SubmitterAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindingsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindingsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindingsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Findings) > 0 {
+		for iNdEx := len(m.Findings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Findings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindingRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindingRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindingRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Finding != nil {
+		{
+			size, err := m.Finding.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisputeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisputeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisputeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Votes) > 0 {
+		for iNdEx := len(m.Votes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Votes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Dispute != nil {
+		{
+			size, err := m.Dispute.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHackersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHackersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHackersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHackersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHackersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHackersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hackers) > 0 {
+		for iNdEx := len(m.Hackers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hackers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHackerRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHackerRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHackerRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHackerResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHackerResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHackerResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AcceptanceRate.Size()
+		i -= size
+		if _, err := m.AcceptanceRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Reputation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisclosuresRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisclosuresRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisclosuresRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Overdue {
+		i--
+		if m.Overdue {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDisclosuresResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDisclosuresResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDisclosuresResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Findings) > 0 {
+		for iNdEx := len(m.Findings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Findings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindingFingerprintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindingFingerprintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindingFingerprintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFindingFingerprintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFindingFingerprintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFindingFingerprintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProgramFingerprintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProgramFingerprintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProgramFingerprintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProgramFingerprintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProgramFingerprintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProgramFingerprintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TheoremType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TheoremType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Theorems) > 0 {
+		for iNdEx := len(m.Theorems) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Theorems[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Theorem != nil {
+		{
+			size, err := m.Theorem.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremByCodeHashRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremByCodeHashRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremByCodeHashRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CodeHash) > 0 {
+		i -= len(m.CodeHash)
+		copy(dAtA[i:], m.CodeHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CodeHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremByCodeHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremByCodeHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremByCodeHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Theorem != nil {
+		{
+			size, err := m.Theorem.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremDependentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremDependentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremDependentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremDependentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremDependentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremDependentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TheoremIds) > 0 {
+		dAtA26 := make([]byte, len(m.TheoremIds)*10)
+		var j25 int
+		for _, num := range m.TheoremIds {
+			for num >= 1<<7 {
+				dAtA26[j25] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j25++
+			}
+			dAtA26[j25] = uint8(num)
+			j25++
+		}
+		i -= j25
+		copy(dAtA[i:], dAtA26[:j25])
+		i = encodeVarintQuery(dAtA, i, uint64(j25))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremDependenciesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremDependenciesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremDependenciesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremDependenciesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremDependenciesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremDependenciesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TheoremIds) > 0 {
+		dAtA28 := make([]byte, len(m.TheoremIds)*10)
+		var j27 int
+		for _, num := range m.TheoremIds {
+			for num >= 1<<7 {
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j27++
+			}
+			dAtA28[j27] = uint8(num)
+			j27++
+		}
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintQuery(dAtA, i, uint64(j27))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremGraphRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremGraphRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremGraphRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremGraphResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremGraphResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremGraphResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Edges) > 0 {
+		for iNdEx := len(m.Edges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Edges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TheoremNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TheoremNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TheoremNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ImportedCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ImportedCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Complexity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Complexity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Status != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TheoremEdge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TheoremEdge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TheoremEdge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Imported != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Imported))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Importer != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Importer))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProofsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProofsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProofsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProofsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProofsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProofsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proofs) > 0 {
+		for iNdEx := len(m.Proofs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proofs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProofRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProofRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProofRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ProofId) > 0 {
+		i -= len(m.ProofId)
+		copy(dAtA[i:], m.ProofId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Verdicts) > 0 {
+		for iNdEx := len(m.Verdicts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Verdicts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Proof != nil {
+		{
+			size, err := m.Proof.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProofChunkRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProofChunkRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProofChunkRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProofId) > 0 {
+		i -= len(m.ProofId)
+		copy(dAtA[i:], m.ProofId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ProofId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProofChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProofChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProofChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenMathStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenMathStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenMathStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOpenMathStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOpenMathStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOpenMathStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremTypeStatsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremTypeStatsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremTypeStatsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTheoremTypeStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTheoremTypeStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTheoremTypeStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for iNdEx := len(m.Stats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Stats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaderboardRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaderboardRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaderboardRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Metric != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Metric))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *LeaderboardEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LeaderboardEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LeaderboardEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Score != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLeaderboardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLeaderboardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLeaderboardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Releasable) > 0 {
+		for iNdEx := len(m.Releasable) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Releasable[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Vesting != nil {
+		{
+			size, err := m.Vesting.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ImportedRewards) > 0 {
+		for iNdEx := len(m.ImportedRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ImportedRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.ProofRewards) > 0 {
+		for iNdEx := len(m.ProofRewards) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ProofRewards[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryHostsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHostsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryHostRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.HostAddr)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHostResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProgramsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AdminAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProgramsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Programs) > 0 {
+		for _, e := range m.Programs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProgramRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Program != nil {
+		l = m.Program.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProgramMembersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProgramMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySponsorshipsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Sponsorships) > 0 {
+		for _, e := range m.Sponsorships {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFindingsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SubmitterAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.DuplicateOf)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TargetId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Status)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SeverityLevel)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedAfter != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedAfter)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.CreatedBefore != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.CreatedBefore)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFindingsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Findings) > 0 {
+		for _, e := range m.Findings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFindingRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Finding != nil {
+		l = m.Finding.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDisputeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Dispute != nil {
+		l = m.Dispute.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Votes) > 0 {
+		for _, e := range m.Votes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryHackersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHackersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hackers) > 0 {
+		for _, e := range m.Hackers {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHackerRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHackerResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Reputation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AcceptanceRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDisclosuresRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Overdue {
+		n += 2
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDisclosuresResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Findings) > 0 {
+		for _, e := range m.Findings {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFindingFingerprintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFindingFingerprintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProgramFingerprintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProgramFingerprintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTheoremsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.TheoremType != 0 {
+		n += 1 + sovQuery(uint64(m.TheoremType))
+	}
+	return n
+}
+
+func (m *QueryTheoremsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Theorems) > 0 {
+		for _, e := range m.Theorems {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTheoremRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovQuery(uint64(m.TheoremId))
+	}
+	return n
+}
+
+func (m *QueryTheoremResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Theorem != nil {
+		l = m.Theorem.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTheoremByCodeHashRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.CodeHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTheoremByCodeHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Theorem != nil {
+		l = m.Theorem.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTheoremDependentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovQuery(uint64(m.TheoremId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTheoremDependentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TheoremIds) > 0 {
+		l = 0
+		for _, e := range m.TheoremIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTheoremDependenciesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovQuery(uint64(m.TheoremId))
+	}
+	return n
+}
+
+func (m *QueryTheoremDependenciesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TheoremIds) > 0 {
+		l = 0
+		for _, e := range m.TheoremIds {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *QueryTheoremGraphRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovQuery(uint64(m.TheoremId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTheoremGraphResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Edges) > 0 {
+		for _, e := range m.Edges {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TheoremNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQuery(uint64(m.Status))
+	}
+	if m.Complexity != 0 {
+		n += 1 + sovQuery(uint64(m.Complexity))
+	}
+	if m.ImportedCount != 0 {
+		n += 1 + sovQuery(uint64(m.ImportedCount))
+	}
+	return n
+}
+
+func (m *TheoremEdge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Importer != 0 {
+		n += 1 + sovQuery(uint64(m.Importer))
+	}
+	if m.Imported != 0 {
+		n += 1 + sovQuery(uint64(m.Imported))
+	}
+	return n
+}
+
+func (m *QueryProofsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovQuery(uint64(m.TheoremId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProofsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proofs) > 0 {
+		for _, e := range m.Proofs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProofRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProofId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Proof != nil {
+		l = m.Proof.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Verdicts) > 0 {
+		for _, e := range m.Verdicts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryProofChunkRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProofId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryProofChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovQuery(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *QueryOpenMathStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOpenMathStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryTheoremTypeStatsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTheoremTypeStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Stats) > 0 {
+		for _, e := range m.Stats {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryLeaderboardRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Metric != 0 {
+		n += 1 + sovQuery(uint64(m.Metric))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *LeaderboardEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Score != 0 {
+		n += 1 + sovQuery(uint64(m.Score))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLeaderboardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRewardsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ProofRewards) > 0 {
+		for _, e := range m.ProofRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.ImportedRewards) > 0 {
+		for _, e := range m.ImportedRewards {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Vesting != nil {
+		l = m.Vesting.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Releasable) > 0 {
+		for _, e := range m.Releasable {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovQuery(uint64(m.TheoremId))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGrantsResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
-	var l int
-	_ = l
-	if len(m.Grants) > 0 {
-		for _, e := range m.Grants {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryHostsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HostAddr", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HostAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHostResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHostResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProgramsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProgramsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProgramsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AdminAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AdminAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProgramsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProgramsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProgramsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Programs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Programs = append(m.Programs, &Program{})
+			if err := m.Programs[len(m.Programs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProgramRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProgramRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProgramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Program == nil {
+				m.Program = &Program{}
+			}
+			if err := m.Program.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProgramMembersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProgramMembersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProgramMembersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProgramMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProgramMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProgramMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, ProgramMember{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorshipsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorshipsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorshipsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySponsorshipsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySponsorshipsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySponsorshipsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsorships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsorships = append(m.Sponsorships, Sponsorship{})
+			if err := m.Sponsorships[len(m.Sponsorships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindingsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindingsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitterAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubmitterAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateOf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DuplicateOf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeverityLevel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAfter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAfter == nil {
+				m.CreatedAfter = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CreatedAfter, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedBefore", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedBefore == nil {
+				m.CreatedBefore = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.CreatedBefore, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindingsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindingsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Findings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Findings = append(m.Findings, &Finding{})
+			if err := m.Findings[len(m.Findings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindingRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindingRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Finding", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Finding == nil {
+				m.Finding = &Finding{}
+			}
+			if err := m.Finding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisputeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisputeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisputeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisputeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisputeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispute", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Dispute == nil {
+				m.Dispute = &Dispute{}
+			}
+			if err := m.Dispute.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Votes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Votes = append(m.Votes, DisputeVote{})
+			if err := m.Votes[len(m.Votes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHackersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHackersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHackersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHackersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHackersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHackersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hackers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hackers = append(m.Hackers, HackerReputation{})
+			if err := m.Hackers[len(m.Hackers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHackerRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHackerRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHackerRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHackerResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHackerResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHackerResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reputation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Reputation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptanceRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AcceptanceRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisclosuresRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisclosuresRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisclosuresRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Overdue", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Overdue = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDisclosuresResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDisclosuresResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDisclosuresResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Findings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Findings = append(m.Findings, Finding{})
+			if err := m.Findings[len(m.Findings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindingFingerprintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindingFingerprintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindingFingerprintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFindingFingerprintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFindingFingerprintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFindingFingerprintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProgramFingerprintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProgramFingerprintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProgramFingerprintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProgramFingerprintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProgramFingerprintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProgramFingerprintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTheoremsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTheoremsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTheoremsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremType", wireType)
+			}
+			m.TheoremType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremType |= TheoremType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTheoremsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTheoremsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTheoremsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Theorems", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Theorems = append(m.Theorems, &Theorem{})
+			if err := m.Theorems[len(m.Theorems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTheoremRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTheoremRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTheoremRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+			}
+			m.TheoremId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTheoremResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTheoremResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTheoremResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Theorem", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Theorem == nil {
+				m.Theorem = &Theorem{}
+			}
+			if err := m.Theorem.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTheoremByCodeHashRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTheoremByCodeHashRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTheoremByCodeHashRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryHostsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTheoremByCodeHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3627,12 +11357,48 @@ func (m *QueryHostsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTheoremByCodeHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTheoremByCodeHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Theorem", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Theorem == nil {
+				m.Theorem = &Theorem{}
+			}
+			if err := m.Theorem.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3654,7 +11420,7 @@ func (m *QueryHostsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTheoremDependentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3677,12 +11443,67 @@ func (m *QueryHostsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTheoremDependentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTheoremDependentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+			}
+			m.TheoremId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3704,7 +11525,7 @@ func (m *QueryHostsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTheoremDependentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3727,17 +11548,93 @@ func (m *QueryHostRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTheoremDependentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTheoremDependentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TheoremIds = append(m.TheoremIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TheoremIds) == 0 {
+					m.TheoremIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TheoremIds = append(m.TheoremIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremIds", wireType)
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HostAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3747,23 +11644,27 @@ func (m *QueryHostRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HostAddr = string(dAtA[iNdEx:postIndex])
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3786,7 +11687,7 @@ func (m *QueryHostRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryHostResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTheoremDependenciesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3809,12 +11710,31 @@ func (m *QueryHostResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryHostResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTheoremDependenciesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryHostResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTheoremDependenciesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+			}
+			m.TheoremId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3836,7 +11756,7 @@ func (m *QueryHostResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProgramsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTheoremDependenciesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3859,48 +11779,88 @@ func (m *QueryProgramsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProgramsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTheoremDependenciesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProgramsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTheoremDependenciesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
+				m.TheoremIds = append(m.TheoremIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
 				}
+				elementCount = count
+				if elementCount != 0 && len(m.TheoremIds) == 0 {
+					m.TheoremIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TheoremIds = append(m.TheoremIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremIds", wireType)
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -3922,7 +11882,7 @@ func (m *QueryProgramsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProgramsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTheoremGraphRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3945,17 +11905,17 @@ func (m *QueryProgramsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProgramsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTheoremGraphRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProgramsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTheoremGraphRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Programs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
 			}
-			var msglen int
+			m.TheoremId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -3965,26 +11925,11 @@ func (m *QueryProgramsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Programs = append(m.Programs, &Program{})
-			if err := m.Programs[len(m.Programs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				m.TheoremId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
@@ -4015,7 +11960,7 @@ func (m *QueryProgramsResponse) Unmarshal(dAtA []byte) error {
 				return io.ErrUnexpectedEOF
 			}
 			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
+				m.Pagination = &query.PageRequest{}
 			}
 			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
@@ -4042,7 +11987,7 @@ func (m *QueryProgramsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProgramRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTheoremGraphResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4065,17 +12010,17 @@ func (m *QueryProgramRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProgramRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTheoremGraphResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProgramRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTheoremGraphResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4085,77 +12030,63 @@ func (m *QueryProgramRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Nodes = append(m.Nodes, TheoremNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Edges", wireType)
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProgramResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Edges = append(m.Edges, TheoremEdge{})
+			if err := m.Edges[len(m.Edges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProgramResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Program", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4182,10 +12113,10 @@ func (m *QueryProgramResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Program == nil {
-				m.Program = &Program{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Program.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4210,7 +12141,7 @@ func (m *QueryProgramResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindingsRequest) Unmarshal(dAtA []byte) error {
+func (m *TheoremNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4233,17 +12164,17 @@ func (m *QueryFindingsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindingsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TheoremNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindingsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TheoremNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4253,27 +12184,14 @@ func (m *QueryFindingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SubmitterAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4301,13 +12219,13 @@ func (m *QueryFindingsRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SubmitterAddress = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			m.Status = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4317,28 +12235,49 @@ func (m *QueryFindingsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Status |= TheoremStatus(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Complexity", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.Complexity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Complexity |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ImportedCount", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ImportedCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ImportedCount |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4360,7 +12299,7 @@ func (m *QueryFindingsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindingsResponse) Unmarshal(dAtA []byte) error {
+func (m *TheoremEdge) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4383,17 +12322,17 @@ func (m *QueryFindingsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindingsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: TheoremEdge: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindingsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TheoremEdge: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Findings", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Importer", wireType)
 			}
-			var msglen int
+			m.Importer = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4403,31 +12342,16 @@ func (m *QueryFindingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Importer |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Findings = append(m.Findings, &Finding{})
-			if err := m.Findings[len(m.Findings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Imported", wireType)
 			}
-			var msglen int
+			m.Imported = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4437,28 +12361,11 @@ func (m *QueryFindingsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Imported |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4480,7 +12387,7 @@ func (m *QueryFindingsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindingRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProofsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4503,17 +12410,36 @@ func (m *QueryFindingRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindingRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProofsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindingRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProofsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+			}
+			m.TheoremId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4523,23 +12449,27 @@ func (m *QueryFindingRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.FindingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4562,7 +12492,7 @@ func (m *QueryFindingRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindingResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProofsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4585,15 +12515,15 @@ func (m *QueryFindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProofsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProofsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Finding", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4620,10 +12550,44 @@ func (m *QueryFindingResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Finding == nil {
-				m.Finding = &Finding{}
+			m.Proofs = append(m.Proofs, &Proof{})
+			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Finding.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4648,7 +12612,7 @@ func (m *QueryFindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindingFingerprintRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProofRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4671,15 +12635,15 @@ func (m *QueryFindingFingerprintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindingFingerprintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProofRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindingFingerprintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4707,7 +12671,7 @@ func (m *QueryFindingFingerprintRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FindingId = string(dAtA[iNdEx:postIndex])
+			m.ProofId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4730,7 +12694,7 @@ func (m *QueryFindingFingerprintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryFindingFingerprintResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4753,17 +12717,17 @@ func (m *QueryFindingFingerprintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryFindingFingerprintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryFindingFingerprintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -4773,23 +12737,61 @@ func (m *QueryFindingFingerprintResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			if m.Proof == nil {
+				m.Proof = &Proof{}
+			}
+			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Verdicts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Verdicts = append(m.Verdicts, ProofVerdict{})
+			if err := m.Verdicts[len(m.Verdicts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4812,7 +12814,7 @@ func (m *QueryFindingFingerprintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProgramFingerprintRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryProofChunkRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4835,15 +12837,15 @@ func (m *QueryProgramFingerprintRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProgramFingerprintRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProofChunkRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProgramFingerprintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProofChunkRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4871,8 +12873,27 @@ func (m *QueryProgramFingerprintRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			m.ProofId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4894,7 +12915,7 @@ func (m *QueryProgramFingerprintRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProgramFingerprintResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryProofChunkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4917,15 +12938,49 @@ func (m *QueryProgramFingerprintResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProgramFingerprintResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryProofChunkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProgramFingerprintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryProofChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4953,8 +13008,27 @@ func (m *QueryProgramFingerprintResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			m.Hash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -4976,7 +13050,7 @@ func (m *QueryProgramFingerprintResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTheoremsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryOpenMathStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4999,17 +13073,17 @@ func (m *QueryTheoremsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTheoremsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryOpenMathStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTheoremsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryOpenMathStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5019,27 +13093,23 @@ func (m *QueryTheoremsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5062,7 +13132,7 @@ func (m *QueryTheoremsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTheoremsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryOpenMathStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5072,62 +13142,28 @@ func (m *QueryTheoremsResponse) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowQuery
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTheoremsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTheoremsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Theorems", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Theorems = append(m.Theorems, &Theorem{})
-			if err := m.Theorems[len(m.Theorems)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 2:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOpenMathStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOpenMathStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5154,10 +13190,7 @@ func (m *QueryTheoremsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5182,7 +13215,7 @@ func (m *QueryTheoremsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTheoremRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryTheoremTypeStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5205,31 +13238,12 @@ func (m *QueryTheoremRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTheoremRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTheoremTypeStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTheoremRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTheoremTypeStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
-			}
-			m.TheoremId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TheoremId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5251,7 +13265,7 @@ func (m *QueryTheoremRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryTheoremResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTheoremTypeStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5274,15 +13288,15 @@ func (m *QueryTheoremResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryTheoremResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTheoremTypeStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryTheoremResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTheoremTypeStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Theorem", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5309,10 +13323,8 @@ func (m *QueryTheoremResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Theorem == nil {
-				m.Theorem = &Theorem{}
-			}
-			if err := m.Theorem.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Stats = append(m.Stats, TheoremTypeStats{})
+			if err := m.Stats[len(m.Stats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5337,7 +13349,7 @@ func (m *QueryTheoremResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProofsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaderboardRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5360,17 +13372,17 @@ func (m *QueryProofsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProofsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaderboardRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProofsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaderboardRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metric", wireType)
 			}
-			m.TheoremId = 0
+			m.Metric = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5380,12 +13392,44 @@ func (m *QueryProofsRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TheoremId |= uint64(b&0x7F) << shift
+				m.Metric |= LeaderboardMetric(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
@@ -5442,7 +13486,7 @@ func (m *QueryProofsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProofsResponse) Unmarshal(dAtA []byte) error {
+func (m *LeaderboardEntry) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5465,17 +13509,17 @@ func (m *QueryProofsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProofsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: LeaderboardEntry: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProofsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: LeaderboardEntry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proofs", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
 			}
-			var msglen int
+			m.Score = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5485,29 +13529,14 @@ func (m *QueryProofsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Score |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proofs = append(m.Proofs, &Proof{})
-			if err := m.Proofs[len(m.Proofs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5534,10 +13563,7 @@ func (m *QueryProofsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5562,7 +13588,7 @@ func (m *QueryProofsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryProofRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryLeaderboardResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5585,17 +13611,17 @@ func (m *QueryProofRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProofRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryLeaderboardResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProofRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryLeaderboardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -5605,77 +13631,29 @@ func (m *QueryProofRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
+			m.Entries = append(m.Entries, LeaderboardEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryProofResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryProofResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5702,10 +13680,10 @@ func (m *QueryProofResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Proof == nil {
-				m.Proof = &Proof{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.Proof.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5870,7 +13848,7 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofRewards = append(m.ProofRewards, types.DecCoin{})
+			m.ProofRewards = append(m.ProofRewards, types1.DecCoin{})
 			if err := m.ProofRewards[len(m.ProofRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -5904,11 +13882,81 @@ func (m *QueryRewardsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImportedRewards = append(m.ImportedRewards, types.DecCoin{})
+			m.ImportedRewards = append(m.ImportedRewards, types1.DecCoin{})
 			if err := m.ImportedRewards[len(m.ImportedRewards)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Vesting == nil {
+				m.Vesting = &RewardVesting{}
+			}
+			if err := m.Vesting.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Releasable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Releasable = append(m.Releasable, types1.Coin{})
+			if err := m.Releasable[len(m.Releasable)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
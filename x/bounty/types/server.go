@@ -3,6 +3,8 @@ package types
 import (
 	"time"
 
+	"cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -36,7 +38,7 @@ func NewFinding(pid, fid, title, detail, hash string, operator sdk.AccAddress, c
 	}
 }
 
-func NewTheorem(id uint64, proposer sdk.AccAddress, title, desc, code string, requireOpenMathCert bool, submitTime, endTime time.Time) Theorem {
+func NewTheorem(id uint64, proposer sdk.AccAddress, title, desc, code string, theoremType TheoremType, requireOpenMathCert bool, submitTime, endTime time.Time) Theorem {
 	return Theorem{
 		Id:                  id,
 		Title:               title,
@@ -47,12 +49,14 @@ func NewTheorem(id uint64, proposer sdk.AccAddress, title, desc, code string, re
 		EndTime:             &endTime,
 		Proposer:            proposer.String(),
 		RequireOpenmathCert: requireOpenMathCert,
+		TheoremType:         theoremType,
 	}
 }
 
-func NewProof(theoremID uint64, proofHash, prover string, submitTime, endTime time.Time, deposit sdk.Coins) Proof {
+func NewProof(theoremID, sequence uint64, proofHash, prover string, submitTime, endTime time.Time, deposit sdk.Coins) Proof {
 	return Proof{
 		TheoremId:  theoremID,
+		Sequence:   sequence,
 		Id:         proofHash,
 		Status:     ProofStatus_PROOF_STATUS_HASH_LOCK_PERIOD,
 		SubmitTime: &submitTime,
@@ -62,11 +66,68 @@ func NewProof(theoremID uint64, proofHash, prover string, submitTime, endTime ti
 	}
 }
 
-func NewGrant(theoremID uint64, grantor sdk.AccAddress, amount sdk.Coins) Grant {
+func NewGrant(theoremID uint64, grantor sdk.AccAddress, amount sdk.Coins, source GrantSource) Grant {
 	return Grant{
 		TheoremId: theoremID,
 		Grantor:   grantor.String(),
 		Amount:    amount,
+		Source:    source,
+	}
+}
+
+func NewVestingSchedule(total sdk.Coins, startTime, endTime time.Time) VestingSchedule {
+	return VestingSchedule{
+		Total:     total,
+		Released:  sdk.NewCoins(),
+		StartTime: startTime,
+		EndTime:   endTime,
+	}
+}
+
+// VestedCoins returns the amount of the schedule vested at the given time, linearly between its
+// start and end times and truncated.
+func (v VestingSchedule) VestedCoins(blockTime time.Time) sdk.Coins {
+	if !blockTime.Before(v.EndTime) {
+		return v.Total
+	}
+	if !blockTime.After(v.StartTime) {
+		return sdk.NewCoins()
+	}
+
+	elapsed := math.NewInt(int64(blockTime.Sub(v.StartTime)))
+	period := math.NewInt(int64(v.EndTime.Sub(v.StartTime)))
+	vested := sdk.NewCoins()
+	for _, coin := range v.Total {
+		vested = vested.Add(sdk.NewCoin(coin.Denom, coin.Amount.Mul(elapsed).Quo(period)))
+	}
+	return vested
+}
+
+// ReleasableCoins returns the vested amount of the schedule not released yet.
+func (v VestingSchedule) ReleasableCoins(blockTime time.Time) sdk.Coins {
+	releasable := sdk.NewCoins()
+	for _, coin := range v.VestedCoins(blockTime) {
+		if amount := coin.Amount.Sub(v.Released.AmountOf(coin.Denom)); amount.IsPositive() {
+			releasable = releasable.Add(sdk.NewCoin(coin.Denom, amount))
+		}
+	}
+	return releasable
+}
+
+// ReleasableCoins returns the vested amount of all schedules not released yet.
+func (v RewardVesting) ReleasableCoins(blockTime time.Time) sdk.Coins {
+	releasable := sdk.NewCoins()
+	for _, schedule := range v.Schedules {
+		releasable = releasable.Add(schedule.ReleasableCoins(blockTime)...)
+	}
+	return releasable
+}
+
+func NewSponsorship(programID string, sponsor sdk.AccAddress, amount sdk.Coins) Sponsorship {
+	return Sponsorship{
+		ProgramId: programID,
+		Sponsor:   sponsor.String(),
+		Amount:    amount,
 	}
 }
 
@@ -0,0 +1,94 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func NewOpenMathStats(address string) OpenMathStats {
+	return OpenMathStats{
+		Address:         address,
+		ProverRewards:   sdk.NewDecCoins(),
+		CheckerRewards:  sdk.NewDecCoins(),
+		ImportedRewards: sdk.NewDecCoins(),
+	}
+}
+
+func NewTheoremTypeStats(theoremType TheoremType) TheoremTypeStats {
+	return TheoremTypeStats{
+		TheoremType:     theoremType,
+		ProverRewards:   sdk.NewDecCoins(),
+		CheckerRewards:  sdk.NewDecCoins(),
+		ImportedRewards: sdk.NewDecCoins(),
+	}
+}
+
+// IsRewardMetric returns true if the leaderboard metric is an amount of rewards in a denom.
+func (m LeaderboardMetric) IsRewardMetric() bool {
+	return m == LeaderboardMetric_LEADERBOARD_METRIC_PROVER_REWARDS ||
+		m == LeaderboardMetric_LEADERBOARD_METRIC_CHECKER_REWARDS ||
+		m == LeaderboardMetric_LEADERBOARD_METRIC_IMPORTED_REWARDS ||
+		m == LeaderboardMetric_LEADERBOARD_METRIC_TOTAL_REWARDS
+}
+
+// LeaderboardMetricFromString returns a LeaderboardMetric from its name, with or without the
+// LEADERBOARD_METRIC_ prefix, case insensitive and with dashes or underscores, e.g. prover-rewards.
+func LeaderboardMetricFromString(str string) (LeaderboardMetric, error) {
+	name := strings.ToUpper(strings.ReplaceAll(str, "-", "_"))
+	if !strings.HasPrefix(name, "LEADERBOARD_METRIC_") {
+		name = "LEADERBOARD_METRIC_" + name
+	}
+	metric, ok := LeaderboardMetric_value[name]
+	if !ok || metric == int32(LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED) {
+		return LeaderboardMetric_LEADERBOARD_METRIC_UNSPECIFIED, fmt.Errorf("'%s' is not a valid leaderboard metric", str)
+	}
+	return LeaderboardMetric(metric), nil
+}
+
+// LeaderboardName returns the name of the leaderboard of a metric, one per denom for the reward metrics.
+func LeaderboardName(metric LeaderboardMetric, denom string) string {
+	if metric.IsRewardMetric() {
+		return fmt.Sprintf("%d/%s", metric, denom)
+	}
+	return fmt.Sprintf("%d", metric)
+}
+
+// LeaderboardScores returns the positive scores of the statistics of an address by leaderboard name.
+// Reward scores are truncated to integers and capped to the maximum uint64.
+func (s OpenMathStats) LeaderboardScores() map[string]uint64 {
+	scores := make(map[string]uint64)
+	addCount := func(metric LeaderboardMetric, count uint64) {
+		if count > 0 {
+			scores[LeaderboardName(metric, "")] = count
+		}
+	}
+	addRewards := func(metric LeaderboardMetric, rewards sdk.DecCoins) {
+		for _, reward := range rewards {
+			amount := reward.Amount.TruncateInt()
+			if !amount.IsPositive() {
+				continue
+			}
+			score := uint64(math.MaxUint64)
+			if amount.IsUint64() {
+				score = amount.Uint64()
+			}
+			scores[LeaderboardName(metric, reward.Denom)] = score
+		}
+	}
+
+	addCount(LeaderboardMetric_LEADERBOARD_METRIC_THEOREMS_PROVEN, s.TheoremsProven)
+	addCount(LeaderboardMetric_LEADERBOARD_METRIC_PROOFS_CHECKED, s.ProofsChecked)
+	addRewards(LeaderboardMetric_LEADERBOARD_METRIC_PROVER_REWARDS, s.ProverRewards)
+	addRewards(LeaderboardMetric_LEADERBOARD_METRIC_CHECKER_REWARDS, s.CheckerRewards)
+	addRewards(LeaderboardMetric_LEADERBOARD_METRIC_IMPORTED_REWARDS, s.ImportedRewards)
+	addRewards(LeaderboardMetric_LEADERBOARD_METRIC_TOTAL_REWARDS, s.TotalRewards())
+	return scores
+}
+
+// TotalRewards returns the sum of the prover, checker and imported rewards.
+func (s OpenMathStats) TotalRewards() sdk.DecCoins {
+	return s.ProverRewards.Add(s.CheckerRewards...).Add(s.ImportedRewards...)
+}
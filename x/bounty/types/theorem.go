@@ -0,0 +1,33 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+)
+
+// NormalizeTheoremCode returns the theorem code with unified line endings, without a leading byte
+// order mark, trailing whitespace on its lines and blank lines, so that formatting differences do
+// not hide duplicate theorems. Indentation is kept as it is significant in Lean.
+func NormalizeTheoremCode(code string) string {
+	code = strings.TrimPrefix(code, "\ufeff")
+	code = strings.ReplaceAll(code, "\r\n", "\n")
+	code = strings.ReplaceAll(code, "\r", "\n")
+
+	lines := strings.Split(code, "\n")
+	normalized := make([]string, 0, len(lines))
+	for _, line := range lines {
+		line = strings.TrimRight(line, " \t\f\v")
+		if line == "" {
+			continue
+		}
+		normalized = append(normalized, line)
+	}
+	return strings.Join(normalized, "\n")
+}
+
+// TheoremCodeHash returns the hex encoded sha256 hash of the normalized theorem code.
+func TheoremCodeHash(code string) string {
+	hash := sha256.Sum256([]byte(NormalizeTheoremCode(code)))
+	return hex.EncodeToString(hash[:])
+}
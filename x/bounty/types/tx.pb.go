@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -15,18 +16,21 @@ import (
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
 	_ "github.com/cosmos/gogoproto/types"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -40,6 +44,24 @@ type MsgCreateProgram struct {
 	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Detail          string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
 	OperatorAddress string `protobuf:"bytes,4,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	// reward_pool is locked in escrow from the operator when the program is created.
+	RewardPool []types.Coin `protobuf:"bytes,5,rep,name=reward_pool,json=rewardPool,proto3" json:"reward_pool"`
+	// reward_schedule defines the payout for each severity level.
+	RewardSchedule []SeverityReward `protobuf:"bytes,6,rep,name=reward_schedule,json=rewardSchedule,proto3" json:"reward_schedule"`
+	// critical_approvals is the number of admin confirmations required for critical findings.
+	CriticalApprovals uint32 `protobuf:"varint,7,opt,name=critical_approvals,json=criticalApprovals,proto3" json:"critical_approvals,omitempty"`
+	// duplicate_policy defines how rewards are shared with duplicate findings, first reporter by default.
+	DuplicatePolicy DuplicatePolicy `protobuf:"varint,8,opt,name=duplicate_policy,json=duplicatePolicy,proto3,enum=shentu.bounty.v1.DuplicatePolicy" json:"duplicate_policy,omitempty"`
+	// activation_sla is the time to activate or close a submitted finding, no limit when unset.
+	ActivationSla *time.Duration `protobuf:"bytes,9,opt,name=activation_sla,json=activationSla,proto3,stdduration" json:"activation_sla,omitempty"`
+	// confirmation_sla is the time to confirm or close an active finding, no limit when unset.
+	ConfirmationSla *time.Duration `protobuf:"bytes,10,opt,name=confirmation_sla,json=confirmationSla,proto3,stdduration" json:"confirmation_sla,omitempty"`
+	// scope lists the assets of the program.
+	Scope []ScopeTarget `protobuf:"bytes,11,rep,name=scope,proto3" json:"scope"`
+	// submission_requirements restricts who can submit findings to the program.
+	SubmissionRequirements SubmissionRequirements `protobuf:"bytes,12,opt,name=submission_requirements,json=submissionRequirements,proto3" json:"submission_requirements"`
+	// disclosure_embargo is how long paid or closed findings stay confidential, no embargo when unset.
+	DisclosureEmbargo *time.Duration `protobuf:"bytes,13,opt,name=disclosure_embargo,json=disclosureEmbargo,proto3,stdduration" json:"disclosure_embargo,omitempty"`
 }
 
 func (m *MsgCreateProgram) Reset()         { *m = MsgCreateProgram{} }
@@ -81,6 +103,22 @@ type MsgEditProgram struct {
 	Name            string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Detail          string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
 	OperatorAddress string `protobuf:"bytes,4,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+	// reward_schedule replaces the program reward schedule when set.
+	RewardSchedule []SeverityReward `protobuf:"bytes,5,rep,name=reward_schedule,json=rewardSchedule,proto3" json:"reward_schedule"`
+	// critical_approvals replaces the number of admin confirmations required for critical findings when set.
+	CriticalApprovals uint32 `protobuf:"varint,6,opt,name=critical_approvals,json=criticalApprovals,proto3" json:"critical_approvals,omitempty"`
+	// duplicate_policy replaces the program duplicate policy when set.
+	DuplicatePolicy DuplicatePolicy `protobuf:"varint,7,opt,name=duplicate_policy,json=duplicatePolicy,proto3,enum=shentu.bounty.v1.DuplicatePolicy" json:"duplicate_policy,omitempty"`
+	// activation_sla replaces the program activation SLA when set.
+	ActivationSla *time.Duration `protobuf:"bytes,8,opt,name=activation_sla,json=activationSla,proto3,stdduration" json:"activation_sla,omitempty"`
+	// confirmation_sla replaces the program confirmation SLA when set.
+	ConfirmationSla *time.Duration `protobuf:"bytes,9,opt,name=confirmation_sla,json=confirmationSla,proto3,stdduration" json:"confirmation_sla,omitempty"`
+	// scope replaces the program scope when set.
+	Scope []ScopeTarget `protobuf:"bytes,10,rep,name=scope,proto3" json:"scope"`
+	// submission_requirements replaces the program submission requirements when set.
+	SubmissionRequirements *SubmissionRequirements `protobuf:"bytes,11,opt,name=submission_requirements,json=submissionRequirements,proto3" json:"submission_requirements,omitempty"`
+	// disclosure_embargo replaces the program disclosure embargo when set.
+	DisclosureEmbargo *time.Duration `protobuf:"bytes,12,opt,name=disclosure_embargo,json=disclosureEmbargo,proto3,stdduration" json:"disclosure_embargo,omitempty"`
 }
 
 func (m *MsgEditProgram) Reset()         { *m = MsgEditProgram{} }
@@ -338,6 +376,238 @@ func (m *MsgCloseProgramResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCloseProgramResponse proto.InternalMessageInfo
 
+// MsgFundProgram defines a message for a sponsor to deposit into the reward pool of an active program.
+type MsgFundProgram struct {
+	ProgramId      string       `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
+	SponsorAddress string       `protobuf:"bytes,2,opt,name=sponsor_address,json=sponsorAddress,proto3" json:"sponsor_address,omitempty" yaml:"sponsor_address"`
+	Amount         []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgFundProgram) Reset()         { *m = MsgFundProgram{} }
+func (m *MsgFundProgram) String() string { return proto.CompactTextString(m) }
+func (*MsgFundProgram) ProtoMessage()    {}
+func (*MsgFundProgram) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{8}
+}
+func (m *MsgFundProgram) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundProgram) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundProgram.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundProgram) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundProgram.Merge(m, src)
+}
+func (m *MsgFundProgram) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundProgram) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundProgram.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundProgram proto.InternalMessageInfo
+
+// MsgFundProgramResponse defines the Msg/FundProgram response type.
+type MsgFundProgramResponse struct {
+}
+
+func (m *MsgFundProgramResponse) Reset()         { *m = MsgFundProgramResponse{} }
+func (m *MsgFundProgramResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundProgramResponse) ProtoMessage()    {}
+func (*MsgFundProgramResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{9}
+}
+func (m *MsgFundProgramResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundProgramResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundProgramResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundProgramResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundProgramResponse.Merge(m, src)
+}
+func (m *MsgFundProgramResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundProgramResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundProgramResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundProgramResponse proto.InternalMessageInfo
+
+// MsgAddProgramMember defines a message to add a member to a program team, or change its role.
+type MsgAddProgramMember struct {
+	ProgramId       string      `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
+	MemberAddress   string      `protobuf:"bytes,2,opt,name=member_address,json=memberAddress,proto3" json:"member_address,omitempty" yaml:"member_address"`
+	Role            ProgramRole `protobuf:"varint,3,opt,name=role,proto3,enum=shentu.bounty.v1.ProgramRole" json:"role,omitempty" yaml:"role"`
+	OperatorAddress string      `protobuf:"bytes,4,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+}
+
+func (m *MsgAddProgramMember) Reset()         { *m = MsgAddProgramMember{} }
+func (m *MsgAddProgramMember) String() string { return proto.CompactTextString(m) }
+func (*MsgAddProgramMember) ProtoMessage()    {}
+func (*MsgAddProgramMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{10}
+}
+func (m *MsgAddProgramMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddProgramMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddProgramMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddProgramMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddProgramMember.Merge(m, src)
+}
+func (m *MsgAddProgramMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddProgramMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddProgramMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddProgramMember proto.InternalMessageInfo
+
+// MsgAddProgramMemberResponse defines the Msg/AddProgramMember response type.
+type MsgAddProgramMemberResponse struct {
+}
+
+func (m *MsgAddProgramMemberResponse) Reset()         { *m = MsgAddProgramMemberResponse{} }
+func (m *MsgAddProgramMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAddProgramMemberResponse) ProtoMessage()    {}
+func (*MsgAddProgramMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{11}
+}
+func (m *MsgAddProgramMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAddProgramMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAddProgramMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAddProgramMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAddProgramMemberResponse.Merge(m, src)
+}
+func (m *MsgAddProgramMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAddProgramMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAddProgramMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAddProgramMemberResponse proto.InternalMessageInfo
+
+// MsgRemoveProgramMember defines a message to remove a member from a program team.
+type MsgRemoveProgramMember struct {
+	ProgramId       string `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
+	MemberAddress   string `protobuf:"bytes,2,opt,name=member_address,json=memberAddress,proto3" json:"member_address,omitempty" yaml:"member_address"`
+	OperatorAddress string `protobuf:"bytes,3,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
+}
+
+func (m *MsgRemoveProgramMember) Reset()         { *m = MsgRemoveProgramMember{} }
+func (m *MsgRemoveProgramMember) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveProgramMember) ProtoMessage()    {}
+func (*MsgRemoveProgramMember) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{12}
+}
+func (m *MsgRemoveProgramMember) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveProgramMember) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveProgramMember.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveProgramMember) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveProgramMember.Merge(m, src)
+}
+func (m *MsgRemoveProgramMember) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveProgramMember) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveProgramMember.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveProgramMember proto.InternalMessageInfo
+
+// MsgRemoveProgramMemberResponse defines the Msg/RemoveProgramMember response type.
+type MsgRemoveProgramMemberResponse struct {
+}
+
+func (m *MsgRemoveProgramMemberResponse) Reset()         { *m = MsgRemoveProgramMemberResponse{} }
+func (m *MsgRemoveProgramMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRemoveProgramMemberResponse) ProtoMessage()    {}
+func (*MsgRemoveProgramMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{13}
+}
+func (m *MsgRemoveProgramMemberResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRemoveProgramMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRemoveProgramMemberResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRemoveProgramMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRemoveProgramMemberResponse.Merge(m, src)
+}
+func (m *MsgRemoveProgramMemberResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRemoveProgramMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRemoveProgramMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRemoveProgramMemberResponse proto.InternalMessageInfo
+
 // MsgSubmitFinding defines a message to submit a finding.
 type MsgSubmitFinding struct {
 	ProgramId       string        `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty" yaml:"program_id"`
@@ -345,13 +615,19 @@ type MsgSubmitFinding struct {
 	FindingHash     string        `protobuf:"bytes,3,opt,name=finding_hash,json=findingHash,proto3" json:"finding_hash,omitempty" yaml:"finding_hash"`
 	OperatorAddress string        `protobuf:"bytes,4,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
 	SeverityLevel   SeverityLevel `protobuf:"varint,5,opt,name=severity_level,json=severityLevel,proto3,enum=shentu.bounty.v1.SeverityLevel" json:"severity_level,omitempty" yaml:"severity_level"`
+	// encrypted_payload is the optional confidential report encrypted to the
+	// program admin and team members. Team members without a secp256k1 public
+	// key on chain are not required as recipients.
+	EncryptedPayload *EncryptedFindingPayload `protobuf:"bytes,6,opt,name=encrypted_payload,json=encryptedPayload,proto3" json:"encrypted_payload,omitempty" yaml:"encrypted_payload"`
+	// target_id is the in-scope target of the program, required when the program has a scope.
+	TargetId string `protobuf:"bytes,7,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty" yaml:"target_id"`
 }
 
 func (m *MsgSubmitFinding) Reset()         { *m = MsgSubmitFinding{} }
 func (m *MsgSubmitFinding) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitFinding) ProtoMessage()    {}
 func (*MsgSubmitFinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{8}
+	return fileDescriptor_1e4b4296bac3db30, []int{14}
 }
 func (m *MsgSubmitFinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,7 +664,7 @@ func (m *MsgSubmitFindingResponse) Reset()         { *m = MsgSubmitFindingRespon
 func (m *MsgSubmitFindingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitFindingResponse) ProtoMessage()    {}
 func (*MsgSubmitFindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{9}
+	return fileDescriptor_1e4b4296bac3db30, []int{15}
 }
 func (m *MsgSubmitFindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -424,13 +700,16 @@ type MsgEditFinding struct {
 	OperatorAddress string        `protobuf:"bytes,3,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
 	SeverityLevel   SeverityLevel `protobuf:"varint,4,opt,name=severity_level,json=severityLevel,proto3,enum=shentu.bounty.v1.SeverityLevel" json:"severity_level,omitempty" yaml:"severity_level"`
 	PaymentHash     string        `protobuf:"bytes,5,opt,name=payment_hash,json=paymentHash,proto3" json:"payment_hash,omitempty" yaml:"payment_hash"`
+	// encrypted_payload replaces the confidential report when set. It is required
+	// to change the finding hash of a finding with an encrypted payload.
+	EncryptedPayload *EncryptedFindingPayload `protobuf:"bytes,6,opt,name=encrypted_payload,json=encryptedPayload,proto3" json:"encrypted_payload,omitempty" yaml:"encrypted_payload"`
 }
 
 func (m *MsgEditFinding) Reset()         { *m = MsgEditFinding{} }
 func (m *MsgEditFinding) String() string { return proto.CompactTextString(m) }
 func (*MsgEditFinding) ProtoMessage()    {}
 func (*MsgEditFinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{10}
+	return fileDescriptor_1e4b4296bac3db30, []int{16}
 }
 func (m *MsgEditFinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -467,7 +746,7 @@ func (m *MsgEditFindingResponse) Reset()         { *m = MsgEditFindingResponse{}
 func (m *MsgEditFindingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgEditFindingResponse) ProtoMessage()    {}
 func (*MsgEditFindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{11}
+	return fileDescriptor_1e4b4296bac3db30, []int{17}
 }
 func (m *MsgEditFindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -501,13 +780,15 @@ type MsgConfirmFinding struct {
 	FindingId       string `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty" yaml:"finding_id"`
 	OperatorAddress string `protobuf:"bytes,2,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
 	Fingerprint     string `protobuf:"bytes,3,opt,name=fingerprint,proto3" json:"fingerprint,omitempty"`
+	// reward is paid to the submitter from the program reward pool. Leave empty to pay off-chain.
+	Reward []types.Coin `protobuf:"bytes,4,rep,name=reward,proto3" json:"reward"`
 }
 
 func (m *MsgConfirmFinding) Reset()         { *m = MsgConfirmFinding{} }
 func (m *MsgConfirmFinding) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmFinding) ProtoMessage()    {}
 func (*MsgConfirmFinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{12}
+	return fileDescriptor_1e4b4296bac3db30, []int{18}
 }
 func (m *MsgConfirmFinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -544,7 +825,7 @@ func (m *MsgConfirmFindingResponse) Reset()         { *m = MsgConfirmFindingResp
 func (m *MsgConfirmFindingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmFindingResponse) ProtoMessage()    {}
 func (*MsgConfirmFindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{13}
+	return fileDescriptor_1e4b4296bac3db30, []int{19}
 }
 func (m *MsgConfirmFindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -583,7 +864,7 @@ func (m *MsgActivateFinding) Reset()         { *m = MsgActivateFinding{} }
 func (m *MsgActivateFinding) String() string { return proto.CompactTextString(m) }
 func (*MsgActivateFinding) ProtoMessage()    {}
 func (*MsgActivateFinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{14}
+	return fileDescriptor_1e4b4296bac3db30, []int{20}
 }
 func (m *MsgActivateFinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -620,7 +901,7 @@ func (m *MsgActivateFindingResponse) Reset()         { *m = MsgActivateFindingRe
 func (m *MsgActivateFindingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgActivateFindingResponse) ProtoMessage()    {}
 func (*MsgActivateFindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{15}
+	return fileDescriptor_1e4b4296bac3db30, []int{21}
 }
 func (m *MsgActivateFindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -659,7 +940,7 @@ func (m *MsgConfirmFindingPaid) Reset()         { *m = MsgConfirmFindingPaid{} }
 func (m *MsgConfirmFindingPaid) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmFindingPaid) ProtoMessage()    {}
 func (*MsgConfirmFindingPaid) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{16}
+	return fileDescriptor_1e4b4296bac3db30, []int{22}
 }
 func (m *MsgConfirmFindingPaid) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -696,7 +977,7 @@ func (m *MsgConfirmFindingPaidResponse) Reset()         { *m = MsgConfirmFinding
 func (m *MsgConfirmFindingPaidResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgConfirmFindingPaidResponse) ProtoMessage()    {}
 func (*MsgConfirmFindingPaidResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{17}
+	return fileDescriptor_1e4b4296bac3db30, []int{23}
 }
 func (m *MsgConfirmFindingPaidResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -735,7 +1016,7 @@ func (m *MsgCloseFinding) Reset()         { *m = MsgCloseFinding{} }
 func (m *MsgCloseFinding) String() string { return proto.CompactTextString(m) }
 func (*MsgCloseFinding) ProtoMessage()    {}
 func (*MsgCloseFinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{18}
+	return fileDescriptor_1e4b4296bac3db30, []int{24}
 }
 func (m *MsgCloseFinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -772,7 +1053,7 @@ func (m *MsgCloseFindingResponse) Reset()         { *m = MsgCloseFindingResponse
 func (m *MsgCloseFindingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCloseFindingResponse) ProtoMessage()    {}
 func (*MsgCloseFindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{19}
+	return fileDescriptor_1e4b4296bac3db30, []int{25}
 }
 func (m *MsgCloseFindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -815,7 +1096,7 @@ func (m *MsgPublishFinding) Reset()         { *m = MsgPublishFinding{} }
 func (m *MsgPublishFinding) String() string { return proto.CompactTextString(m) }
 func (*MsgPublishFinding) ProtoMessage()    {}
 func (*MsgPublishFinding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{20}
+	return fileDescriptor_1e4b4296bac3db30, []int{26}
 }
 func (m *MsgPublishFinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -852,7 +1133,7 @@ func (m *MsgPublishFindingResponse) Reset()         { *m = MsgPublishFindingResp
 func (m *MsgPublishFindingResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgPublishFindingResponse) ProtoMessage()    {}
 func (*MsgPublishFindingResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{21}
+	return fileDescriptor_1e4b4296bac3db30, []int{27}
 }
 func (m *MsgPublishFindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -881,28 +1162,25 @@ func (m *MsgPublishFindingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgPublishFindingResponse proto.InternalMessageInfo
 
-// MsgCreateTheorem defines a message to create a new theorem.
-type MsgCreateTheorem struct {
-	Title               string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
-	Description         string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
-	Code                string       `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
-	InitialGrant        []types.Coin `protobuf:"bytes,4,rep,name=initial_grant,json=initialGrant,proto3" json:"initial_grant"`
-	Proposer            string       `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
-	RequireOpenmathCert bool         `protobuf:"varint,6,opt,name=require_openmath_cert,json=requireOpenmathCert,proto3" json:"require_openmath_cert,omitempty"`
+// MsgMarkDuplicateFinding defines a message to mark a finding as a duplicate of another finding.
+type MsgMarkDuplicateFinding struct {
+	FindingId       string `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty" yaml:"finding_id"`
+	DuplicateOf     string `protobuf:"bytes,2,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty" yaml:"duplicate_of"`
+	OperatorAddress string `protobuf:"bytes,3,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
 }
 
-func (m *MsgCreateTheorem) Reset()         { *m = MsgCreateTheorem{} }
-func (m *MsgCreateTheorem) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateTheorem) ProtoMessage()    {}
-func (*MsgCreateTheorem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{22}
+func (m *MsgMarkDuplicateFinding) Reset()         { *m = MsgMarkDuplicateFinding{} }
+func (m *MsgMarkDuplicateFinding) String() string { return proto.CompactTextString(m) }
+func (*MsgMarkDuplicateFinding) ProtoMessage()    {}
+func (*MsgMarkDuplicateFinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{28}
 }
-func (m *MsgCreateTheorem) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarkDuplicateFinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateTheorem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarkDuplicateFinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateTheorem.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarkDuplicateFinding.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -912,35 +1190,34 @@ func (m *MsgCreateTheorem) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateTheorem) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateTheorem.Merge(m, src)
+func (m *MsgMarkDuplicateFinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarkDuplicateFinding.Merge(m, src)
 }
-func (m *MsgCreateTheorem) XXX_Size() int {
+func (m *MsgMarkDuplicateFinding) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateTheorem) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateTheorem.DiscardUnknown(m)
+func (m *MsgMarkDuplicateFinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarkDuplicateFinding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateTheorem proto.InternalMessageInfo
+var xxx_messageInfo_MsgMarkDuplicateFinding proto.InternalMessageInfo
 
-// MsgCreateTheoremResponse defines the Msg/CreateTheorem response type.
-type MsgCreateTheoremResponse struct {
-	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
+// MsgMarkDuplicateFindingResponse defines the Msg/MarkDuplicateFinding response type.
+type MsgMarkDuplicateFindingResponse struct {
 }
 
-func (m *MsgCreateTheoremResponse) Reset()         { *m = MsgCreateTheoremResponse{} }
-func (m *MsgCreateTheoremResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateTheoremResponse) ProtoMessage()    {}
-func (*MsgCreateTheoremResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{23}
+func (m *MsgMarkDuplicateFindingResponse) Reset()         { *m = MsgMarkDuplicateFindingResponse{} }
+func (m *MsgMarkDuplicateFindingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMarkDuplicateFindingResponse) ProtoMessage()    {}
+func (*MsgMarkDuplicateFindingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{29}
 }
-func (m *MsgCreateTheoremResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgMarkDuplicateFindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateTheoremResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgMarkDuplicateFindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateTheoremResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgMarkDuplicateFindingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -950,45 +1227,37 @@ func (m *MsgCreateTheoremResponse) XXX_Marshal(b []byte, deterministic bool) ([]
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateTheoremResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateTheoremResponse.Merge(m, src)
+func (m *MsgMarkDuplicateFindingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMarkDuplicateFindingResponse.Merge(m, src)
 }
-func (m *MsgCreateTheoremResponse) XXX_Size() int {
+func (m *MsgMarkDuplicateFindingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateTheoremResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateTheoremResponse.DiscardUnknown(m)
+func (m *MsgMarkDuplicateFindingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMarkDuplicateFindingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateTheoremResponse proto.InternalMessageInfo
-
-func (m *MsgCreateTheoremResponse) GetTheoremId() uint64 {
-	if m != nil {
-		return m.TheoremId
-	}
-	return 0
-}
+var xxx_messageInfo_MsgMarkDuplicateFindingResponse proto.InternalMessageInfo
 
-// MsgGrant defines a message to grant funds to a theorem.
-type MsgGrant struct {
-	// theorem_id defines the unique id of the theorem.
-	TheoremId uint64       `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id"`
-	Grantor   string       `protobuf:"bytes,2,opt,name=grantor,proto3" json:"grantor,omitempty"`
-	Amount    []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
+// MsgDisputeFinding defines a message to dispute the closure of a finding.
+type MsgDisputeFinding struct {
+	FindingId       string `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty" yaml:"finding_id"`
+	Reason          string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+	OperatorAddress string `protobuf:"bytes,3,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty" yaml:"operator_address"`
 }
 
-func (m *MsgGrant) Reset()         { *m = MsgGrant{} }
-func (m *MsgGrant) String() string { return proto.CompactTextString(m) }
-func (*MsgGrant) ProtoMessage()    {}
-func (*MsgGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{24}
+func (m *MsgDisputeFinding) Reset()         { *m = MsgDisputeFinding{} }
+func (m *MsgDisputeFinding) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeFinding) ProtoMessage()    {}
+func (*MsgDisputeFinding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{30}
 }
-func (m *MsgGrant) XXX_Unmarshal(b []byte) error {
+func (m *MsgDisputeFinding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDisputeFinding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGrant.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDisputeFinding.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -998,34 +1267,34 @@ func (m *MsgGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 		return b[:n], nil
 	}
 }
-func (m *MsgGrant) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGrant.Merge(m, src)
+func (m *MsgDisputeFinding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeFinding.Merge(m, src)
 }
-func (m *MsgGrant) XXX_Size() int {
+func (m *MsgDisputeFinding) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGrant) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGrant.DiscardUnknown(m)
+func (m *MsgDisputeFinding) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeFinding.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGrant proto.InternalMessageInfo
+var xxx_messageInfo_MsgDisputeFinding proto.InternalMessageInfo
 
-// MsgGrantResponse defines the Msg/Grant response type.
-type MsgGrantResponse struct {
+// MsgDisputeFindingResponse defines the Msg/DisputeFinding response type.
+type MsgDisputeFindingResponse struct {
 }
 
-func (m *MsgGrantResponse) Reset()         { *m = MsgGrantResponse{} }
-func (m *MsgGrantResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgGrantResponse) ProtoMessage()    {}
-func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{25}
+func (m *MsgDisputeFindingResponse) Reset()         { *m = MsgDisputeFindingResponse{} }
+func (m *MsgDisputeFindingResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeFindingResponse) ProtoMessage()    {}
+func (*MsgDisputeFindingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{31}
 }
-func (m *MsgGrantResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgDisputeFindingResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgDisputeFindingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgGrantResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgDisputeFindingResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1035,38 +1304,37 @@ func (m *MsgGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 		return b[:n], nil
 	}
 }
-func (m *MsgGrantResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgGrantResponse.Merge(m, src)
+func (m *MsgDisputeFindingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeFindingResponse.Merge(m, src)
 }
-func (m *MsgGrantResponse) XXX_Size() int {
+func (m *MsgDisputeFindingResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgGrantResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgGrantResponse.DiscardUnknown(m)
+func (m *MsgDisputeFindingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeFindingResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgGrantResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgDisputeFindingResponse proto.InternalMessageInfo
 
-// MsgSubmitProofHash defines a message to submit a proof hash.
-type MsgSubmitProofHash struct {
-	TheoremId uint64       `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id"`
-	Prover    string       `protobuf:"bytes,2,opt,name=prover,proto3" json:"prover,omitempty"`
-	ProofHash string       `protobuf:"bytes,3,opt,name=proof_hash,json=proofHash,proto3" json:"proof_hash,omitempty"`
-	Deposit   []types.Coin `protobuf:"bytes,4,rep,name=deposit,proto3" json:"deposit"`
+// MsgVoteDispute defines a message to vote on a finding dispute.
+type MsgVoteDispute struct {
+	FindingId string            `protobuf:"bytes,1,opt,name=finding_id,json=findingId,proto3" json:"finding_id,omitempty" yaml:"finding_id"`
+	Option    DisputeVoteOption `protobuf:"varint,2,opt,name=option,proto3,enum=shentu.bounty.v1.DisputeVoteOption" json:"option,omitempty" yaml:"option"`
+	Voter     string            `protobuf:"bytes,3,opt,name=voter,proto3" json:"voter,omitempty" yaml:"voter"`
 }
 
-func (m *MsgSubmitProofHash) Reset()         { *m = MsgSubmitProofHash{} }
-func (m *MsgSubmitProofHash) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitProofHash) ProtoMessage()    {}
-func (*MsgSubmitProofHash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{26}
+func (m *MsgVoteDispute) Reset()         { *m = MsgVoteDispute{} }
+func (m *MsgVoteDispute) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteDispute) ProtoMessage()    {}
+func (*MsgVoteDispute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{32}
 }
-func (m *MsgSubmitProofHash) XXX_Unmarshal(b []byte) error {
+func (m *MsgVoteDispute) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitProofHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVoteDispute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitProofHash.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVoteDispute.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1076,34 +1344,34 @@ func (m *MsgSubmitProofHash) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitProofHash) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitProofHash.Merge(m, src)
+func (m *MsgVoteDispute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteDispute.Merge(m, src)
 }
-func (m *MsgSubmitProofHash) XXX_Size() int {
+func (m *MsgVoteDispute) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitProofHash) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitProofHash.DiscardUnknown(m)
+func (m *MsgVoteDispute) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteDispute.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitProofHash proto.InternalMessageInfo
+var xxx_messageInfo_MsgVoteDispute proto.InternalMessageInfo
 
-// MsgSubmitProofHashResponse defines the Msg/SubmitProofHash response type.
-type MsgSubmitProofHashResponse struct {
+// MsgVoteDisputeResponse defines the Msg/VoteDispute response type.
+type MsgVoteDisputeResponse struct {
 }
 
-func (m *MsgSubmitProofHashResponse) Reset()         { *m = MsgSubmitProofHashResponse{} }
-func (m *MsgSubmitProofHashResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitProofHashResponse) ProtoMessage()    {}
-func (*MsgSubmitProofHashResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{27}
+func (m *MsgVoteDisputeResponse) Reset()         { *m = MsgVoteDisputeResponse{} }
+func (m *MsgVoteDisputeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgVoteDisputeResponse) ProtoMessage()    {}
+func (*MsgVoteDisputeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{33}
 }
-func (m *MsgSubmitProofHashResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgVoteDisputeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitProofHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgVoteDisputeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitProofHashResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgVoteDisputeResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1113,37 +1381,41 @@ func (m *MsgSubmitProofHashResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitProofHashResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitProofHashResponse.Merge(m, src)
+func (m *MsgVoteDisputeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgVoteDisputeResponse.Merge(m, src)
 }
-func (m *MsgSubmitProofHashResponse) XXX_Size() int {
+func (m *MsgVoteDisputeResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitProofHashResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitProofHashResponse.DiscardUnknown(m)
+func (m *MsgVoteDisputeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgVoteDisputeResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitProofHashResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgVoteDisputeResponse proto.InternalMessageInfo
 
-// MsgSubmitProofDetail defines a message to submit proof details.
-type MsgSubmitProofDetail struct {
-	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id"`
-	Prover  string `protobuf:"bytes,2,opt,name=prover,proto3" json:"prover,omitempty"`
-	Detail  string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+// MsgCreateTheorem defines a message to create a new theorem.
+type MsgCreateTheorem struct {
+	Title               string       `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description         string       `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Code                string       `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	InitialGrant        []types.Coin `protobuf:"bytes,4,rep,name=initial_grant,json=initialGrant,proto3" json:"initial_grant"`
+	Proposer            string       `protobuf:"bytes,5,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	RequireOpenmathCert bool         `protobuf:"varint,6,opt,name=require_openmath_cert,json=requireOpenmathCert,proto3" json:"require_openmath_cert,omitempty"`
+	TheoremType         TheoremType  `protobuf:"varint,7,opt,name=theorem_type,json=theoremType,proto3,enum=shentu.bounty.v1.TheoremType" json:"theorem_type,omitempty"`
 }
 
-func (m *MsgSubmitProofDetail) Reset()         { *m = MsgSubmitProofDetail{} }
-func (m *MsgSubmitProofDetail) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitProofDetail) ProtoMessage()    {}
-func (*MsgSubmitProofDetail) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{28}
+func (m *MsgCreateTheorem) Reset()         { *m = MsgCreateTheorem{} }
+func (m *MsgCreateTheorem) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTheorem) ProtoMessage()    {}
+func (*MsgCreateTheorem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{34}
 }
-func (m *MsgSubmitProofDetail) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateTheorem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitProofDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateTheorem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitProofDetail.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateTheorem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1153,34 +1425,35 @@ func (m *MsgSubmitProofDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitProofDetail) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitProofDetail.Merge(m, src)
+func (m *MsgCreateTheorem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTheorem.Merge(m, src)
 }
-func (m *MsgSubmitProofDetail) XXX_Size() int {
+func (m *MsgCreateTheorem) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitProofDetail) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitProofDetail.DiscardUnknown(m)
+func (m *MsgCreateTheorem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTheorem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitProofDetail proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateTheorem proto.InternalMessageInfo
 
-// MsgSubmitProofDetailResponse defines the Msg/SubmitProofDetail response type.
-type MsgSubmitProofDetailResponse struct {
+// MsgCreateTheoremResponse defines the Msg/CreateTheorem response type.
+type MsgCreateTheoremResponse struct {
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id,omitempty"`
 }
 
-func (m *MsgSubmitProofDetailResponse) Reset()         { *m = MsgSubmitProofDetailResponse{} }
-func (m *MsgSubmitProofDetailResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitProofDetailResponse) ProtoMessage()    {}
-func (*MsgSubmitProofDetailResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{29}
+func (m *MsgCreateTheoremResponse) Reset()         { *m = MsgCreateTheoremResponse{} }
+func (m *MsgCreateTheoremResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTheoremResponse) ProtoMessage()    {}
+func (*MsgCreateTheoremResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{35}
 }
-func (m *MsgSubmitProofDetailResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgCreateTheoremResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitProofDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCreateTheoremResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitProofDetailResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCreateTheoremResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1190,40 +1463,45 @@ func (m *MsgSubmitProofDetailResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitProofDetailResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitProofDetailResponse.Merge(m, src)
+func (m *MsgCreateTheoremResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTheoremResponse.Merge(m, src)
 }
-func (m *MsgSubmitProofDetailResponse) XXX_Size() int {
+func (m *MsgCreateTheoremResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitProofDetailResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitProofDetailResponse.DiscardUnknown(m)
+func (m *MsgCreateTheoremResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTheoremResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitProofDetailResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgCreateTheoremResponse proto.InternalMessageInfo
 
-// MsgSubmitProofVerification defines a message to submit proof verification.
-type MsgSubmitProofVerification struct {
-	ProofId     string      `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
-	Status      ProofStatus `protobuf:"varint,2,opt,name=status,proto3,enum=shentu.bounty.v1.ProofStatus" json:"status,omitempty"`
-	Checker     string      `protobuf:"bytes,3,opt,name=checker,proto3" json:"checker,omitempty"`
-	Complexity  int64       `protobuf:"varint,4,opt,name=complexity,proto3" json:"complexity,omitempty"`
-	Imports     []uint64    `protobuf:"varint,5,rep,packed,name=imports,proto3" json:"imports,omitempty"`
-	TheoremType TheoremType `protobuf:"varint,6,opt,name=theorem_type,json=theoremType,proto3,enum=shentu.bounty.v1.TheoremType" json:"theorem_type,omitempty"`
+func (m *MsgCreateTheoremResponse) GetTheoremId() uint64 {
+	if m != nil {
+		return m.TheoremId
+	}
+	return 0
 }
 
-func (m *MsgSubmitProofVerification) Reset()         { *m = MsgSubmitProofVerification{} }
-func (m *MsgSubmitProofVerification) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitProofVerification) ProtoMessage()    {}
-func (*MsgSubmitProofVerification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{30}
+// MsgGrant defines a message to grant funds to a theorem.
+type MsgGrant struct {
+	// theorem_id defines the unique id of the theorem.
+	TheoremId uint64       `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id"`
+	Grantor   string       `protobuf:"bytes,2,opt,name=grantor,proto3" json:"grantor,omitempty"`
+	Amount    []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
 }
-func (m *MsgSubmitProofVerification) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgGrant) Reset()         { *m = MsgGrant{} }
+func (m *MsgGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgGrant) ProtoMessage()    {}
+func (*MsgGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{36}
+}
+func (m *MsgGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitProofVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitProofVerification.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrant.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1233,34 +1511,34 @@ func (m *MsgSubmitProofVerification) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitProofVerification) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitProofVerification.Merge(m, src)
+func (m *MsgGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrant.Merge(m, src)
 }
-func (m *MsgSubmitProofVerification) XXX_Size() int {
+func (m *MsgGrant) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitProofVerification) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitProofVerification.DiscardUnknown(m)
+func (m *MsgGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrant.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitProofVerification proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrant proto.InternalMessageInfo
 
-// MsgSubmitProofVerificationResponse defines the Msg/SubmitProofVerification response type.
-type MsgSubmitProofVerificationResponse struct {
+// MsgGrantResponse defines the Msg/Grant response type.
+type MsgGrantResponse struct {
 }
 
-func (m *MsgSubmitProofVerificationResponse) Reset()         { *m = MsgSubmitProofVerificationResponse{} }
-func (m *MsgSubmitProofVerificationResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgSubmitProofVerificationResponse) ProtoMessage()    {}
-func (*MsgSubmitProofVerificationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{31}
+func (m *MsgGrantResponse) Reset()         { *m = MsgGrantResponse{} }
+func (m *MsgGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantResponse) ProtoMessage()    {}
+func (*MsgGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{37}
 }
-func (m *MsgSubmitProofVerificationResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgSubmitProofVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgSubmitProofVerificationResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1270,35 +1548,40 @@ func (m *MsgSubmitProofVerificationResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgSubmitProofVerificationResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgSubmitProofVerificationResponse.Merge(m, src)
+func (m *MsgGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantResponse.Merge(m, src)
 }
-func (m *MsgSubmitProofVerificationResponse) XXX_Size() int {
+func (m *MsgGrantResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgSubmitProofVerificationResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgSubmitProofVerificationResponse.DiscardUnknown(m)
+func (m *MsgGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgSubmitProofVerificationResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantResponse proto.InternalMessageInfo
 
-// MsgWithdrawReward defines a message to withdraw rewards.
-type MsgWithdrawReward struct {
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+// MsgGrantFromCommunityPool defines a governance message to grant a theorem from the community pool.
+// The grant is recorded with the authority as grantor and is refunded to the community pool.
+type MsgGrantFromCommunityPool struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// theorem_id defines the unique id of the theorem.
+	TheoremId uint64       `protobuf:"varint,2,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id"`
+	Amount    []types.Coin `protobuf:"bytes,3,rep,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgWithdrawReward) Reset()         { *m = MsgWithdrawReward{} }
-func (m *MsgWithdrawReward) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawReward) ProtoMessage()    {}
-func (*MsgWithdrawReward) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{32}
+func (m *MsgGrantFromCommunityPool) Reset()         { *m = MsgGrantFromCommunityPool{} }
+func (m *MsgGrantFromCommunityPool) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantFromCommunityPool) ProtoMessage()    {}
+func (*MsgGrantFromCommunityPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{38}
 }
-func (m *MsgWithdrawReward) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantFromCommunityPool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantFromCommunityPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawReward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantFromCommunityPool.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1308,33 +1591,34 @@ func (m *MsgWithdrawReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawReward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawReward.Merge(m, src)
+func (m *MsgGrantFromCommunityPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantFromCommunityPool.Merge(m, src)
 }
-func (m *MsgWithdrawReward) XXX_Size() int {
+func (m *MsgGrantFromCommunityPool) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawReward) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawReward.DiscardUnknown(m)
+func (m *MsgGrantFromCommunityPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantFromCommunityPool.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawReward proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantFromCommunityPool proto.InternalMessageInfo
 
-type MsgWithdrawRewardResponse struct {
+// MsgGrantFromCommunityPoolResponse defines the Msg/GrantFromCommunityPool response type.
+type MsgGrantFromCommunityPoolResponse struct {
 }
 
-func (m *MsgWithdrawRewardResponse) Reset()         { *m = MsgWithdrawRewardResponse{} }
-func (m *MsgWithdrawRewardResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgWithdrawRewardResponse) ProtoMessage()    {}
-func (*MsgWithdrawRewardResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{33}
+func (m *MsgGrantFromCommunityPoolResponse) Reset()         { *m = MsgGrantFromCommunityPoolResponse{} }
+func (m *MsgGrantFromCommunityPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgGrantFromCommunityPoolResponse) ProtoMessage()    {}
+func (*MsgGrantFromCommunityPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{39}
 }
-func (m *MsgWithdrawRewardResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgGrantFromCommunityPoolResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgWithdrawRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgGrantFromCommunityPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgWithdrawRewardResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgGrantFromCommunityPoolResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1344,38 +1628,39 @@ func (m *MsgWithdrawRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *MsgWithdrawRewardResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgWithdrawRewardResponse.Merge(m, src)
+func (m *MsgGrantFromCommunityPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgGrantFromCommunityPoolResponse.Merge(m, src)
 }
-func (m *MsgWithdrawRewardResponse) XXX_Size() int {
+func (m *MsgGrantFromCommunityPoolResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgWithdrawRewardResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgWithdrawRewardResponse.DiscardUnknown(m)
+func (m *MsgGrantFromCommunityPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgGrantFromCommunityPoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgWithdrawRewardResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgGrantFromCommunityPoolResponse proto.InternalMessageInfo
 
-// MsgUpdateTheoremComplexity defines a message to update theorem complexity.
-type MsgUpdateTheoremComplexity struct {
+// MsgWithdrawGrant defines a message to withdraw a grant from a theorem without a proof in progress.
+// The withdrawal cannot leave less than the min grant in the grant pool of the theorem, and the
+// theorem is closed when its last grant is withdrawn.
+type MsgWithdrawGrant struct {
 	// theorem_id defines the unique id of the theorem.
-	TheoremId  uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id"`
-	Checker    string `protobuf:"bytes,2,opt,name=checker,proto3" json:"checker,omitempty"`
-	Complexity int64  `protobuf:"varint,3,opt,name=complexity,proto3" json:"complexity,omitempty"`
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id"`
+	Grantor   string `protobuf:"bytes,2,opt,name=grantor,proto3" json:"grantor,omitempty"`
 }
 
-func (m *MsgUpdateTheoremComplexity) Reset()         { *m = MsgUpdateTheoremComplexity{} }
-func (m *MsgUpdateTheoremComplexity) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateTheoremComplexity) ProtoMessage()    {}
-func (*MsgUpdateTheoremComplexity) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{34}
+func (m *MsgWithdrawGrant) Reset()         { *m = MsgWithdrawGrant{} }
+func (m *MsgWithdrawGrant) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawGrant) ProtoMessage()    {}
+func (*MsgWithdrawGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{40}
 }
-func (m *MsgUpdateTheoremComplexity) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateTheoremComplexity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateTheoremComplexity.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawGrant.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1385,34 +1670,38 @@ func (m *MsgUpdateTheoremComplexity) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateTheoremComplexity) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateTheoremComplexity.Merge(m, src)
+func (m *MsgWithdrawGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawGrant.Merge(m, src)
 }
-func (m *MsgUpdateTheoremComplexity) XXX_Size() int {
+func (m *MsgWithdrawGrant) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateTheoremComplexity) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateTheoremComplexity.DiscardUnknown(m)
+func (m *MsgWithdrawGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawGrant.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateTheoremComplexity proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawGrant proto.InternalMessageInfo
 
-// MsgUpdateTheoremComplexityResponse defines the Msg/UpdateTheoremComplexity response type.
-type MsgUpdateTheoremComplexityResponse struct {
+// MsgWithdrawGrantResponse defines the Msg/WithdrawGrant response type.
+type MsgWithdrawGrantResponse struct {
+	// refunded is the amount returned to the grantor.
+	Refunded []types.Coin `protobuf:"bytes,1,rep,name=refunded,proto3" json:"refunded"`
+	// penalty is the amount kept as the withdrawal penalty.
+	Penalty []types.Coin `protobuf:"bytes,2,rep,name=penalty,proto3" json:"penalty"`
 }
 
-func (m *MsgUpdateTheoremComplexityResponse) Reset()         { *m = MsgUpdateTheoremComplexityResponse{} }
-func (m *MsgUpdateTheoremComplexityResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateTheoremComplexityResponse) ProtoMessage()    {}
-func (*MsgUpdateTheoremComplexityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{35}
+func (m *MsgWithdrawGrantResponse) Reset()         { *m = MsgWithdrawGrantResponse{} }
+func (m *MsgWithdrawGrantResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawGrantResponse) ProtoMessage()    {}
+func (*MsgWithdrawGrantResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{41}
 }
-func (m *MsgUpdateTheoremComplexityResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgWithdrawGrantResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateTheoremComplexityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgWithdrawGrantResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateTheoremComplexityResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgWithdrawGrantResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1422,40 +1711,51 @@ func (m *MsgUpdateTheoremComplexityResponse) XXX_Marshal(b []byte, deterministic
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateTheoremComplexityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateTheoremComplexityResponse.Merge(m, src)
+func (m *MsgWithdrawGrantResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawGrantResponse.Merge(m, src)
 }
-func (m *MsgUpdateTheoremComplexityResponse) XXX_Size() int {
+func (m *MsgWithdrawGrantResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateTheoremComplexityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateTheoremComplexityResponse.DiscardUnknown(m)
+func (m *MsgWithdrawGrantResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawGrantResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateTheoremComplexityResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgWithdrawGrantResponse proto.InternalMessageInfo
 
-// MsgUpdateParams is the Msg/UpdateParams request type.
-type MsgUpdateParams struct {
-	// authority is the address that controls the module (defaults to x/gov unless overwritten).
-	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// params defines the x/bounty parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+func (m *MsgWithdrawGrantResponse) GetRefunded() []types.Coin {
+	if m != nil {
+		return m.Refunded
+	}
+	return nil
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{36}
+func (m *MsgWithdrawGrantResponse) GetPenalty() []types.Coin {
+	if m != nil {
+		return m.Penalty
+	}
+	return nil
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+
+// MsgCloseTheorem defines a message for the proposer to close a theorem without a proof in progress.
+type MsgCloseTheorem struct {
+	// theorem_id defines the unique id of the theorem.
+	TheoremId uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id"`
+	Proposer  string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+}
+
+func (m *MsgCloseTheorem) Reset()         { *m = MsgCloseTheorem{} }
+func (m *MsgCloseTheorem) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseTheorem) ProtoMessage()    {}
+func (*MsgCloseTheorem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{42}
+}
+func (m *MsgCloseTheorem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgCloseTheorem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgCloseTheorem.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1465,49 +1765,75 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgCloseTheorem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseTheorem.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgCloseTheorem) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgCloseTheorem) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseTheorem.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgCloseTheorem proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
-	if m != nil {
-		return m.Authority
-	}
-	return ""
+// MsgCloseTheoremResponse defines the Msg/CloseTheorem response type.
+type MsgCloseTheoremResponse struct {
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
-	if m != nil {
-		return m.Params
+func (m *MsgCloseTheoremResponse) Reset()         { *m = MsgCloseTheoremResponse{} }
+func (m *MsgCloseTheoremResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseTheoremResponse) ProtoMessage()    {}
+func (*MsgCloseTheoremResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{43}
+}
+func (m *MsgCloseTheoremResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseTheoremResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseTheoremResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return Params{}
+}
+func (m *MsgCloseTheoremResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseTheoremResponse.Merge(m, src)
+}
+func (m *MsgCloseTheoremResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseTheoremResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseTheoremResponse.DiscardUnknown(m)
 }
 
-// MsgUpdateParamsResponse defines the response structure for executing a
-// MsgUpdateParams message.
-type MsgUpdateParamsResponse struct {
+var xxx_messageInfo_MsgCloseTheoremResponse proto.InternalMessageInfo
+
+// MsgSubmitProofHash defines a message to submit a proof hash.
+type MsgSubmitProofHash struct {
+	TheoremId uint64       `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id"`
+	Prover    string       `protobuf:"bytes,2,opt,name=prover,proto3" json:"prover,omitempty"`
+	ProofHash string       `protobuf:"bytes,3,opt,name=proof_hash,json=proofHash,proto3" json:"proof_hash,omitempty"`
+	Deposit   []types.Coin `protobuf:"bytes,4,rep,name=deposit,proto3" json:"deposit"`
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1e4b4296bac3db30, []int{37}
+func (m *MsgSubmitProofHash) Reset()         { *m = MsgSubmitProofHash{} }
+func (m *MsgSubmitProofHash) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProofHash) ProtoMessage()    {}
+func (*MsgSubmitProofHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{44}
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgSubmitProofHash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgSubmitProofHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgSubmitProofHash.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -1517,1885 +1843,2095 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgSubmitProofHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProofHash.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgSubmitProofHash) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgSubmitProofHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProofHash.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgSubmitProofHash proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgCreateProgram)(nil), "shentu.bounty.v1.MsgCreateProgram")
-	proto.RegisterType((*MsgEditProgram)(nil), "shentu.bounty.v1.MsgEditProgram")
-	proto.RegisterType((*MsgCreateProgramResponse)(nil), "shentu.bounty.v1.MsgCreateProgramResponse")
-	proto.RegisterType((*MsgEditProgramResponse)(nil), "shentu.bounty.v1.MsgEditProgramResponse")
-	proto.RegisterType((*MsgActivateProgram)(nil), "shentu.bounty.v1.MsgActivateProgram")
-	proto.RegisterType((*MsgActivateProgramResponse)(nil), "shentu.bounty.v1.MsgActivateProgramResponse")
-	proto.RegisterType((*MsgCloseProgram)(nil), "shentu.bounty.v1.MsgCloseProgram")
-	proto.RegisterType((*MsgCloseProgramResponse)(nil), "shentu.bounty.v1.MsgCloseProgramResponse")
-	proto.RegisterType((*MsgSubmitFinding)(nil), "shentu.bounty.v1.MsgSubmitFinding")
-	proto.RegisterType((*MsgSubmitFindingResponse)(nil), "shentu.bounty.v1.MsgSubmitFindingResponse")
-	proto.RegisterType((*MsgEditFinding)(nil), "shentu.bounty.v1.MsgEditFinding")
-	proto.RegisterType((*MsgEditFindingResponse)(nil), "shentu.bounty.v1.MsgEditFindingResponse")
-	proto.RegisterType((*MsgConfirmFinding)(nil), "shentu.bounty.v1.MsgConfirmFinding")
-	proto.RegisterType((*MsgConfirmFindingResponse)(nil), "shentu.bounty.v1.MsgConfirmFindingResponse")
-	proto.RegisterType((*MsgActivateFinding)(nil), "shentu.bounty.v1.MsgActivateFinding")
-	proto.RegisterType((*MsgActivateFindingResponse)(nil), "shentu.bounty.v1.MsgActivateFindingResponse")
-	proto.RegisterType((*MsgConfirmFindingPaid)(nil), "shentu.bounty.v1.MsgConfirmFindingPaid")
-	proto.RegisterType((*MsgConfirmFindingPaidResponse)(nil), "shentu.bounty.v1.MsgConfirmFindingPaidResponse")
-	proto.RegisterType((*MsgCloseFinding)(nil), "shentu.bounty.v1.MsgCloseFinding")
-	proto.RegisterType((*MsgCloseFindingResponse)(nil), "shentu.bounty.v1.MsgCloseFindingResponse")
-	proto.RegisterType((*MsgPublishFinding)(nil), "shentu.bounty.v1.MsgPublishFinding")
-	proto.RegisterType((*MsgPublishFindingResponse)(nil), "shentu.bounty.v1.MsgPublishFindingResponse")
-	proto.RegisterType((*MsgCreateTheorem)(nil), "shentu.bounty.v1.MsgCreateTheorem")
-	proto.RegisterType((*MsgCreateTheoremResponse)(nil), "shentu.bounty.v1.MsgCreateTheoremResponse")
-	proto.RegisterType((*MsgGrant)(nil), "shentu.bounty.v1.MsgGrant")
-	proto.RegisterType((*MsgGrantResponse)(nil), "shentu.bounty.v1.MsgGrantResponse")
-	proto.RegisterType((*MsgSubmitProofHash)(nil), "shentu.bounty.v1.MsgSubmitProofHash")
-	proto.RegisterType((*MsgSubmitProofHashResponse)(nil), "shentu.bounty.v1.MsgSubmitProofHashResponse")
-	proto.RegisterType((*MsgSubmitProofDetail)(nil), "shentu.bounty.v1.MsgSubmitProofDetail")
-	proto.RegisterType((*MsgSubmitProofDetailResponse)(nil), "shentu.bounty.v1.MsgSubmitProofDetailResponse")
-	proto.RegisterType((*MsgSubmitProofVerification)(nil), "shentu.bounty.v1.MsgSubmitProofVerification")
-	proto.RegisterType((*MsgSubmitProofVerificationResponse)(nil), "shentu.bounty.v1.MsgSubmitProofVerificationResponse")
-	proto.RegisterType((*MsgWithdrawReward)(nil), "shentu.bounty.v1.MsgWithdrawReward")
-	proto.RegisterType((*MsgWithdrawRewardResponse)(nil), "shentu.bounty.v1.MsgWithdrawRewardResponse")
-	proto.RegisterType((*MsgUpdateTheoremComplexity)(nil), "shentu.bounty.v1.MsgUpdateTheoremComplexity")
-	proto.RegisterType((*MsgUpdateTheoremComplexityResponse)(nil), "shentu.bounty.v1.MsgUpdateTheoremComplexityResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "shentu.bounty.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "shentu.bounty.v1.MsgUpdateParamsResponse")
+// MsgSubmitProofHashResponse defines the Msg/SubmitProofHash response type.
+type MsgSubmitProofHashResponse struct {
 }
 
-func init() { proto.RegisterFile("shentu/bounty/v1/tx.proto", fileDescriptor_1e4b4296bac3db30) }
-
-var fileDescriptor_1e4b4296bac3db30 = []byte{
-	// 1838 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x59, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xcf, 0xda, 0xf9, 0x68, 0x26, 0xdf, 0x9b, 0x2f, 0xdb, 0x6d, 0xec, 0x74, 0x29, 0x90, 0x86,
-	0x62, 0x37, 0x26, 0x2d, 0xc5, 0x45, 0x88, 0x26, 0xb4, 0x25, 0x82, 0xa8, 0xd1, 0xa6, 0x80, 0x40,
-	0x08, 0x6b, 0xed, 0x1d, 0xaf, 0x57, 0xf5, 0xee, 0x2c, 0xbb, 0xe3, 0xb4, 0x3e, 0x20, 0x21, 0x0e,
-	0x08, 0x38, 0xf1, 0x0f, 0x20, 0x7a, 0xe4, 0x58, 0x24, 0xfe, 0x00, 0x24, 0x24, 0xd4, 0x03, 0x87,
-	0xaa, 0x97, 0x72, 0xc1, 0x42, 0xed, 0xa1, 0xa8, 0xe2, 0x80, 0x22, 0x2e, 0xdc, 0xd0, 0xce, 0xcc,
-	0xae, 0x77, 0xd7, 0xbb, 0xd9, 0xc4, 0x89, 0x44, 0xb9, 0x44, 0x9e, 0x79, 0xbf, 0x79, 0x33, 0xbf,
-	0xdf, 0x9b, 0x79, 0x6f, 0x66, 0x03, 0xd2, 0x56, 0x1d, 0xea, 0xb8, 0x59, 0xa8, 0xa0, 0xa6, 0x8e,
-	0x5b, 0x85, 0x9d, 0x95, 0x02, 0xbe, 0x95, 0x37, 0x4c, 0x84, 0x11, 0x3f, 0x49, 0x4d, 0x79, 0x6a,
-	0xca, 0xef, 0xac, 0x64, 0x66, 0x14, 0xa4, 0x20, 0x62, 0x2c, 0xd8, 0xbf, 0x28, 0x2e, 0x93, 0x53,
-	0x10, 0x52, 0x1a, 0xb0, 0x40, 0x5a, 0x95, 0x66, 0xad, 0x80, 0x55, 0x0d, 0x5a, 0x58, 0xd2, 0x0c,
-	0x06, 0x48, 0x07, 0x01, 0x92, 0xde, 0x72, 0x4c, 0x55, 0x64, 0x69, 0xc8, 0x2a, 0x53, 0xa7, 0xb4,
-	0xc1, 0x4c, 0xf3, 0xb4, 0x55, 0xd0, 0x2c, 0xc5, 0x5e, 0x96, 0x66, 0x29, 0xcc, 0x90, 0x65, 0x86,
-	0x8a, 0x64, 0xc1, 0xc2, 0xce, 0x4a, 0x05, 0x62, 0x69, 0xa5, 0x50, 0x45, 0xaa, 0xce, 0xec, 0x53,
-	0x92, 0xa6, 0xea, 0xa8, 0x40, 0xfe, 0xb2, 0xae, 0x85, 0x2e, 0x96, 0x8c, 0x14, 0x31, 0x0b, 0x7f,
-	0x73, 0x60, 0x72, 0xd3, 0x52, 0xd6, 0x4d, 0x28, 0x61, 0xb8, 0x65, 0x22, 0xc5, 0x94, 0x34, 0x7e,
-	0x15, 0x00, 0x83, 0xfe, 0x2c, 0xab, 0x72, 0x8a, 0x5b, 0xe4, 0x96, 0x86, 0xd7, 0x66, 0x77, 0xdb,
-	0xb9, 0xa9, 0x96, 0xa4, 0x35, 0x4a, 0x42, 0xc7, 0x26, 0x88, 0xc3, 0xac, 0xb1, 0x21, 0xf3, 0x3c,
-	0xe8, 0xd7, 0x25, 0x0d, 0xa6, 0x12, 0x36, 0x5e, 0x24, 0xbf, 0xf9, 0x39, 0x30, 0x28, 0x43, 0x2c,
-	0xa9, 0x8d, 0x54, 0x92, 0xf4, 0xb2, 0x16, 0x7f, 0x05, 0x4c, 0x22, 0x03, 0x9a, 0x12, 0x46, 0x66,
-	0x59, 0x92, 0x65, 0x13, 0x5a, 0x56, 0xaa, 0x9f, 0xcc, 0x73, 0x7c, 0xb7, 0x9d, 0x9b, 0xa7, 0xf3,
-	0x04, 0x11, 0x82, 0x38, 0xe1, 0x74, 0x5d, 0xa2, 0x3d, 0xa5, 0xf3, 0x5f, 0xdc, 0xce, 0xf5, 0xfd,
-	0x71, 0x3b, 0xd7, 0xf7, 0xd9, 0xe3, 0x3b, 0xcb, 0x5d, 0x2e, 0xbf, 0x7a, 0x7c, 0x67, 0x79, 0x86,
-	0x11, 0xf7, 0x31, 0x14, 0xfe, 0xe2, 0xc0, 0xf8, 0xa6, 0xa5, 0x5c, 0x96, 0x55, 0xfc, 0xff, 0x23,
-	0xbd, 0x1a, 0x4b, 0x9a, 0x67, 0xa4, 0x3d, 0xfc, 0x84, 0x0c, 0x48, 0x05, 0x03, 0x2d, 0x42, 0xcb,
-	0x40, 0xba, 0x05, 0x85, 0x14, 0x98, 0xf3, 0xab, 0xe1, 0x5a, 0x7e, 0xe1, 0x00, 0xbf, 0x69, 0x29,
-	0x97, 0xaa, 0x58, 0xdd, 0x39, 0xf4, 0x0e, 0x09, 0x13, 0x20, 0xd1, 0x83, 0x00, 0x17, 0x62, 0x05,
-	0x98, 0x63, 0x02, 0x04, 0xd6, 0x2d, 0x9c, 0x00, 0x99, 0x6e, 0x36, 0x2e, 0xd9, 0x9f, 0x39, 0x30,
-	0x61, 0x6b, 0xd4, 0x40, 0xd6, 0x53, 0xc2, 0xf4, 0x5c, 0x2c, 0xd3, 0x69, 0x67, 0x7f, 0x7b, 0x16,
-	0x2d, 0xa4, 0xc1, 0x7c, 0x80, 0x87, 0xcb, 0xf1, 0x9b, 0x24, 0x39, 0xf0, 0xdb, 0xcd, 0x8a, 0xa6,
-	0xe2, 0x2b, 0xaa, 0x2e, 0xab, 0xba, 0xd2, 0x23, 0xc9, 0x55, 0x00, 0x6a, 0xd4, 0x81, 0x3d, 0x2a,
-	0x11, 0x1c, 0xd5, 0xb1, 0x09, 0xe2, 0x30, 0x6b, 0x6c, 0xc8, 0x7c, 0x09, 0x8c, 0x3a, 0x96, 0xba,
-	0x64, 0xd5, 0xe9, 0x19, 0x59, 0x9b, 0xdf, 0x6d, 0xe7, 0xa6, 0xfd, 0xe3, 0x6c, 0xab, 0x20, 0x8e,
-	0xb0, 0xe6, 0x9b, 0x92, 0x55, 0x3f, 0xaa, 0x13, 0xc4, 0x4b, 0x60, 0xdc, 0x82, 0x3b, 0xd0, 0x54,
-	0x71, 0xab, 0xdc, 0x80, 0x3b, 0xb0, 0x91, 0x1a, 0x58, 0xe4, 0x96, 0xc6, 0x8b, 0xb9, 0x7c, 0x30,
-	0xf1, 0xe7, 0xb7, 0x19, 0xee, 0x6d, 0x1b, 0xb6, 0x96, 0xde, 0x6d, 0xe7, 0x66, 0xe9, 0x34, 0x7e,
-	0x07, 0x82, 0x38, 0x66, 0x79, 0x91, 0x07, 0xc8, 0x4c, 0xbe, 0x50, 0xb0, 0x63, 0xea, 0xeb, 0x73,
-	0x63, 0xf7, 0x6d, 0xd2, 0xcd, 0x5a, 0x9e, 0xc8, 0x79, 0x62, 0xc0, 0xf5, 0x18, 0x83, 0xc4, 0x21,
-	0x63, 0x90, 0x3c, 0x92, 0x18, 0xf4, 0x1f, 0x71, 0x0c, 0x6c, 0x9a, 0x86, 0xd4, 0xd2, 0xa0, 0x8e,
-	0x29, 0xcd, 0x81, 0x20, 0x4d, 0xaf, 0x55, 0x10, 0x47, 0x58, 0xd3, 0xa6, 0x79, 0xc0, 0x24, 0xeb,
-	0x44, 0xaf, 0x93, 0x48, 0x83, 0xb1, 0xfb, 0x93, 0x03, 0x53, 0xf6, 0x99, 0x44, 0x7a, 0x4d, 0x35,
-	0xb5, 0xc3, 0x85, 0xef, 0x88, 0xb2, 0x0b, 0xbf, 0x08, 0xec, 0xc8, 0x2a, 0xd0, 0x34, 0x4c, 0x55,
-	0xc7, 0xac, 0x5a, 0x79, 0xbb, 0x4a, 0x2f, 0xc7, 0xaa, 0x30, 0xeb, 0xe4, 0x1f, 0x1f, 0x31, 0xe1,
-	0x38, 0x48, 0x77, 0xb1, 0x8d, 0x2a, 0x2a, 0x4f, 0x85, 0x18, 0x3d, 0x14, 0x15, 0x87, 0xab, 0xbf,
-	0xa8, 0x04, 0xc9, 0xde, 0xe7, 0xc0, 0x6c, 0x97, 0x14, 0x5b, 0x92, 0x2a, 0xff, 0xc7, 0x7c, 0x2f,
-	0xc6, 0xf2, 0x4d, 0x87, 0x86, 0xd6, 0x5e, 0xba, 0x90, 0x03, 0x0b, 0xa1, 0x9c, 0x42, 0x4b, 0xe9,
-	0xd3, 0x11, 0xdf, 0x03, 0x96, 0x52, 0x27, 0xb8, 0x9e, 0x52, 0x1a, 0x8c, 0xec, 0x3f, 0x09, 0x72,
-	0xa4, 0xb7, 0x9a, 0x95, 0x86, 0x6a, 0xd5, 0x0f, 0xc7, 0x72, 0x06, 0x0c, 0x60, 0x15, 0x37, 0x9c,
-	0x8b, 0x24, 0x6d, 0x44, 0xde, 0x24, 0x2f, 0x80, 0x11, 0x19, 0x5a, 0x55, 0x53, 0x35, 0xb0, 0x8a,
-	0x74, 0x56, 0x02, 0xe7, 0x76, 0xdb, 0x39, 0x9e, 0x4e, 0xe2, 0x31, 0x0a, 0xa2, 0x17, 0xca, 0x5f,
-	0x06, 0x93, 0x86, 0x89, 0x50, 0xad, 0x8c, 0x6a, 0xe5, 0x2a, 0xd2, 0xab, 0xd0, 0xc0, 0x2c, 0x2d,
-	0x7a, 0xd4, 0x0c, 0x22, 0x04, 0x71, 0x9c, 0x74, 0x5d, 0xab, 0xad, 0xd3, 0x8e, 0xd0, 0xa0, 0x0c,
-	0xf6, 0x10, 0x94, 0xfd, 0xe7, 0x17, 0xbf, 0xca, 0x2c, 0xbf, 0xf8, 0x3b, 0xdd, 0xc0, 0xfc, 0x94,
-	0xf0, 0x3c, 0x6a, 0xae, 0xd7, 0x21, 0x32, 0xa1, 0xd6, 0x51, 0x98, 0xf3, 0x2a, 0xbc, 0xe8, 0x57,
-	0x92, 0xaa, 0xef, 0x53, 0x8c, 0x07, 0xfd, 0x55, 0x24, 0x43, 0x16, 0x01, 0xf2, 0x9b, 0xdf, 0x00,
-	0x63, 0xaa, 0xae, 0x62, 0x55, 0x6a, 0x94, 0x15, 0x53, 0xd2, 0x71, 0xaa, 0x7f, 0x31, 0xb9, 0x34,
-	0x52, 0x4c, 0xe7, 0xd9, 0x33, 0xce, 0x7e, 0x9f, 0xe5, 0xd9, 0xfb, 0x2c, 0xbf, 0x8e, 0x54, 0x7d,
-	0x6d, 0xf8, 0x6e, 0x3b, 0xd7, 0xf7, 0xdd, 0xe3, 0x3b, 0xcb, 0x9c, 0x38, 0xca, 0x86, 0x5e, 0xb5,
-	0x47, 0xf2, 0xab, 0xe0, 0x98, 0x61, 0x22, 0x03, 0x59, 0xd0, 0x64, 0x81, 0x48, 0xdd, 0xff, 0xe1,
-	0xc5, 0x19, 0xe6, 0x88, 0xe9, 0xb4, 0x8d, 0x4d, 0x9b, 0x9f, 0x8b, 0xe4, 0x8b, 0x60, 0xd6, 0x84,
-	0x1f, 0x37, 0x55, 0x13, 0x96, 0x91, 0x01, 0x75, 0x4d, 0xc2, 0xf5, 0x72, 0x15, 0x9a, 0x98, 0x04,
-	0xe1, 0x98, 0x38, 0xcd, 0x8c, 0xd7, 0x98, 0x6d, 0x1d, 0x9a, 0xb8, 0x94, 0xf7, 0x6a, 0xed, 0xba,
-	0xea, 0x7e, 0x23, 0x31, 0xc1, 0x84, 0x57, 0x3c, 0x0f, 0x06, 0xd6, 0xe7, 0x28, 0xcc, 0x2f, 0x00,
-	0x80, 0x69, 0x97, 0xb3, 0xc9, 0xfb, 0xc5, 0x61, 0xd6, 0xb3, 0x21, 0x0b, 0x0f, 0x38, 0x70, 0x6c,
-	0xd3, 0x52, 0x28, 0xc3, 0x62, 0x37, 0x76, 0x6d, 0xfa, 0x49, 0x3b, 0xe7, 0xe9, 0xa5, 0xc2, 0x74,
-	0x1c, 0xf0, 0x45, 0x30, 0x44, 0x84, 0x45, 0x26, 0x3b, 0xeb, 0xd1, 0xa2, 0x38, 0x40, 0xfe, 0x55,
-	0x30, 0x28, 0x69, 0x36, 0x91, 0x54, 0xf2, 0x00, 0xd1, 0x60, 0x63, 0x4a, 0xcf, 0x7a, 0xd5, 0x71,
-	0x7c, 0xda, 0xe2, 0x8c, 0x32, 0x71, 0x08, 0x19, 0x81, 0x27, 0x3b, 0x8b, 0xfc, 0x76, 0xb7, 0xdb,
-	0x97, 0x09, 0x52, 0xce, 0xe8, 0x9d, 0x6d, 0xcb, 0x3e, 0x28, 0xe4, 0xa2, 0xd4, 0x0b, 0xef, 0xb3,
-	0x60, 0xd0, 0x30, 0xd1, 0x0e, 0x8c, 0xa7, 0xcd, 0x70, 0x76, 0x24, 0xe8, 0x71, 0xed, 0x5c, 0xa6,
-	0xc9, 0x1d, 0x9d, 0x2d, 0xe2, 0x35, 0x30, 0x24, 0x43, 0x03, 0x59, 0xea, 0xc1, 0xf6, 0xa8, 0x33,
-	0xc8, 0xbf, 0x69, 0xd8, 0x9c, 0xde, 0x5a, 0x18, 0x20, 0xcd, 0x6a, 0x61, 0xa0, 0xd7, 0x55, 0xea,
-	0x47, 0x0e, 0xcc, 0xf8, 0xcd, 0x6f, 0xd0, 0x84, 0x76, 0x86, 0x9c, 0x02, 0x54, 0xeb, 0xa4, 0xcc,
-	0xa9, 0x27, 0xed, 0x9c, 0xdb, 0xc7, 0x16, 0x45, 0x9a, 0x3d, 0xa9, 0x14, 0x91, 0x48, 0x4b, 0x67,
-	0x23, 0xe8, 0xa5, 0xba, 0xe9, 0xd1, 0x95, 0x0a, 0x59, 0x70, 0x22, 0x8c, 0x81, 0x4b, 0xf1, 0x41,
-	0x22, 0xa8, 0xc0, 0xbb, 0xd0, 0x54, 0x6b, 0x6a, 0x55, 0x22, 0xd9, 0x24, 0x1d, 0x24, 0xda, 0x61,
-	0x75, 0x0e, 0x0c, 0x5a, 0x58, 0xc2, 0x4d, 0x5a, 0xde, 0xc6, 0x8b, 0x0b, 0xdd, 0x17, 0x61, 0xe2,
-	0x6f, 0x9b, 0x80, 0x44, 0x06, 0xb6, 0x8f, 0x4a, 0xb5, 0x0e, 0xab, 0x37, 0xa0, 0xc9, 0xae, 0xe1,
-	0x7b, 0x1c, 0x15, 0x06, 0xe4, 0xb3, 0x00, 0x54, 0x91, 0x66, 0x34, 0xe0, 0x2d, 0x15, 0xb7, 0x48,
-	0xf9, 0x48, 0x8a, 0x9e, 0x1e, 0x3e, 0x05, 0x86, 0x54, 0xcd, 0x40, 0x26, 0xb6, 0x52, 0x03, 0x8b,
-	0xc9, 0xa5, 0x7e, 0xd1, 0x69, 0xf2, 0xaf, 0x83, 0x51, 0x67, 0xfb, 0xe2, 0x96, 0x01, 0x49, 0xbe,
-	0x09, 0x5d, 0x2a, 0xcb, 0x18, 0xd7, 0x5b, 0x06, 0x14, 0x47, 0x70, 0xa7, 0xe1, 0x4f, 0xf9, 0xce,
-	0x8a, 0x6c, 0xcd, 0xb3, 0xdd, 0x9a, 0x7b, 0xa5, 0x13, 0x4e, 0x01, 0x21, 0x5a, 0x58, 0x57, 0xff,
-	0x9b, 0xa4, 0x26, 0xbf, 0xa7, 0xe2, 0xba, 0x6c, 0x4a, 0x37, 0x45, 0x78, 0x53, 0x32, 0x49, 0x3a,
-	0x71, 0xaa, 0x14, 0x17, 0xa7, 0x11, 0x03, 0xfa, 0x77, 0xfe, 0x50, 0x48, 0x45, 0xf2, 0xcf, 0xc1,
-	0x2a, 0x92, 0xbf, 0xd3, 0x5d, 0xd5, 0x6f, 0x1c, 0xd9, 0x15, 0xef, 0x18, 0x72, 0x27, 0x99, 0xae,
-	0x77, 0xf4, 0xee, 0x31, 0x45, 0x3a, 0x71, 0x4f, 0xf4, 0x16, 0xf7, 0x64, 0x30, 0xee, 0xf1, 0xb1,
-	0x89, 0x20, 0xc0, 0x62, 0x13, 0x61, 0x75, 0x55, 0xf8, 0x9e, 0x5e, 0x0a, 0x29, 0x6c, 0x4b, 0x32,
-	0x25, 0xcd, 0xe2, 0xcf, 0x83, 0x61, 0xa9, 0x89, 0xeb, 0xc8, 0x7e, 0xb5, 0xc5, 0x06, 0xa7, 0x03,
-	0xe5, 0x2f, 0x82, 0x41, 0x83, 0x78, 0x20, 0xec, 0x47, 0x8a, 0xa9, 0x90, 0xd3, 0x42, 0xec, 0xbe,
-	0x64, 0x4f, 0x87, 0x94, 0x4e, 0xdb, 0xfc, 0x3a, 0xce, 0xbc, 0x09, 0x2d, 0xb0, 0x3e, 0x76, 0xff,
-	0xf3, 0x76, 0x39, 0x74, 0x8a, 0x9f, 0x4f, 0x80, 0xe4, 0xa6, 0xa5, 0xf0, 0x65, 0x30, 0xe6, 0xff,
-	0x7e, 0x2a, 0x74, 0xaf, 0x25, 0xf8, 0xe9, 0x2d, 0xb3, 0x1c, 0x8f, 0x71, 0xab, 0xed, 0xfb, 0x60,
-	0xc4, 0xfb, 0xa5, 0x72, 0x31, 0x74, 0xa8, 0x07, 0x91, 0x59, 0x8a, 0x43, 0xb8, 0xae, 0x21, 0x98,
-	0x08, 0x7e, 0xdb, 0x3b, 0x15, 0x3a, 0x38, 0x80, 0xca, 0x9c, 0xd9, 0x0f, 0xca, 0x9d, 0xe6, 0x43,
-	0x30, 0xea, 0xfb, 0xaa, 0x76, 0x32, 0x9c, 0xbd, 0x07, 0x92, 0x39, 0x1d, 0x0b, 0x71, 0xbd, 0x97,
-	0xc1, 0x98, 0xff, 0x7b, 0x56, 0x78, 0x00, 0x7c, 0x98, 0x88, 0x00, 0x84, 0x7e, 0x78, 0x71, 0x02,
-	0xe0, 0xb8, 0x8f, 0x0e, 0x80, 0xe3, 0x7c, 0x29, 0x0e, 0x11, 0x16, 0x00, 0xc7, 0xfd, 0xde, 0x01,
-	0x70, 0xa6, 0x38, 0xb3, 0x1f, 0x94, 0x3b, 0x4d, 0x05, 0x8c, 0x07, 0x3e, 0x3d, 0x3c, 0x13, 0xae,
-	0xaf, 0x0f, 0x94, 0x79, 0x61, 0x1f, 0x20, 0x77, 0x0e, 0x1d, 0xf0, 0x21, 0xaf, 0xdc, 0xe7, 0xf7,
-	0xe1, 0xc2, 0x06, 0x66, 0x0a, 0xfb, 0x04, 0x76, 0x6d, 0x2a, 0x87, 0xd1, 0x1e, 0x9b, 0xca, 0xe1,
-	0x73, 0x3a, 0x16, 0xe2, 0x55, 0x2c, 0xf0, 0xb2, 0x0b, 0x57, 0xcc, 0x0f, 0x8a, 0x50, 0x2c, 0xfc,
-	0xa1, 0xd2, 0xc9, 0x1c, 0xce, 0x23, 0x65, 0xaf, 0xcc, 0xc1, 0x30, 0x7b, 0x66, 0x8e, 0xe0, 0x3d,
-	0x1d, 0x82, 0x89, 0xe0, 0xb5, 0xf4, 0xd4, 0x1e, 0xfb, 0xde, 0x45, 0x45, 0xec, 0xae, 0x88, 0x7b,
-	0x1d, 0x7f, 0x03, 0x4c, 0x75, 0xdf, 0xe9, 0x9e, 0x8b, 0x73, 0x41, 0x71, 0x99, 0xfc, 0xfe, 0x70,
-	0xee, 0x64, 0x9f, 0x80, 0xf9, 0xa8, 0xdb, 0x55, 0xec, 0xaa, 0xbd, 0xe8, 0xcc, 0xea, 0x41, 0xd0,
-	0xde, 0xe9, 0xa3, 0xca, 0x78, 0xf8, 0xf4, 0x11, 0xe8, 0x88, 0xe9, 0x63, 0x6a, 0x28, 0x7f, 0x15,
-	0x0c, 0xd0, 0x67, 0x55, 0x26, 0x74, 0x38, 0xb1, 0x65, 0x84, 0x68, 0x9b, 0x77, 0x7f, 0x07, 0x6e,
-	0x49, 0xe1, 0xfb, 0xdb, 0x0f, 0x8a, 0xd8, 0xdf, 0xe1, 0xd7, 0x1e, 0xfe, 0x23, 0x30, 0xea, 0x2b,
-	0xf6, 0x27, 0xf7, 0xa0, 0x4c, 0x21, 0x11, 0x27, 0x34, 0xac, 0xfe, 0x0a, 0x7d, 0x99, 0x81, 0x4f,
-	0xed, 0xb2, 0xbe, 0xf6, 0xd6, 0xdd, 0x87, 0x59, 0xee, 0xde, 0xc3, 0x2c, 0xf7, 0xfb, 0xc3, 0x2c,
-	0xf7, 0xf5, 0xa3, 0x6c, 0xdf, 0xbd, 0x47, 0xd9, 0xbe, 0x5f, 0x1f, 0x65, 0xfb, 0x3e, 0x58, 0x51,
-	0x54, 0x5c, 0x6f, 0x56, 0xf2, 0x55, 0xa4, 0x15, 0xa8, 0xdf, 0x1a, 0x6a, 0xea, 0x32, 0x89, 0x28,
-	0xeb, 0x28, 0xdc, 0x72, 0xfe, 0x37, 0x6a, 0x5f, 0x68, 0xad, 0xca, 0x20, 0xf9, 0xc7, 0xe8, 0x4b,
-	0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x43, 0x1e, 0x89, 0x16, 0x1f, 0x1e, 0x00, 0x00,
+func (m *MsgSubmitProofHashResponse) Reset()         { *m = MsgSubmitProofHashResponse{} }
+func (m *MsgSubmitProofHashResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProofHashResponse) ProtoMessage()    {}
+func (*MsgSubmitProofHashResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{45}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// CreateProgram defines a method for creating a new program.
-	CreateProgram(ctx context.Context, in *MsgCreateProgram, opts ...grpc.CallOption) (*MsgCreateProgramResponse, error)
-	// EditProgram defines a method for modifying a program.
-	EditProgram(ctx context.Context, in *MsgEditProgram, opts ...grpc.CallOption) (*MsgEditProgramResponse, error)
-	// Activate a program status by program_id
-	ActivateProgram(ctx context.Context, in *MsgActivateProgram, opts ...grpc.CallOption) (*MsgActivateProgramResponse, error)
-	// Closed a program status by program_id
-	CloseProgram(ctx context.Context, in *MsgCloseProgram, opts ...grpc.CallOption) (*MsgCloseProgramResponse, error)
-	// SubmitFinding defines a method for submitting a new finding.
-	SubmitFinding(ctx context.Context, in *MsgSubmitFinding, opts ...grpc.CallOption) (*MsgSubmitFindingResponse, error)
-	// EditFinding defines a method for editing a new finding.
-	EditFinding(ctx context.Context, in *MsgEditFinding, opts ...grpc.CallOption) (*MsgEditFindingResponse, error)
-	// ActivateFinding defines a method for activate a new finding.
-	ActivateFinding(ctx context.Context, in *MsgActivateFinding, opts ...grpc.CallOption) (*MsgActivateFindingResponse, error)
-	// ConfirmFinding defines a method for host confirm a finding.
-	ConfirmFinding(ctx context.Context, in *MsgConfirmFinding, opts ...grpc.CallOption) (*MsgConfirmFindingResponse, error)
-	// ConfirmFindingPaid defines a method for submitter confirm a finding paid
-	ConfirmFindingPaid(ctx context.Context, in *MsgConfirmFindingPaid, opts ...grpc.CallOption) (*MsgConfirmFindingPaidResponse, error)
-	// CloseFinding defines a method for submitter or certifier close a finding
-	CloseFinding(ctx context.Context, in *MsgCloseFinding, opts ...grpc.CallOption) (*MsgCloseFindingResponse, error)
-	// PublishFinding defines a method for publish a finding.
-	PublishFinding(ctx context.Context, in *MsgPublishFinding, opts ...grpc.CallOption) (*MsgPublishFindingResponse, error)
-	// CreateTheorem defines a method to create new theorem given the messages.
-	CreateTheorem(ctx context.Context, in *MsgCreateTheorem, opts ...grpc.CallOption) (*MsgCreateTheoremResponse, error)
-	// SubmitProofHash defines a method to submit a proof with hash.
-	SubmitProofHash(ctx context.Context, in *MsgSubmitProofHash, opts ...grpc.CallOption) (*MsgSubmitProofHashResponse, error)
-	// SubmitProofDetail defines a method to submit a proof with detail.
-	SubmitProofDetail(ctx context.Context, in *MsgSubmitProofDetail, opts ...grpc.CallOption) (*MsgSubmitProofDetailResponse, error)
-	// SubmitProofVerification defines a method to submit a proof result.
-	SubmitProofVerification(ctx context.Context, in *MsgSubmitProofVerification, opts ...grpc.CallOption) (*MsgSubmitProofVerificationResponse, error)
-	// UpdateTheoremComplexity defines a method for checker to update theorem complexity.
-	UpdateTheoremComplexity(ctx context.Context, in *MsgUpdateTheoremComplexity, opts ...grpc.CallOption) (*MsgUpdateTheoremComplexityResponse, error)
-	// Grant defines a method to grant theorem given the messages.
-	Grant(ctx context.Context, in *MsgGrant, opts ...grpc.CallOption) (*MsgGrantResponse, error)
-	// WithdrawReward defines a method to withdraw reward given the messages.
-	WithdrawReward(ctx context.Context, in *MsgWithdrawReward, opts ...grpc.CallOption) (*MsgWithdrawRewardResponse, error)
-	// UpdateParams defines a governance operation for updating the x/bounty module parameters.
-	// The authority is defined in the keeper.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgSubmitProofHashResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSubmitProofHashResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProofHashResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSubmitProofHashResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProofHashResponse.Merge(m, src)
 }
-
-func (c *msgClient) CreateProgram(ctx context.Context, in *MsgCreateProgram, opts ...grpc.CallOption) (*MsgCreateProgramResponse, error) {
-	out := new(MsgCreateProgramResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/CreateProgram", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSubmitProofHashResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) EditProgram(ctx context.Context, in *MsgEditProgram, opts ...grpc.CallOption) (*MsgEditProgramResponse, error) {
-	out := new(MsgEditProgramResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/EditProgram", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSubmitProofHashResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProofHashResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ActivateProgram(ctx context.Context, in *MsgActivateProgram, opts ...grpc.CallOption) (*MsgActivateProgramResponse, error) {
-	out := new(MsgActivateProgramResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/ActivateProgram", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSubmitProofHashResponse proto.InternalMessageInfo
+
+// MsgSubmitProofDetail defines a message to submit proof details.
+type MsgSubmitProofDetail struct {
+	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id"`
+	Prover  string `protobuf:"bytes,2,opt,name=prover,proto3" json:"prover,omitempty"`
+	Detail  string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
 }
 
-func (c *msgClient) CloseProgram(ctx context.Context, in *MsgCloseProgram, opts ...grpc.CallOption) (*MsgCloseProgramResponse, error) {
-	out := new(MsgCloseProgramResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/CloseProgram", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSubmitProofDetail) Reset()         { *m = MsgSubmitProofDetail{} }
+func (m *MsgSubmitProofDetail) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProofDetail) ProtoMessage()    {}
+func (*MsgSubmitProofDetail) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{46}
 }
-
-func (c *msgClient) SubmitFinding(ctx context.Context, in *MsgSubmitFinding, opts ...grpc.CallOption) (*MsgSubmitFindingResponse, error) {
-	out := new(MsgSubmitFindingResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/SubmitFinding", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSubmitProofDetail) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) EditFinding(ctx context.Context, in *MsgEditFinding, opts ...grpc.CallOption) (*MsgEditFindingResponse, error) {
-	out := new(MsgEditFindingResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/EditFinding", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProofDetail) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProofDetail.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitProofDetail) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProofDetail.Merge(m, src)
+}
+func (m *MsgSubmitProofDetail) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProofDetail) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProofDetail.DiscardUnknown(m)
 }
 
-func (c *msgClient) ActivateFinding(ctx context.Context, in *MsgActivateFinding, opts ...grpc.CallOption) (*MsgActivateFindingResponse, error) {
-	out := new(MsgActivateFindingResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/ActivateFinding", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSubmitProofDetail proto.InternalMessageInfo
+
+// MsgSubmitProofDetailResponse defines the Msg/SubmitProofDetail response type.
+type MsgSubmitProofDetailResponse struct {
 }
 
-func (c *msgClient) ConfirmFinding(ctx context.Context, in *MsgConfirmFinding, opts ...grpc.CallOption) (*MsgConfirmFindingResponse, error) {
-	out := new(MsgConfirmFindingResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/ConfirmFinding", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProofDetailResponse) Reset()         { *m = MsgSubmitProofDetailResponse{} }
+func (m *MsgSubmitProofDetailResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProofDetailResponse) ProtoMessage()    {}
+func (*MsgSubmitProofDetailResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{47}
+}
+func (m *MsgSubmitProofDetailResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProofDetailResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProofDetailResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitProofDetailResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProofDetailResponse.Merge(m, src)
+}
+func (m *MsgSubmitProofDetailResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProofDetailResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProofDetailResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ConfirmFindingPaid(ctx context.Context, in *MsgConfirmFindingPaid, opts ...grpc.CallOption) (*MsgConfirmFindingPaidResponse, error) {
-	out := new(MsgConfirmFindingPaidResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/ConfirmFindingPaid", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSubmitProofDetailResponse proto.InternalMessageInfo
+
+// MsgUploadProofChunk defines a message to upload a chunk of a proof detail in hash lock period.
+type MsgUploadProofChunk struct {
+	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
+	Prover  string `protobuf:"bytes,2,opt,name=prover,proto3" json:"prover,omitempty"`
+	// data is the chunk, zstd compressed if the detail is to be submitted as compressed.
+	Data []byte `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
 }
 
-func (c *msgClient) CloseFinding(ctx context.Context, in *MsgCloseFinding, opts ...grpc.CallOption) (*MsgCloseFindingResponse, error) {
-	out := new(MsgCloseFindingResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/CloseFinding", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUploadProofChunk) Reset()         { *m = MsgUploadProofChunk{} }
+func (m *MsgUploadProofChunk) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadProofChunk) ProtoMessage()    {}
+func (*MsgUploadProofChunk) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{48}
+}
+func (m *MsgUploadProofChunk) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadProofChunk) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadProofChunk.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUploadProofChunk) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadProofChunk.Merge(m, src)
+}
+func (m *MsgUploadProofChunk) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadProofChunk) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadProofChunk.DiscardUnknown(m)
 }
 
-func (c *msgClient) PublishFinding(ctx context.Context, in *MsgPublishFinding, opts ...grpc.CallOption) (*MsgPublishFindingResponse, error) {
-	out := new(MsgPublishFindingResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/PublishFinding", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUploadProofChunk proto.InternalMessageInfo
+
+// MsgUploadProofChunkResponse defines the Msg/UploadProofChunk response type.
+type MsgUploadProofChunkResponse struct {
+	// hash is the hex encoded sha256 hash of the chunk data.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (c *msgClient) CreateTheorem(ctx context.Context, in *MsgCreateTheorem, opts ...grpc.CallOption) (*MsgCreateTheoremResponse, error) {
-	out := new(MsgCreateTheoremResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/CreateTheorem", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUploadProofChunkResponse) Reset()         { *m = MsgUploadProofChunkResponse{} }
+func (m *MsgUploadProofChunkResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUploadProofChunkResponse) ProtoMessage()    {}
+func (*MsgUploadProofChunkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{49}
+}
+func (m *MsgUploadProofChunkResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUploadProofChunkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUploadProofChunkResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUploadProofChunkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUploadProofChunkResponse.Merge(m, src)
+}
+func (m *MsgUploadProofChunkResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUploadProofChunkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUploadProofChunkResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) SubmitProofHash(ctx context.Context, in *MsgSubmitProofHash, opts ...grpc.CallOption) (*MsgSubmitProofHashResponse, error) {
-	out := new(MsgSubmitProofHashResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/SubmitProofHash", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgUploadProofChunkResponse proto.InternalMessageInfo
+
+func (m *MsgUploadProofChunkResponse) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) SubmitProofDetail(ctx context.Context, in *MsgSubmitProofDetail, opts ...grpc.CallOption) (*MsgSubmitProofDetailResponse, error) {
-	out := new(MsgSubmitProofDetailResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/SubmitProofDetail", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgSubmitProofDetailChunks defines a message to submit a proof detail as the concatenation of
+// uploaded chunks, checked against the proof hash.
+type MsgSubmitProofDetailChunks struct {
+	ProofId string `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
+	Prover  string `protobuf:"bytes,2,opt,name=prover,proto3" json:"prover,omitempty"`
+	// chunk_hashes are the hashes of the uploaded chunks in detail order.
+	ChunkHashes []string `protobuf:"bytes,3,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// compressed tells whether every chunk is a zstd frame.
+	Compressed bool `protobuf:"varint,4,opt,name=compressed,proto3" json:"compressed,omitempty"`
 }
 
-func (c *msgClient) SubmitProofVerification(ctx context.Context, in *MsgSubmitProofVerification, opts ...grpc.CallOption) (*MsgSubmitProofVerificationResponse, error) {
-	out := new(MsgSubmitProofVerificationResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/SubmitProofVerification", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProofDetailChunks) Reset()         { *m = MsgSubmitProofDetailChunks{} }
+func (m *MsgSubmitProofDetailChunks) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProofDetailChunks) ProtoMessage()    {}
+func (*MsgSubmitProofDetailChunks) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{50}
+}
+func (m *MsgSubmitProofDetailChunks) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProofDetailChunks) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProofDetailChunks.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitProofDetailChunks) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProofDetailChunks.Merge(m, src)
+}
+func (m *MsgSubmitProofDetailChunks) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProofDetailChunks) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProofDetailChunks.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateTheoremComplexity(ctx context.Context, in *MsgUpdateTheoremComplexity, opts ...grpc.CallOption) (*MsgUpdateTheoremComplexityResponse, error) {
-	out := new(MsgUpdateTheoremComplexityResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/UpdateTheoremComplexity", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSubmitProofDetailChunks proto.InternalMessageInfo
+
+// MsgSubmitProofDetailChunksResponse defines the Msg/SubmitProofDetailChunks response type.
+type MsgSubmitProofDetailChunksResponse struct {
 }
 
-func (c *msgClient) Grant(ctx context.Context, in *MsgGrant, opts ...grpc.CallOption) (*MsgGrantResponse, error) {
-	out := new(MsgGrantResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/Grant", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProofDetailChunksResponse) Reset()         { *m = MsgSubmitProofDetailChunksResponse{} }
+func (m *MsgSubmitProofDetailChunksResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProofDetailChunksResponse) ProtoMessage()    {}
+func (*MsgSubmitProofDetailChunksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{51}
+}
+func (m *MsgSubmitProofDetailChunksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProofDetailChunksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProofDetailChunksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgSubmitProofDetailChunksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProofDetailChunksResponse.Merge(m, src)
+}
+func (m *MsgSubmitProofDetailChunksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProofDetailChunksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProofDetailChunksResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) WithdrawReward(ctx context.Context, in *MsgWithdrawReward, opts ...grpc.CallOption) (*MsgWithdrawRewardResponse, error) {
-	out := new(MsgWithdrawRewardResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/WithdrawReward", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgSubmitProofDetailChunksResponse proto.InternalMessageInfo
+
+// MsgSubmitProofVerification defines a message to submit proof verification.
+type MsgSubmitProofVerification struct {
+	ProofId     string      `protobuf:"bytes,1,opt,name=proof_id,json=proofId,proto3" json:"proof_id,omitempty"`
+	Status      ProofStatus `protobuf:"varint,2,opt,name=status,proto3,enum=shentu.bounty.v1.ProofStatus" json:"status,omitempty"`
+	Checker     string      `protobuf:"bytes,3,opt,name=checker,proto3" json:"checker,omitempty"`
+	Complexity  int64       `protobuf:"varint,4,opt,name=complexity,proto3" json:"complexity,omitempty"`
+	Imports     []uint64    `protobuf:"varint,5,rep,packed,name=imports,proto3" json:"imports,omitempty"`
+	TheoremType TheoremType `protobuf:"varint,6,opt,name=theorem_type,json=theoremType,proto3,enum=shentu.bounty.v1.TheoremType" json:"theorem_type,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSubmitProofVerification) Reset()         { *m = MsgSubmitProofVerification{} }
+func (m *MsgSubmitProofVerification) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProofVerification) ProtoMessage()    {}
+func (*MsgSubmitProofVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{52}
+}
+func (m *MsgSubmitProofVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSubmitProofVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProofVerification.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// CreateProgram defines a method for creating a new program.
-	CreateProgram(context.Context, *MsgCreateProgram) (*MsgCreateProgramResponse, error)
-	// EditProgram defines a method for modifying a program.
-	EditProgram(context.Context, *MsgEditProgram) (*MsgEditProgramResponse, error)
-	// Activate a program status by program_id
-	ActivateProgram(context.Context, *MsgActivateProgram) (*MsgActivateProgramResponse, error)
-	// Closed a program status by program_id
-	CloseProgram(context.Context, *MsgCloseProgram) (*MsgCloseProgramResponse, error)
-	// SubmitFinding defines a method for submitting a new finding.
-	SubmitFinding(context.Context, *MsgSubmitFinding) (*MsgSubmitFindingResponse, error)
-	// EditFinding defines a method for editing a new finding.
-	EditFinding(context.Context, *MsgEditFinding) (*MsgEditFindingResponse, error)
-	// ActivateFinding defines a method for activate a new finding.
-	ActivateFinding(context.Context, *MsgActivateFinding) (*MsgActivateFindingResponse, error)
-	// ConfirmFinding defines a method for host confirm a finding.
-	ConfirmFinding(context.Context, *MsgConfirmFinding) (*MsgConfirmFindingResponse, error)
-	// ConfirmFindingPaid defines a method for submitter confirm a finding paid
-	ConfirmFindingPaid(context.Context, *MsgConfirmFindingPaid) (*MsgConfirmFindingPaidResponse, error)
-	// CloseFinding defines a method for submitter or certifier close a finding
-	CloseFinding(context.Context, *MsgCloseFinding) (*MsgCloseFindingResponse, error)
-	// PublishFinding defines a method for publish a finding.
-	PublishFinding(context.Context, *MsgPublishFinding) (*MsgPublishFindingResponse, error)
-	// CreateTheorem defines a method to create new theorem given the messages.
-	CreateTheorem(context.Context, *MsgCreateTheorem) (*MsgCreateTheoremResponse, error)
-	// SubmitProofHash defines a method to submit a proof with hash.
-	SubmitProofHash(context.Context, *MsgSubmitProofHash) (*MsgSubmitProofHashResponse, error)
-	// SubmitProofDetail defines a method to submit a proof with detail.
-	SubmitProofDetail(context.Context, *MsgSubmitProofDetail) (*MsgSubmitProofDetailResponse, error)
-	// SubmitProofVerification defines a method to submit a proof result.
-	SubmitProofVerification(context.Context, *MsgSubmitProofVerification) (*MsgSubmitProofVerificationResponse, error)
-	// UpdateTheoremComplexity defines a method for checker to update theorem complexity.
-	UpdateTheoremComplexity(context.Context, *MsgUpdateTheoremComplexity) (*MsgUpdateTheoremComplexityResponse, error)
-	// Grant defines a method to grant theorem given the messages.
-	Grant(context.Context, *MsgGrant) (*MsgGrantResponse, error)
-	// WithdrawReward defines a method to withdraw reward given the messages.
-	WithdrawReward(context.Context, *MsgWithdrawReward) (*MsgWithdrawRewardResponse, error)
-	// UpdateParams defines a governance operation for updating the x/bounty module parameters.
-	// The authority is defined in the keeper.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgSubmitProofVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProofVerification.Merge(m, src)
+}
+func (m *MsgSubmitProofVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSubmitProofVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProofVerification.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgSubmitProofVerification proto.InternalMessageInfo
+
+// MsgSubmitProofVerificationResponse defines the Msg/SubmitProofVerification response type.
+type MsgSubmitProofVerificationResponse struct {
 }
 
-func (*UnimplementedMsgServer) CreateProgram(ctx context.Context, req *MsgCreateProgram) (*MsgCreateProgramResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateProgram not implemented")
+func (m *MsgSubmitProofVerificationResponse) Reset()         { *m = MsgSubmitProofVerificationResponse{} }
+func (m *MsgSubmitProofVerificationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSubmitProofVerificationResponse) ProtoMessage()    {}
+func (*MsgSubmitProofVerificationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{53}
 }
-func (*UnimplementedMsgServer) EditProgram(ctx context.Context, req *MsgEditProgram) (*MsgEditProgramResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditProgram not implemented")
+func (m *MsgSubmitProofVerificationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ActivateProgram(ctx context.Context, req *MsgActivateProgram) (*MsgActivateProgramResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateProgram not implemented")
+func (m *MsgSubmitProofVerificationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSubmitProofVerificationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CloseProgram(ctx context.Context, req *MsgCloseProgram) (*MsgCloseProgramResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseProgram not implemented")
+func (m *MsgSubmitProofVerificationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSubmitProofVerificationResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitFinding(ctx context.Context, req *MsgSubmitFinding) (*MsgSubmitFindingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitFinding not implemented")
+func (m *MsgSubmitProofVerificationResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) EditFinding(ctx context.Context, req *MsgEditFinding) (*MsgEditFindingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EditFinding not implemented")
+func (m *MsgSubmitProofVerificationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSubmitProofVerificationResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) ActivateFinding(ctx context.Context, req *MsgActivateFinding) (*MsgActivateFindingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ActivateFinding not implemented")
+
+var xxx_messageInfo_MsgSubmitProofVerificationResponse proto.InternalMessageInfo
+
+// MsgWithdrawReward defines a message to withdraw rewards.
+type MsgWithdrawReward struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
-func (*UnimplementedMsgServer) ConfirmFinding(ctx context.Context, req *MsgConfirmFinding) (*MsgConfirmFindingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfirmFinding not implemented")
+
+func (m *MsgWithdrawReward) Reset()         { *m = MsgWithdrawReward{} }
+func (m *MsgWithdrawReward) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawReward) ProtoMessage()    {}
+func (*MsgWithdrawReward) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{54}
 }
-func (*UnimplementedMsgServer) ConfirmFindingPaid(ctx context.Context, req *MsgConfirmFindingPaid) (*MsgConfirmFindingPaidResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfirmFindingPaid not implemented")
+func (m *MsgWithdrawReward) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CloseFinding(ctx context.Context, req *MsgCloseFinding) (*MsgCloseFindingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CloseFinding not implemented")
+func (m *MsgWithdrawReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawReward.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) PublishFinding(ctx context.Context, req *MsgPublishFinding) (*MsgPublishFindingResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method PublishFinding not implemented")
+func (m *MsgWithdrawReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawReward.Merge(m, src)
 }
-func (*UnimplementedMsgServer) CreateTheorem(ctx context.Context, req *MsgCreateTheorem) (*MsgCreateTheoremResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateTheorem not implemented")
+func (m *MsgWithdrawReward) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) SubmitProofHash(ctx context.Context, req *MsgSubmitProofHash) (*MsgSubmitProofHashResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofHash not implemented")
+func (m *MsgWithdrawReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawReward.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) SubmitProofDetail(ctx context.Context, req *MsgSubmitProofDetail) (*MsgSubmitProofDetailResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofDetail not implemented")
+
+var xxx_messageInfo_MsgWithdrawReward proto.InternalMessageInfo
+
+type MsgWithdrawRewardResponse struct {
+	// amount is the reward paid liquid, including the released vested rewards.
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+	// vesting is the reward added to the vesting schedule of the address.
+	Vesting github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=vesting,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"vesting"`
 }
-func (*UnimplementedMsgServer) SubmitProofVerification(ctx context.Context, req *MsgSubmitProofVerification) (*MsgSubmitProofVerificationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofVerification not implemented")
+
+func (m *MsgWithdrawRewardResponse) Reset()         { *m = MsgWithdrawRewardResponse{} }
+func (m *MsgWithdrawRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawRewardResponse) ProtoMessage()    {}
+func (*MsgWithdrawRewardResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{55}
 }
-func (*UnimplementedMsgServer) UpdateTheoremComplexity(ctx context.Context, req *MsgUpdateTheoremComplexity) (*MsgUpdateTheoremComplexityResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateTheoremComplexity not implemented")
+func (m *MsgWithdrawRewardResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) Grant(ctx context.Context, req *MsgGrant) (*MsgGrantResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Grant not implemented")
+func (m *MsgWithdrawRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawRewardResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) WithdrawReward(ctx context.Context, req *MsgWithdrawReward) (*MsgWithdrawRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawReward not implemented")
+func (m *MsgWithdrawRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawRewardResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgWithdrawRewardResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *MsgWithdrawRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawRewardResponse.DiscardUnknown(m)
 }
 
-func _Msg_CreateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateProgram)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateProgram(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/CreateProgram",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateProgram(ctx, req.(*MsgCreateProgram))
-	}
-	return interceptor(ctx, in, info, handler)
-}
+var xxx_messageInfo_MsgWithdrawRewardResponse proto.InternalMessageInfo
 
-func _Msg_EditProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditProgram)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditProgram(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/EditProgram",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditProgram(ctx, req.(*MsgEditProgram))
+func (m *MsgWithdrawRewardResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_ActivateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgActivateProgram)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ActivateProgram(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/ActivateProgram",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ActivateProgram(ctx, req.(*MsgActivateProgram))
+func (m *MsgWithdrawRewardResponse) GetVesting() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Vesting
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Msg_CloseProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseProgram)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CloseProgram(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/CloseProgram",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CloseProgram(ctx, req.(*MsgCloseProgram))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateTheoremComplexity defines a message to update theorem complexity.
+type MsgUpdateTheoremComplexity struct {
+	// theorem_id defines the unique id of the theorem.
+	TheoremId  uint64 `protobuf:"varint,1,opt,name=theorem_id,json=theoremId,proto3" json:"theorem_id"`
+	Checker    string `protobuf:"bytes,2,opt,name=checker,proto3" json:"checker,omitempty"`
+	Complexity int64  `protobuf:"varint,3,opt,name=complexity,proto3" json:"complexity,omitempty"`
 }
 
-func _Msg_SubmitFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitFinding)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitFinding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/SubmitFinding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitFinding(ctx, req.(*MsgSubmitFinding))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgUpdateTheoremComplexity) Reset()         { *m = MsgUpdateTheoremComplexity{} }
+func (m *MsgUpdateTheoremComplexity) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTheoremComplexity) ProtoMessage()    {}
+func (*MsgUpdateTheoremComplexity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{56}
 }
-
-func _Msg_EditFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgEditFinding)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).EditFinding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/EditFinding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).EditFinding(ctx, req.(*MsgEditFinding))
-	}
-	return interceptor(ctx, in, info, handler)
+func (m *MsgUpdateTheoremComplexity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func _Msg_ActivateFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgActivateFinding)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ActivateFinding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/ActivateFinding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ActivateFinding(ctx, req.(*MsgActivateFinding))
+func (m *MsgUpdateTheoremComplexity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTheoremComplexity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateTheoremComplexity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTheoremComplexity.Merge(m, src)
+}
+func (m *MsgUpdateTheoremComplexity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTheoremComplexity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTheoremComplexity.DiscardUnknown(m)
 }
 
-func _Msg_ConfirmFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConfirmFinding)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConfirmFinding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/ConfirmFinding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConfirmFinding(ctx, req.(*MsgConfirmFinding))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdateTheoremComplexity proto.InternalMessageInfo
+
+// MsgUpdateTheoremComplexityResponse defines the Msg/UpdateTheoremComplexity response type.
+type MsgUpdateTheoremComplexityResponse struct {
 }
 
-func _Msg_ConfirmFindingPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConfirmFindingPaid)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConfirmFindingPaid(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/ConfirmFindingPaid",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConfirmFindingPaid(ctx, req.(*MsgConfirmFindingPaid))
+func (m *MsgUpdateTheoremComplexityResponse) Reset()         { *m = MsgUpdateTheoremComplexityResponse{} }
+func (m *MsgUpdateTheoremComplexityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateTheoremComplexityResponse) ProtoMessage()    {}
+func (*MsgUpdateTheoremComplexityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{57}
+}
+func (m *MsgUpdateTheoremComplexityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateTheoremComplexityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateTheoremComplexityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateTheoremComplexityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateTheoremComplexityResponse.Merge(m, src)
+}
+func (m *MsgUpdateTheoremComplexityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateTheoremComplexityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateTheoremComplexityResponse.DiscardUnknown(m)
 }
 
-func _Msg_CloseFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCloseFinding)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CloseFinding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/CloseFinding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CloseFinding(ctx, req.(*MsgCloseFinding))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgUpdateTheoremComplexityResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the Msg/UpdateParams request type.
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the x/bounty parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-func _Msg_PublishFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPublishFinding)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).PublishFinding(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/PublishFinding",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).PublishFinding(ctx, req.(*MsgPublishFinding))
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{58}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
 }
 
-func _Msg_CreateTheorem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateTheorem)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateTheorem(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/CreateTheorem",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateTheorem(ctx, req.(*MsgCreateTheorem))
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SubmitProofHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitProofHash)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitProofHash(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/SubmitProofHash",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitProofHash(ctx, req.(*MsgSubmitProofHash))
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
 	}
-	return interceptor(ctx, in, info, handler)
+	return Params{}
 }
 
-func _Msg_SubmitProofDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitProofDetail)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitProofDetail(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/SubmitProofDetail",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitProofDetail(ctx, req.(*MsgSubmitProofDetail))
-	}
-	return interceptor(ctx, in, info, handler)
+// MsgUpdateParamsResponse defines the response structure for executing a
+// MsgUpdateParams message.
+type MsgUpdateParamsResponse struct {
 }
 
-func _Msg_SubmitProofVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitProofVerification)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitProofVerification(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/SubmitProofVerification",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitProofVerification(ctx, req.(*MsgSubmitProofVerification))
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e4b4296bac3db30, []int{59}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-func _Msg_UpdateTheoremComplexity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateTheoremComplexity)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgCreateProgram)(nil), "shentu.bounty.v1.MsgCreateProgram")
+	proto.RegisterType((*MsgEditProgram)(nil), "shentu.bounty.v1.MsgEditProgram")
+	proto.RegisterType((*MsgCreateProgramResponse)(nil), "shentu.bounty.v1.MsgCreateProgramResponse")
+	proto.RegisterType((*MsgEditProgramResponse)(nil), "shentu.bounty.v1.MsgEditProgramResponse")
+	proto.RegisterType((*MsgActivateProgram)(nil), "shentu.bounty.v1.MsgActivateProgram")
+	proto.RegisterType((*MsgActivateProgramResponse)(nil), "shentu.bounty.v1.MsgActivateProgramResponse")
+	proto.RegisterType((*MsgCloseProgram)(nil), "shentu.bounty.v1.MsgCloseProgram")
+	proto.RegisterType((*MsgCloseProgramResponse)(nil), "shentu.bounty.v1.MsgCloseProgramResponse")
+	proto.RegisterType((*MsgFundProgram)(nil), "shentu.bounty.v1.MsgFundProgram")
+	proto.RegisterType((*MsgFundProgramResponse)(nil), "shentu.bounty.v1.MsgFundProgramResponse")
+	proto.RegisterType((*MsgAddProgramMember)(nil), "shentu.bounty.v1.MsgAddProgramMember")
+	proto.RegisterType((*MsgAddProgramMemberResponse)(nil), "shentu.bounty.v1.MsgAddProgramMemberResponse")
+	proto.RegisterType((*MsgRemoveProgramMember)(nil), "shentu.bounty.v1.MsgRemoveProgramMember")
+	proto.RegisterType((*MsgRemoveProgramMemberResponse)(nil), "shentu.bounty.v1.MsgRemoveProgramMemberResponse")
+	proto.RegisterType((*MsgSubmitFinding)(nil), "shentu.bounty.v1.MsgSubmitFinding")
+	proto.RegisterType((*MsgSubmitFindingResponse)(nil), "shentu.bounty.v1.MsgSubmitFindingResponse")
+	proto.RegisterType((*MsgEditFinding)(nil), "shentu.bounty.v1.MsgEditFinding")
+	proto.RegisterType((*MsgEditFindingResponse)(nil), "shentu.bounty.v1.MsgEditFindingResponse")
+	proto.RegisterType((*MsgConfirmFinding)(nil), "shentu.bounty.v1.MsgConfirmFinding")
+	proto.RegisterType((*MsgConfirmFindingResponse)(nil), "shentu.bounty.v1.MsgConfirmFindingResponse")
+	proto.RegisterType((*MsgActivateFinding)(nil), "shentu.bounty.v1.MsgActivateFinding")
+	proto.RegisterType((*MsgActivateFindingResponse)(nil), "shentu.bounty.v1.MsgActivateFindingResponse")
+	proto.RegisterType((*MsgConfirmFindingPaid)(nil), "shentu.bounty.v1.MsgConfirmFindingPaid")
+	proto.RegisterType((*MsgConfirmFindingPaidResponse)(nil), "shentu.bounty.v1.MsgConfirmFindingPaidResponse")
+	proto.RegisterType((*MsgCloseFinding)(nil), "shentu.bounty.v1.MsgCloseFinding")
+	proto.RegisterType((*MsgCloseFindingResponse)(nil), "shentu.bounty.v1.MsgCloseFindingResponse")
+	proto.RegisterType((*MsgPublishFinding)(nil), "shentu.bounty.v1.MsgPublishFinding")
+	proto.RegisterType((*MsgPublishFindingResponse)(nil), "shentu.bounty.v1.MsgPublishFindingResponse")
+	proto.RegisterType((*MsgMarkDuplicateFinding)(nil), "shentu.bounty.v1.MsgMarkDuplicateFinding")
+	proto.RegisterType((*MsgMarkDuplicateFindingResponse)(nil), "shentu.bounty.v1.MsgMarkDuplicateFindingResponse")
+	proto.RegisterType((*MsgDisputeFinding)(nil), "shentu.bounty.v1.MsgDisputeFinding")
+	proto.RegisterType((*MsgDisputeFindingResponse)(nil), "shentu.bounty.v1.MsgDisputeFindingResponse")
+	proto.RegisterType((*MsgVoteDispute)(nil), "shentu.bounty.v1.MsgVoteDispute")
+	proto.RegisterType((*MsgVoteDisputeResponse)(nil), "shentu.bounty.v1.MsgVoteDisputeResponse")
+	proto.RegisterType((*MsgCreateTheorem)(nil), "shentu.bounty.v1.MsgCreateTheorem")
+	proto.RegisterType((*MsgCreateTheoremResponse)(nil), "shentu.bounty.v1.MsgCreateTheoremResponse")
+	proto.RegisterType((*MsgGrant)(nil), "shentu.bounty.v1.MsgGrant")
+	proto.RegisterType((*MsgGrantResponse)(nil), "shentu.bounty.v1.MsgGrantResponse")
+	proto.RegisterType((*MsgGrantFromCommunityPool)(nil), "shentu.bounty.v1.MsgGrantFromCommunityPool")
+	proto.RegisterType((*MsgGrantFromCommunityPoolResponse)(nil), "shentu.bounty.v1.MsgGrantFromCommunityPoolResponse")
+	proto.RegisterType((*MsgWithdrawGrant)(nil), "shentu.bounty.v1.MsgWithdrawGrant")
+	proto.RegisterType((*MsgWithdrawGrantResponse)(nil), "shentu.bounty.v1.MsgWithdrawGrantResponse")
+	proto.RegisterType((*MsgCloseTheorem)(nil), "shentu.bounty.v1.MsgCloseTheorem")
+	proto.RegisterType((*MsgCloseTheoremResponse)(nil), "shentu.bounty.v1.MsgCloseTheoremResponse")
+	proto.RegisterType((*MsgSubmitProofHash)(nil), "shentu.bounty.v1.MsgSubmitProofHash")
+	proto.RegisterType((*MsgSubmitProofHashResponse)(nil), "shentu.bounty.v1.MsgSubmitProofHashResponse")
+	proto.RegisterType((*MsgSubmitProofDetail)(nil), "shentu.bounty.v1.MsgSubmitProofDetail")
+	proto.RegisterType((*MsgSubmitProofDetailResponse)(nil), "shentu.bounty.v1.MsgSubmitProofDetailResponse")
+	proto.RegisterType((*MsgUploadProofChunk)(nil), "shentu.bounty.v1.MsgUploadProofChunk")
+	proto.RegisterType((*MsgUploadProofChunkResponse)(nil), "shentu.bounty.v1.MsgUploadProofChunkResponse")
+	proto.RegisterType((*MsgSubmitProofDetailChunks)(nil), "shentu.bounty.v1.MsgSubmitProofDetailChunks")
+	proto.RegisterType((*MsgSubmitProofDetailChunksResponse)(nil), "shentu.bounty.v1.MsgSubmitProofDetailChunksResponse")
+	proto.RegisterType((*MsgSubmitProofVerification)(nil), "shentu.bounty.v1.MsgSubmitProofVerification")
+	proto.RegisterType((*MsgSubmitProofVerificationResponse)(nil), "shentu.bounty.v1.MsgSubmitProofVerificationResponse")
+	proto.RegisterType((*MsgWithdrawReward)(nil), "shentu.bounty.v1.MsgWithdrawReward")
+	proto.RegisterType((*MsgWithdrawRewardResponse)(nil), "shentu.bounty.v1.MsgWithdrawRewardResponse")
+	proto.RegisterType((*MsgUpdateTheoremComplexity)(nil), "shentu.bounty.v1.MsgUpdateTheoremComplexity")
+	proto.RegisterType((*MsgUpdateTheoremComplexityResponse)(nil), "shentu.bounty.v1.MsgUpdateTheoremComplexityResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "shentu.bounty.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "shentu.bounty.v1.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("shentu/bounty/v1/tx.proto", fileDescriptor_1e4b4296bac3db30) }
+
+var fileDescriptor_1e4b4296bac3db30 = []byte{
+	// 3066 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5b, 0xcf, 0x6f, 0x24, 0x47,
+	0xf5, 0xf7, 0x8c, 0xc7, 0xbf, 0x6a, 0xfc, 0xb3, 0xd7, 0x6b, 0x8f, 0x67, 0xb3, 0x1e, 0x6f, 0x6f,
+	0xf2, 0xfd, 0xda, 0x9b, 0xdd, 0x99, 0xd8, 0xd9, 0x84, 0x64, 0x12, 0xa2, 0xc4, 0xde, 0xdd, 0x64,
+	0x21, 0xce, 0x5a, 0xbd, 0x49, 0x10, 0x08, 0x31, 0x6a, 0x4f, 0xd7, 0xcc, 0x34, 0x3b, 0xdd, 0xd5,
+	0xe9, 0xea, 0x71, 0x32, 0x48, 0x48, 0x10, 0x09, 0x09, 0x38, 0x01, 0x12, 0x3f, 0x0e, 0x1c, 0x72,
+	0x03, 0x72, 0x0a, 0x12, 0x7f, 0x40, 0x4e, 0x28, 0x07, 0x0e, 0x51, 0x84, 0x14, 0x2e, 0x4c, 0x50,
+	0x82, 0x14, 0x94, 0x1b, 0x96, 0x38, 0x70, 0x41, 0xa8, 0x7e, 0xf5, 0x54, 0xf7, 0x54, 0x7b, 0x7e,
+	0xd8, 0x90, 0xe5, 0xb2, 0x3b, 0x5d, 0xf5, 0x79, 0x55, 0xf5, 0x3e, 0xf5, 0xde, 0xab, 0xf7, 0xaa,
+	0xdb, 0x60, 0x0d, 0x37, 0xa0, 0x1b, 0xb4, 0x4a, 0x87, 0xa8, 0xe5, 0x06, 0xed, 0xd2, 0xd1, 0x76,
+	0x29, 0x78, 0xa3, 0xe8, 0xf9, 0x28, 0x40, 0xda, 0x22, 0xeb, 0x2a, 0xb2, 0xae, 0xe2, 0xd1, 0x76,
+	0x7e, 0xb9, 0x8e, 0xea, 0x88, 0x76, 0x96, 0xc8, 0x2f, 0x86, 0xcb, 0x17, 0xea, 0x08, 0xd5, 0x9b,
+	0xb0, 0x44, 0x9f, 0x0e, 0x5b, 0xb5, 0x52, 0x60, 0x3b, 0x10, 0x07, 0xa6, 0xe3, 0x71, 0xc0, 0x7a,
+	0x1c, 0x60, 0xb5, 0x7c, 0x33, 0xb0, 0x91, 0xcb, 0xfb, 0xd7, 0xe2, 0xfd, 0xa6, 0xdb, 0x16, 0x5d,
+	0x55, 0x84, 0x1d, 0x84, 0x2b, 0x6c, 0x52, 0xf6, 0xc0, 0xbb, 0x56, 0xd9, 0x53, 0xc9, 0xc1, 0x75,
+	0xb2, 0x6c, 0x07, 0xd7, 0xc5, 0x74, 0xbc, 0xe3, 0xd0, 0xc4, 0xb0, 0x74, 0xb4, 0x7d, 0x08, 0x03,
+	0x73, 0xbb, 0x54, 0x45, 0xb6, 0x98, 0x6e, 0xc9, 0x74, 0x6c, 0x17, 0x95, 0xe8, 0xbf, 0xbc, 0xe9,
+	0x62, 0x0f, 0x0b, 0x5c, 0x69, 0xda, 0xad, 0xff, 0x78, 0x0a, 0x2c, 0xee, 0xe3, 0xfa, 0x9e, 0x0f,
+	0xcd, 0x00, 0x1e, 0xf8, 0xa8, 0xee, 0x9b, 0x8e, 0x76, 0x1d, 0x00, 0x8f, 0xfd, 0xac, 0xd8, 0x56,
+	0x2e, 0xb5, 0x91, 0xda, 0x9c, 0xd9, 0x3d, 0x7f, 0xdc, 0x29, 0x2c, 0xb5, 0x4d, 0xa7, 0x59, 0xd6,
+	0xbb, 0x7d, 0xba, 0x31, 0xc3, 0x1f, 0x6e, 0x5b, 0x9a, 0x06, 0x32, 0xae, 0xe9, 0xc0, 0x5c, 0x9a,
+	0xe0, 0x0d, 0xfa, 0x5b, 0x5b, 0x01, 0x93, 0x16, 0x0c, 0x4c, 0xbb, 0x99, 0x1b, 0xa7, 0xad, 0xfc,
+	0x49, 0xbb, 0x05, 0x16, 0x91, 0x07, 0x7d, 0x33, 0x40, 0x7e, 0xc5, 0xb4, 0x2c, 0x1f, 0x62, 0x9c,
+	0xcb, 0xd0, 0x79, 0x2e, 0x1c, 0x77, 0x0a, 0xab, 0x6c, 0x9e, 0x38, 0x42, 0x37, 0x16, 0x44, 0xd3,
+	0x73, 0xac, 0x45, 0xbb, 0x09, 0xb2, 0x3e, 0x7c, 0xdd, 0xf4, 0xad, 0x8a, 0x87, 0x50, 0x33, 0x37,
+	0xb1, 0x31, 0xbe, 0x99, 0xdd, 0x59, 0x2b, 0x72, 0x36, 0x09, 0x4d, 0x45, 0x4e, 0x53, 0x71, 0x0f,
+	0xd9, 0xee, 0xee, 0xcc, 0x7b, 0x9d, 0xc2, 0xd8, 0xaf, 0x3f, 0x7d, 0xe7, 0x4a, 0xca, 0x00, 0x4c,
+	0xf0, 0x00, 0xa1, 0xa6, 0x76, 0x07, 0x2c, 0xf0, 0x61, 0x70, 0xb5, 0x01, 0xad, 0x56, 0x13, 0xe6,
+	0x26, 0xe9, 0x50, 0x1b, 0xc5, 0xb8, 0xa5, 0x14, 0xef, 0xc2, 0x23, 0xe8, 0xdb, 0x41, 0xdb, 0xa0,
+	0x02, 0xbb, 0x19, 0x32, 0xa2, 0x31, 0xcf, 0xc4, 0xef, 0x72, 0x69, 0xed, 0x1a, 0xd0, 0xaa, 0xbe,
+	0x1d, 0xd8, 0x55, 0xb3, 0x59, 0x31, 0x3d, 0xcf, 0x47, 0x47, 0x66, 0x13, 0xe7, 0xa6, 0x36, 0x52,
+	0x9b, 0x73, 0xc6, 0x92, 0xe8, 0x79, 0x4e, 0x74, 0x68, 0x2f, 0x82, 0x45, 0xab, 0xe5, 0x35, 0xed,
+	0xaa, 0x19, 0xc0, 0x8a, 0x87, 0x9a, 0x76, 0xb5, 0x9d, 0x9b, 0xde, 0x48, 0x6d, 0xce, 0xef, 0x5c,
+	0xea, 0x5d, 0xc0, 0x0d, 0x81, 0x3c, 0xa0, 0x40, 0x63, 0xc1, 0x8a, 0x36, 0x68, 0xb7, 0xc0, 0xbc,
+	0x59, 0x0d, 0xec, 0x23, 0x6a, 0x88, 0x15, 0xdc, 0x34, 0x73, 0x33, 0x1b, 0x29, 0xca, 0x0b, 0xb3,
+	0xc6, 0xa2, 0xb0, 0xc6, 0xe2, 0x0d, 0x6e, 0xad, 0xbb, 0x99, 0x5f, 0x7c, 0x54, 0x48, 0x19, 0x73,
+	0x5d, 0xb1, 0xbb, 0x4d, 0x53, 0xfb, 0x12, 0x58, 0xac, 0x22, 0xb7, 0x66, 0xfb, 0x4e, 0x77, 0x24,
+	0x30, 0xd8, 0x48, 0x0b, 0xb2, 0x20, 0x19, 0xeb, 0x49, 0x30, 0x81, 0xab, 0xc8, 0x83, 0xb9, 0x2c,
+	0xe5, 0xf5, 0xa2, 0x82, 0x57, 0xd2, 0xfd, 0xb2, 0xe9, 0xd7, 0x61, 0xc0, 0x49, 0x65, 0x12, 0x5a,
+	0x1d, 0xac, 0xe2, 0xd6, 0xa1, 0x63, 0x63, 0x4c, 0x16, 0xe1, 0xc3, 0xd7, 0x5a, 0xb6, 0x0f, 0x1d,
+	0xe8, 0x06, 0x38, 0x37, 0x4b, 0x57, 0xb3, 0xa9, 0x18, 0x2c, 0x14, 0x30, 0x24, 0x3c, 0x1f, 0x77,
+	0x05, 0x2b, 0x7b, 0xb5, 0x97, 0x80, 0x66, 0xd9, 0xb8, 0xda, 0x44, 0xb8, 0xe5, 0xc3, 0x0a, 0x74,
+	0x0e, 0x4d, 0xbf, 0x8e, 0x72, 0x73, 0x83, 0x69, 0xbc, 0xd4, 0x15, 0xbd, 0xc9, 0x24, 0xcb, 0x8f,
+	0x7f, 0xff, 0xad, 0xc2, 0xd8, 0xdf, 0xde, 0x2a, 0x8c, 0xbd, 0xf9, 0xe9, 0x3b, 0x57, 0x7a, 0xec,
+	0xfd, 0x87, 0x9f, 0xbe, 0x73, 0x65, 0x99, 0x7b, 0x65, 0xc4, 0xfd, 0xf4, 0x77, 0x27, 0xc1, 0xfc,
+	0x3e, 0xae, 0xdf, 0xb4, 0xec, 0xe0, 0x7f, 0xcf, 0x23, 0x15, 0xae, 0x34, 0xf1, 0x1f, 0x70, 0xa5,
+	0xc9, 0x61, 0x5c, 0x69, 0xea, 0x0c, 0x5d, 0x69, 0xfa, 0xcc, 0x5c, 0x69, 0xe6, 0xb4, 0xae, 0x04,
+	0x86, 0x76, 0x25, 0x33, 0xd9, 0x95, 0xb2, 0xc3, 0xb9, 0xd2, 0x90, 0x4e, 0x34, 0x3b, 0xb2, 0x13,
+	0x5d, 0xef, 0xeb, 0x44, 0x1a, 0x77, 0x22, 0xc9, 0x5f, 0xf4, 0x3c, 0xc8, 0xc5, 0x4f, 0x35, 0x03,
+	0x62, 0x0f, 0xb9, 0x18, 0xea, 0x39, 0xb0, 0x12, 0xf5, 0xae, 0xb0, 0xe7, 0x0f, 0x29, 0xa0, 0xed,
+	0xe3, 0xfa, 0x73, 0x6c, 0xeb, 0x4e, 0x79, 0x1c, 0xaa, 0x1c, 0x2a, 0x3d, 0xbc, 0x43, 0x95, 0x9f,
+	0xe8, 0x4b, 0xc0, 0x0a, 0x27, 0x20, 0xb6, 0x6e, 0xfd, 0x01, 0x90, 0xef, 0xd5, 0x26, 0x54, 0xf6,
+	0xf7, 0x29, 0xb0, 0x40, 0x38, 0x6a, 0x22, 0x7c, 0x9f, 0x68, 0xfa, 0x58, 0x5f, 0x4d, 0xcf, 0x89,
+	0x78, 0x29, 0x2d, 0x5a, 0x5f, 0x03, 0xab, 0x31, 0x3d, 0x42, 0x1d, 0xff, 0x95, 0xa2, 0x91, 0xf4,
+	0x56, 0xcb, 0xb5, 0x4e, 0xa7, 0xe2, 0x1e, 0x58, 0xa0, 0x43, 0xf6, 0x68, 0x98, 0x3f, 0xee, 0x14,
+	0x56, 0x98, 0x68, 0x0c, 0xa0, 0x1b, 0xf3, 0xbc, 0x45, 0x84, 0xc6, 0xa7, 0xc1, 0xa4, 0xe9, 0x10,
+	0x05, 0x72, 0xe3, 0x43, 0xe4, 0x29, 0x5c, 0xa6, 0xfc, 0xa8, 0xcc, 0x4e, 0x7c, 0x35, 0xb2, 0x1f,
+	0x48, 0xda, 0x72, 0x5b, 0x97, 0x5a, 0x42, 0x6a, 0xfe, 0x98, 0x06, 0xe7, 0x88, 0x75, 0x58, 0xa2,
+	0x67, 0x1f, 0x3a, 0x87, 0xd0, 0x1f, 0x91, 0x9f, 0x67, 0xc1, 0xbc, 0x43, 0xe5, 0x63, 0xf4, 0xac,
+	0x1d, 0x77, 0x0a, 0xe7, 0x99, 0x64, 0xb4, 0x5f, 0x37, 0xe6, 0x58, 0x83, 0x20, 0x67, 0x17, 0x64,
+	0x7c, 0xd4, 0x84, 0xf4, 0x54, 0x9a, 0x57, 0x05, 0x35, 0xa1, 0x00, 0x6a, 0xc2, 0xdd, 0x85, 0xe3,
+	0x4e, 0x21, 0xcb, 0x86, 0x25, 0x42, 0xba, 0x41, 0x65, 0xcf, 0xea, 0x0c, 0x2b, 0x3f, 0xd9, 0xd7,
+	0x10, 0x57, 0x85, 0xcb, 0xc5, 0xe8, 0xd3, 0x2f, 0x82, 0x0b, 0x0a, 0x56, 0x43, 0xd6, 0x7f, 0x96,
+	0xa6, 0x1b, 0x62, 0x40, 0x07, 0x1d, 0xc1, 0xfb, 0x83, 0x78, 0x15, 0x69, 0xe3, 0x23, 0x90, 0xf6,
+	0x74, 0x5f, 0xd2, 0xf2, 0x9c, 0x34, 0x85, 0xf6, 0xfa, 0x06, 0x58, 0x57, 0xf3, 0xd2, 0x8d, 0x57,
+	0x19, 0x5a, 0xa9, 0xd0, 0xe3, 0x28, 0xb8, 0x65, 0xbb, 0x96, 0xed, 0xd6, 0x47, 0x24, 0xed, 0x3a,
+	0x00, 0x35, 0x36, 0x00, 0x91, 0x4a, 0xc7, 0xa5, 0xba, 0x7d, 0xba, 0x31, 0xc3, 0x1f, 0x6e, 0x5b,
+	0x5a, 0x19, 0xcc, 0x8a, 0x9e, 0x86, 0x89, 0x1b, 0x9c, 0xa4, 0xd5, 0xe3, 0x4e, 0xe1, 0x5c, 0x54,
+	0x8e, 0xf4, 0xea, 0x46, 0x96, 0x3f, 0xbe, 0x60, 0xe2, 0xc6, 0x99, 0x65, 0x57, 0x26, 0x98, 0xc7,
+	0x3c, 0x69, 0xaa, 0x34, 0xe1, 0x11, 0x24, 0x25, 0x0f, 0xf1, 0x97, 0x42, 0x72, 0x72, 0xf5, 0x22,
+	0x81, 0xc9, 0xf6, 0x10, 0x1d, 0x40, 0x37, 0xe6, 0xb0, 0x8c, 0xd4, 0x02, 0xb0, 0x04, 0xdd, 0xaa,
+	0xdf, 0xf6, 0x02, 0x68, 0x55, 0x3c, 0xb3, 0xdd, 0x44, 0xa6, 0x45, 0xd3, 0xad, 0xec, 0xce, 0x56,
+	0xef, 0x2c, 0x37, 0x05, 0x94, 0xef, 0xc8, 0x01, 0x13, 0xd8, 0x7d, 0xe0, 0xb8, 0x53, 0xc8, 0xb1,
+	0xf9, 0x7a, 0x46, 0xd3, 0x8d, 0xc5, 0xb0, 0x8d, 0xe3, 0xb5, 0x6d, 0x30, 0x13, 0xd0, 0x84, 0x85,
+	0xec, 0xc8, 0x14, 0x65, 0x66, 0xf9, 0xb8, 0x53, 0x58, 0x64, 0x43, 0x84, 0x5d, 0xba, 0x31, 0xcd,
+	0x7e, 0xdf, 0xb6, 0x86, 0x48, 0xaf, 0x23, 0x36, 0xc3, 0x73, 0x83, 0x48, 0x5b, 0x68, 0x64, 0x3f,
+	0xcd, 0x84, 0xa9, 0xb7, 0x64, 0x62, 0x92, 0xb1, 0xa4, 0x46, 0x34, 0x96, 0xf4, 0x29, 0x8d, 0x65,
+	0xfc, 0x4c, 0x8c, 0x25, 0x73, 0xd6, 0xc6, 0x52, 0x06, 0xb3, 0x9e, 0xd9, 0x26, 0x99, 0x1f, 0x53,
+	0x73, 0x22, 0xae, 0xa6, 0xdc, 0xab, 0x1b, 0x59, 0xfe, 0x48, 0xd5, 0xfc, 0x5c, 0x0c, 0x6d, 0xc8,
+	0x7c, 0x52, 0xd8, 0x4c, 0x37, 0x67, 0x8c, 0x5b, 0xcc, 0xdb, 0x69, 0xb0, 0x44, 0xd2, 0x0f, 0x96,
+	0xa4, 0x9f, 0xce, 0x68, 0xce, 0x28, 0x91, 0xd2, 0x36, 0x00, 0xb1, 0xa7, 0x3a, 0xf4, 0x3d, 0xdf,
+	0xa6, 0xd9, 0x06, 0x29, 0xf4, 0xe4, 0x26, 0x92, 0x8a, 0xb0, 0x32, 0x2b, 0x97, 0x19, 0x26, 0x15,
+	0x61, 0x32, 0xe5, 0x2f, 0xf4, 0xe5, 0xf0, 0xbc, 0x48, 0xd4, 0x22, 0xb4, 0xe8, 0x17, 0xc0, 0x5a,
+	0x0f, 0x57, 0x49, 0xd9, 0xf7, 0x7d, 0x41, 0xe5, 0x08, 0xd9, 0xb7, 0xd0, 0x35, 0x9a, 0x7d, 0xc7,
+	0x95, 0xfd, 0x20, 0x05, 0xce, 0xf7, 0x50, 0x71, 0x60, 0xda, 0xd6, 0xe7, 0xac, 0xef, 0x53, 0x7d,
+	0xf5, 0x5d, 0x53, 0x6e, 0x2d, 0x59, 0xba, 0x5e, 0x00, 0x17, 0x95, 0x3a, 0x29, 0x6b, 0x8e, 0xfb,
+	0x63, 0x7f, 0x87, 0xac, 0x39, 0xc4, 0xe6, 0x4a, 0x35, 0x47, 0x7c, 0x67, 0xff, 0xc9, 0x02, 0xc2,
+	0x41, 0xeb, 0xb0, 0x69, 0xe3, 0xc6, 0xe9, 0xb4, 0x5c, 0x06, 0x13, 0x81, 0x1d, 0x34, 0xc5, 0x0d,
+	0x0e, 0x7b, 0x48, 0xbc, 0xc2, 0x79, 0x02, 0x64, 0x2d, 0x88, 0xab, 0xbe, 0xed, 0x91, 0x92, 0x9a,
+	0xe7, 0x17, 0x2b, 0xc7, 0x9d, 0x82, 0xc6, 0x26, 0x91, 0x3a, 0x75, 0x43, 0x86, 0x6a, 0x37, 0xc1,
+	0xa2, 0xe7, 0x23, 0x54, 0xab, 0xa0, 0x5a, 0xa5, 0x8a, 0xdc, 0x2a, 0xf4, 0x02, 0x1e, 0xca, 0x25,
+	0x36, 0xe3, 0x08, 0xdd, 0x98, 0xa7, 0x4d, 0x77, 0x6a, 0x7b, 0xac, 0x41, 0xb9, 0x29, 0x93, 0x23,
+	0x6c, 0xca, 0xe0, 0xf1, 0x25, 0xca, 0x32, 0x8f, 0x2f, 0xd1, 0xc6, 0x70, 0x63, 0x7e, 0x92, 0xa6,
+	0x9b, 0xb6, 0x6f, 0xfa, 0xf7, 0xc2, 0x7b, 0x9f, 0x53, 0x1f, 0xf2, 0xdd, 0xbb, 0x26, 0x54, 0xeb,
+	0x3d, 0xe4, 0xe5, 0x5e, 0x42, 0xb9, 0x78, 0xbc, 0x53, 0x3b, 0xb3, 0xb4, 0xfb, 0x8b, 0x7d, 0xb9,
+	0xba, 0xc0, 0xb9, 0x52, 0x29, 0xae, 0x5f, 0x02, 0x85, 0x04, 0x4e, 0x42, 0xde, 0xfe, 0x91, 0xa2,
+	0x06, 0x7d, 0xc3, 0xc6, 0x5e, 0xeb, 0xb4, 0x8c, 0x6d, 0x91, 0x73, 0xc7, 0xc4, 0xc8, 0xe5, 0x5c,
+	0x2d, 0x1d, 0x77, 0x0a, 0x73, 0xbc, 0x90, 0xa3, 0xed, 0xba, 0xc1, 0x01, 0x67, 0x46, 0xd0, 0xe0,
+	0xc6, 0x14, 0xd5, 0x90, 0x1b, 0x53, 0xb4, 0x31, 0x24, 0xe5, 0xbb, 0x69, 0x9a, 0x28, 0xbe, 0x8a,
+	0x02, 0xc8, 0x11, 0x23, 0x32, 0xf2, 0x12, 0x98, 0x44, 0xcc, 0x5f, 0xd3, 0x34, 0x39, 0xbb, 0xac,
+	0xb8, 0xa5, 0x64, 0x13, 0x90, 0xb9, 0xee, 0x50, 0xa8, 0x4c, 0x1b, 0xe2, 0xfe, 0xcc, 0x47, 0xd1,
+	0x9e, 0x01, 0x13, 0x47, 0x28, 0x80, 0x3e, 0xe7, 0x6a, 0xf3, 0xb8, 0x53, 0x98, 0x65, 0x48, 0xda,
+	0xac, 0x7f, 0xf0, 0xbb, 0x6b, 0xcb, 0xfc, 0xac, 0xe7, 0x0c, 0xdd, 0x0d, 0x7c, 0xa2, 0x19, 0x13,
+	0x2b, 0x6f, 0xc9, 0x74, 0xb1, 0x36, 0x39, 0x29, 0x92, 0x14, 0xe6, 0x49, 0x91, 0xd4, 0x12, 0xb2,
+	0xf3, 0xbd, 0x71, 0xe9, 0xad, 0xd2, 0xcb, 0x0d, 0x88, 0x7c, 0xe8, 0x74, 0x83, 0x59, 0x4a, 0x0e,
+	0x66, 0x1b, 0xd1, 0xa0, 0xc5, 0x02, 0x5d, 0x24, 0x38, 0x69, 0x20, 0x53, 0x45, 0x16, 0xe4, 0xc1,
+	0x8e, 0xfe, 0xd6, 0x6e, 0x83, 0x39, 0xdb, 0xb5, 0x03, 0xdb, 0x6c, 0x56, 0xea, 0xbe, 0xe9, 0x06,
+	0x43, 0xa5, 0x31, 0xb3, 0x5c, 0xf4, 0x79, 0x22, 0xa9, 0x5d, 0x07, 0xd3, 0x9e, 0x8f, 0x3c, 0x84,
+	0xa1, 0xcf, 0x63, 0x5e, 0x2e, 0x91, 0xa3, 0x10, 0xa9, 0xed, 0x80, 0xf3, 0xfc, 0xfa, 0xb4, 0x82,
+	0x3c, 0xe8, 0x3a, 0x66, 0xd0, 0xa8, 0x54, 0xa1, 0x1f, 0xd0, 0x78, 0x37, 0x6d, 0x9c, 0xe3, 0x9d,
+	0x77, 0x78, 0xdf, 0x1e, 0xf4, 0x03, 0xed, 0x59, 0x30, 0x1b, 0x30, 0x2e, 0x2a, 0x41, 0xdb, 0x83,
+	0xfc, 0x5a, 0x5a, 0x71, 0xd5, 0xc1, 0x19, 0x7b, 0xb9, 0xed, 0x41, 0x23, 0x1b, 0x74, 0x1f, 0xca,
+	0x45, 0x79, 0x73, 0xc2, 0xc5, 0xf4, 0xbe, 0x49, 0xe0, 0x03, 0xe8, 0x4f, 0x4a, 0xd7, 0xa0, 0xbc,
+	0x4d, 0xec, 0x91, 0x76, 0x11, 0x00, 0xb1, 0x1a, 0x6e, 0xae, 0x19, 0x63, 0x86, 0xb7, 0xdc, 0xb6,
+	0xf4, 0x0f, 0x53, 0x60, 0x7a, 0x1f, 0xd7, 0x19, 0x47, 0x3b, 0xbd, 0xd8, 0xdd, 0x73, 0x9f, 0x75,
+	0x0a, 0x52, 0x2b, 0xa3, 0xb6, 0x3b, 0x80, 0xb6, 0x03, 0xa6, 0xe8, 0xd6, 0x20, 0x9f, 0xfb, 0x7a,
+	0x32, 0xad, 0x02, 0x78, 0xca, 0x1b, 0xb2, 0x87, 0x64, 0x76, 0xc4, 0x98, 0x84, 0x9c, 0x59, 0x4e,
+	0x0e, 0x55, 0x46, 0xd7, 0xa8, 0x6d, 0xd2, 0xdf, 0xa1, 0xc1, 0xbe, 0x99, 0xa6, 0xce, 0x4e, 0x1b,
+	0x6f, 0xf9, 0xc8, 0xd9, 0x43, 0x8e, 0xd3, 0x72, 0xed, 0xa0, 0x4d, 0x5f, 0x0f, 0x3e, 0x0e, 0x66,
+	0xcc, 0x56, 0xd0, 0x40, 0xa4, 0xee, 0xe1, 0x8e, 0x9d, 0xac, 0x4c, 0x17, 0x1a, 0xa3, 0x2d, 0x3d,
+	0x10, 0x6d, 0xa7, 0xa3, 0x20, 0x92, 0xbe, 0x75, 0x57, 0x42, 0x48, 0xd8, 0x10, 0xc7, 0x40, 0x92,
+	0x9a, 0xfa, 0x65, 0x70, 0x29, 0xb1, 0x33, 0x64, 0xea, 0x37, 0x29, 0x4a, 0xdf, 0x57, 0xec, 0xa0,
+	0x61, 0xf9, 0xe6, 0xeb, 0xff, 0x55, 0xfb, 0x28, 0x5f, 0x4b, 0xda, 0x61, 0x61, 0xfe, 0x91, 0x65,
+	0xe9, 0xbf, 0x4c, 0x51, 0xfb, 0x8f, 0x34, 0x86, 0xf6, 0xff, 0x2c, 0x98, 0xf6, 0x61, 0xad, 0xe5,
+	0x5a, 0x90, 0xac, 0x78, 0x70, 0xaa, 0x43, 0x29, 0xed, 0x19, 0x30, 0xe5, 0x41, 0xd7, 0x6c, 0x06,
+	0xed, 0x5c, 0x7a, 0x88, 0x01, 0x84, 0x90, 0xfe, 0xb6, 0x94, 0x0d, 0x8b, 0x20, 0x39, 0x0a, 0x93,
+	0x72, 0x04, 0x4b, 0x0f, 0x1a, 0xc1, 0xa2, 0x5c, 0x46, 0x62, 0x49, 0x24, 0xe3, 0x15, 0xa1, 0x44,
+	0xca, 0x78, 0x63, 0x91, 0x44, 0xff, 0x41, 0x9a, 0x16, 0x6e, 0xec, 0x46, 0xe5, 0x80, 0xa4, 0x84,
+	0xb4, 0xbe, 0x1f, 0x45, 0x95, 0x47, 0xc0, 0xa4, 0xe7, 0xa3, 0xa3, 0x01, 0x14, 0xe1, 0x38, 0x12,
+	0xc6, 0x58, 0x62, 0xda, 0xbd, 0x93, 0xa3, 0x57, 0x7d, 0x7c, 0x11, 0xcf, 0x80, 0x29, 0x0b, 0x7a,
+	0x08, 0xdb, 0xc3, 0x1d, 0x11, 0x42, 0x28, 0x1a, 0x71, 0xf9, 0x9c, 0x72, 0xd5, 0x17, 0x53, 0x9a,
+	0x57, 0x7d, 0xb1, 0xd6, 0x90, 0xa9, 0x77, 0x53, 0x60, 0x39, 0xda, 0x7d, 0x83, 0xa5, 0xee, 0x57,
+	0xe9, 0x16, 0xa2, 0x5a, 0x37, 0x73, 0x58, 0xfa, 0xac, 0x53, 0x08, 0xdb, 0x84, 0xe1, 0x90, 0xc7,
+	0x91, 0x58, 0x4a, 0x28, 0x19, 0xca, 0x8f, 0x24, 0xa8, 0x97, 0xeb, 0x55, 0x8f, 0xad, 0x54, 0x5f,
+	0x07, 0x0f, 0xa8, 0x34, 0x08, 0x55, 0xfc, 0x55, 0x8a, 0xbe, 0x57, 0x78, 0xc5, 0x6b, 0x22, 0xd3,
+	0xa2, 0x80, 0xbd, 0x46, 0xcb, 0xbd, 0xa7, 0xad, 0xc5, 0x35, 0x3c, 0x8d, 0x3a, 0x1a, 0xc8, 0x58,
+	0x66, 0x60, 0x52, 0x65, 0x66, 0x0d, 0xfa, 0xbb, 0x5c, 0x4a, 0x50, 0x45, 0x5c, 0xd5, 0xc7, 0x57,
+	0xa4, 0x6f, 0xd3, 0xab, 0xfa, 0x78, 0x73, 0x18, 0x1f, 0x34, 0x90, 0xa1, 0x26, 0xc5, 0x16, 0x4b,
+	0x7f, 0xeb, 0x7f, 0x4d, 0xc5, 0xb7, 0x97, 0x69, 0x4f, 0x25, 0xf1, 0xd9, 0xea, 0x78, 0x09, 0xcc,
+	0x56, 0xc9, 0xb0, 0xd4, 0xb0, 0x21, 0xa6, 0xc7, 0xc1, 0x8c, 0x91, 0xa5, 0x6d, 0x2f, 0xd0, 0x26,
+	0x6d, 0x1d, 0x80, 0x2a, 0x72, 0x3c, 0x22, 0x0c, 0x2d, 0x5a, 0xef, 0x4d, 0x1b, 0x52, 0x4b, 0xf4,
+	0x86, 0x54, 0xa2, 0x64, 0x3d, 0x69, 0x77, 0x99, 0x1e, 0xfa, 0x83, 0x40, 0x4f, 0xd6, 0x32, 0xdc,
+	0xe9, 0x0f, 0xd3, 0x71, 0x32, 0x5e, 0x85, 0xbe, 0x5d, 0x23, 0x35, 0x04, 0x49, 0xdb, 0x4e, 0x20,
+	0xe3, 0x31, 0x30, 0x89, 0x03, 0x33, 0x68, 0x61, 0x9e, 0xf3, 0xaa, 0xdf, 0xf6, 0xa0, 0xda, 0x5d,
+	0x0a, 0x32, 0x38, 0x98, 0x9c, 0x18, 0xd5, 0x06, 0xac, 0xde, 0x0b, 0x93, 0xdb, 0x13, 0x4e, 0x0c,
+	0x0e, 0x14, 0x14, 0x35, 0xe1, 0x1b, 0xe4, 0xec, 0x26, 0x14, 0x8d, 0x1b, 0x52, 0x8b, 0x96, 0x03,
+	0x53, 0xb6, 0xe3, 0x21, 0x3f, 0xc0, 0xf4, 0x33, 0x85, 0x8c, 0x21, 0x1e, 0x7b, 0xb2, 0xb5, 0xc9,
+	0xa1, 0xb3, 0xb5, 0x48, 0xe5, 0x21, 0x56, 0x94, 0xc0, 0xbf, 0x4c, 0x5d, 0x2f, 0xff, 0x72, 0x6f,
+	0xc8, 0xff, 0xeb, 0xb4, 0x2c, 0x13, 0x87, 0x1b, 0xfb, 0x86, 0x82, 0x70, 0x24, 0x8a, 0xa5, 0x7e,
+	0x89, 0x8a, 0x00, 0x46, 0x63, 0xdc, 0x94, 0xa2, 0x30, 0x8a, 0xce, 0xa1, 0xff, 0x3d, 0x45, 0x93,
+	0xa5, 0x68, 0x6b, 0xe8, 0x37, 0x8d, 0x30, 0x81, 0xe9, 0x7b, 0xaa, 0x3e, 0x46, 0x02, 0xee, 0xdb,
+	0x1f, 0x15, 0x36, 0xeb, 0x76, 0xd0, 0x68, 0x1d, 0x16, 0xab, 0xc8, 0xe1, 0x1f, 0xc2, 0xf1, 0xff,
+	0xae, 0x61, 0xeb, 0x5e, 0x89, 0xb0, 0x8f, 0xa9, 0x00, 0x8e, 0x24, 0x3b, 0xda, 0x37, 0xc1, 0xd4,
+	0x11, 0xc4, 0x81, 0xed, 0xd6, 0xfb, 0x9f, 0xbf, 0x23, 0x4e, 0x25, 0x26, 0xd0, 0xff, 0xcc, 0x3c,
+	0xff, 0x15, 0xcf, 0xea, 0xa6, 0xd2, 0x7b, 0x5d, 0x33, 0x1a, 0x31, 0x01, 0x12, 0xe6, 0x9c, 0x1e,
+	0xcd, 0x9c, 0xc7, 0xe3, 0xe6, 0xdc, 0xdf, 0xe4, 0x12, 0x14, 0xe0, 0x26, 0x97, 0xd0, 0x1b, 0x9a,
+	0xdc, 0x6f, 0x59, 0xc6, 0xc2, 0x60, 0x07, 0xa6, 0x6f, 0x3a, 0x78, 0xe4, 0xe4, 0xf8, 0x29, 0x30,
+	0xe9, 0xd1, 0x11, 0xa8, 0xf6, 0xd9, 0x9d, 0x9c, 0x22, 0x08, 0xd0, 0xfe, 0x48, 0x9e, 0xcb, 0x44,
+	0xca, 0x5b, 0xbd, 0xf9, 0xed, 0x4a, 0x37, 0xbf, 0x95, 0xd7, 0xc7, 0x13, 0x17, 0xb9, 0x49, 0xa8,
+	0xb3, 0xf3, 0xf3, 0x1c, 0x18, 0xdf, 0xc7, 0x75, 0xad, 0x02, 0xe6, 0xa2, 0x1f, 0x40, 0xea, 0xbd,
+	0x6b, 0x89, 0x7f, 0x4e, 0x92, 0xbf, 0xd2, 0x1f, 0x13, 0xfa, 0xc4, 0x57, 0x41, 0x56, 0xfe, 0x9a,
+	0x6b, 0x43, 0x29, 0x2a, 0x21, 0xf2, 0x9b, 0xfd, 0x10, 0xe1, 0xd0, 0x10, 0x2c, 0xc4, 0xbf, 0x57,
+	0x79, 0x50, 0x29, 0x1c, 0x43, 0xe5, 0xaf, 0x0e, 0x82, 0x0a, 0xa7, 0xf9, 0x3a, 0x98, 0x8d, 0x7c,
+	0x29, 0x72, 0x49, 0xad, 0xbd, 0x04, 0xc9, 0x6f, 0xf5, 0x85, 0xc8, 0xfc, 0xc8, 0xdf, 0x68, 0xa8,
+	0xf9, 0x91, 0x10, 0x09, 0xfc, 0x28, 0xbe, 0x73, 0xd0, 0x1a, 0x60, 0xb1, 0xe7, 0x1b, 0x87, 0x87,
+	0xd4, 0xaa, 0xc7, 0x60, 0xf9, 0x6b, 0x03, 0xc1, 0xc2, 0x99, 0x5e, 0x03, 0xe7, 0x54, 0xef, 0xf5,
+	0xd5, 0x4b, 0x55, 0x20, 0xf3, 0x8f, 0x0c, 0x8a, 0x0c, 0xa7, 0xac, 0x80, 0xb9, 0xe8, 0xfb, 0x70,
+	0xb5, 0xe1, 0x46, 0x30, 0x09, 0x86, 0xab, 0x7c, 0x1f, 0x2a, 0x0c, 0x57, 0x0c, 0x9f, 0x6c, 0xb8,
+	0x62, 0xf0, 0xcd, 0x7e, 0x08, 0x95, 0xe1, 0x8a, 0xe1, 0x4f, 0x36, 0x5c, 0x31, 0xc5, 0xd5, 0x41,
+	0x50, 0xe1, 0x34, 0x87, 0x60, 0x3e, 0xf6, 0x6e, 0xee, 0xb2, 0xda, 0x2e, 0x23, 0xa0, 0xfc, 0xc3,
+	0x03, 0x80, 0xc2, 0x39, 0x5c, 0xa0, 0x29, 0x5e, 0xe4, 0xfc, 0xff, 0x00, 0x43, 0x10, 0x60, 0xbe,
+	0x34, 0x20, 0xb0, 0xc7, 0x19, 0x85, 0x46, 0x27, 0x38, 0xa3, 0xd0, 0x67, 0xab, 0x2f, 0x44, 0x66,
+	0x2c, 0xf6, 0xf2, 0x42, 0xcd, 0x58, 0x14, 0x94, 0xc0, 0x98, 0xfa, 0x2e, 0x5e, 0x0b, 0xc0, 0xb2,
+	0xf2, 0x1e, 0x5e, 0xbd, 0x4c, 0x15, 0x34, 0xbf, 0x3d, 0x30, 0x54, 0xd6, 0x2c, 0x76, 0x8b, 0xad,
+	0xd6, 0x2c, 0x0a, 0x4a, 0xd0, 0x4c, 0x7d, 0x31, 0x4c, 0x3c, 0x46, 0xbe, 0x14, 0x56, 0x7b, 0x8c,
+	0x84, 0x48, 0xf0, 0x18, 0xc5, 0xad, 0x6a, 0xf7, 0x98, 0x12, 0x97, 0x05, 0x27, 0x1d, 0x53, 0x1c,
+	0x73, 0xe2, 0x31, 0x15, 0xbf, 0x12, 0x84, 0x60, 0x21, 0x5e, 0xc4, 0x3f, 0x78, 0x42, 0xb0, 0x08,
+	0x51, 0x09, 0x2e, 0x99, 0x50, 0x05, 0x6b, 0xf7, 0xc0, 0x52, 0x6f, 0x05, 0xfc, 0x7f, 0xfd, 0x86,
+	0x60, 0xb8, 0x7c, 0x71, 0x30, 0x9c, 0x1c, 0xff, 0x7b, 0x6a, 0x51, 0x75, 0xfc, 0x8f, 0xc3, 0x12,
+	0xe2, 0x7f, 0x62, 0xc1, 0xf8, 0x6d, 0xb0, 0x9a, 0x54, 0x18, 0x5e, 0x1d, 0x6c, 0xd1, 0x0c, 0x9d,
+	0xbf, 0x3e, 0x0c, 0x3a, 0x61, 0xfa, 0x48, 0x29, 0xd6, 0x77, 0x7a, 0x19, 0xdd, 0x7f, 0x7a, 0x55,
+	0x35, 0x42, 0xa6, 0x4f, 0x4a, 0x8e, 0xaf, 0x26, 0xf0, 0xa8, 0x44, 0x27, 0x4c, 0xdf, 0x27, 0x33,
+	0xd5, 0x9e, 0x07, 0x13, 0xec, 0x2a, 0x32, 0xaf, 0x14, 0xa7, 0x7d, 0x79, 0x3d, 0xb9, 0x2f, 0x1c,
+	0xe8, 0x5b, 0x60, 0x25, 0xe1, 0x16, 0xf8, 0xe1, 0x64, 0xe9, 0x1e, 0x70, 0xfe, 0xd1, 0x21, 0xc0,
+	0xb2, 0x83, 0x47, 0xef, 0x55, 0xd5, 0x0b, 0x8e, 0x60, 0x12, 0x1c, 0x5c, 0x7d, 0xe7, 0x29, 0x0e,
+	0x0e, 0x11, 0x40, 0x4e, 0x38, 0x38, 0x44, 0xfc, 0xd8, 0xea, 0x0b, 0x91, 0xc3, 0x6b, 0xac, 0x1a,
+	0xbd, 0x7c, 0xe2, 0xda, 0x18, 0x28, 0x21, 0xbc, 0x26, 0x54, 0x97, 0xdf, 0x00, 0xb3, 0x91, 0xea,
+	0xe3, 0xd2, 0x09, 0xd6, 0xc2, 0x20, 0x09, 0x1a, 0xa8, 0x0a, 0x02, 0x7d, 0x2c, 0x3f, 0xf1, 0x1d,
+	0x52, 0x67, 0xec, 0x7e, 0xf9, 0xbd, 0x8f, 0xd7, 0x53, 0xef, 0x7f, 0xbc, 0x9e, 0xfa, 0xcb, 0xc7,
+	0xeb, 0xa9, 0x1f, 0x7d, 0xb2, 0x3e, 0xf6, 0xfe, 0x27, 0xeb, 0x63, 0x7f, 0xfa, 0x64, 0x7d, 0xec,
+	0x6b, 0xdb, 0x52, 0x01, 0xc9, 0xc6, 0xad, 0xa1, 0x96, 0x6b, 0x51, 0x67, 0xe0, 0x0d, 0xa5, 0x37,
+	0xc4, 0x5f, 0x5b, 0xd1, 0x7a, 0xf2, 0x70, 0x92, 0x7e, 0xee, 0xfe, 0xe8, 0xbf, 0x03, 0x00, 0x00,
+	0xff, 0xff, 0xe0, 0x23, 0xa8, 0x50, 0x91, 0x36, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// CreateProgram defines a method for creating a new program.
+	CreateProgram(ctx context.Context, in *MsgCreateProgram, opts ...grpc.CallOption) (*MsgCreateProgramResponse, error)
+	// EditProgram defines a method for modifying a program.
+	EditProgram(ctx context.Context, in *MsgEditProgram, opts ...grpc.CallOption) (*MsgEditProgramResponse, error)
+	// Activate a program status by program_id
+	ActivateProgram(ctx context.Context, in *MsgActivateProgram, opts ...grpc.CallOption) (*MsgActivateProgramResponse, error)
+	// Closed a program status by program_id
+	CloseProgram(ctx context.Context, in *MsgCloseProgram, opts ...grpc.CallOption) (*MsgCloseProgramResponse, error)
+	// FundProgram defines a method for sponsors to top up the reward pool of a program.
+	FundProgram(ctx context.Context, in *MsgFundProgram, opts ...grpc.CallOption) (*MsgFundProgramResponse, error)
+	// AddProgramMember defines a method for adding a member to a program team.
+	AddProgramMember(ctx context.Context, in *MsgAddProgramMember, opts ...grpc.CallOption) (*MsgAddProgramMemberResponse, error)
+	// RemoveProgramMember defines a method for removing a member from a program team.
+	RemoveProgramMember(ctx context.Context, in *MsgRemoveProgramMember, opts ...grpc.CallOption) (*MsgRemoveProgramMemberResponse, error)
+	// SubmitFinding defines a method for submitting a new finding.
+	SubmitFinding(ctx context.Context, in *MsgSubmitFinding, opts ...grpc.CallOption) (*MsgSubmitFindingResponse, error)
+	// EditFinding defines a method for editing a new finding.
+	EditFinding(ctx context.Context, in *MsgEditFinding, opts ...grpc.CallOption) (*MsgEditFindingResponse, error)
+	// ActivateFinding defines a method for activate a new finding.
+	ActivateFinding(ctx context.Context, in *MsgActivateFinding, opts ...grpc.CallOption) (*MsgActivateFindingResponse, error)
+	// ConfirmFinding defines a method for host confirm a finding.
+	ConfirmFinding(ctx context.Context, in *MsgConfirmFinding, opts ...grpc.CallOption) (*MsgConfirmFindingResponse, error)
+	// ConfirmFindingPaid defines a method for submitter confirm a finding paid
+	ConfirmFindingPaid(ctx context.Context, in *MsgConfirmFindingPaid, opts ...grpc.CallOption) (*MsgConfirmFindingPaidResponse, error)
+	// CloseFinding defines a method for submitter or certifier close a finding
+	CloseFinding(ctx context.Context, in *MsgCloseFinding, opts ...grpc.CallOption) (*MsgCloseFindingResponse, error)
+	// PublishFinding defines a method for publish a finding.
+	PublishFinding(ctx context.Context, in *MsgPublishFinding, opts ...grpc.CallOption) (*MsgPublishFindingResponse, error)
+	// MarkDuplicateFinding defines a method to mark a finding as a duplicate of another finding.
+	MarkDuplicateFinding(ctx context.Context, in *MsgMarkDuplicateFinding, opts ...grpc.CallOption) (*MsgMarkDuplicateFindingResponse, error)
+	// DisputeFinding defines a method for the submitter to dispute the closure of a finding.
+	DisputeFinding(ctx context.Context, in *MsgDisputeFinding, opts ...grpc.CallOption) (*MsgDisputeFindingResponse, error)
+	// VoteDispute defines a method for bounty admins to vote on a finding dispute.
+	VoteDispute(ctx context.Context, in *MsgVoteDispute, opts ...grpc.CallOption) (*MsgVoteDisputeResponse, error)
+	// CreateTheorem defines a method to create new theorem given the messages.
+	CreateTheorem(ctx context.Context, in *MsgCreateTheorem, opts ...grpc.CallOption) (*MsgCreateTheoremResponse, error)
+	// SubmitProofHash defines a method to submit a proof with hash.
+	SubmitProofHash(ctx context.Context, in *MsgSubmitProofHash, opts ...grpc.CallOption) (*MsgSubmitProofHashResponse, error)
+	// SubmitProofDetail defines a method to submit a proof with detail.
+	SubmitProofDetail(ctx context.Context, in *MsgSubmitProofDetail, opts ...grpc.CallOption) (*MsgSubmitProofDetailResponse, error)
+	// UploadProofChunk defines a method to upload a chunk of a large proof detail.
+	UploadProofChunk(ctx context.Context, in *MsgUploadProofChunk, opts ...grpc.CallOption) (*MsgUploadProofChunkResponse, error)
+	// SubmitProofDetailChunks defines a method to submit a proof detail assembled from uploaded chunks.
+	SubmitProofDetailChunks(ctx context.Context, in *MsgSubmitProofDetailChunks, opts ...grpc.CallOption) (*MsgSubmitProofDetailChunksResponse, error)
+	// SubmitProofVerification defines a method to submit a proof result.
+	SubmitProofVerification(ctx context.Context, in *MsgSubmitProofVerification, opts ...grpc.CallOption) (*MsgSubmitProofVerificationResponse, error)
+	// UpdateTheoremComplexity defines a method for checker to update theorem complexity.
+	UpdateTheoremComplexity(ctx context.Context, in *MsgUpdateTheoremComplexity, opts ...grpc.CallOption) (*MsgUpdateTheoremComplexityResponse, error)
+	// Grant defines a method to grant theorem given the messages.
+	Grant(ctx context.Context, in *MsgGrant, opts ...grpc.CallOption) (*MsgGrantResponse, error)
+	// GrantFromCommunityPool defines a governance operation for granting a theorem from the
+	// community pool. The authority is defined in the keeper.
+	GrantFromCommunityPool(ctx context.Context, in *MsgGrantFromCommunityPool, opts ...grpc.CallOption) (*MsgGrantFromCommunityPoolResponse, error)
+	// WithdrawGrant defines a method for a grantor to withdraw its grant from a theorem.
+	WithdrawGrant(ctx context.Context, in *MsgWithdrawGrant, opts ...grpc.CallOption) (*MsgWithdrawGrantResponse, error)
+	// CloseTheorem defines a method for the proposer to close a theorem and refund its grants.
+	CloseTheorem(ctx context.Context, in *MsgCloseTheorem, opts ...grpc.CallOption) (*MsgCloseTheoremResponse, error)
+	// WithdrawReward defines a method to withdraw reward given the messages.
+	WithdrawReward(ctx context.Context, in *MsgWithdrawReward, opts ...grpc.CallOption) (*MsgWithdrawRewardResponse, error)
+	// UpdateParams defines a governance operation for updating the x/bounty module parameters.
+	// The authority is defined in the keeper.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) CreateProgram(ctx context.Context, in *MsgCreateProgram, opts ...grpc.CallOption) (*MsgCreateProgramResponse, error) {
+	out := new(MsgCreateProgramResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/CreateProgram", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateTheoremComplexity(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/UpdateTheoremComplexity",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateTheoremComplexity(ctx, req.(*MsgUpdateTheoremComplexity))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_Grant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGrant)
-	if err := dec(in); err != nil {
+func (c *msgClient) EditProgram(ctx context.Context, in *MsgEditProgram, opts ...grpc.CallOption) (*MsgEditProgramResponse, error) {
+	out := new(MsgEditProgramResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/EditProgram", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).Grant(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/Grant",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Grant(ctx, req.(*MsgGrant))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_WithdrawReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawReward)
-	if err := dec(in); err != nil {
+func (c *msgClient) ActivateProgram(ctx context.Context, in *MsgActivateProgram, opts ...grpc.CallOption) (*MsgActivateProgramResponse, error) {
+	out := new(MsgActivateProgramResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/ActivateProgram", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawReward(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/WithdrawReward",
+	return out, nil
+}
+
+func (c *msgClient) CloseProgram(ctx context.Context, in *MsgCloseProgram, opts ...grpc.CallOption) (*MsgCloseProgramResponse, error) {
+	out := new(MsgCloseProgramResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/CloseProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawReward(ctx, req.(*MsgWithdrawReward))
+	return out, nil
+}
+
+func (c *msgClient) FundProgram(ctx context.Context, in *MsgFundProgram, opts ...grpc.CallOption) (*MsgFundProgramResponse, error) {
+	out := new(MsgFundProgramResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/FundProgram", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
+func (c *msgClient) AddProgramMember(ctx context.Context, in *MsgAddProgramMember, opts ...grpc.CallOption) (*MsgAddProgramMemberResponse, error) {
+	out := new(MsgAddProgramMemberResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/AddProgramMember", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) RemoveProgramMember(ctx context.Context, in *MsgRemoveProgramMember, opts ...grpc.CallOption) (*MsgRemoveProgramMemberResponse, error) {
+	out := new(MsgRemoveProgramMemberResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/RemoveProgramMember", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/shentu.bounty.v1.Msg/UpdateParams",
+	return out, nil
+}
+
+func (c *msgClient) SubmitFinding(ctx context.Context, in *MsgSubmitFinding, opts ...grpc.CallOption) (*MsgSubmitFindingResponse, error) {
+	out := new(MsgSubmitFindingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/SubmitFinding", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return out, nil
+}
+
+func (c *msgClient) EditFinding(ctx context.Context, in *MsgEditFinding, opts ...grpc.CallOption) (*MsgEditFindingResponse, error) {
+	out := new(MsgEditFindingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/EditFinding", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "shentu.bounty.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "CreateProgram",
-			Handler:    _Msg_CreateProgram_Handler,
-		},
-		{
-			MethodName: "EditProgram",
-			Handler:    _Msg_EditProgram_Handler,
-		},
-		{
-			MethodName: "ActivateProgram",
-			Handler:    _Msg_ActivateProgram_Handler,
-		},
-		{
-			MethodName: "CloseProgram",
-			Handler:    _Msg_CloseProgram_Handler,
-		},
-		{
-			MethodName: "SubmitFinding",
-			Handler:    _Msg_SubmitFinding_Handler,
-		},
-		{
-			MethodName: "EditFinding",
-			Handler:    _Msg_EditFinding_Handler,
-		},
-		{
-			MethodName: "ActivateFinding",
-			Handler:    _Msg_ActivateFinding_Handler,
-		},
-		{
-			MethodName: "ConfirmFinding",
-			Handler:    _Msg_ConfirmFinding_Handler,
-		},
-		{
-			MethodName: "ConfirmFindingPaid",
-			Handler:    _Msg_ConfirmFindingPaid_Handler,
-		},
-		{
-			MethodName: "CloseFinding",
-			Handler:    _Msg_CloseFinding_Handler,
-		},
-		{
-			MethodName: "PublishFinding",
-			Handler:    _Msg_PublishFinding_Handler,
-		},
-		{
-			MethodName: "CreateTheorem",
-			Handler:    _Msg_CreateTheorem_Handler,
-		},
-		{
-			MethodName: "SubmitProofHash",
-			Handler:    _Msg_SubmitProofHash_Handler,
-		},
-		{
-			MethodName: "SubmitProofDetail",
-			Handler:    _Msg_SubmitProofDetail_Handler,
-		},
-		{
-			MethodName: "SubmitProofVerification",
-			Handler:    _Msg_SubmitProofVerification_Handler,
-		},
-		{
-			MethodName: "UpdateTheoremComplexity",
-			Handler:    _Msg_UpdateTheoremComplexity_Handler,
-		},
-		{
-			MethodName: "Grant",
-			Handler:    _Msg_Grant_Handler,
-		},
-		{
-			MethodName: "WithdrawReward",
-			Handler:    _Msg_WithdrawReward_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "shentu/bounty/v1/tx.proto",
-}
-
-func (m *MsgCreateProgram) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ActivateFinding(ctx context.Context, in *MsgActivateFinding, opts ...grpc.CallOption) (*MsgActivateFindingResponse, error) {
+	out := new(MsgActivateFindingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/ActivateFinding", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateProgram) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ConfirmFinding(ctx context.Context, in *MsgConfirmFinding, opts ...grpc.CallOption) (*MsgConfirmFindingResponse, error) {
+	out := new(MsgConfirmFindingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/ConfirmFinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ConfirmFindingPaid(ctx context.Context, in *MsgConfirmFindingPaid, opts ...grpc.CallOption) (*MsgConfirmFindingPaidResponse, error) {
+	out := new(MsgConfirmFindingPaidResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/ConfirmFindingPaid", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgEditProgram) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CloseFinding(ctx context.Context, in *MsgCloseFinding, opts ...grpc.CallOption) (*MsgCloseFindingResponse, error) {
+	out := new(MsgCloseFindingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/CloseFinding", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgEditProgram) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) PublishFinding(ctx context.Context, in *MsgPublishFinding, opts ...grpc.CallOption) (*MsgPublishFindingResponse, error) {
+	out := new(MsgPublishFindingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/PublishFinding", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEditProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) MarkDuplicateFinding(ctx context.Context, in *MsgMarkDuplicateFinding, opts ...grpc.CallOption) (*MsgMarkDuplicateFindingResponse, error) {
+	out := new(MsgMarkDuplicateFindingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/MarkDuplicateFinding", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCreateProgramResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DisputeFinding(ctx context.Context, in *MsgDisputeFinding, opts ...grpc.CallOption) (*MsgDisputeFindingResponse, error) {
+	out := new(MsgDisputeFindingResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/DisputeFinding", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgCreateProgramResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) VoteDispute(ctx context.Context, in *MsgVoteDispute, opts ...grpc.CallOption) (*MsgVoteDisputeResponse, error) {
+	out := new(MsgVoteDisputeResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/VoteDispute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgCreateProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) CreateTheorem(ctx context.Context, in *MsgCreateTheorem, opts ...grpc.CallOption) (*MsgCreateTheoremResponse, error) {
+	out := new(MsgCreateTheoremResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/CreateTheorem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEditProgramResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitProofHash(ctx context.Context, in *MsgSubmitProofHash, opts ...grpc.CallOption) (*MsgSubmitProofHashResponse, error) {
+	out := new(MsgSubmitProofHashResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/SubmitProofHash", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgEditProgramResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitProofDetail(ctx context.Context, in *MsgSubmitProofDetail, opts ...grpc.CallOption) (*MsgSubmitProofDetailResponse, error) {
+	out := new(MsgSubmitProofDetailResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/SubmitProofDetail", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgEditProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) UploadProofChunk(ctx context.Context, in *MsgUploadProofChunk, opts ...grpc.CallOption) (*MsgUploadProofChunkResponse, error) {
+	out := new(MsgUploadProofChunkResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/UploadProofChunk", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgActivateProgram) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SubmitProofDetailChunks(ctx context.Context, in *MsgSubmitProofDetailChunks, opts ...grpc.CallOption) (*MsgSubmitProofDetailChunksResponse, error) {
+	out := new(MsgSubmitProofDetailChunksResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/SubmitProofDetailChunks", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgActivateProgram) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgActivateProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SubmitProofVerification(ctx context.Context, in *MsgSubmitProofVerification, opts ...grpc.CallOption) (*MsgSubmitProofVerificationResponse, error) {
+	out := new(MsgSubmitProofVerificationResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/SubmitProofVerification", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgActivateProgramResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateTheoremComplexity(ctx context.Context, in *MsgUpdateTheoremComplexity, opts ...grpc.CallOption) (*MsgUpdateTheoremComplexityResponse, error) {
+	out := new(MsgUpdateTheoremComplexityResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/UpdateTheoremComplexity", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgActivateProgramResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgActivateProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCloseProgram) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Grant(ctx context.Context, in *MsgGrant, opts ...grpc.CallOption) (*MsgGrantResponse, error) {
+	out := new(MsgGrantResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/Grant", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCloseProgram) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgCloseProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) GrantFromCommunityPool(ctx context.Context, in *MsgGrantFromCommunityPool, opts ...grpc.CallOption) (*MsgGrantFromCommunityPoolResponse, error) {
+	out := new(MsgGrantFromCommunityPoolResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/GrantFromCommunityPool", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgCloseProgramResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) WithdrawGrant(ctx context.Context, in *MsgWithdrawGrant, opts ...grpc.CallOption) (*MsgWithdrawGrantResponse, error) {
+	out := new(MsgWithdrawGrantResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/WithdrawGrant", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCloseProgramResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCloseProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitFinding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) CloseTheorem(ctx context.Context, in *MsgCloseTheorem, opts ...grpc.CallOption) (*MsgCloseTheoremResponse, error) {
+	out := new(MsgCloseTheoremResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/CloseTheorem", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitFinding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgSubmitFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.SeverityLevel != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SeverityLevel))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.FindingHash) > 0 {
-		i -= len(m.FindingHash)
-		copy(dAtA[i:], m.FindingHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ProgramId) > 0 {
-		i -= len(m.ProgramId)
-		copy(dAtA[i:], m.ProgramId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) WithdrawReward(ctx context.Context, in *MsgWithdrawReward, opts ...grpc.CallOption) (*MsgWithdrawRewardResponse, error) {
+	out := new(MsgWithdrawRewardResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/WithdrawReward", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgSubmitFindingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/shentu.bounty.v1.Msg/UpdateParams", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgSubmitFindingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// CreateProgram defines a method for creating a new program.
+	CreateProgram(context.Context, *MsgCreateProgram) (*MsgCreateProgramResponse, error)
+	// EditProgram defines a method for modifying a program.
+	EditProgram(context.Context, *MsgEditProgram) (*MsgEditProgramResponse, error)
+	// Activate a program status by program_id
+	ActivateProgram(context.Context, *MsgActivateProgram) (*MsgActivateProgramResponse, error)
+	// Closed a program status by program_id
+	CloseProgram(context.Context, *MsgCloseProgram) (*MsgCloseProgramResponse, error)
+	// FundProgram defines a method for sponsors to top up the reward pool of a program.
+	FundProgram(context.Context, *MsgFundProgram) (*MsgFundProgramResponse, error)
+	// AddProgramMember defines a method for adding a member to a program team.
+	AddProgramMember(context.Context, *MsgAddProgramMember) (*MsgAddProgramMemberResponse, error)
+	// RemoveProgramMember defines a method for removing a member from a program team.
+	RemoveProgramMember(context.Context, *MsgRemoveProgramMember) (*MsgRemoveProgramMemberResponse, error)
+	// SubmitFinding defines a method for submitting a new finding.
+	SubmitFinding(context.Context, *MsgSubmitFinding) (*MsgSubmitFindingResponse, error)
+	// EditFinding defines a method for editing a new finding.
+	EditFinding(context.Context, *MsgEditFinding) (*MsgEditFindingResponse, error)
+	// ActivateFinding defines a method for activate a new finding.
+	ActivateFinding(context.Context, *MsgActivateFinding) (*MsgActivateFindingResponse, error)
+	// ConfirmFinding defines a method for host confirm a finding.
+	ConfirmFinding(context.Context, *MsgConfirmFinding) (*MsgConfirmFindingResponse, error)
+	// ConfirmFindingPaid defines a method for submitter confirm a finding paid
+	ConfirmFindingPaid(context.Context, *MsgConfirmFindingPaid) (*MsgConfirmFindingPaidResponse, error)
+	// CloseFinding defines a method for submitter or certifier close a finding
+	CloseFinding(context.Context, *MsgCloseFinding) (*MsgCloseFindingResponse, error)
+	// PublishFinding defines a method for publish a finding.
+	PublishFinding(context.Context, *MsgPublishFinding) (*MsgPublishFindingResponse, error)
+	// MarkDuplicateFinding defines a method to mark a finding as a duplicate of another finding.
+	MarkDuplicateFinding(context.Context, *MsgMarkDuplicateFinding) (*MsgMarkDuplicateFindingResponse, error)
+	// DisputeFinding defines a method for the submitter to dispute the closure of a finding.
+	DisputeFinding(context.Context, *MsgDisputeFinding) (*MsgDisputeFindingResponse, error)
+	// VoteDispute defines a method for bounty admins to vote on a finding dispute.
+	VoteDispute(context.Context, *MsgVoteDispute) (*MsgVoteDisputeResponse, error)
+	// CreateTheorem defines a method to create new theorem given the messages.
+	CreateTheorem(context.Context, *MsgCreateTheorem) (*MsgCreateTheoremResponse, error)
+	// SubmitProofHash defines a method to submit a proof with hash.
+	SubmitProofHash(context.Context, *MsgSubmitProofHash) (*MsgSubmitProofHashResponse, error)
+	// SubmitProofDetail defines a method to submit a proof with detail.
+	SubmitProofDetail(context.Context, *MsgSubmitProofDetail) (*MsgSubmitProofDetailResponse, error)
+	// UploadProofChunk defines a method to upload a chunk of a large proof detail.
+	UploadProofChunk(context.Context, *MsgUploadProofChunk) (*MsgUploadProofChunkResponse, error)
+	// SubmitProofDetailChunks defines a method to submit a proof detail assembled from uploaded chunks.
+	SubmitProofDetailChunks(context.Context, *MsgSubmitProofDetailChunks) (*MsgSubmitProofDetailChunksResponse, error)
+	// SubmitProofVerification defines a method to submit a proof result.
+	SubmitProofVerification(context.Context, *MsgSubmitProofVerification) (*MsgSubmitProofVerificationResponse, error)
+	// UpdateTheoremComplexity defines a method for checker to update theorem complexity.
+	UpdateTheoremComplexity(context.Context, *MsgUpdateTheoremComplexity) (*MsgUpdateTheoremComplexityResponse, error)
+	// Grant defines a method to grant theorem given the messages.
+	Grant(context.Context, *MsgGrant) (*MsgGrantResponse, error)
+	// GrantFromCommunityPool defines a governance operation for granting a theorem from the
+	// community pool. The authority is defined in the keeper.
+	GrantFromCommunityPool(context.Context, *MsgGrantFromCommunityPool) (*MsgGrantFromCommunityPoolResponse, error)
+	// WithdrawGrant defines a method for a grantor to withdraw its grant from a theorem.
+	WithdrawGrant(context.Context, *MsgWithdrawGrant) (*MsgWithdrawGrantResponse, error)
+	// CloseTheorem defines a method for the proposer to close a theorem and refund its grants.
+	CloseTheorem(context.Context, *MsgCloseTheorem) (*MsgCloseTheoremResponse, error)
+	// WithdrawReward defines a method to withdraw reward given the messages.
+	WithdrawReward(context.Context, *MsgWithdrawReward) (*MsgWithdrawRewardResponse, error)
+	// UpdateParams defines a governance operation for updating the x/bounty module parameters.
+	// The authority is defined in the keeper.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
-func (m *MsgSubmitFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgEditFinding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CreateProgram(ctx context.Context, req *MsgCreateProgram) (*MsgCreateProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateProgram not implemented")
 }
-
-func (m *MsgEditFinding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) EditProgram(ctx context.Context, req *MsgEditProgram) (*MsgEditProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditProgram not implemented")
 }
-
-func (m *MsgEditFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PaymentHash) > 0 {
-		i -= len(m.PaymentHash)
-		copy(dAtA[i:], m.PaymentHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentHash)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.SeverityLevel != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.SeverityLevel))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.FindingHash) > 0 {
-		i -= len(m.FindingHash)
-		copy(dAtA[i:], m.FindingHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingHash)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) ActivateProgram(ctx context.Context, req *MsgActivateProgram) (*MsgActivateProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateProgram not implemented")
 }
-
-func (m *MsgEditFindingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) CloseProgram(ctx context.Context, req *MsgCloseProgram) (*MsgCloseProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseProgram not implemented")
 }
-
-func (m *MsgEditFindingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) FundProgram(ctx context.Context, req *MsgFundProgram) (*MsgFundProgramResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundProgram not implemented")
 }
-
-func (m *MsgEditFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) AddProgramMember(ctx context.Context, req *MsgAddProgramMember) (*MsgAddProgramMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddProgramMember not implemented")
 }
-
-func (m *MsgConfirmFinding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) RemoveProgramMember(ctx context.Context, req *MsgRemoveProgramMember) (*MsgRemoveProgramMemberResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveProgramMember not implemented")
+}
+func (*UnimplementedMsgServer) SubmitFinding(ctx context.Context, req *MsgSubmitFinding) (*MsgSubmitFindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitFinding not implemented")
+}
+func (*UnimplementedMsgServer) EditFinding(ctx context.Context, req *MsgEditFinding) (*MsgEditFindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EditFinding not implemented")
+}
+func (*UnimplementedMsgServer) ActivateFinding(ctx context.Context, req *MsgActivateFinding) (*MsgActivateFindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ActivateFinding not implemented")
+}
+func (*UnimplementedMsgServer) ConfirmFinding(ctx context.Context, req *MsgConfirmFinding) (*MsgConfirmFindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmFinding not implemented")
+}
+func (*UnimplementedMsgServer) ConfirmFindingPaid(ctx context.Context, req *MsgConfirmFindingPaid) (*MsgConfirmFindingPaidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmFindingPaid not implemented")
+}
+func (*UnimplementedMsgServer) CloseFinding(ctx context.Context, req *MsgCloseFinding) (*MsgCloseFindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseFinding not implemented")
+}
+func (*UnimplementedMsgServer) PublishFinding(ctx context.Context, req *MsgPublishFinding) (*MsgPublishFindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishFinding not implemented")
+}
+func (*UnimplementedMsgServer) MarkDuplicateFinding(ctx context.Context, req *MsgMarkDuplicateFinding) (*MsgMarkDuplicateFindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkDuplicateFinding not implemented")
+}
+func (*UnimplementedMsgServer) DisputeFinding(ctx context.Context, req *MsgDisputeFinding) (*MsgDisputeFindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeFinding not implemented")
+}
+func (*UnimplementedMsgServer) VoteDispute(ctx context.Context, req *MsgVoteDispute) (*MsgVoteDisputeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VoteDispute not implemented")
+}
+func (*UnimplementedMsgServer) CreateTheorem(ctx context.Context, req *MsgCreateTheorem) (*MsgCreateTheoremResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTheorem not implemented")
+}
+func (*UnimplementedMsgServer) SubmitProofHash(ctx context.Context, req *MsgSubmitProofHash) (*MsgSubmitProofHashResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofHash not implemented")
+}
+func (*UnimplementedMsgServer) SubmitProofDetail(ctx context.Context, req *MsgSubmitProofDetail) (*MsgSubmitProofDetailResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofDetail not implemented")
+}
+func (*UnimplementedMsgServer) UploadProofChunk(ctx context.Context, req *MsgUploadProofChunk) (*MsgUploadProofChunkResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UploadProofChunk not implemented")
+}
+func (*UnimplementedMsgServer) SubmitProofDetailChunks(ctx context.Context, req *MsgSubmitProofDetailChunks) (*MsgSubmitProofDetailChunksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofDetailChunks not implemented")
+}
+func (*UnimplementedMsgServer) SubmitProofVerification(ctx context.Context, req *MsgSubmitProofVerification) (*MsgSubmitProofVerificationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitProofVerification not implemented")
+}
+func (*UnimplementedMsgServer) UpdateTheoremComplexity(ctx context.Context, req *MsgUpdateTheoremComplexity) (*MsgUpdateTheoremComplexityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTheoremComplexity not implemented")
+}
+func (*UnimplementedMsgServer) Grant(ctx context.Context, req *MsgGrant) (*MsgGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Grant not implemented")
+}
+func (*UnimplementedMsgServer) GrantFromCommunityPool(ctx context.Context, req *MsgGrantFromCommunityPool) (*MsgGrantFromCommunityPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantFromCommunityPool not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawGrant(ctx context.Context, req *MsgWithdrawGrant) (*MsgWithdrawGrantResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawGrant not implemented")
+}
+func (*UnimplementedMsgServer) CloseTheorem(ctx context.Context, req *MsgCloseTheorem) (*MsgCloseTheoremResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseTheorem not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawReward(ctx context.Context, req *MsgWithdrawReward) (*MsgWithdrawRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawReward not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
 
-func (m *MsgConfirmFinding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgConfirmFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Fingerprint) > 0 {
-		i -= len(m.Fingerprint)
-		copy(dAtA[i:], m.Fingerprint)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Fingerprint)))
-		i--
-		dAtA[i] = 0x1a
+func _Msg_CreateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateProgram)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).CreateProgram(ctx, in)
 	}
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/CreateProgram",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateProgram(ctx, req.(*MsgCreateProgram))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConfirmFindingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_EditProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditProgram)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgConfirmFindingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgConfirmFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).EditProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/EditProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditProgram(ctx, req.(*MsgEditProgram))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgActivateFinding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ActivateProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateProgram)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgActivateFinding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).ActivateProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/ActivateProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ActivateProgram(ctx, req.(*MsgActivateProgram))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgActivateFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_CloseProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseProgram)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).CloseProgram(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/CloseProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseProgram(ctx, req.(*MsgCloseProgram))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgActivateFindingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_FundProgram_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundProgram)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).FundProgram(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/FundProgram",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundProgram(ctx, req.(*MsgFundProgram))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgActivateFindingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgActivateFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgConfirmFindingPaid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_AddProgramMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAddProgramMember)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgConfirmFindingPaid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgConfirmFindingPaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).AddProgramMember(ctx, in)
 	}
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/AddProgramMember",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AddProgramMember(ctx, req.(*MsgAddProgramMember))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConfirmFindingPaidResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RemoveProgramMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveProgramMember)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgConfirmFindingPaidResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveProgramMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/RemoveProgramMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveProgramMember(ctx, req.(*MsgRemoveProgramMember))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConfirmFindingPaidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SubmitFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitFinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitFinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/SubmitFinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitFinding(ctx, req.(*MsgSubmitFinding))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseFinding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_EditFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEditFinding)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).EditFinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/EditFinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EditFinding(ctx, req.(*MsgEditFinding))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseFinding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ActivateFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgActivateFinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ActivateFinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/ActivateFinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ActivateFinding(ctx, req.(*MsgActivateFinding))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_ConfirmFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmFinding)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmFinding(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/ConfirmFinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmFinding(ctx, req.(*MsgConfirmFinding))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseFindingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ConfirmFindingPaid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmFindingPaid)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmFindingPaid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/ConfirmFindingPaid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmFindingPaid(ctx, req.(*MsgConfirmFindingPaid))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseFindingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_CloseFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseFinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseFinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/CloseFinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseFinding(ctx, req.(*MsgCloseFinding))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCloseFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_PublishFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPublishFinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PublishFinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/PublishFinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PublishFinding(ctx, req.(*MsgPublishFinding))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPublishFinding) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_MarkDuplicateFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMarkDuplicateFinding)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).MarkDuplicateFinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/MarkDuplicateFinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MarkDuplicateFinding(ctx, req.(*MsgMarkDuplicateFinding))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPublishFinding) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_DisputeFinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeFinding)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeFinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/DisputeFinding",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeFinding(ctx, req.(*MsgDisputeFinding))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPublishFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.OperatorAddress) > 0 {
-		i -= len(m.OperatorAddress)
-		copy(dAtA[i:], m.OperatorAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.ProofOfConcept) > 0 {
-		i -= len(m.ProofOfConcept)
-		copy(dAtA[i:], m.ProofOfConcept)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofOfConcept)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_VoteDispute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgVoteDispute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Detail) > 0 {
-		i -= len(m.Detail)
-		copy(dAtA[i:], m.Detail)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Detail)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).VoteDispute(ctx, in)
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/VoteDispute",
 	}
-	if len(m.FindingId) > 0 {
-		i -= len(m.FindingId)
-		copy(dAtA[i:], m.FindingId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).VoteDispute(ctx, req.(*MsgVoteDispute))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPublishFindingResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateTheorem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTheorem)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTheorem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/CreateTheorem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTheorem(ctx, req.(*MsgCreateTheorem))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPublishFindingResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitProofHash_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProofHash)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProofHash(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/SubmitProofHash",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProofHash(ctx, req.(*MsgSubmitProofHash))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgPublishFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_SubmitProofDetail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProofDetail)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProofDetail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/SubmitProofDetail",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProofDetail(ctx, req.(*MsgSubmitProofDetail))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTheorem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UploadProofChunk_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUploadProofChunk)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UploadProofChunk(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/UploadProofChunk",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UploadProofChunk(ctx, req.(*MsgUploadProofChunk))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTheorem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_SubmitProofDetailChunks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProofDetailChunks)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProofDetailChunks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/SubmitProofDetailChunks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProofDetailChunks(ctx, req.(*MsgSubmitProofDetailChunks))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTheorem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.RequireOpenmathCert {
-		i--
-		if m.RequireOpenmathCert {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x30
+func _Msg_SubmitProofVerification_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitProofVerification)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Proposer) > 0 {
-		i -= len(m.Proposer)
-		copy(dAtA[i:], m.Proposer)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
-		i--
-		dAtA[i] = 0x2a
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitProofVerification(ctx, in)
 	}
-	if len(m.InitialGrant) > 0 {
-		for iNdEx := len(m.InitialGrant) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.InitialGrant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/SubmitProofVerification",
 	}
-	if len(m.Code) > 0 {
-		i -= len(m.Code)
-		copy(dAtA[i:], m.Code)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
-		i--
-		dAtA[i] = 0x1a
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitProofVerification(ctx, req.(*MsgSubmitProofVerification))
 	}
-	if len(m.Description) > 0 {
-		i -= len(m.Description)
-		copy(dAtA[i:], m.Description)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
-		i--
-		dAtA[i] = 0x12
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateTheoremComplexity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateTheoremComplexity)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Title) > 0 {
-		i -= len(m.Title)
-		copy(dAtA[i:], m.Title)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateTheoremComplexity(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/UpdateTheoremComplexity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateTheoremComplexity(ctx, req.(*MsgUpdateTheoremComplexity))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTheoremResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Grant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrant)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).Grant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/Grant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Grant(ctx, req.(*MsgGrant))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTheoremResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_GrantFromCommunityPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGrantFromCommunityPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GrantFromCommunityPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/GrantFromCommunityPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GrantFromCommunityPool(ctx, req.(*MsgGrantFromCommunityPool))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateTheoremResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.TheoremId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
-		i--
-		dAtA[i] = 0x8
+func _Msg_WithdrawGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawGrant)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/WithdrawGrant",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawGrant(ctx, req.(*MsgWithdrawGrant))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CloseTheorem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseTheorem)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgGrant) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Amount) > 0 {
-		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseTheorem(ctx, in)
 	}
-	if len(m.Grantor) > 0 {
-		i -= len(m.Grantor)
-		copy(dAtA[i:], m.Grantor)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantor)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/CloseTheorem",
 	}
-	if m.TheoremId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseTheorem(ctx, req.(*MsgCloseTheorem))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGrantResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WithdrawReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawReward)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/WithdrawReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawReward(ctx, req.(*MsgWithdrawReward))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGrantResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/shentu.bounty.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "shentu.bounty.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateProgram",
+			Handler:    _Msg_CreateProgram_Handler,
+		},
+		{
+			MethodName: "EditProgram",
+			Handler:    _Msg_EditProgram_Handler,
+		},
+		{
+			MethodName: "ActivateProgram",
+			Handler:    _Msg_ActivateProgram_Handler,
+		},
+		{
+			MethodName: "CloseProgram",
+			Handler:    _Msg_CloseProgram_Handler,
+		},
+		{
+			MethodName: "FundProgram",
+			Handler:    _Msg_FundProgram_Handler,
+		},
+		{
+			MethodName: "AddProgramMember",
+			Handler:    _Msg_AddProgramMember_Handler,
+		},
+		{
+			MethodName: "RemoveProgramMember",
+			Handler:    _Msg_RemoveProgramMember_Handler,
+		},
+		{
+			MethodName: "SubmitFinding",
+			Handler:    _Msg_SubmitFinding_Handler,
+		},
+		{
+			MethodName: "EditFinding",
+			Handler:    _Msg_EditFinding_Handler,
+		},
+		{
+			MethodName: "ActivateFinding",
+			Handler:    _Msg_ActivateFinding_Handler,
+		},
+		{
+			MethodName: "ConfirmFinding",
+			Handler:    _Msg_ConfirmFinding_Handler,
+		},
+		{
+			MethodName: "ConfirmFindingPaid",
+			Handler:    _Msg_ConfirmFindingPaid_Handler,
+		},
+		{
+			MethodName: "CloseFinding",
+			Handler:    _Msg_CloseFinding_Handler,
+		},
+		{
+			MethodName: "PublishFinding",
+			Handler:    _Msg_PublishFinding_Handler,
+		},
+		{
+			MethodName: "MarkDuplicateFinding",
+			Handler:    _Msg_MarkDuplicateFinding_Handler,
+		},
+		{
+			MethodName: "DisputeFinding",
+			Handler:    _Msg_DisputeFinding_Handler,
+		},
+		{
+			MethodName: "VoteDispute",
+			Handler:    _Msg_VoteDispute_Handler,
+		},
+		{
+			MethodName: "CreateTheorem",
+			Handler:    _Msg_CreateTheorem_Handler,
+		},
+		{
+			MethodName: "SubmitProofHash",
+			Handler:    _Msg_SubmitProofHash_Handler,
+		},
+		{
+			MethodName: "SubmitProofDetail",
+			Handler:    _Msg_SubmitProofDetail_Handler,
+		},
+		{
+			MethodName: "UploadProofChunk",
+			Handler:    _Msg_UploadProofChunk_Handler,
+		},
+		{
+			MethodName: "SubmitProofDetailChunks",
+			Handler:    _Msg_SubmitProofDetailChunks_Handler,
+		},
+		{
+			MethodName: "SubmitProofVerification",
+			Handler:    _Msg_SubmitProofVerification_Handler,
+		},
+		{
+			MethodName: "UpdateTheoremComplexity",
+			Handler:    _Msg_UpdateTheoremComplexity_Handler,
+		},
+		{
+			MethodName: "Grant",
+			Handler:    _Msg_Grant_Handler,
+		},
+		{
+			MethodName: "GrantFromCommunityPool",
+			Handler:    _Msg_GrantFromCommunityPool_Handler,
+		},
+		{
+			MethodName: "WithdrawGrant",
+			Handler:    _Msg_WithdrawGrant_Handler,
+		},
+		{
+			MethodName: "CloseTheorem",
+			Handler:    _Msg_CloseTheorem_Handler,
+		},
+		{
+			MethodName: "WithdrawReward",
+			Handler:    _Msg_WithdrawReward_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "shentu/bounty/v1/tx.proto",
 }
 
-func (m *MsgSubmitProofHash) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateProgram) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3405,20 +3941,40 @@ func (m *MsgSubmitProofHash) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitProofHash) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateProgram) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProofHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Deposit) > 0 {
-		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+	if m.DisclosureEmbargo != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.DisclosureEmbargo, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DisclosureEmbargo):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x6a
+	}
+	{
+		size, err := m.SubmissionRequirements.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if len(m.Scope) > 0 {
+		for iNdEx := len(m.Scope) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Scope[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3426,55 +3982,99 @@ func (m *MsgSubmitProofHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintTx(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0x5a
 		}
 	}
-	if len(m.ProofHash) > 0 {
-		i -= len(m.ProofHash)
-		copy(dAtA[i:], m.ProofHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofHash)))
+	if m.ConfirmationSla != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ConfirmationSla, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConfirmationSla):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x52
 	}
-	if len(m.Prover) > 0 {
-		i -= len(m.Prover)
-		copy(dAtA[i:], m.Prover)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Prover)))
+	if m.ActivationSla != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ActivationSla, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ActivationSla):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x4a
 	}
-	if m.TheoremId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
+	if m.DuplicatePolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DuplicatePolicy))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x40
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSubmitProofHashResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.CriticalApprovals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CriticalApprovals))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.RewardSchedule) > 0 {
+		for iNdEx := len(m.RewardSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RewardPool) > 0 {
+		for iNdEx := len(m.RewardPool) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardPool[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitProofHashResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitProofHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProofDetail) Marshal() (dAtA []byte, err error) {
+func (m *MsgEditProgram) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3484,16 +4084,103 @@ func (m *MsgSubmitProofDetail) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitProofDetail) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEditProgram) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProofDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEditProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.DisclosureEmbargo != nil {
+		n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.DisclosureEmbargo, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DisclosureEmbargo):])
+		if err5 != nil {
+			return 0, err5
+		}
+		i -= n5
+		i = encodeVarintTx(dAtA, i, uint64(n5))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.SubmissionRequirements != nil {
+		{
+			size, err := m.SubmissionRequirements.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if len(m.Scope) > 0 {
+		for iNdEx := len(m.Scope) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Scope[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if m.ConfirmationSla != nil {
+		n7, err7 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ConfirmationSla, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConfirmationSla):])
+		if err7 != nil {
+			return 0, err7
+		}
+		i -= n7
+		i = encodeVarintTx(dAtA, i, uint64(n7))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.ActivationSla != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ActivationSla, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ActivationSla):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintTx(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.DuplicatePolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DuplicatePolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CriticalApprovals != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CriticalApprovals))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.RewardSchedule) > 0 {
+		for iNdEx := len(m.RewardSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Detail) > 0 {
 		i -= len(m.Detail)
 		copy(dAtA[i:], m.Detail)
@@ -3501,24 +4188,24 @@ func (m *MsgSubmitProofDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Prover) > 0 {
-		i -= len(m.Prover)
-		copy(dAtA[i:], m.Prover)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Prover)))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.ProofId) > 0 {
-		i -= len(m.ProofId)
-		copy(dAtA[i:], m.ProofId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofId)))
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProofDetailResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCreateProgramResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3528,12 +4215,12 @@ func (m *MsgSubmitProofDetailResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitProofDetailResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCreateProgramResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProofDetailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCreateProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3541,7 +4228,7 @@ func (m *MsgSubmitProofDetailResponse) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProofVerification) Marshal() (dAtA []byte, err error) {
+func (m *MsgEditProgramResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3551,67 +4238,57 @@ func (m *MsgSubmitProofVerification) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitProofVerification) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgEditProgramResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProofVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgEditProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.TheoremType != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TheoremType))
-		i--
-		dAtA[i] = 0x30
-	}
-	if len(m.Imports) > 0 {
-		dAtA2 := make([]byte, len(m.Imports)*10)
-		var j1 int
-		for _, num := range m.Imports {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
-		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintTx(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.Complexity != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Complexity))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.Checker) > 0 {
-		i -= len(m.Checker)
-		copy(dAtA[i:], m.Checker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Checker)))
-		i--
-		dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgActivateProgram) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Status != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+	return dAtA[:n], nil
+}
+
+func (m *MsgActivateProgram) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if len(m.ProofId) > 0 {
-		i -= len(m.ProofId)
-		copy(dAtA[i:], m.ProofId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofId)))
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProofVerificationResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgActivateProgramResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3621,12 +4298,12 @@ func (m *MsgSubmitProofVerificationResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitProofVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgActivateProgramResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProofVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgActivateProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3634,7 +4311,7 @@ func (m *MsgSubmitProofVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawReward) Marshal() (dAtA []byte, err error) {
+func (m *MsgCloseProgram) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3644,27 +4321,34 @@ func (m *MsgWithdrawReward) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawReward) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCloseProgram) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCloseProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawRewardResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCloseProgramResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3674,12 +4358,12 @@ func (m *MsgWithdrawRewardResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCloseProgramResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCloseProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3687,7 +4371,7 @@ func (m *MsgWithdrawRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateTheoremComplexity) Marshal() (dAtA []byte, err error) {
+func (m *MsgFundProgram) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3697,37 +4381,48 @@ func (m *MsgUpdateTheoremComplexity) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateTheoremComplexity) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFundProgram) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateTheoremComplexity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFundProgram) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Complexity != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.Complexity))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.Checker) > 0 {
-		i -= len(m.Checker)
-		copy(dAtA[i:], m.Checker)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Checker)))
-		i--
-		dAtA[i] = 0x12
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.TheoremId != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
+	if len(m.SponsorAddress) > 0 {
+		i -= len(m.SponsorAddress)
+		copy(dAtA[i:], m.SponsorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SponsorAddress)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateTheoremComplexityResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgFundProgramResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3737,12 +4432,12 @@ func (m *MsgUpdateTheoremComplexityResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateTheoremComplexityResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgFundProgramResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateTheoremComplexityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgFundProgramResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3750,7 +4445,7 @@ func (m *MsgUpdateTheoremComplexityResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddProgramMember) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3760,37 +4455,46 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddProgramMember) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddProgramMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if m.Role != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MemberAddress) > 0 {
+		i -= len(m.MemberAddress)
+		copy(dAtA[i:], m.MemberAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MemberAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgAddProgramMemberResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3800,12 +4504,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgAddProgramMemberResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgAddProgramMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3813,626 +4517,5792 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgRemoveProgramMember) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateProgram) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+
+func (m *MsgRemoveProgramMember) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgEditProgram) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveProgramMember) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.MemberAddress) > 0 {
+		i -= len(m.MemberAddress)
+		copy(dAtA[i:], m.MemberAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MemberAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateProgramResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveProgramMemberResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgEditProgramResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRemoveProgramMemberResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgActivateProgram) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRemoveProgramMemberResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgActivateProgramResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCloseProgram) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgSubmitFinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCloseProgramResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSubmitFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
-}
-
-func (m *MsgSubmitFinding) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.TargetId) > 0 {
+		i -= len(m.TargetId)
+		copy(dAtA[i:], m.TargetId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TargetId)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	l = len(m.ProgramId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.EncryptedPayload != nil {
+		{
+			size, err := m.EncryptedPayload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.SeverityLevel != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.SeverityLevel))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.FindingHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.FindingHash) > 0 {
+		i -= len(m.FindingHash)
+		copy(dAtA[i:], m.FindingHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingHash)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.SeverityLevel != 0 {
-		n += 1 + sovTx(uint64(m.SeverityLevel))
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.ProgramId) > 0 {
+		i -= len(m.ProgramId)
+		copy(dAtA[i:], m.ProgramId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProgramId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitFindingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSubmitFindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitFindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditFinding) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEditFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEditFinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEditFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FindingHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.EncryptedPayload != nil {
+		{
+			size, err := m.EncryptedPayload.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.PaymentHash) > 0 {
+		i -= len(m.PaymentHash)
+		copy(dAtA[i:], m.PaymentHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.PaymentHash)))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if m.SeverityLevel != 0 {
-		n += 1 + sovTx(uint64(m.SeverityLevel))
+		i = encodeVarintTx(dAtA, i, uint64(m.SeverityLevel))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.PaymentHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.FindingHash) > 0 {
+		i -= len(m.FindingHash)
+		copy(dAtA[i:], m.FindingHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgEditFindingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgEditFindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgConfirmFinding) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Fingerprint)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgEditFindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConfirmFindingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgEditFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgActivateFinding) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgConfirmFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgActivateFindingResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgConfirmFinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConfirmFindingPaid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConfirmFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Reward) > 0 {
+		for iNdEx := len(m.Reward) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Reward[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Fingerprint) > 0 {
+		i -= len(m.Fingerprint)
+		copy(dAtA[i:], m.Fingerprint)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Fingerprint)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConfirmFindingPaidResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConfirmFindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCloseFinding) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConfirmFindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCloseFindingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgActivateFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPublishFinding) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgActivateFinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgActivateFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FindingId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofOfConcept)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgPublishFindingResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgActivateFindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgCreateTheorem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Title)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Code)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.InitialGrant) > 0 {
-		for _, e := range m.InitialGrant {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	l = len(m.Proposer)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if m.RequireOpenmathCert {
-		n += 2
-	}
-	return n
+func (m *MsgActivateFindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateTheoremResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgActivateFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TheoremId != 0 {
-		n += 1 + sovTx(uint64(m.TheoremId))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGrant) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.TheoremId != 0 {
-		n += 1 + sovTx(uint64(m.TheoremId))
-	}
-	l = len(m.Grantor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Amount) > 0 {
-		for _, e := range m.Amount {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgConfirmFindingPaid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgGrantResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgConfirmFindingPaid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProofHash) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConfirmFindingPaid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TheoremId != 0 {
-		n += 1 + sovTx(uint64(m.TheoremId))
-	}
-	l = len(m.Prover)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ProofHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Deposit) > 0 {
-		for _, e := range m.Deposit {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProofHashResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgConfirmFindingPaidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitProofDetail) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ProofId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Prover)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Detail)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+func (m *MsgConfirmFindingPaidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitProofDetailResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgConfirmFindingPaidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProofVerification) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCloseFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseFinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
 	_ = l
-	l = len(m.ProofId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Status != 0 {
-		n += 1 + sovTx(uint64(m.Status))
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.Checker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseFindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Complexity != 0 {
-		n += 1 + sovTx(uint64(m.Complexity))
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseFindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPublishFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Imports) > 0 {
-		l = 0
-		for _, e := range m.Imports {
-			l += sovTx(uint64(e))
-		}
-		n += 1 + sovTx(uint64(l)) + l
+	return dAtA[:n], nil
+}
+
+func (m *MsgPublishFinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPublishFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.TheoremType != 0 {
-		n += 1 + sovTx(uint64(m.TheoremType))
+	if len(m.ProofOfConcept) > 0 {
+		i -= len(m.ProofOfConcept)
+		copy(dAtA[i:], m.ProofOfConcept)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofOfConcept)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitProofVerificationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgPublishFindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPublishFindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPublishFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawReward) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarkDuplicateFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarkDuplicateFinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarkDuplicateFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if len(m.DuplicateOf) > 0 {
+		i -= len(m.DuplicateOf)
+		copy(dAtA[i:], m.DuplicateOf)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DuplicateOf)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMarkDuplicateFindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMarkDuplicateFindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMarkDuplicateFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateTheoremComplexity) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgDisputeFinding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeFinding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeFinding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.TheoremId != 0 {
-		n += 1 + sovTx(uint64(m.TheoremId))
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Checker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Complexity != 0 {
-		n += 1 + sovTx(uint64(m.Complexity))
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeFindingResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeFindingResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeFindingResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteDispute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteDispute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteDispute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Voter) > 0 {
+		i -= len(m.Voter)
+		copy(dAtA[i:], m.Voter)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Voter)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Option != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Option))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FindingId) > 0 {
+		i -= len(m.FindingId)
+		copy(dAtA[i:], m.FindingId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FindingId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgVoteDisputeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgVoteDisputeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgVoteDisputeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTheorem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTheorem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTheorem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TheoremType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TheoremType))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RequireOpenmathCert {
+		i--
+		if m.RequireOpenmathCert {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.InitialGrant) > 0 {
+		for iNdEx := len(m.InitialGrant) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.InitialGrant[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Code) > 0 {
+		i -= len(m.Code)
+		copy(dAtA[i:], m.Code)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Code)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTheoremResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTheoremResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTheoremResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Grantor) > 0 {
+		i -= len(m.Grantor)
+		copy(dAtA[i:], m.Grantor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantFromCommunityPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantFromCommunityPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantFromCommunityPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGrantFromCommunityPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGrantFromCommunityPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGrantFromCommunityPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Grantor) > 0 {
+		i -= len(m.Grantor)
+		copy(dAtA[i:], m.Grantor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Grantor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawGrantResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawGrantResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawGrantResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Penalty) > 0 {
+		for iNdEx := len(m.Penalty) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Penalty[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Refunded) > 0 {
+		for iNdEx := len(m.Refunded) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Refunded[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseTheorem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseTheorem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseTheorem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseTheoremResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseTheoremResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseTheoremResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProofHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProofHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProofHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Deposit) > 0 {
+		for iNdEx := len(m.Deposit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Deposit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.ProofHash) > 0 {
+		i -= len(m.ProofHash)
+		copy(dAtA[i:], m.ProofHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Prover) > 0 {
+		i -= len(m.Prover)
+		copy(dAtA[i:], m.Prover)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Prover)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProofHashResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProofHashResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProofHashResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProofDetail) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProofDetail) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProofDetail) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Detail) > 0 {
+		i -= len(m.Detail)
+		copy(dAtA[i:], m.Detail)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Detail)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Prover) > 0 {
+		i -= len(m.Prover)
+		copy(dAtA[i:], m.Prover)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Prover)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProofId) > 0 {
+		i -= len(m.ProofId)
+		copy(dAtA[i:], m.ProofId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProofDetailResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProofDetailResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProofDetailResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadProofChunk) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadProofChunk) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadProofChunk) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Prover) > 0 {
+		i -= len(m.Prover)
+		copy(dAtA[i:], m.Prover)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Prover)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProofId) > 0 {
+		i -= len(m.ProofId)
+		copy(dAtA[i:], m.ProofId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUploadProofChunkResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUploadProofChunkResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUploadProofChunkResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProofDetailChunks) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProofDetailChunks) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProofDetailChunks) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Compressed {
+		i--
+		if m.Compressed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChunkHashes) > 0 {
+		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChunkHashes[iNdEx])
+			copy(dAtA[i:], m.ChunkHashes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.ChunkHashes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Prover) > 0 {
+		i -= len(m.Prover)
+		copy(dAtA[i:], m.Prover)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Prover)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ProofId) > 0 {
+		i -= len(m.ProofId)
+		copy(dAtA[i:], m.ProofId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProofDetailChunksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProofDetailChunksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProofDetailChunksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProofVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProofVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProofVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TheoremType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TheoremType))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Imports) > 0 {
+		dAtA12 := make([]byte, len(m.Imports)*10)
+		var j11 int
+		for _, num := range m.Imports {
+			for num >= 1<<7 {
+				dAtA12[j11] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j11++
+			}
+			dAtA12[j11] = uint8(num)
+			j11++
+		}
+		i -= j11
+		copy(dAtA[i:], dAtA12[:j11])
+		i = encodeVarintTx(dAtA, i, uint64(j11))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Complexity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Complexity))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Checker) > 0 {
+		i -= len(m.Checker)
+		copy(dAtA[i:], m.Checker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checker)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Status != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProofId) > 0 {
+		i -= len(m.ProofId)
+		copy(dAtA[i:], m.ProofId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ProofId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitProofVerificationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSubmitProofVerificationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSubmitProofVerificationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Vesting) > 0 {
+		for iNdEx := len(m.Vesting) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Vesting[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTheoremComplexity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTheoremComplexity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTheoremComplexity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Complexity != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Complexity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Checker) > 0 {
+		i -= len(m.Checker)
+		copy(dAtA[i:], m.Checker)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Checker)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TheoremId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TheoremId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateTheoremComplexityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateTheoremComplexityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateTheoremComplexityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RewardPool) > 0 {
+		for _, e := range m.RewardPool {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RewardSchedule) > 0 {
+		for _, e := range m.RewardSchedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CriticalApprovals != 0 {
+		n += 1 + sovTx(uint64(m.CriticalApprovals))
+	}
+	if m.DuplicatePolicy != 0 {
+		n += 1 + sovTx(uint64(m.DuplicatePolicy))
+	}
+	if m.ActivationSla != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ActivationSla)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConfirmationSla != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConfirmationSla)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Scope) > 0 {
+		for _, e := range m.Scope {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = m.SubmissionRequirements.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DisclosureEmbargo != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DisclosureEmbargo)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RewardSchedule) > 0 {
+		for _, e := range m.RewardSchedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.CriticalApprovals != 0 {
+		n += 1 + sovTx(uint64(m.CriticalApprovals))
+	}
+	if m.DuplicatePolicy != 0 {
+		n += 1 + sovTx(uint64(m.DuplicatePolicy))
+	}
+	if m.ActivationSla != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ActivationSla)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ConfirmationSla != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ConfirmationSla)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Scope) > 0 {
+		for _, e := range m.Scope {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.SubmissionRequirements != nil {
+		l = m.SubmissionRequirements.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.DisclosureEmbargo != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.DisclosureEmbargo)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgActivateProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundProgram) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.SponsorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundProgramResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgAddProgramMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MemberAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Role != 0 {
+		n += 1 + sovTx(uint64(m.Role))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAddProgramMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveProgramMember) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MemberAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveProgramMemberResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitFinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProgramId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FindingHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SeverityLevel != 0 {
+		n += 1 + sovTx(uint64(m.SeverityLevel))
+	}
+	if m.EncryptedPayload != nil {
+		l = m.EncryptedPayload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TargetId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitFindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEditFinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FindingHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.SeverityLevel != 0 {
+		n += 1 + sovTx(uint64(m.SeverityLevel))
+	}
+	l = len(m.PaymentHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.EncryptedPayload != nil {
+		l = m.EncryptedPayload.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEditFindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConfirmFinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Fingerprint)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Reward) > 0 {
+		for _, e := range m.Reward {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgConfirmFindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgActivateFinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgActivateFindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConfirmFindingPaid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConfirmFindingPaidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseFinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseFindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPublishFinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofOfConcept)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPublishFindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMarkDuplicateFinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DuplicateOf)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgMarkDuplicateFindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDisputeFinding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeFindingResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgVoteDispute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FindingId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Option != 0 {
+		n += 1 + sovTx(uint64(m.Option))
+	}
+	l = len(m.Voter)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgVoteDisputeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateTheorem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Code)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.InitialGrant) > 0 {
+		for _, e := range m.InitialGrant {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RequireOpenmathCert {
+		n += 2
+	}
+	if m.TheoremType != 0 {
+		n += 1 + sovTx(uint64(m.TheoremType))
+	}
+	return n
+}
+
+func (m *MsgCreateTheoremResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovTx(uint64(m.TheoremId))
+	}
+	return n
+}
+
+func (m *MsgGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovTx(uint64(m.TheoremId))
+	}
+	l = len(m.Grantor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGrantFromCommunityPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TheoremId != 0 {
+		n += 1 + sovTx(uint64(m.TheoremId))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGrantFromCommunityPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovTx(uint64(m.TheoremId))
+	}
+	l = len(m.Grantor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawGrantResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Refunded) > 0 {
+		for _, e := range m.Refunded {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Penalty) > 0 {
+		for _, e := range m.Penalty {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCloseTheorem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovTx(uint64(m.TheoremId))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseTheoremResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitProofHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovTx(uint64(m.TheoremId))
+	}
+	l = len(m.Prover)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ProofHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Deposit) > 0 {
+		for _, e := range m.Deposit {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSubmitProofHashResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitProofDetail) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProofId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Prover)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Detail)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProofDetailResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUploadProofChunk) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProofId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Prover)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUploadProofChunkResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitProofDetailChunks) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProofId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Prover)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.ChunkHashes) > 0 {
+		for _, s := range m.ChunkHashes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Compressed {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSubmitProofDetailChunksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitProofVerification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProofId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTx(uint64(m.Status))
+	}
+	l = len(m.Checker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Complexity != 0 {
+		n += 1 + sovTx(uint64(m.Complexity))
+	}
+	if len(m.Imports) > 0 {
+		l = 0
+		for _, e := range m.Imports {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	if m.TheoremType != 0 {
+		n += 1 + sovTx(uint64(m.TheoremType))
+	}
+	return n
+}
+
+func (m *MsgSubmitProofVerificationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Vesting) > 0 {
+		for _, e := range m.Vesting {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateTheoremComplexity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TheoremId != 0 {
+		n += 1 + sovTx(uint64(m.TheoremId))
+	}
+	l = len(m.Checker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Complexity != 0 {
+		n += 1 + sovTx(uint64(m.Complexity))
+	}
+	return n
+}
+
+func (m *MsgUpdateTheoremComplexityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPool", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardPool = append(m.RewardPool, types.Coin{})
+			if err := m.RewardPool[len(m.RewardPool)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardSchedule = append(m.RewardSchedule, SeverityReward{})
+			if err := m.RewardSchedule[len(m.RewardSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalApprovals", wireType)
+			}
+			m.CriticalApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CriticalApprovals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicatePolicy", wireType)
+			}
+			m.DuplicatePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DuplicatePolicy |= DuplicatePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationSla", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivationSla == nil {
+				m.ActivationSla = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ActivationSla, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationSla", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfirmationSla == nil {
+				m.ConfirmationSla = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ConfirmationSla, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = append(m.Scope, ScopeTarget{})
+			if err := m.Scope[len(m.Scope)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SubmissionRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisclosureEmbargo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisclosureEmbargo == nil {
+				m.DisclosureEmbargo = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.DisclosureEmbargo, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardSchedule = append(m.RewardSchedule, SeverityReward{})
+			if err := m.RewardSchedule[len(m.RewardSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CriticalApprovals", wireType)
+			}
+			m.CriticalApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CriticalApprovals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicatePolicy", wireType)
+			}
+			m.DuplicatePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DuplicatePolicy |= DuplicatePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationSla", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivationSla == nil {
+				m.ActivationSla = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ActivationSla, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationSla", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ConfirmationSla == nil {
+				m.ConfirmationSla = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ConfirmationSla, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = append(m.Scope, ScopeTarget{})
+			if err := m.Scope[len(m.Scope)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmissionRequirements", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SubmissionRequirements == nil {
+				m.SubmissionRequirements = &SubmissionRequirements{}
+			}
+			if err := m.SubmissionRequirements.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisclosureEmbargo", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DisclosureEmbargo == nil {
+				m.DisclosureEmbargo = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.DisclosureEmbargo, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundProgram) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundProgram: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SponsorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundProgramResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundProgramResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddProgramMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProgramMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProgramMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= ProgramRole(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddProgramMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddProgramMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddProgramMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveProgramMember) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveProgramMember: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveProgramMember: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MemberAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MemberAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveProgramMemberResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveProgramMemberResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveProgramMemberResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
+			}
+			m.SeverityLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeverityLevel |= SeverityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EncryptedPayload == nil {
+				m.EncryptedPayload = &EncryptedFindingPayload{}
+			}
+			if err := m.EncryptedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSubmitFindingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSubmitFindingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSubmitFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
+			}
+			m.SeverityLevel = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SeverityLevel |= SeverityLevel(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedPayload", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EncryptedPayload == nil {
+				m.EncryptedPayload = &EncryptedFindingPayload{}
+			}
+			if err := m.EncryptedPayload.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEditFindingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEditFindingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEditFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmFinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmFinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fingerprint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reward", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reward = append(m.Reward, types.Coin{})
+			if err := m.Reward[len(m.Reward)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmFindingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmFindingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgActivateFinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateFinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateTheoremComplexityResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgActivateFindingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgActivateFindingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgActivateFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateProgram) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmFindingPaid) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4455,15 +10325,15 @@ func (m *MsgCreateProgram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateProgram: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmFindingPaid: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmFindingPaid: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4491,11 +10361,11 @@ func (m *MsgCreateProgram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			m.FindingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4523,11 +10393,111 @@ func (m *MsgCreateProgram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmFindingPaidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmFindingPaidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmFindingPaidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseFinding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseFinding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4555,9 +10525,9 @@ func (m *MsgCreateProgram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = string(dAtA[iNdEx:postIndex])
+			m.FindingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
@@ -4580,15 +10550,65 @@ func (m *MsgCreateProgram) Unmarshal(dAtA []byte) error {
 			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseFindingResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseFindingResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4610,7 +10630,7 @@ func (m *MsgCreateProgram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditProgram) Unmarshal(dAtA []byte) error {
+func (m *MsgPublishFinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4633,15 +10653,15 @@ func (m *MsgEditProgram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditProgram: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPublishFinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPublishFinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4669,11 +10689,11 @@ func (m *MsgEditProgram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			m.FindingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4701,7 +10721,7 @@ func (m *MsgEditProgram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -4737,7 +10757,7 @@ func (m *MsgEditProgram) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4765,161 +10785,11 @@ func (m *MsgEditProgram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCreateProgramResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateProgramResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgEditProgramResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditProgramResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgActivateProgram) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateProgram: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateProgram: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfConcept", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4947,9 +10817,9 @@ func (m *MsgActivateProgram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			m.ProofOfConcept = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
@@ -5002,7 +10872,7 @@ func (m *MsgActivateProgram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateProgramResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPublishFindingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5025,10 +10895,10 @@ func (m *MsgActivateProgramResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateProgramResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPublishFindingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPublishFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5052,7 +10922,7 @@ func (m *MsgActivateProgramResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseProgram) Unmarshal(dAtA []byte) error {
+func (m *MsgMarkDuplicateFinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5075,15 +10945,47 @@ func (m *MsgCloseProgram) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseProgram: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarkDuplicateFinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseProgram: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarkDuplicateFinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FindingId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateOf", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5111,9 +11013,9 @@ func (m *MsgCloseProgram) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
+			m.DuplicateOf = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
@@ -5166,7 +11068,7 @@ func (m *MsgCloseProgram) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseProgramResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMarkDuplicateFindingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5189,10 +11091,10 @@ func (m *MsgCloseProgramResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseProgramResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMarkDuplicateFindingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseProgramResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMarkDuplicateFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5216,7 +11118,7 @@ func (m *MsgCloseProgramResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitFinding) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeFinding) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5239,45 +11141,13 @@ func (m *MsgSubmitFinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitFinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeFinding: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeFinding: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProgramId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProgramId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
 			}
@@ -5309,9 +11179,9 @@ func (m *MsgSubmitFinding) Unmarshal(dAtA []byte) error {
 			}
 			m.FindingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5339,9 +11209,9 @@ func (m *MsgSubmitFinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FindingHash = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
@@ -5373,25 +11243,6 @@ func (m *MsgSubmitFinding) Unmarshal(dAtA []byte) error {
 			}
 			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
-			}
-			m.SeverityLevel = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.SeverityLevel |= SeverityLevel(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5413,7 +11264,7 @@ func (m *MsgSubmitFinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitFindingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeFindingResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5436,10 +11287,10 @@ func (m *MsgSubmitFindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitFindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeFindingResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5463,7 +11314,7 @@ func (m *MsgSubmitFindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFinding) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteDispute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5486,10 +11337,10 @@ func (m *MsgEditFinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteDispute: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteDispute: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -5525,74 +11376,10 @@ func (m *MsgEditFinding) Unmarshal(dAtA []byte) error {
 			m.FindingId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FindingHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SeverityLevel", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Option", wireType)
 			}
-			m.SeverityLevel = 0
+			m.Option = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5602,14 +11389,14 @@ func (m *MsgEditFinding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SeverityLevel |= SeverityLevel(b&0x7F) << shift
+				m.Option |= DisputeVoteOption(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5637,7 +11424,7 @@ func (m *MsgEditFinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PaymentHash = string(dAtA[iNdEx:postIndex])
+			m.Voter = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5660,7 +11447,7 @@ func (m *MsgEditFinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgEditFindingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgVoteDisputeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5683,10 +11470,10 @@ func (m *MsgEditFindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgEditFindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgVoteDisputeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgEditFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgVoteDisputeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5710,7 +11497,7 @@ func (m *MsgEditFindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmFinding) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTheorem) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5733,15 +11520,47 @@ func (m *MsgConfirmFinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmFinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTheorem: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTheorem: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5758,22 +11577,88 @@ func (m *MsgConfirmFinding) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Code = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialGrant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FindingId = string(dAtA[iNdEx:postIndex])
+			m.InitialGrant = append(m.InitialGrant, types.Coin{})
+			if err := m.InitialGrant[len(m.InitialGrant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5801,13 +11686,13 @@ func (m *MsgConfirmFinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Fingerprint", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireOpenmathCert", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5817,24 +11702,31 @@ func (m *MsgConfirmFinding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			m.RequireOpenmathCert = bool(v != 0)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremType", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.TheoremType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremType |= TheoremType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Fingerprint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5856,7 +11748,7 @@ func (m *MsgConfirmFinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmFindingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateTheoremResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5879,12 +11771,31 @@ func (m *MsgConfirmFindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmFindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateTheoremResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateTheoremResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+			}
+			m.TheoremId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5906,7 +11817,7 @@ func (m *MsgConfirmFindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateFinding) Unmarshal(dAtA []byte) error {
+func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5929,15 +11840,34 @@ func (m *MsgActivateFinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateFinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+			}
+			m.TheoremId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5965,13 +11895,13 @@ func (m *MsgActivateFinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FindingId = string(dAtA[iNdEx:postIndex])
+			m.Grantor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5981,23 +11911,25 @@ func (m *MsgActivateFinding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6020,7 +11952,7 @@ func (m *MsgActivateFinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgActivateFindingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6043,10 +11975,10 @@ func (m *MsgActivateFindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgActivateFindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgActivateFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6070,7 +12002,7 @@ func (m *MsgActivateFindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmFindingPaid) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantFromCommunityPool) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6093,15 +12025,15 @@ func (m *MsgConfirmFindingPaid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmFindingPaid: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantFromCommunityPool: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmFindingPaid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantFromCommunityPool: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6129,13 +12061,32 @@ func (m *MsgConfirmFindingPaid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FindingId = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+			}
+			m.TheoremId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TheoremId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6145,23 +12096,25 @@ func (m *MsgConfirmFindingPaid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6184,7 +12137,7 @@ func (m *MsgConfirmFindingPaid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmFindingPaidResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGrantFromCommunityPoolResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6207,10 +12160,10 @@ func (m *MsgConfirmFindingPaidResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmFindingPaidResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGrantFromCommunityPoolResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmFindingPaidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGrantFromCommunityPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6234,7 +12187,7 @@ func (m *MsgConfirmFindingPaidResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCloseFinding) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawGrant) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6257,17 +12210,17 @@ func (m *MsgCloseFinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseFinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawGrant: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawGrant: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
 			}
-			var stringLen uint64
+			m.TheoremId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6277,27 +12230,14 @@ func (m *MsgCloseFinding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TheoremId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FindingId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Grantor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6325,58 +12265,8 @@ func (m *MsgCloseFinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgCloseFindingResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCloseFindingResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCloseFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
+			m.Grantor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6398,7 +12288,7 @@ func (m *MsgCloseFindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPublishFinding) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawGrantResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6421,17 +12311,17 @@ func (m *MsgPublishFinding) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPublishFinding: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawGrantResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPublishFinding: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FindingId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Refunded", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6441,29 +12331,31 @@ func (m *MsgPublishFinding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FindingId = string(dAtA[iNdEx:postIndex])
+			m.Refunded = append(m.Refunded, types.Coin{})
+			if err := m.Refunded[len(m.Refunded)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6473,93 +12365,81 @@ func (m *MsgPublishFinding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Penalty = append(m.Penalty, types.Coin{})
+			if err := m.Penalty[len(m.Penalty)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseTheorem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofOfConcept", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseTheorem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseTheorem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+			}
+			m.TheoremId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6569,27 +12449,14 @@ func (m *MsgPublishFinding) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TheoremId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ProofOfConcept = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6617,7 +12484,7 @@ func (m *MsgPublishFinding) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.Proposer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6640,7 +12507,7 @@ func (m *MsgPublishFinding) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPublishFindingResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCloseTheoremResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6663,10 +12530,10 @@ func (m *MsgPublishFindingResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPublishFindingResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCloseTheoremResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPublishFindingResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCloseTheoremResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6690,7 +12557,7 @@ func (m *MsgPublishFindingResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTheorem) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProofHash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6713,17 +12580,17 @@ func (m *MsgCreateTheorem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTheorem: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProofHash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTheorem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProofHash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
 			}
-			var stringLen uint64
+			m.TheoremId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6733,27 +12600,14 @@ func (m *MsgCreateTheorem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TheoremId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prover", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6781,11 +12635,11 @@ func (m *MsgCreateTheorem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.Prover = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Code", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6813,11 +12667,11 @@ func (m *MsgCreateTheorem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Code = string(dAtA[iNdEx:postIndex])
+			m.ProofHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialGrant", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6837,70 +12691,18 @@ func (m *MsgCreateTheorem) Unmarshal(dAtA []byte) error {
 			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.InitialGrant = append(m.InitialGrant, types.Coin{})
-			if err := m.InitialGrant[len(m.InitialGrant)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Proposer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequireOpenmathCert", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			m.RequireOpenmathCert = bool(v != 0)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = append(m.Deposit, types.Coin{})
+			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6922,7 +12724,7 @@ func (m *MsgCreateTheorem) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateTheoremResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProofHashResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6945,31 +12747,12 @@ func (m *MsgCreateTheoremResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateTheoremResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProofHashResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateTheoremResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProofHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
-			}
-			m.TheoremId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TheoremId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -6991,7 +12774,7 @@ func (m *MsgCreateTheoremResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrant) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProofDetail) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7014,17 +12797,17 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrant: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProofDetail: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProofDetail: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofId", wireType)
 			}
-			m.TheoremId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7034,14 +12817,27 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TheoremId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProofId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Grantor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prover", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7069,13 +12865,13 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Grantor = string(dAtA[iNdEx:postIndex])
+			m.Prover = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7085,25 +12881,23 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Detail = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7126,7 +12920,7 @@ func (m *MsgGrant) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProofDetailResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7149,10 +12943,10 @@ func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGrantResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProofDetailResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGrantResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProofDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7176,7 +12970,7 @@ func (m *MsgGrantResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProofHash) Unmarshal(dAtA []byte) error {
+func (m *MsgUploadProofChunk) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7199,34 +12993,15 @@ func (m *MsgSubmitProofHash) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProofHash: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUploadProofChunk: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProofHash: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUploadProofChunk: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TheoremId", wireType)
-			}
-			m.TheoremId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TheoremId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prover", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7254,11 +13029,11 @@ func (m *MsgSubmitProofHash) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prover = string(dAtA[iNdEx:postIndex])
+			m.ProofId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prover", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7286,13 +13061,13 @@ func (m *MsgSubmitProofHash) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ProofHash = string(dAtA[iNdEx:postIndex])
+			m.Prover = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
-			var msglen int
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -7302,24 +13077,24 @@ func (m *MsgSubmitProofHash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Deposit = append(m.Deposit, types.Coin{})
-			if err := m.Deposit[len(m.Deposit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
 			}
 			iNdEx = postIndex
 		default:
@@ -7343,7 +13118,7 @@ func (m *MsgSubmitProofHash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProofHashResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUploadProofChunkResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7366,12 +13141,44 @@ func (m *MsgSubmitProofHashResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProofHashResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUploadProofChunkResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProofHashResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUploadProofChunkResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7393,7 +13200,7 @@ func (m *MsgSubmitProofHashResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProofDetail) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProofDetailChunks) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7416,10 +13223,10 @@ func (m *MsgSubmitProofDetail) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProofDetail: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProofDetailChunks: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProofDetail: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProofDetailChunks: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7488,7 +13295,7 @@ func (m *MsgSubmitProofDetail) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Detail", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChunkHashes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7516,8 +13323,28 @@ func (m *MsgSubmitProofDetail) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Detail = string(dAtA[iNdEx:postIndex])
+			m.ChunkHashes = append(m.ChunkHashes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Compressed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Compressed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -7539,7 +13366,7 @@ func (m *MsgSubmitProofDetail) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitProofDetailResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitProofDetailChunksResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7562,10 +13389,10 @@ func (m *MsgSubmitProofDetailResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitProofDetailResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitProofDetailChunksResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitProofDetailResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitProofDetailChunksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7997,6 +13824,74 @@ func (m *MsgWithdrawRewardResponse) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: MsgWithdrawRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vesting", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Vesting = append(m.Vesting, types.Coin{})
+			if err := m.Vesting[len(m.Vesting)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -24,6 +24,9 @@ func ValidateTheoremType(t TheoremType) error {
 	if t == TheoremType_THEOREM_TYPE_UNSPECIFIED {
 		return errorsmod.Wrap(ErrInvalidContent, "theorem type must be specified")
 	}
+	if _, ok := TheoremType_name[int32(t)]; !ok {
+		return errorsmod.Wrapf(ErrInvalidContent, "invalid theorem type %d", t)
+	}
 	return nil
 }
 
@@ -61,11 +64,61 @@ func ValidateProgram(program *Program) error {
 		return errorsmod.Wrapf(err, "invalid admin address %s", program.AdminAddress)
 	}
 
+	if err := sdk.Coins(program.RewardPool).Validate(); err != nil {
+		return errorsmod.Wrapf(err, "invalid reward pool %s", program.RewardPool)
+	}
+
+	if err := ValidateRewardSchedule(program.RewardSchedule); err != nil {
+		return err
+	}
+
+	// programs created before duplicate policies were introduced have none
+	if program.DuplicatePolicy != DuplicatePolicyUnspecified && !ValidDuplicatePolicy(program.DuplicatePolicy) {
+		return errorsmod.Wrapf(ErrProgramDuplicatePolicyInvalid, "%s", program.DuplicatePolicy)
+	}
+
+	if err := ValidateFindingSLA(program.ActivationSla); err != nil {
+		return err
+	}
+
+	if err := ValidateFindingSLA(program.ConfirmationSla); err != nil {
+		return err
+	}
+
+	if err := ValidateDisclosureEmbargo(program.DisclosureEmbargo); err != nil {
+		return err
+	}
+
+	if err := ValidateScope(program.Scope); err != nil {
+		return err
+	}
+
 	// Other program validations can be added here
 
 	return nil
 }
 
+// ValidateProgramMember validates a program member
+func ValidateProgramMember(member *ProgramMember) error {
+	if member == nil {
+		return errorsmod.Wrap(ErrProgramMemberInvalid, "program member cannot be nil")
+	}
+
+	if len(member.ProgramId) == 0 {
+		return errorsmod.Wrap(ErrProgramID, "program id cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(member.Address); err != nil {
+		return errorsmod.Wrapf(err, "invalid member address %s", member.Address)
+	}
+
+	if !ValidProgramRole(member.Role) {
+		return errorsmod.Wrapf(ErrProgramMemberInvalid, "invalid role %s", member.Role)
+	}
+
+	return nil
+}
+
 // ValidateFinding validates a finding
 func ValidateFinding(finding *Finding) error {
 	if finding == nil {
@@ -92,9 +145,90 @@ func ValidateFinding(finding *Finding) error {
 		return errorsmod.Wrap(ErrFindingSeverityLevelInvalid, "invalid finding severity level")
 	}
 
+	// duplicates paid a share of the reward of the original finding keep referencing it
+	if finding.Status == FindingStatusDuplicate && len(finding.DuplicateOf) == 0 {
+		return errorsmod.Wrap(ErrFindingDuplicateInvalid, "duplicate finding must reference an original finding")
+	}
+	if len(finding.DuplicateOf) != 0 && finding.Status != FindingStatusDuplicate && finding.Status != FindingStatusPaid {
+		return errorsmod.Wrap(ErrFindingDuplicateInvalid, "only duplicate findings reference an original finding")
+	}
+
+	if finding.DuplicateOf == finding.FindingId {
+		return errorsmod.Wrap(ErrFindingDuplicateInvalid, "finding cannot be a duplicate of itself")
+	}
+
+	if finding.SlaDeadline != nil && finding.Status != FindingStatusSubmitted && finding.Status != FindingStatusActive {
+		return errorsmod.Wrapf(ErrFindingStatusInvalid, "%s finding cannot have an SLA deadline", finding.Status)
+	}
+
+	if finding.DisclosureDeadline != nil && finding.Status != FindingStatusPaid && finding.Status != FindingStatusClosed {
+		return errorsmod.Wrapf(ErrFindingStatusInvalid, "%s finding cannot have a disclosure deadline", finding.Status)
+	}
+
+	if finding.DisclosureOverdue && finding.DisclosureDeadline == nil {
+		return errorsmod.Wrap(ErrFindingStatusInvalid, "overdue finding disclosure must have a deadline")
+	}
+
+	return nil
+}
+
+// ValidateDispute validates a dispute
+func ValidateDispute(dispute *Dispute) error {
+	if dispute == nil {
+		return errorsmod.Wrap(ErrDisputeNotExists, "dispute cannot be nil")
+	}
+
+	if len(dispute.FindingId) == 0 {
+		return errorsmod.Wrap(ErrFindingID, "finding id cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(dispute.DisputerAddress); err != nil {
+		return errorsmod.Wrapf(err, "invalid disputer address %s", dispute.DisputerAddress)
+	}
+
+	if dispute.Status != DisputeStatusVoting && dispute.Status != DisputeStatusUpheld && dispute.Status != DisputeStatusOverturned {
+		return errorsmod.Wrapf(ErrFindingDisputeNotAllowed, "invalid dispute status %s", dispute.Status)
+	}
+
+	if dispute.EndTime.Before(dispute.CreateTime) {
+		return errorsmod.Wrap(ErrFindingDisputeNotAllowed, "dispute end time must not be before create time")
+	}
+
 	return nil
 }
 
+// ValidateHackerReputation validates a hacker reputation record.
+func ValidateHackerReputation(reputation *HackerReputation) error {
+	if reputation == nil {
+		return errorsmod.Wrap(ErrHackerReputationInvalid, "reputation cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(reputation.Address); err != nil {
+		return errorsmod.Wrapf(err, "invalid hacker address %s", reputation.Address)
+	}
+
+	seen := make(map[SeverityLevel]bool, len(reputation.Confirmed))
+	for _, count := range reputation.Confirmed {
+		if !ValidFindingSeverityLevel(count.SeverityLevel) || seen[count.SeverityLevel] {
+			return errorsmod.Wrapf(ErrHackerReputationInvalid, "invalid confirmed count for %s", count.SeverityLevel)
+		}
+		seen[count.SeverityLevel] = true
+	}
+	if reputation.PaidFindings > reputation.ConfirmedFindings() {
+		return errorsmod.Wrap(ErrHackerReputationInvalid, "paid findings exceed confirmed findings")
+	}
+
+	if err := reputation.TotalPaid.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrHackerReputationInvalid, "invalid total paid: %s", err)
+	}
+	return nil
+}
+
+// ValidDisputeVoteOption returns true if the option can be cast on a dispute.
+func ValidDisputeVoteOption(option DisputeVoteOption) bool {
+	return option == DisputeVoteOptionUphold || option == DisputeVoteOptionOverturn
+}
+
 // ValidFindingStatus returns true if the finding status is valid and false
 // otherwise.
 func ValidFindingStatus(status FindingStatus) bool {
@@ -102,7 +236,10 @@ func ValidFindingStatus(status FindingStatus) bool {
 		status == FindingStatusActive ||
 		status == FindingStatusConfirmed ||
 		status == FindingStatusPaid ||
-		status == FindingStatusClosed {
+		status == FindingStatusClosed ||
+		status == FindingStatusDisputed ||
+		status == FindingStatusDuplicate ||
+		status == FindingStatusEscalated {
 		return true
 	}
 	return false
@@ -146,6 +283,33 @@ func ValidateTheorem(theorem *Theorem, maxComplexity int64) error {
 		return errorsmod.Wrap(ErrInvalidContent, "active theorem must have an end time")
 	}
 
+	// The forfeited deposits are part of the total grant
+	forfeited := sdk.NewCoins(theorem.ForfeitedDeposits...)
+	if !forfeited.IsZero() && !sdk.NewCoins(theorem.TotalGrant...).IsAllGTE(forfeited) {
+		return errorsmod.Wrapf(ErrInvalidContent, "forfeited deposits %s exceed the total grant %s", forfeited, sdk.NewCoins(theorem.TotalGrant...))
+	}
+
+	return nil
+}
+
+// ValidateSponsorship validates a program sponsorship
+func ValidateSponsorship(sponsorship *Sponsorship) error {
+	if sponsorship == nil {
+		return errorsmod.Wrap(ErrProgramSponsorshipInvalid, "sponsorship cannot be nil")
+	}
+
+	if len(sponsorship.ProgramId) == 0 {
+		return errorsmod.Wrap(ErrProgramID, "sponsorship program id cannot be empty")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(sponsorship.Sponsor); err != nil {
+		return errorsmod.Wrapf(err, "invalid sponsor address %s", sponsorship.Sponsor)
+	}
+
+	if !sponsorship.Amount.IsValid() || sponsorship.Amount.IsZero() {
+		return errorsmod.Wrapf(ErrProgramSponsorshipInvalid, "invalid amount %s", sponsorship.Amount)
+	}
+
 	return nil
 }
 
@@ -218,6 +382,75 @@ func ValidateReward(reward *Reward) error {
 	return nil
 }
 
+// ValidateRewardVesting validates the reward vesting schedules of an address
+func ValidateRewardVesting(vesting *RewardVesting) error {
+	if vesting == nil {
+		return errorsmod.Wrap(ErrRewardVestingInvalid, "reward vesting cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(vesting.Address); err != nil {
+		return errorsmod.Wrapf(err, "invalid reward vesting address %s", vesting.Address)
+	}
+
+	if len(vesting.Schedules) == 0 {
+		return errorsmod.Wrap(ErrRewardVestingInvalid, "reward vesting must have a schedule")
+	}
+	if len(vesting.Schedules) > MaxRewardVestingSchedules {
+		return errorsmod.Wrapf(ErrRewardVestingInvalid, "too many schedules: %d > %d", len(vesting.Schedules), MaxRewardVestingSchedules)
+	}
+
+	for _, schedule := range vesting.Schedules {
+		if !schedule.Total.IsValid() || schedule.Total.IsZero() {
+			return errorsmod.Wrapf(ErrRewardVestingInvalid, "invalid total %s", schedule.Total)
+		}
+
+		if !schedule.Released.IsValid() || !schedule.Total.IsAllGTE(schedule.Released) || schedule.Released.Equal(schedule.Total) {
+			return errorsmod.Wrapf(ErrRewardVestingInvalid, "invalid released %s of total %s", schedule.Released, schedule.Total)
+		}
+
+		if !schedule.EndTime.After(schedule.StartTime) {
+			return errorsmod.Wrap(ErrRewardVestingInvalid, "end time must be after start time")
+		}
+	}
+
+	return nil
+}
+
+// ValidateOpenMathStats validates the OpenMath statistics of an address
+func ValidateOpenMathStats(stats *OpenMathStats) error {
+	if stats == nil {
+		return errorsmod.Wrap(ErrOpenMathStatsInvalid, "statistics cannot be nil")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(stats.Address); err != nil {
+		return errorsmod.Wrapf(err, "invalid statistics address %s", stats.Address)
+	}
+
+	return validateStatsRewards(stats.ProverRewards, stats.CheckerRewards, stats.ImportedRewards)
+}
+
+// ValidateTheoremTypeStats validates the OpenMath statistics of a theorem type
+func ValidateTheoremTypeStats(stats *TheoremTypeStats) error {
+	if stats == nil {
+		return errorsmod.Wrap(ErrOpenMathStatsInvalid, "statistics cannot be nil")
+	}
+
+	if _, ok := TheoremType_name[int32(stats.TheoremType)]; !ok {
+		return errorsmod.Wrapf(ErrOpenMathStatsInvalid, "invalid theorem type %d", stats.TheoremType)
+	}
+
+	return validateStatsRewards(stats.ProverRewards, stats.CheckerRewards, stats.ImportedRewards)
+}
+
+func validateStatsRewards(rewards ...sdk.DecCoins) error {
+	for _, reward := range rewards {
+		if err := reward.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrOpenMathStatsInvalid, "invalid rewards %s: %s", reward, err)
+		}
+	}
+	return nil
+}
+
 // ValidateProof validates a proof
 func ValidateProof(proof *Proof) error {
 	if proof == nil {
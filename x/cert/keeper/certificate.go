@@ -427,3 +427,8 @@ func (k Keeper) IsBountyAdmin(ctx context.Context, address sdk.AccAddress) bool
 func (k Keeper) IsOpenMathCertified(ctx context.Context, address sdk.AccAddress) bool {
 	return k.hasTypedCertForAddr(ctx, types.CertificateTypeOpenMath, address)
 }
+
+// IsIdentityCertified checks if an address holds an identity certificate.
+func (k Keeper) IsIdentityCertified(ctx context.Context, address sdk.AccAddress) bool {
+	return k.hasTypedCertForAddr(ctx, types.CertificateTypeIdentity, address)
+}